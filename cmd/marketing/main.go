@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/marketing"
+	"go.uber.org/zap"
+)
+
+// CLI reconciles the marketing audience with the users of the environment configured by the
+// IMPART_ environment variables, the same ones the server reads.
+type CLI struct {
+	Reconcile ReconcileCmd `cmd:"true" help:"Compares the users with the members of the marketing audience and prints the differences."`
+}
+
+type ReconcileCmd struct {
+	Fix     bool          `short:"f" help:"queue the fixes of the differences, the server pushes them to the audience"`
+	Prune   bool          `help:"with --fix, also remove the members that are not users; they may have signed up elsewhere"`
+	Timeout time.Duration `default:"10m" help:"how long the reconciliation may take"`
+}
+
+func (c *ReconcileCmd) Run(cfg *config.Impart, logger *zap.Logger) error {
+	db, err := cfg.GetDBConnection()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+	svc := marketing.New(db, marketing.NewAudience(cfg, logger), logger)
+	out, err := svc.Reconcile(ctx, marketing.ReconcileOptions{Fix: c.Fix, Prune: c.Prune})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func main() {
+	logger, _ := zap.NewProduction()
+	defer logger.Sync()
+
+	cfg, err := config.GetImpart()
+	if err != nil {
+		logger.Fatal("error parsing config", zap.Error(err))
+	}
+
+	cli := CLI{}
+	ctx := kong.Parse(&cli,
+		kong.Name("marketing"),
+		kong.Description("manages the marketing audience of the impart wealth users"),
+		kong.UsageOnError(),
+		kong.ConfigureHelp(kong.HelpOptions{
+			Compact: true,
+		}))
+	ctx.Bind(cfg, logger)
+	if err := ctx.Run(); err != nil {
+		logger.Fatal("error executing command", zap.Error(err))
+	}
+}
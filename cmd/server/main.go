@@ -11,11 +11,18 @@ import (
 	"syscall"
 	"time"
 
-	mailchimp "github.com/beeker1121/mailchimp-go"
 	"github.com/impartwealthapp/backend/pkg/data/migrater"
+	"github.com/impartwealthapp/backend/pkg/deletion"
+	"github.com/impartwealthapp/backend/pkg/export"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/impartwealthapp/backend/pkg/moderation"
+	"github.com/impartwealthapp/backend/pkg/notification"
 	"github.com/impartwealthapp/backend/pkg/plaid"
+	"github.com/impartwealthapp/backend/pkg/ratelimit"
+	"github.com/impartwealthapp/backend/pkg/scheduler"
 	"github.com/impartwealthapp/backend/pkg/secure"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
@@ -25,6 +32,7 @@ import (
 	"github.com/impartwealthapp/backend/pkg/auth"
 	hivedata "github.com/impartwealthapp/backend/pkg/data/hive"
 	profiledata "github.com/impartwealthapp/backend/pkg/data/profile"
+	"github.com/impartwealthapp/backend/pkg/digest"
 	"github.com/impartwealthapp/backend/pkg/hive"
 	"github.com/impartwealthapp/backend/pkg/impart"
 	"github.com/impartwealthapp/backend/pkg/profile"
@@ -114,12 +122,24 @@ func main() {
 	// 	logger.Fatal("unable to bootstrap user", zap.Error(err))
 	// }
 
-	// initiate global profanity detector
-	impart.InitProfanityDetector(db, logger)
+	// initiate global profanity detector, reloaded when the word list changes
+	profanityFilter := impart.InitProfanityDetector(db, logger)
+	profanityCtx, stopProfanityWatch := context.WithCancel(context.Background())
+	defer stopProfanityWatch()
+	go profanityFilter.Watch(profanityCtx, db, cfg.ProfanityReloadInterval, logger)
 
 	services := setupServices(cfg, db, logger)
 
+	// prometheus metrics on the admin port, away from the public api
+	if metricsServer := startMetricsServer(cfg, db, logger); metricsServer != nil {
+		defer metricsServer.Close()
+	}
+
 	r := gin.New()
+	r.Use(impart.RequestIDHandler()) // X-Request-ID on the context, the logs and the responses
+	if cfg.Metrics.Enabled {
+		r.Use(metrics.HTTPHandler()) // request metrics by route template
+	}
 	r.Use(CORS)
 	r.Use(secure.Secure(secure.Options{
 		//AllowedHosts:          []string{"*"},
@@ -134,8 +154,8 @@ func main() {
 		ContentSecurityPolicy: "default-src 'self'",
 	}))
 	r.RedirectTrailingSlash = true
-	r.Use(ginzap.RecoveryWithZap(logger, true))      // panics don't stop server
-	r.Use(ginzap.Ginzap(logger, time.RFC3339, true)) // logs all requests
+	r.Use(ginzap.RecoveryWithZap(logger, true)) // panics don't stop server
+	r.Use(impart.AccessLogHandler(logger))      // logs all requests
 
 	r.NoRoute(noRouteFunc)
 	r.GET("/ping", func(ctx *gin.Context) {
@@ -154,20 +174,25 @@ func main() {
 		v1Route = fmt.Sprintf("%s/v1", cfg.Env)
 		v2Route = fmt.Sprintf("%s/v1.1", cfg.Env)
 	}
-	logger.Info("Mailcimp -", zap.Any("MailchimpApikey", cfg.MailchimpApikey),
-		zap.Any("MailchimpAudienceId", cfg.MailchimpAudienceId))
-	err = mailchimp.SetKey(cfg.MailchimpApikey)
-	if err != nil {
-		logger.Info("Error connecting Mailchimp", zap.Error(err),
-			zap.Any("MailchimpApikey", cfg.MailchimpApikey))
-	}
-
 	v1 := r.Group(v1Route)
 	setRouter(v1, services, logger, db)
 
 	v2 := r.Group(v2Route)
 	setRouter(v2, services, logger, db)
 
+	// links opened from emails carry no api key or JWT
+	public := r.Group(v1Route)
+	if services.RateLimiter != nil {
+		public.Use(services.RateLimiter.Handler(public.BasePath()))
+	}
+	digest.SetupRoutes(public, services.Digest, logger)
+	hive.SetupMediaRoutes(public, services.Hive, logger)
+
+	if cfg.Scheduler.Enabled {
+		services.Scheduler.Start()
+		defer services.Scheduler.Stop()
+	}
+
 	server := cfg.GetHttpServer()
 	server.Handler = r
 	logger.Info("Impart backend started.", zap.Int("port", cfg.Port), zap.String("env", string(cfg.Env)))
@@ -182,10 +207,18 @@ func setRouter(router *gin.RouterGroup, services *Services, logger *zap.Logger,
 	router.Use(services.Auth.ClientIdentificationHandler()) //context for client identification
 	router.Use(services.Auth.RequestAuthorizationHandler()) //ensure request has valid JWT
 	router.Use(services.Auth.DeviceIdentificationHandler()) //context for device identification
+	if services.RateLimiter != nil {
+		router.Use(services.RateLimiter.Handler(router.BasePath())) //throttle by token or ip
+	}
 	router.GET("/tags", func(ctx *gin.Context) { ctx.JSON(http.StatusOK, tags.AvailableTags()) })
 
 	hive.SetupRoutes(router, db, services.HiveData, services.Hive, logger)
 	profile.SetupRoutes(router, services.ProfileData, services.Profile, logger, services.Notifications, services.Plaid)
+	scheduler.SetupRoutes(router, services.Scheduler, logger)
+	notification.SetupRoutes(router, services.Inbox, logger)
+	moderation.SetupRoutes(router, services.Moderation, logger)
+	export.SetupRoutes(router, services.Export, logger)
+	deletion.SetupRoutes(router, services.Deletion, logger)
 }
 
 func noRouteFunc(ctx *gin.Context) {
@@ -201,6 +234,16 @@ type Services struct {
 	Notifications impart.NotificationService
 	MediaStorage  media.StorageConfigurations
 	Plaid         plaid.Service
+	Scheduler     scheduler.Scheduler
+	Inbox         notification.Service
+	Emails        impart.EmailService
+	Digest        digest.Service
+	Moderation    moderation.Service
+	Export        export.Service
+	Deletion      deletion.Service
+	Audience      marketing.Audience
+	Marketing     marketing.Service
+	RateLimiter   *ratelimit.Limiter
 }
 
 func setupServices(cfg *config.Impart, db *sql.DB, logger *zap.Logger) *Services {
@@ -208,9 +251,9 @@ func setupServices(cfg *config.Impart, db *sql.DB, logger *zap.Logger) *Services
 	svcs := &Services{}
 
 	if cfg.Env == config.Local {
-		svcs.Notifications = impart.NewNoopNotificationService()
+		svcs.Notifications = impart.NewInboxNotificationService(impart.NewNoopNotificationService(), db, logger)
 	} else {
-		svcs.Notifications = impart.NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, logger)
+		svcs.Notifications = impart.NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, cfg.AndroidNotificationARN, logger)
 	}
 
 	svcs.ProfileData = profiledata.NewMySQLStore(db, logger, svcs.Notifications)
@@ -228,14 +271,115 @@ func setupServices(cfg *config.Impart, db *sql.DB, logger *zap.Logger) *Services
 	}
 
 	svcs.MediaStorage = media.LoadMediaConfig(cfg)
+	if err := svcs.MediaStorage.Validate(); err != nil {
+		logger.Fatal("invalid media storage", zap.Error(err))
+	}
 	svcs.Hive = hive.New(cfg, db, logger, svcs.MediaStorage)
 	svcs.Plaid = plaid.New(db, logger, svcs.Hive)
+	svcs.Audience = marketing.NewAudience(cfg, logger)
+	svcs.Marketing = marketing.New(db, svcs.Audience, logger)
+	svcs.Deletion = deletion.New(db, media.New(svcs.MediaStorage), svcs.Notifications, svcs.Plaid, svcs.Audience, logger)
+
+	svcs.Profile = profile.New(logger.Sugar(), db, svcs.ProfileData, svcs.Notifications, profileValidator, string(cfg.Env), svcs.Hive, svcs.HiveData, svcs.Deletion)
 
-	svcs.Profile = profile.New(logger.Sugar(), db, svcs.ProfileData, svcs.Notifications, profileValidator, string(cfg.Env), svcs.Hive, svcs.HiveData)
+	svcs.Inbox = notification.New(db, logger)
+
+	if cfg.Env == config.Local && cfg.Email.Sink == config.SESEmailSink {
+		svcs.Emails = impart.NewFileEmailService(cfg.Email.FileDir, cfg.Email.From, logger)
+	} else {
+		svcs.Emails = impart.NewEmailService(cfg, db, logger)
+	}
+	svcs.Digest, err = digest.New(cfg, db, svcs.Emails, svcs.ProfileData, logger)
+	if err != nil {
+		logger.Fatal("unable to create the weekly digest", zap.Error(err))
+	}
+
+	svcs.Moderation = moderation.New(db, impart.ProfanityDetector, svcs.Notifications, logger)
+	svcs.Export = export.New(db, media.New(svcs.MediaStorage), svcs.Notifications, logger)
+
+	svcs.RateLimiter = newRateLimiter(cfg, db, logger)
+
+	svcs.Scheduler = scheduler.New(db, logger)
+	registerJobs(cfg, db, svcs, logger)
 
 	return svcs
 }
 
+// registerJobs adds the recurring jobs to the scheduler, the schedules are read from the config.
+func registerJobs(cfg *config.Impart, db *sql.DB, svcs *Services, logger *zap.Logger) {
+	mediaProcessor := media.NewProcessor(db, media.New(svcs.MediaStorage), logger)
+	jobs := []scheduler.Job{
+		{
+			Name:     "weekly-activity",
+			Schedule: cfg.Scheduler.WeeklyActivity,
+			Run: func(ctx context.Context) error {
+				return impart.NotifyWeeklyActivity(db, logger)
+			},
+		},
+		{
+			Name:     "weekly-popular-post",
+			Schedule: cfg.Scheduler.WeeklyPopularPost,
+			Run: func(ctx context.Context) error {
+				return impart.NotifyWeeklyMostPopularPost(db, logger)
+			},
+		},
+		{
+			Name:     "hive-notification",
+			Schedule: cfg.Scheduler.HiveNotification,
+			Run:      svcs.ProfileData.GetHiveNotification,
+		},
+		{
+			Name:     "weekly-digest",
+			Schedule: cfg.Scheduler.WeeklyDigest,
+			Run:      svcs.Digest.SendWeeklyDigest,
+		},
+		{
+			Name:     "demographics",
+			Schedule: cfg.Scheduler.Demographics,
+			Run: func(ctx context.Context) error {
+				return impart.UserDemographicsUpdate(ctx, db, true, true)
+			},
+		},
+		{
+			Name:     "media-processing",
+			Schedule: cfg.Scheduler.MediaProcessing,
+			Run:      mediaProcessor.ProcessPending,
+		},
+		{
+			Name:     "media-cleanup",
+			Schedule: cfg.Scheduler.MediaCleanup,
+			Run:      mediaProcessor.CleanupUploads,
+		},
+		{
+			Name:     "data-export",
+			Schedule: cfg.Scheduler.DataExport,
+			Run:      svcs.Export.ProcessPending,
+		},
+		{
+			Name:     "account-purge",
+			Schedule: cfg.Scheduler.AccountPurge,
+			Run:      svcs.Deletion.PurgeDue,
+		},
+		{
+			Name:     "marketing-sync",
+			Schedule: cfg.Scheduler.MarketingSync,
+			Run:      svcs.Marketing.ProcessQueue,
+		},
+	}
+	if svcs.RateLimiter != nil {
+		jobs = append(jobs, scheduler.Job{
+			Name:     "rate-limit-cleanup",
+			Schedule: cfg.Scheduler.RateLimitCleanup,
+			Run:      svcs.RateLimiter.Cleanup,
+		})
+	}
+	for _, job := range jobs {
+		if err := svcs.Scheduler.Register(job); err != nil {
+			logger.Fatal("unable to register scheduled job", zap.String("job", job.Name), zap.Error(err))
+		}
+	}
+}
+
 func CORS(c *gin.Context) {
 
 	// First, we add the headers with need to enable CORS
@@ -261,7 +405,8 @@ func CORS(c *gin.Context) {
 	c.Header("Access-Control-Allow-Origin", cfg.AllowOrigin)
 	c.Header("Access-Control-Allow-Methods", "POST,GET,PATCH,PUT,DELETE,OPTION")
 	//access-control-allow-origin,authorization,content-type,x-api-key,x-client-identity
-	c.Header("Access-Control-Allow-Headers", "access-control-allow-origin,authorization,content-type,x-api-key,x-client-identity, set-cookie")
+	c.Header("Access-Control-Allow-Headers", "access-control-allow-origin,authorization,content-type,x-api-key,x-client-identity, set-cookie,x-request-id")
+	c.Header("Access-Control-Expose-Headers", "x-request-id")
 	c.Header("Access-Control-Allow-Credentials", "true")
 	c.Header("Content-Type", "application/json")
 
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// startMetricsServer serves /metrics on the admin port, it returns nil when the metrics are disabled
+func startMetricsServer(cfg *config.Impart, db *sql.DB, logger *zap.Logger) *http.Server {
+	if !cfg.Metrics.Enabled {
+		return nil
+	}
+	if err := metrics.RegisterDB("impart", db); err != nil {
+		logger.Error("unable to register the database metrics", zap.Error(err))
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	server := cfg.GetMetricsServer()
+	server.Handler = mux
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("error serving metrics", zap.Error(err))
+		}
+	}()
+	logger.Info("metrics served", zap.Int("port", cfg.Metrics.Port))
+	return server
+}
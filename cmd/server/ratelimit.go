@@ -0,0 +1,51 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/ratelimit"
+	"go.uber.org/zap"
+)
+
+// defaultRateLimit applies to every route without a policy of its own
+var defaultRateLimit = ratelimit.Policy{Name: "default", Limit: 300, Window: time.Minute}
+
+var (
+	createPostLimit         = ratelimit.Policy{Name: "create-post", Limit: 10, Window: 10 * time.Minute}
+	createCommentLimit      = ratelimit.Policy{Name: "create-comment", Limit: 30, Window: 10 * time.Minute}
+	reportLimit             = ratelimit.Policy{Name: "report", Limit: 20, Window: time.Hour}
+	validateScreenNameLimit = ratelimit.Policy{Name: "validate-screen-name", Limit: 30, Window: time.Minute}
+	sendEmailLimit          = ratelimit.Policy{Name: "send-email", Limit: 3, Window: time.Hour}
+)
+
+// rateLimitRoutes are the routes limited tighter than the default, the paths are relative to the
+// version group. Reports share their route with the reactions and carry the report parameter.
+var rateLimitRoutes = []ratelimit.Route{
+	{Method: http.MethodPost, Path: "/hives/:hiveId/posts", Policy: createPostLimit},
+	{Method: http.MethodPost, Path: "/hives/:hiveId/posts/:postId/comments", Policy: createCommentLimit},
+	{Method: http.MethodPost, Path: "/hives/:hiveId/posts/:postId", Query: "report", Policy: reportLimit},
+	{Method: http.MethodPost, Path: "/hives/:hiveId/posts/:postId/comments/:commentId", Query: "report", Policy: reportLimit},
+	{Method: http.MethodPost, Path: "/profiles/validate/screen-name", Policy: validateScreenNameLimit},
+	{Method: http.MethodPost, Path: "/profiles/send-email", Policy: sendEmailLimit},
+}
+
+// newRateLimiter returns the limiter of the api, nil when rate limiting is disabled
+func newRateLimiter(cfg *config.Impart, db *sql.DB, logger *zap.Logger) *ratelimit.Limiter {
+	if !cfg.RateLimit.Enabled {
+		return nil
+	}
+	store := ratelimit.NewMemoryStore()
+	if cfg.RateLimit.Store == config.MySQLRateLimitStore {
+		store = ratelimit.NewMySQLStore(db)
+	}
+	routes := make([]ratelimit.Route, len(rateLimitRoutes))
+	copy(routes, rateLimitRoutes)
+	limiter := ratelimit.New(store, defaultRateLimit, routes, logger)
+	if err := limiter.Override(cfg.RateLimit.Policies); err != nil {
+		logger.Fatal("invalid rate limit policies", zap.Error(err))
+	}
+	return limiter
+}
@@ -48,6 +48,14 @@ variable "deployments" {
           key = "IOS_NOTIFICATION_ARN",
           value = ""
         },
+        {
+          key = "ANDROID_NOTIFICATION_ARN",
+          value = ""
+        },
+        {
+          key = "EMAIL_UNSUBSCRIBE_URL",
+          value = "https://app.impartwealth.com/dev/v1/email/unsubscribe"
+        },
         {
           key = "PROFILE_SCHEMA_PATH",
           value = "./schemas/json/Profile.json"
@@ -112,6 +120,14 @@ variable "deployments" {
           key = "IOS_NOTIFICATION_ARN",
           value = ""
         },
+        {
+          key = "ANDROID_NOTIFICATION_ARN",
+          value = ""
+        },
+        {
+          key = "EMAIL_UNSUBSCRIBE_URL",
+          value = "https://app.impartwealth.com/iosdev/v1/email/unsubscribe"
+        },
         {
           key = "PROFILE_SCHEMA_PATH",
           value = "./schemas/json/Profile.json"
@@ -176,6 +192,14 @@ variable "deployments" {
           key = "IOS_NOTIFICATION_ARN",
           value = ""
         },
+        {
+          key = "ANDROID_NOTIFICATION_ARN",
+          value = ""
+        },
+        {
+          key = "EMAIL_UNSUBSCRIBE_URL",
+          value = "https://app.impartwealth.com/preprod/v1/email/unsubscribe"
+        },
         {
           key = "PROFILE_SCHEMA_PATH",
           value = "./schemas/json/Profile.json"
@@ -240,6 +264,14 @@ variable "deployments" {
           key = "IOS_NOTIFICATION_ARN",
           value = ""
         },
+        {
+          key = "ANDROID_NOTIFICATION_ARN",
+          value = ""
+        },
+        {
+          key = "EMAIL_UNSUBSCRIBE_URL",
+          value = "https://app.impartwealth.com/v1/email/unsubscribe"
+        },
         {
           key = "PROFILE_SCHEMA_PATH",
           value = "./schemas/json/Profile.json"
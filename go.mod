@@ -26,6 +26,8 @@ require (
 	github.com/pcpratheesh/go-censorword v1.1.0
 	github.com/pkg/errors v0.9.1
 	github.com/plaid/plaid-go v1.2.0
+	github.com/prometheus/client_golang v1.10.0
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/segmentio/ksuid v1.0.3
 	github.com/shopspring/decimal v1.2.0
 	github.com/stretchr/testify v1.7.0
@@ -34,7 +36,7 @@ require (
 	github.com/volatiletech/strmangle v0.0.1
 	github.com/xeipuuv/gojsonschema v1.2.0
 	go.uber.org/zap v1.16.0
-	golang.org/x/sync v0.0.0-20201020160332-67f06af15bc9
+	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
 	gopkg.in/auth0.v5 v5.11.0
 	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776 // indirect
 )
@@ -36,6 +36,97 @@ type Media struct {
 	BucketRegion string `split_words:"true"`
 }
 
+// scheduler configurations, each job takes a standard 5 field cron expression (UTC),
+// an empty expression disables the job.
+type Scheduler struct {
+	Enabled           bool   `split_words:"true" default:"false"`
+	WeeklyActivity    string `split_words:"true" default:"0 16 * * 5"`
+	WeeklyPopularPost string `split_words:"true" default:"0 16 * * 2"`
+	HiveNotification  string `split_words:"true" default:"0 15 * * *"`
+	Demographics      string `split_words:"true" default:"30 3 * * *"`
+	WeeklyDigest      string `split_words:"true" default:"0 15 * * 0"`
+	MediaProcessing   string `split_words:"true" default:"*/5 * * * *"`
+	MediaCleanup      string `split_words:"true" default:"15 * * * *"`
+	DataExport        string `split_words:"true" default:"*/10 * * * *"`
+	AccountPurge      string `split_words:"true" default:"45 * * * *"`
+	MarketingSync     string `split_words:"true" default:"*/5 * * * *"`
+	RateLimitCleanup  string `split_words:"true" default:"*/15 * * * *"`
+}
+
+const (
+	SESEmailSink  = "ses"
+	SMTPEmailSink = "smtp"
+	FileEmailSink = "file"
+	NoopEmailSink = "noop"
+)
+
+// email configurations, the sink decides where outgoing email is delivered;
+// ses in the deployed environments, smtp (e.g. mailhog) or file for local development and tests.
+// UnsubscribeSecret signs the unsubscribe links of the digest and is required.
+type Email struct {
+	Sink              string `split_words:"true" default:"ses"`
+	From              string `split_words:"true" default:"support@impartwealth.com"`
+	SMTPAddr          string `split_words:"true" default:"localhost:1025"`
+	FileDir           string `split_words:"true" default:"./tmp/emails"`
+	UnsubscribeURL    string `split_words:"true" default:"http://localhost:8080/v1/email/unsubscribe"`
+	UnsubscribeSecret string `split_words:"true"`
+}
+
+const (
+	MailchimpMarketingSink = "mailchimp"
+	FileMarketingSink      = "file"
+	NoopMarketingSink      = "noop"
+)
+
+// marketing configurations, the sink decides where the members are synced to; the mailchimp
+// audience of MailchimpAudienceId in the deployed environments, a json file for local development.
+type Marketing struct {
+	Sink     string `split_words:"true" default:"mailchimp"`
+	FilePath string `split_words:"true" default:"./tmp/marketing/audience.json"`
+}
+
+const (
+	MemoryRateLimitStore = "memory"
+	MySQLRateLimitStore  = "mysql"
+)
+
+// rate limit configurations, the store keeps the request counts; in memory every instance has its
+// own limits, mysql shares them. Policies overrides the limit of the named policies as
+// name:limit/window pairs, e.g. create-post:5/1m,send-email:1/1h.
+type RateLimit struct {
+	Enabled  bool              `split_words:"true" default:"true"`
+	Store    string            `split_words:"true" default:"memory"`
+	Policies map[string]string `split_words:"true"`
+}
+
+// metrics configurations, the prometheus metrics are served on their own admin port so they are
+// not reachable through the public api
+type Metrics struct {
+	Enabled bool `split_words:"true" default:"true"`
+	Port    int  `split_words:"true" default:"9090"`
+}
+
+// link preview configurations, limits applied when fetching the open graph details of a posted link
+type LinkPreview struct {
+	Timeout      time.Duration `split_words:"true" default:"5s"`
+	MaxBodyBytes int64         `split_words:"true" default:"1048576"`
+	MaxRedirects int           `split_words:"true" default:"3"`
+	CacheTTL     time.Duration `split_words:"true" default:"24h"`
+}
+
+// direct upload configurations, clients put files straight to the media storage with a signed url
+// and reference them by file id. Stored files are private and read with signed urls that expire
+// after MediaURLExpiry. The local urls and secret are only used by a local storage, which requires
+// the secret.
+type Upload struct {
+	MaxBytes       int64         `split_words:"true" default:"10485760"`
+	URLExpiry      time.Duration `split_words:"true" default:"15m"`
+	Secret         string        `split_words:"true"`
+	LocalURL       string        `split_words:"true" default:"http://localhost:8080/v1/uploads"`
+	MediaURLExpiry time.Duration `split_words:"true" default:"30m"`
+	LocalMediaURL  string        `split_words:"true" default:"http://localhost:8080/v1/media"`
+}
+
 // all fields read from the environment, and prefixed with IMPART_
 type Impart struct {
 	Env    Environment `split_words:"true" default:"dev"`
@@ -44,9 +135,10 @@ type Impart struct {
 	APIKey string      `split_words:"true" default:"38c31c4a79c04fd102e105f23a7cdcf832e40ad1b1a526ba82da9fe1f86aa5aab288a3f1a85f5edf39478d65c05c6f1328c82de7e1677ca31a4392ab"` //default is dev api key, generated via "openssl rand -hex 60"
 	Region string      `split_words:"true" default:"us-east-2"`
 	//DynamoEndpoint     string      `split_words:"true" default:"http://localhost:8000"`
-	IOSNotificationARN string `split_words:"true" default:""`
-	ProfileSchemaPath  string `split_words:"true" default:"./schemas/json/Profile.json"`
-	MigrationsPath     string `split_word:"true" default:"schemas/migrations"`
+	IOSNotificationARN     string `split_words:"true" default:""`
+	AndroidNotificationARN string `split_words:"true" default:""`
+	ProfileSchemaPath      string `split_words:"true" default:"./schemas/json/Profile.json"`
+	MigrationsPath         string `split_word:"true" default:"schemas/migrations"`
 
 	DBHost     string `split_words:"true" default:"localhost"`
 	DBPort     int    `split_words:"true" default:"3306"`
@@ -66,6 +158,17 @@ type Impart struct {
 	AuthDomain                  string            `split_words:"true"`
 	Auth0ManagementClient       string            `split_words:"true"`
 	Auth0ManagementClientSecret string            `split_words:"true"`
+	Scheduler                   Scheduler         `split_words:"true"`
+	Email                       Email             `split_words:"true"`
+	Marketing                   Marketing         `split_words:"true"`
+	RateLimit                   RateLimit         `split_words:"true"`
+	Metrics                     Metrics           `split_words:"true"`
+	LinkPreview                 LinkPreview       `split_words:"true"`
+	Upload                      Upload            `split_words:"true"`
+	// how often every instance checks the profanity word list for changes
+	ProfanityReloadInterval time.Duration `split_words:"true" default:"30s"`
+	// how long the author of removed content has to appeal the removal
+	AppealWindow time.Duration `split_words:"true" default:"336h"`
 }
 
 func GetImpart() (*Impart, error) {
@@ -94,6 +197,14 @@ func (ic Impart) GetHttpServer() *http.Server {
 	return server
 }
 
+// GetMetricsServer returns the admin server of the metrics endpoint
+func (ic Impart) GetMetricsServer() *http.Server {
+	return graceful.WithDefaults(&http.Server{
+		Addr:        fmt.Sprintf(":%v", ic.Metrics.Port),
+		ReadTimeout: time.Second * 10,
+	})
+}
+
 func (ic Impart) GetProfileSchemaValidator() (gojsonschema.JSONLoader, error) {
 	v := gojsonschema.NewReferenceLoader(fmt.Sprintf("file://%s", ic.ProfileSchemaPath))
 	_, err := v.LoadJSON()
@@ -7,8 +7,12 @@ import (
 	"net/http"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 )
 
+// jwksClient records the calls fetching the auth0 keys in the metrics
+var jwksClient = metrics.InstrumentClient(metrics.Auth0, http.DefaultClient)
+
 type jwks struct {
 	Keys []jsonWebKeys `json:"keys"`
 }
@@ -25,7 +29,7 @@ type jsonWebKeys struct {
 // GetRSAPublicKeys retrieves the public keys from auth0
 func GetRSAPublicKeys() (map[string]*rsa.PublicKey, error) {
 	certs := make(map[string]*rsa.PublicKey)
-	resp, err := http.Get("https://impartwealth.auth0.com/.well-known/jwks.json")
+	resp, err := jwksClient.Get("https://impartwealth.auth0.com/.well-known/jwks.json")
 
 	if err != nil {
 		return certs, err
@@ -93,7 +93,7 @@ func (a *authService) RequestAuthorizationHandler() gin.HandlerFunc {
 		} else {
 			parts := strings.Split(ctx.GetHeader(AuthorizationHeader), " ")
 			if len(parts) != 2 || parts[0] != AuthorizationHeaderBearerType || len(parts[0]) == 0 || len(parts[1]) == 0 {
-				a.logger.Info("invalid authorization header", zap.Strings("split_authz_header", parts))
+				impart.CtxLogger(ctx, a.logger).Info("invalid authorization header", zap.Strings("split_authz_header", parts))
 				err := impart.NewError(impart.ErrUnauthorized, "invalid authorization header")
 				ctx.AbortWithStatusJSON(http.StatusUnauthorized, impart.ErrorResponse(err))
 				return
@@ -102,13 +102,13 @@ func (a *authService) RequestAuthorizationHandler() gin.HandlerFunc {
 		}
 		claims, err := ValidateAuth0Token(new_token, a.Auth0Certs, a.logger.Sugar())
 		if err != nil {
-			a.logger.Error("couldn't validate token", zap.Error(err))
+			impart.CtxLogger(ctx, a.logger).Error("couldn't validate token", zap.Error(err))
 			err := impart.NewError(impart.ErrUnauthorized, "couldn't validate token")
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, impart.ErrorResponse(err))
 			return
 		}
 		authID := claims.Subject
-		a.logger.Debug("request authentication context", zap.String("authId", authID))
+		impart.CtxLogger(ctx, a.logger).Debug("request authentication context", zap.String("authId", authID))
 		ctx.Set(impart.AuthIDRequestContextKey, authID)
 		u, _ := a.profileData.GetUserFromAuthId(ctx, authID)
 		if u == nil {
@@ -130,7 +130,7 @@ func (a *authService) RequestAuthorizationHandler() gin.HandlerFunc {
 		}
 		//always set this value because it should always be present, minus account creation.
 		ctx.Set(impart.UserRequestContextKey, u)
-		a.logger.Debug("request user context", zap.String("impartWealthId", u.ImpartWealthID),
+		impart.CtxLogger(ctx, a.logger).Debug("request user context", zap.String("impartWealthId", u.ImpartWealthID),
 			zap.String("screenName", u.ScreenName),
 			zap.String("email", u.Email))
 		ctx.Next()
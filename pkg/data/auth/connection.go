@@ -1,7 +1,10 @@
 package auth
 
 import (
+	"net/http"
+
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"gopkg.in/auth0.v5/management"
 )
 
@@ -13,7 +16,8 @@ const auth0managementClientSecret = "X3bXip3IZTQcLRoYIQ5VkMfSQdqcSZdJtdZpQd8w5-D
 func NewImpartManagementClient() (*management.Management, error) {
 	cfg, _ := config.GetImpart()
 	if cfg != nil {
-		mngmnt, errDel := management.New(cfg.AuthDomain, management.WithClientCredentials(cfg.Auth0ManagementClient, cfg.Auth0ManagementClientSecret))
+		mngmnt, errDel := management.New(cfg.AuthDomain, management.WithClientCredentials(cfg.Auth0ManagementClient, cfg.Auth0ManagementClientSecret),
+			management.WithClient(metrics.InstrumentClient(metrics.Auth0, http.DefaultClient)))
 		if errDel != nil {
 			return nil, errDel
 		}
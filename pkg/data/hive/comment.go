@@ -65,13 +65,19 @@ func (d *mysqlHiveData) GetComments(ctx context.Context, postID uint64, limit in
 		limit = maxPostLimit
 	}
 	orderByMod := qm.OrderBy("comment_id desc")
-	comments, err := dbmodels.Comments(dbmodels.CommentWhere.PostID.EQ(postID),
+	queryMods := []qm.QueryMod{
+		dbmodels.CommentWhere.PostID.EQ(postID),
 		qm.Offset(offset),
 		qm.Limit(limit),
 		orderByMod,
 		qm.Load(dbmodels.CommentRels.ImpartWealth),
 		qm.Load(dbmodels.CommentRels.CommentReactions, dbmodels.CommentReactionWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID)),
-	).All(ctx, d.db)
+	}
+	// content held by the profanity filter is only visible to its author until it is reviewed
+	if !ctxUser.Admin {
+		queryMods = append(queryMods, qm.Where("(`comment`.`held` = false or `comment`.`impart_wealth_id` = ?)", ctxUser.ImpartWealthID))
+	}
+	comments, err := dbmodels.Comments(queryMods...).All(ctx, d.db)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return dbmodels.CommentSlice{}, nil, nil
@@ -113,6 +119,9 @@ func (d *mysqlHiveData) NewComment(ctx context.Context, comment *dbmodels.Commen
 	if err != nil {
 		return nil, err
 	}
+	if err = scorePosts(ctx, tx, post.PostID); err != nil {
+		return nil, err
+	}
 	tx.Commit()
 	return d.GetComment(ctx, comment.CommentID)
 }
@@ -128,6 +137,12 @@ func (d *mysqlHiveData) EditComment(ctx context.Context, comment *dbmodels.Comme
 	}
 
 	existingComment.Content = comment.Content
+	// an edit can put the comment on hold, only a review releases it
+	if comment.Held && !existingComment.Held {
+		existingComment.Held = true
+		existingComment.Obfuscated = true
+		existingComment.Reviewed = false
+	}
 	if _, err := existingComment.Update(ctx, d.db, boil.Infer()); err != nil {
 		return nil, err
 	}
@@ -166,5 +181,8 @@ func (d *mysqlHiveData) DeleteComment(ctx context.Context, commentID uint64) err
 	if err != nil {
 		return err
 	}
+	if err = scorePosts(ctx, tx, post.PostID); err != nil {
+		return err
+	}
 	return tx.Commit()
 }
@@ -9,9 +9,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/beeker1121/mailchimp-go/lists/members"
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/media"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
@@ -87,7 +86,7 @@ func (d *mysqlHiveData) NewHive(ctx context.Context, hive *dbmodels.Hive) (*dbmo
 	// 	return nil, impart.ErrUnauthorized
 	// }
 	if err := hive.Insert(ctx, d.db, boil.Infer()); err != nil {
-		d.logger.Error("Hive creation failed", zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("Hive creation failed", zap.Error(err))
 		return nil, err
 	}
 	queryFirst := `
@@ -96,7 +95,7 @@ func (d *mysqlHiveData) NewHive(ctx context.Context, hive *dbmodels.Hive) (*dbmo
 					FROM answer;`
 	_, err := queries.Raw(queryFirst, hive.HiveID).ExecContext(ctx, d.db)
 	if err != nil {
-		d.logger.Error("Updating Hive demographic data failed", zap.String("Hive name", hive.Name))
+		impart.CtxLogger(ctx, d.logger).Error("Updating Hive demographic data failed", zap.String("Hive name", hive.Name))
 	}
 	return hive, hive.Reload(ctx, d.db)
 }
@@ -118,6 +117,10 @@ func (d *mysqlHiveData) EditHive(ctx context.Context, hive models.Hive) (*dbmode
 		existing.Name = hive.HiveName
 	}
 
+	if hive.ReportHideThreshold > 0 {
+		existing.ReportHideThreshold = hive.ReportHideThreshold
+	}
+
 	if _, err := existing.Update(ctx, d.db, boil.Infer()); err != nil {
 		return nil, err
 	}
@@ -203,7 +206,7 @@ func (d *mysqlHiveData) DeleteHive(ctx context.Context, hiveID uint64) error {
 	where user.deleted_at is null and member_hive_id=?
 	`, hiveID).Bind(ctx, d.db, &memberHives)
 	if err != nil {
-		d.logger.Error(err.Error())
+		impart.CtxLogger(ctx, d.logger).Error(err.Error())
 	}
 	hives.Name = fmt.Sprintf("%s-%d-%s", hives.Name, hives.HiveID, "Deleted")
 	if _, err := hives.Update(ctx, d.db, boil.Infer()); err != nil {
@@ -216,8 +219,10 @@ func (d *mysqlHiveData) DeleteHive(ctx context.Context, hiveID uint64) error {
 		return err
 	}
 	impartWealthIDs := ""
+	memberIDs := make([]string, 0, len(memberHives))
 	for _, existmember := range memberHives {
 		impartWealthIDs = fmt.Sprintf("%s '%s' ,", impartWealthIDs, existmember.ImpartWealthID)
+		memberIDs = append(memberIDs, existmember.ImpartWealthID)
 	}
 	if impartWealthIDs != "" {
 		impartWealthIDs = strings.Trim(impartWealthIDs, ",")
@@ -226,7 +231,10 @@ func (d *mysqlHiveData) DeleteHive(ctx context.Context, hiveID uint64) error {
 			impart.DefaultHiveID, impartWealthIDs)
 		_, err = queries.Raw(query).ExecContext(ctx, d.db)
 		if err != nil {
-			d.logger.Error(err.Error())
+			impart.CtxLogger(ctx, d.logger).Error(err.Error())
+		}
+		if err := marketing.QueueSync(ctx, d.db, memberIDs...); err != nil {
+			impart.CtxLogger(ctx, d.logger).Error("unable to queue the marketing sync", zap.Strings("impartWealthIDs", memberIDs), zap.Error(err))
 		}
 	}
 
@@ -235,7 +243,7 @@ func (d *mysqlHiveData) DeleteHive(ctx context.Context, hiveID uint64) error {
 }
 
 func (d *mysqlHiveData) DeleteBulkHive(ctx context.Context, hiveInput dbmodels.HiveSlice) error {
-	var allUser []string
+	var memberIDs []string
 	currTime := time.Now().In(boil.GetLocation())
 	golangDateTime := currTime.Format("2006-01-02 15:04:05.000")
 	hiveIds := ""
@@ -249,7 +257,7 @@ func (d *mysqlHiveData) DeleteBulkHive(ctx context.Context, hiveInput dbmodels.H
 		exitingmembers := hive.R.MemberImpartWealthUsers
 		for _, existmem := range exitingmembers {
 			impartWealthIDs = fmt.Sprintf("%s '%s' ,", impartWealthIDs, existmem.ImpartWealthID)
-			allUser = append(allUser, existmem.Email)
+			memberIDs = append(memberIDs, existmem.ImpartWealthID)
 		}
 	}
 	impartWealthIDs = strings.Trim(impartWealthIDs, ",")
@@ -271,20 +279,10 @@ func (d *mysqlHiveData) DeleteBulkHive(ctx context.Context, hiveInput dbmodels.H
 		return err
 	}
 	tx.Commit()
-	// Update mailChimp
-	go func() {
-		cfg, _ := config.GetImpart()
-		for hiveUser := range allUser {
-			mailChimpParams := &members.UpdateParams{
-				MergeFields: map[string]interface{}{"STATUS": impart.WaitList},
-			}
-			_, err = members.Update(cfg.MailchimpAudienceId, allUser[hiveUser], mailChimpParams)
-			if err != nil {
-				d.logger.Error("Delete user requset failed in MailChimp", zap.String("deleteUser", allUser[hiveUser]),
-					zap.String("contextUser", allUser[hiveUser]))
-			}
-		}
-	}()
+	// the members moved to the waitlist get their status updated in the marketing audience
+	if err := marketing.QueueSync(ctx, d.db, memberIDs...); err != nil {
+		impart.CtxLogger(ctx, d.logger).Error("unable to queue the marketing sync", zap.Strings("impartWealthIDs", memberIDs), zap.Error(err))
+	}
 	go impart.UserDemographicsUpdate(ctx, d.db, true, true)
 	return nil
 }
@@ -351,7 +349,7 @@ func (d *mysqlHiveData) PinPostForBulkPostAction(ctx context.Context, postHiveDe
 
 	_, err = queries.Raw(finlQuery).ExecContext(ctx, d.db)
 	if err != nil {
-		d.logger.Error("error attempting to creating bulk post  data tag ", zap.Any("post", finlQuery), zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("error attempting to creating bulk post  data tag ", zap.Any("post", finlQuery), zap.Error(err))
 		return err
 	}
 	tx.Commit()
@@ -362,18 +360,18 @@ func (d *mysqlHiveData) PinPostForBulkPostAction(ctx context.Context, postHiveDe
 
 func (d *mysqlHiveData) NewHiveRule(ctx context.Context, hiveRule *dbmodels.HiveRule, hiveCriteria dbmodels.HiveRulesCriteriumSlice) (*dbmodels.HiveRule, error) {
 	if err := hiveRule.Insert(ctx, d.db, boil.Infer()); err != nil {
-		d.logger.Error("HiveRule creation failed", zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("HiveRule creation failed", zap.Error(err))
 		return nil, err
 	}
 	err := hiveRule.AddRuleHiveRulesCriteria(ctx, d.db, true, hiveCriteria...)
 	if err != nil {
-		d.logger.Error("HiveRule criteria creation failed", zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("HiveRule criteria creation failed", zap.Error(err))
 	}
 	if (hiveRule.HiveID != null.Uint64{}) && hiveRule.HiveID.Uint64 > 0 {
 		newHive := &dbmodels.Hive{HiveID: hiveRule.HiveID.Uint64}
 		errHive := hiveRule.AddHives(ctx, d.db, false, newHive)
 		if errHive != nil {
-			d.logger.Error("New hive rule map failed", zap.Any("hive", newHive),
+			impart.CtxLogger(ctx, d.logger).Error("New hive rule map failed", zap.Any("hive", newHive),
 				zap.Error(errHive))
 		}
 	}
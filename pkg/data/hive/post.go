@@ -79,6 +79,9 @@ func (d *mysqlHiveData) NewPost(ctx context.Context, post *dbmodels.Post, tags d
 	if err != nil {
 		return nil, err
 	}
+	if err = scorePosts(ctx, d.db, post.PostID); err != nil {
+		return nil, err
+	}
 	err = post.SetTags(ctx, d.db, false, tags...)
 	if err != nil {
 		return nil, err
@@ -105,17 +108,24 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 		existing.Subject = post.Subject
 	}
 
+	// an edit can put the post on hold, only a review releases it
+	if post.Held && !existing.Held {
+		existing.Held = true
+		existing.Obfuscated = true
+		existing.Reviewed = false
+	}
+
 	_, err = existing.Update(ctx, d.db, boil.Infer())
 
 	if shouldPin {
 		existingPost, err0 := d.GetPost(ctx, existing.PostID)
 		if err0 != nil {
-			d.logger.Error("error attempting to fetching post  data ", zap.Any("postVideo", postVideo), zap.Error(err))
+			impart.CtxLogger(ctx, d.logger).Error("error attempting to fetching post  data ", zap.Any("postVideo", postVideo), zap.Error(err))
 		} else {
 			if postVideo != nil {
 				if existingPost.R.PostVideos == nil && len(existingPost.R.PostVideos) == 0 {
 					if err := postVideo.Insert(ctx, d.db, boil.Infer()); err != nil {
-						d.logger.Error("error attempting to Save post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to Save post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
 					}
 				} else if existingPost.R.PostVideos != nil && len(existingPost.R.PostVideos) > 0 && postVideo.URL != "" {
 					if existingPost.R.PostVideos[0].ReferenceID != postVideo.ReferenceID {
@@ -128,23 +138,23 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 						existingPost.R.PostVideos[0].Source = postVideo.Source
 					}
 					if _, err := existingPost.R.PostVideos[0].Update(ctx, d.db, boil.Infer()); err != nil {
-						d.logger.Error("error attempting to Update post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to Update post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
 					}
 				} else if existingPost.R.PostVideos != nil && len(existingPost.R.PostVideos) > 0 && postVideo.URL == "" {
 					if _, err := existingPost.R.PostVideos[0].Delete(ctx, d.db); err != nil {
-						d.logger.Error("error attempting to delete post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to delete post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
 					}
 				}
 			} else if existingPost.R.PostVideos != nil && len(existingPost.R.PostVideos) > 0 && postVideo == nil {
 				if _, err := existingPost.R.PostVideos[0].Delete(ctx, d.db); err != nil {
-					d.logger.Error("error attempting to delete post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
+					impart.CtxLogger(ctx, d.logger).Error("error attempting to delete post video data ", zap.Any("postVideo", postVideo), zap.Error(err))
 				}
 			}
 
 			if postUrl != nil {
 				if existingPost.R.PostUrls == nil && len(existingPost.R.PostUrls) == 0 {
 					if err := postUrl.Insert(ctx, d.db, boil.Infer()); err != nil {
-						d.logger.Error("error attempting to Save post url data ", zap.Any("PostUrls", postUrl), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to Save post url data ", zap.Any("PostUrls", postUrl), zap.Error(err))
 					}
 				} else if existingPost.R.PostUrls != nil && len(existingPost.R.PostUrls) > 0 && postUrl.Title != "" {
 					existingPost.R.PostUrls[0].Title = postUrl.Title
@@ -152,16 +162,16 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 					existingPost.R.PostUrls[0].ImageUrl = postUrl.ImageUrl
 					existingPost.R.PostUrls[0].Description = postUrl.Description
 					if _, err := existingPost.R.PostUrls[0].Update(ctx, d.db, boil.Infer()); err != nil {
-						d.logger.Error("error attempting to Update postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to Update postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
 					}
 				} else if existingPost.R.PostUrls != nil && len(existingPost.R.PostUrls) > 0 && postUrl.Title == "" {
 					if _, err := existingPost.R.PostUrls[0].Delete(ctx, d.db); err != nil {
-						d.logger.Error("error attempting to delete postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to delete postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
 					}
 				}
 			} else if existingPost.R.PostUrls != nil && len(existingPost.R.PostUrls) > 0 && postUrl == nil {
 				if _, err := existingPost.R.PostUrls[0].Delete(ctx, d.db); err != nil {
-					d.logger.Error("error attempting to delete postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
+					impart.CtxLogger(ctx, d.logger).Error("error attempting to delete postUrl data ", zap.Any("postUrl", postUrl), zap.Error(err))
 				}
 			}
 			if len(file) > 0 {
@@ -175,7 +185,7 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 				} else if len(existingPost.R.PostFiles) >= 0 && file[0].FileName != "" {
 					existingfile, err := dbmodels.FindFile(ctx, d.db, existingPost.R.PostFiles[0].Fid)
 					if err != nil {
-						d.logger.Error("error attempting to fetching file  data ", zap.Any("postVideo", existingPost.R.PostFiles[0].Fid), zap.Error(err))
+						impart.CtxLogger(ctx, d.logger).Error("error attempting to fetching file  data ", zap.Any("postVideo", existingPost.R.PostFiles[0].Fid), zap.Error(err))
 					} else {
 						if existingfile.FileName != file[0].FileName && file[0].FileName != "" {
 							existingfile.FileName = file[0].FileName
@@ -193,7 +203,7 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 			if (len(existingPost.R.PostFiles) > 0 && fileName == "") || (len(existingPost.R.PostFiles) > 0 && len(file) == 0 && fileName != "noUpdate") {
 				existingfile, err := dbmodels.FindFile(ctx, d.db, existingPost.R.PostFiles[0].Fid)
 				if err != nil {
-					d.logger.Error("error attempting to fetching file  data ", zap.Any("postVideo", existingPost.R.PostFiles[0].Fid), zap.Error(err))
+					impart.CtxLogger(ctx, d.logger).Error("error attempting to fetching file  data ", zap.Any("postVideo", existingPost.R.PostFiles[0].Fid), zap.Error(err))
 				} else {
 					_, err = existingPost.R.PostFiles[0].Delete(ctx, d.db)
 					_, err = existingfile.Delete(ctx, d.db)
@@ -217,6 +227,10 @@ func (d *mysqlHiveData) EditPost(ctx context.Context, post *dbmodels.Post, tags
 type GetPostsInput struct {
 	// HiveID is the ID that should be queried for posts
 	HiveID uint64
+	// HiveIDs queries the posts of several hives when HiveID is not set
+	HiveIDs []uint64
+	// AllHives queries the posts of every hive when neither HiveID nor HiveIDs are set
+	AllHives bool
 	// Limit is the maximum number of records that should be returns.  The API can optionally return
 	// less than Limit, if DynamoDB decides the items read were too large.
 	Limit  int
@@ -224,6 +238,10 @@ type GetPostsInput struct {
 	// IsLastCommentSorted Changes the sort from default of PostDatetime to LastCommentDatetime
 	// Default: false
 	IsLastCommentSorted bool
+	// Sort ranks the posts by score instead, it takes precedence over IsLastCommentSorted
+	Sort PostSort
+	// TopSince limits SortTop to the posts created since, zero for all time
+	TopSince time.Time
 	// Tags is the optional list of tags to filter on
 	TagIDs []int
 
@@ -258,11 +276,15 @@ func (d *mysqlHiveData) GetPosts(ctx context.Context, gpi GetPostsInput) (dbmode
 	}
 
 	orderByMod := qm.OrderBy("created_at desc, post_id desc")
-	if gpi.IsLastCommentSorted {
+	switch {
+	case gpi.Sort == SortHot:
+		orderByMod = qm.OrderBy("hot_score desc, post_id desc")
+	case gpi.Sort == SortTop:
+		orderByMod = qm.OrderBy("popularity desc, post_id desc")
+	case gpi.IsLastCommentSorted:
 		orderByMod = qm.OrderBy("last_comment_ts desc, post_id desc")
 	}
 	queryMods := []qm.QueryMod{
-		dbmodels.PostWhere.HiveID.EQ(gpi.HiveID),
 		qm.Offset(gpi.Offset),
 		qm.Limit(gpi.Limit),
 		orderByMod,
@@ -275,6 +297,31 @@ func (d *mysqlHiveData) GetPosts(ctx context.Context, gpi GetPostsInput) (dbmode
 		qm.Load("PostFiles.FidFile"), // get files
 	}
 
+	switch {
+	case gpi.HiveID > 0:
+		queryMods = append(queryMods, dbmodels.PostWhere.HiveID.EQ(gpi.HiveID))
+	case len(gpi.HiveIDs) > 0 || gpi.AllHives:
+		// posts across hives carry their hive, and leave out the hives that were deleted
+		queryMods = append(queryMods,
+			qm.Load(dbmodels.PostRels.Hive),
+			qm.Where("exists (select 1 from hive h where h.hive_id = `post`.`hive_id` and h.deleted_at is null)"),
+		)
+		if len(gpi.HiveIDs) > 0 {
+			queryMods = append(queryMods, dbmodels.PostWhere.HiveID.IN(gpi.HiveIDs))
+		}
+	default:
+		return empty, nil, nil
+	}
+
+	// content held by the profanity filter is only visible to its author until it is reviewed
+	if !ctxUser.Admin {
+		queryMods = append(queryMods, qm.Where("(`post`.`held` = false or `post`.`impart_wealth_id` = ?)", ctxUser.ImpartWealthID))
+	}
+
+	if gpi.Sort == SortTop && !gpi.TopSince.IsZero() {
+		queryMods = append(queryMods, dbmodels.PostWhere.CreatedAt.GTE(gpi.TopSince))
+	}
+
 	if len(gpi.TagIDs) > 0 {
 		inParamValues := make([]interface{}, len(gpi.TagIDs), len(gpi.TagIDs))
 		for i, id := range gpi.TagIDs {
@@ -289,7 +336,7 @@ func (d *mysqlHiveData) GetPosts(ctx context.Context, gpi GetPostsInput) (dbmode
 		if err == sql.ErrNoRows {
 			return empty, nil, nil
 		}
-		d.logger.Error("couldn't fetch posts from db", zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("couldn't fetch posts from db", zap.Error(err))
 		return empty, nil, err
 	}
 	boil.DebugMode = false
@@ -355,7 +402,7 @@ func (s *mysqlHiveData) AddPostFilesDBEdit(ctx context.Context, post *dbmodels.P
 				URL:      f.URL,
 			}
 			if err := fileModel.Insert(ctx, s.db, boil.Infer()); err != nil {
-				s.logger.Error("error attempting to Save files ", zap.Any("files", f), zap.Error(err))
+				impart.CtxLogger(ctx, s.logger).Error("error attempting to Save files ", zap.Any("files", f), zap.Error(err))
 			}
 			file[index].FID = int(fileModel.Fid)
 			postFielRelationMap = append(postFielRelationMap, &dbmodels.PostFile{
@@ -369,7 +416,7 @@ func (s *mysqlHiveData) AddPostFilesDBEdit(ctx context.Context, post *dbmodels.P
 		}
 		err := post.AddPostFiles(ctx, s.db, true, postFielRelationMap...)
 		if err != nil {
-			s.logger.Error("error attempting to map post files ",
+			impart.CtxLogger(ctx, s.logger).Error("error attempting to map post files ",
 				zap.Any("data", postFielRelationMap),
 				zap.Any("err", err),
 				zap.Error(err),
@@ -410,7 +457,7 @@ func (d *mysqlHiveData) DeletePostFromList(ctx context.Context, posts dbmodels.P
 			"deleted_at": golangDateTime})
 
 	if err != nil {
-		d.logger.Error("hive Update Failed", zap.Any("query", posts),
+		impart.CtxLogger(ctx, d.logger).Error("hive Update Failed", zap.Any("query", posts),
 			zap.Error(err))
 		return err
 	}
@@ -448,7 +495,7 @@ func (d *mysqlHiveData) NewPostForMultipleHives(ctx context.Context, post models
 	query = fmt.Sprintf("%s ;", query)
 	_, err = queries.Raw(query).ExecContext(ctx, d.db)
 	if err != nil {
-		d.logger.Error("error attempting to creating bulk post  data ", zap.Any("post", post), zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("error attempting to creating bulk post  data ", zap.Any("post", post), zap.Error(err))
 		return nil, err
 	}
 	posts, _ := dbmodels.Posts(
@@ -464,6 +511,13 @@ func (d *mysqlHiveData) NewPostForMultipleHives(ctx context.Context, post models
 			}
 		}
 	}
+	ids := make([]uint64, 0, len(postIds))
+	for _, id := range postIds {
+		ids = append(ids, id)
+	}
+	if err = scorePosts(ctx, d.db, ids...); err != nil {
+		impart.CtxLogger(ctx, d.logger).Error("error attempting to score bulk posts", zap.Any("posts", postIds), zap.Error(err))
+	}
 	tagid := tags[0].TagID
 	query = "insert into post_tag (tag_id,post_id) values "
 	inserQury = ""
@@ -475,7 +529,7 @@ func (d *mysqlHiveData) NewPostForMultipleHives(ctx context.Context, post models
 	query = strings.Trim(query, ",")
 	_, err = queries.Raw(query).ExecContext(ctx, d.db)
 	if err != nil {
-		d.logger.Error("error attempting to creating bulk post  data tag ", zap.Any("post", post), zap.Error(err))
+		impart.CtxLogger(ctx, d.logger).Error("error attempting to creating bulk post  data tag ", zap.Any("post", post), zap.Error(err))
 	}
 	tx.Commit()
 	return postIds, nil
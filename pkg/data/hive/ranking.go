@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// PostSort is the ranking of GetPosts, newest first when empty
+type PostSort string
+
+const (
+	SortNewest PostSort = ""
+	// SortHot ranks by popularity decayed by the age of the post
+	SortHot PostSort = "hot"
+	// SortTop ranks by popularity alone, optionally of the posts created since TopSince
+	SortTop PostSort = "top"
+)
+
+const (
+	// popularityExpr weighs the votes and comments of a post, a comment counts as two up votes
+	popularityExpr = "up_vote_count - down_vote_count + 2 * comment_count"
+	// hotScoreExpr adds the age of the post to the magnitude of its popularity, so it doesn't change
+	// with time and only needs updating with the popularity. A post needs ten times the popularity
+	// to outrank one posted 45000 seconds (12.5 hours) later.
+	hotScoreExpr = "SIGN(popularity) * LOG10(GREATEST(ABS(popularity), 1)) + TIMESTAMPDIFF(SECOND, '2021-01-01', created_at) / 45000"
+)
+
+// scorePosts recomputes the ranking scores of the posts, call it in the same transaction that
+// changes their votes or comments. Columns are assigned left to right so hot_score sees the new popularity.
+func scorePosts(ctx context.Context, exec boil.ContextExecutor, postIDs ...uint64) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(postIDs))
+	for i, id := range postIDs {
+		args[i] = id
+	}
+	q := "UPDATE post SET popularity = " + popularityExpr + ", hot_score = " + hotScoreExpr +
+		" WHERE post_id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(postIDs)), ",") + ");"
+	_, err := queries.Raw(q, args...).ExecContext(ctx, exec)
+	return err
+}
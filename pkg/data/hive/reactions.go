@@ -227,6 +227,9 @@ func (d *mysqlHiveData) vote(ctx context.Context, upVote, increment bool, in Con
 			dbmodels.PostColumns.DownVoteCount)); err != nil {
 			return err
 		}
+		if err = scorePosts(ctx, tx, p.PostID); err != nil {
+			return err
+		}
 		return tx.Commit()
 	case Comment:
 		var dbc *dbmodels.CommentReaction
@@ -401,11 +404,10 @@ func (d *mysqlHiveData) ReportPost(ctx context.Context, postId uint64, reason *s
 
 	if pr.Reported {
 		pr.ReportedReason = null.StringFromPtr(reason)
-		post.ReportedCount++
-		if !post.ReviewedAt.Valid {
-			// if this post has never been reviewed, mark it as obfuscated
-			post.Obfuscated = true
+		if pr.ReportWeight, err = reporterWeight(ctx, tx, ctxUser.ImpartWealthID); err != nil {
+			return err
 		}
+		post.ReportedCount++
 
 		//when reported, then disable reviewedStatus and reviewComment
 		post.ReviewComment = null.String{}
@@ -414,10 +416,6 @@ func (d *mysqlHiveData) ReportPost(ctx context.Context, postId uint64, reason *s
 	} else {
 		//we're removing a report
 		post.ReportedCount--
-		if !post.ReviewedAt.Valid && post.ReportedCount == 0 {
-			//if this hasn't been reviewed, and the report count went to 0, un-obfuscate it.
-			post.Obfuscated = false
-		}
 	}
 
 	if reactionNeedsInsert {
@@ -429,6 +427,15 @@ func (d *mysqlHiveData) ReportPost(ctx context.Context, postId uint64, reason *s
 		return err
 	}
 
+	// a post that has never been reviewed is hidden while its weighted reports reach the hive threshold
+	score, threshold, err := postReportScore(ctx, tx, postId)
+	if err != nil {
+		return err
+	}
+	if !post.ReviewedAt.Valid && !post.Held {
+		post.Obfuscated = ReportHidden(score, threshold)
+	}
+
 	if err = post.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
 		return err
 	}
@@ -495,11 +502,10 @@ func (d *mysqlHiveData) ReportComment(ctx context.Context, commentId uint64, rea
 
 	if cr.Reported {
 		cr.ReportedReason = null.StringFromPtr(reason)
-		comment.ReportedCount++
-		if !comment.ReviewedAt.Valid {
-			// if this comment has never been reviewed, mark it as obfuscated
-			comment.Obfuscated = true
+		if cr.ReportWeight, err = reporterWeight(ctx, tx, ctxUser.ImpartWealthID); err != nil {
+			return err
 		}
+		comment.ReportedCount++
 
 		//when reported, then disable reviewedStatus and reviewComment
 		comment.ReviewComment = null.String{}
@@ -507,10 +513,6 @@ func (d *mysqlHiveData) ReportComment(ctx context.Context, commentId uint64, rea
 	} else {
 		//we're removing a report
 		comment.ReportedCount--
-		if !comment.ReviewedAt.Valid && comment.ReportedCount == 0 {
-			//if this hasn't been reviewed, and the report count went to 0, un-obfuscate it.
-			comment.Obfuscated = false
-		}
 	}
 
 	if reactionNeedsInsert {
@@ -522,13 +524,27 @@ func (d *mysqlHiveData) ReportComment(ctx context.Context, commentId uint64, rea
 		return err
 	}
 
+	// a comment that has never been reviewed is hidden while its weighted reports reach the hive threshold
+	score, threshold, err := commentReportScore(ctx, tx, commentId)
+	if err != nil {
+		return err
+	}
+	if !comment.ReviewedAt.Valid && !comment.Held {
+		comment.Obfuscated = ReportHidden(score, threshold)
+	}
+
 	if err = comment.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
 		return err
 	}
 	return tx.Commit()
 }
 
-// ReviewPost
+// removed is whether a review already removed the content
+func removed(reviewed bool, reviewedAt null.Time, held, obfuscated bool) bool {
+	return !reviewed && reviewedAt.Valid && !held && obfuscated
+}
+
+// ReviewPost approves the post, or removes it when remove is set
 func (d *mysqlHiveData) ReviewPost(ctx context.Context, postId uint64, reason *string, remove bool) error {
 	// update the post review status
 	dbPost, err := dbmodels.Posts(
@@ -539,7 +555,8 @@ func (d *mysqlHiveData) ReviewPost(ctx context.Context, postId uint64, reason *s
 	if err != nil {
 		return err
 	}
-	reported := false
+	// held posts are in the review queue without being reported, removed posts were reviewed before
+	reported := dbPost.Held || dbPost.ReviewedAt.Valid
 	if dbPost.R.PostReactions != nil && len(dbPost.R.PostReactions) > 0 {
 		for _, p := range dbPost.R.PostReactions {
 			if p.Reported {
@@ -552,12 +569,16 @@ func (d *mysqlHiveData) ReviewPost(ctx context.Context, postId uint64, reason *s
 	}
 	//alter the reaction first
 	if remove {
-		if !dbPost.Reviewed {
+		if removed(dbPost.Reviewed, dbPost.ReviewedAt, dbPost.Held, dbPost.Obfuscated) {
 			return impart.ErrNoOp
 		} else {
+			// removing reported or held content, or withdrawing an approval, hides it for good,
+			// the review date keeps further reports from changing that
 			dbPost.Reviewed = false
-			dbPost.ReviewedAt = null.Time{}
-			dbPost.ReviewComment = null.StringFrom("")
+			dbPost.ReviewedAt = null.TimeFrom(time.Now())
+			dbPost.ReviewComment = null.StringFromPtr(reason)
+			dbPost.Held = false
+			dbPost.Obfuscated = true
 		}
 	} else {
 		if dbPost.Reviewed {
@@ -566,6 +587,9 @@ func (d *mysqlHiveData) ReviewPost(ctx context.Context, postId uint64, reason *s
 			dbPost.Reviewed = true
 			dbPost.ReviewedAt = null.TimeFrom(time.Now())
 			dbPost.ReviewComment = null.StringFromPtr(reason)
+			// approving reported or held content publishes it
+			dbPost.Held = false
+			dbPost.Obfuscated = false
 		}
 	}
 
@@ -590,12 +614,14 @@ func (d *mysqlHiveData) ReviewComment(ctx context.Context, commentId uint64, rea
 
 	//alter the reaction first
 	if remove {
-		if !dbComment.Reviewed {
+		if removed(dbComment.Reviewed, dbComment.ReviewedAt, dbComment.Held, dbComment.Obfuscated) {
 			return impart.ErrNoOp
 		} else {
 			dbComment.Reviewed = false
-			dbComment.ReviewedAt = null.Time{}
-			dbComment.ReviewComment = null.StringFrom("")
+			dbComment.ReviewedAt = null.TimeFrom(time.Now())
+			dbComment.ReviewComment = null.StringFromPtr(reason)
+			dbComment.Held = false
+			dbComment.Obfuscated = true
 		}
 	} else {
 		if dbComment.Reviewed {
@@ -604,6 +630,8 @@ func (d *mysqlHiveData) ReviewComment(ctx context.Context, commentId uint64, rea
 			dbComment.Reviewed = true
 			dbComment.ReviewedAt = null.TimeFrom(time.Now())
 			dbComment.ReviewComment = null.StringFromPtr(reason)
+			dbComment.Held = false
+			dbComment.Obfuscated = false
 		}
 	}
 
@@ -3,7 +3,11 @@
 package data
 
 import (
+	"context"
+
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func (s *HiveTestSuite) TestPostVotes() {
@@ -224,9 +228,47 @@ func (s *HiveTestSuite) TestCommentVotes() {
 	s.Equal(0, p.DownVoteCount)
 }
 
+func (s *HiveTestSuite) setReportHideThreshold(hiveID uint64, threshold uint16) {
+	hive, err := dbmodels.FindHive(context.TODO(), s.db, hiveID)
+	s.Require().NoError(err)
+	hive.ReportHideThreshold = threshold
+	_, err = hive.Update(context.TODO(), s.db, boil.Whitelist(dbmodels.HiveColumns.ReportHideThreshold))
+	s.Require().NoError(err)
+}
+
+func (s *HiveTestSuite) TestPostReportThreshold() {
+	ctx := s.contextWithImpartAdmin()
+	hiveID := s.bootstrapTestHive(ctx)
+	s.setReportHideThreshold(hiveID, 2)
+	postID := s.bootstrapPost(ctx, hiveID)
+
+	err := s.hiveData.ReportPost(ctx, postID, nil, false)
+	s.NoError(err)
+	p, err := s.hiveData.GetPost(ctx, postID)
+	s.NoError(err)
+	s.Equal(1, p.ReportedCount)
+	s.False(p.Obfuscated)
+
+	ctx2 := s.contextWithImpartAdmin()
+	err = s.hiveData.ReportPost(ctx2, postID, nil, false)
+	s.NoError(err)
+	p, err = s.hiveData.GetPost(ctx2, postID)
+	s.NoError(err)
+	s.Equal(2, p.ReportedCount)
+	s.True(p.Obfuscated)
+
+	err = s.hiveData.ReportPost(ctx, postID, nil, true)
+	s.NoError(err)
+	p, err = s.hiveData.GetPost(ctx, postID)
+	s.NoError(err)
+	s.False(p.Obfuscated)
+}
+
 func (s *HiveTestSuite) TestPostReports() {
 	ctx := s.contextWithImpartAdmin()
 	hiveID := s.bootstrapTestHive(ctx)
+	// a single report hides the content
+	s.setReportHideThreshold(hiveID, 1)
 	postID := s.bootstrapPost(ctx, hiveID)
 	//commentID := s.bootstrapComment(ctx, postID)
 
@@ -296,6 +338,8 @@ func (s *HiveTestSuite) TestPostReports() {
 func (s *HiveTestSuite) TestCommentReports() {
 	ctx := s.contextWithImpartAdmin()
 	hiveID := s.bootstrapTestHive(ctx)
+	// a single report hides the content
+	s.setReportHideThreshold(hiveID, 1)
 	postID := s.bootstrapPost(ctx, hiveID)
 	commentID := s.bootstrapComment(ctx, postID)
 
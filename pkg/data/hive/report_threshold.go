@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const (
+	// reportWeightScale is the weight of a report by a member without a reporting history,
+	// weights are stored in hundredths of a member.
+	reportWeightScale = 100
+	minReportWeight   = 25
+	maxReportWeight   = 200
+	// defaultReportHideThreshold is used for a hive without a threshold
+	defaultReportHideThreshold = 3
+)
+
+// ReportWeight scales a report by how the earlier reports of the member were resolved; reports
+// on content a moderator removed count for the member, reports on content a moderator approved
+// count against.
+func ReportWeight(upheld, dismissed int) uint16 {
+	w := reportWeightScale * (upheld + 2) / (dismissed + 2)
+	if w < minReportWeight {
+		w = minReportWeight
+	}
+	if w > maxReportWeight {
+		w = maxReportWeight
+	}
+	return uint16(w)
+}
+
+// ReportHidden reports whether the summed weights of the reports reach the hive threshold
+func ReportHidden(score int, threshold uint16) bool {
+	if threshold == 0 {
+		threshold = defaultReportHideThreshold
+	}
+	return score >= int(threshold)*reportWeightScale
+}
+
+// reporterWeight is the weight of a new report by the member
+func reporterWeight(ctx context.Context, exec boil.ContextExecutor, impartWealthID string) (uint16, error) {
+	var history struct {
+		Upheld    int `boil:"upheld"`
+		Dismissed int `boil:"dismissed"`
+	}
+	err := queries.Raw(`
+		select
+			(select count(*) from post_reactions r join post p on p.post_id = r.post_id
+				where r.impart_wealth_id = ? and r.reported = true
+				and p.reviewed = false and p.reviewed_at is not null and p.obfuscated = true and p.held = false)
+			+ (select count(*) from comment_reactions r join comment c on c.comment_id = r.comment_id
+				where r.impart_wealth_id = ? and r.reported = true
+				and c.reviewed = false and c.reviewed_at is not null and c.obfuscated = true and c.held = false) as upheld,
+			(select count(*) from post_reactions r join post p on p.post_id = r.post_id
+				where r.impart_wealth_id = ? and r.reported = true and p.reviewed = true)
+			+ (select count(*) from comment_reactions r join comment c on c.comment_id = r.comment_id
+				where r.impart_wealth_id = ? and r.reported = true and c.reviewed = true) as dismissed
+	`, impartWealthID, impartWealthID, impartWealthID, impartWealthID).Bind(ctx, exec, &history)
+	if err != nil {
+		return 0, err
+	}
+	return ReportWeight(history.Upheld, history.Dismissed), nil
+}
+
+// postReportScore sums the weights of the current reports of the post, with the hive threshold
+func postReportScore(ctx context.Context, exec boil.ContextExecutor, postID uint64) (int, uint16, error) {
+	var score struct {
+		Score     int    `boil:"score"`
+		Threshold uint16 `boil:"threshold"`
+	}
+	err := queries.Raw(`
+		select
+			coalesce((select sum(r.report_weight) from post_reactions r where r.post_id = p.post_id and r.reported = true), 0) as score,
+			h.report_hide_threshold as threshold
+		from post p
+		join hive h on h.hive_id = p.hive_id
+		where p.post_id = ?
+	`, postID).Bind(ctx, exec, &score)
+	return score.Score, score.Threshold, err
+}
+
+// commentReportScore sums the weights of the current reports of the comment, with the hive threshold
+func commentReportScore(ctx context.Context, exec boil.ContextExecutor, commentID uint64) (int, uint16, error) {
+	var score struct {
+		Score     int    `boil:"score"`
+		Threshold uint16 `boil:"threshold"`
+	}
+	err := queries.Raw(`
+		select
+			coalesce((select sum(r.report_weight) from comment_reactions r where r.comment_id = c.comment_id and r.reported = true), 0) as score,
+			h.report_hide_threshold as threshold
+		from comment c
+		join post p on p.post_id = c.post_id
+		join hive h on h.hive_id = p.hive_id
+		where c.comment_id = ?
+	`, commentID).Bind(ctx, exec, &score)
+	return score.Score, score.Threshold, err
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportWeight(t *testing.T) {
+	require.Equal(t, uint16(100), ReportWeight(0, 0), "a member without history counts once")
+	require.Equal(t, uint16(150), ReportWeight(1, 0))
+	require.Equal(t, uint16(200), ReportWeight(10, 0), "weight is capped")
+	require.Equal(t, uint16(50), ReportWeight(0, 2))
+	require.Equal(t, uint16(25), ReportWeight(0, 20), "weight never drops to nothing")
+}
+
+func TestReportHidden(t *testing.T) {
+	require.False(t, ReportHidden(200, 3))
+	require.True(t, ReportHidden(300, 3))
+	require.True(t, ReportHidden(100, 1))
+	require.True(t, ReportHidden(300, 0), "hives without a threshold use the default")
+	require.False(t, ReportHidden(0, 1))
+}
@@ -261,7 +261,9 @@ func (d *mysqlHiveData) GetReportedContents(ctx context.Context, gpi GetReported
 		qm.Load("PostFiles.FidFile"), // get files
 	}
 
-	queryMods = append(queryMods, qm.WhereIn("exists (select * from post_reactions rectn where rectn.post_id = `post`.`post_id` and rectn.reported = ? and `post`.`deleted_at` is null)", 1))
+	// posts held by the profanity filter wait for review the same as reported ones, removed posts are done
+	queryMods = append(queryMods, qm.Where("(`post`.`held` = true or exists (select * from post_reactions rectn where rectn.post_id = `post`.`post_id` and rectn.reported = ?)) and `post`.`deleted_at` is null", 1),
+		qm.Where("not (`post`.`reviewed_at` is not null and `post`.`obfuscated` = true and `post`.`held` = false)"))
 	posts, err := dbmodels.Posts(queryMods...).All(ctx, d.db)
 	if err != nil {
 		posts = dbmodels.PostSlice{}
@@ -279,8 +281,7 @@ func (d *mysqlHiveData) GetReportedContents(ctx context.Context, gpi GetReported
 	err = queries.Raw(`
 	Select pst.post_id, cmt.comment_id from post pst
 	join comment cmt on pst.post_id=cmt.post_id
-	join comment_reactions cmtrec on cmt.comment_id=cmtrec.comment_id
-	where cmtrec.reported=?
+	where (cmt.held = true or exists (select * from comment_reactions cmtrec where cmtrec.comment_id = cmt.comment_id and cmtrec.reported=?))
 	and hive_id=?
 	and pst.deleted_at is null
 	and cmt.deleted_at is null
@@ -297,6 +298,7 @@ func (d *mysqlHiveData) GetReportedContents(ctx context.Context, gpi GetReported
 		queryCommnt := []qm.QueryMod{
 			dbmodels.CommentWhere.CommentID.IN(commentIds),
 			dbmodels.CommentWhere.Reviewed.EQ(false),
+			qm.Where("not (`comment`.`reviewed_at` is not null and `comment`.`obfuscated` = true and `comment`.`held` = false)"),
 			qm.Offset(gpi.OffsetComment),
 			qm.Limit(gpi.Limit),
 			orderByMod,
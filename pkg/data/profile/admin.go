@@ -9,9 +9,8 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/beeker1121/mailchimp-go/lists/members"
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
@@ -86,7 +85,10 @@ func (m *mysqlStore) GetUsersDetails(ctx context.Context, gpi models.GetAdminInp
 								ELSE makeup.Income END AS income,	
 					CASE WHEN makeup.EmploymentStatus IS NULL THEN 'NA' 
 								ELSE makeup.EmploymentStatus END AS employment_status,					
-					makeup.sortorder as sortorder
+					makeup.sortorder as sortorder,
+					COALESCE(standing.strikes, 0) AS strikes,
+					standing.suspended_until,
+					COALESCE(standing.read_only, 0) AS read_only
 					FROM user
 
 					left join
@@ -197,6 +199,17 @@ func (m *mysqlStore) GetUsersDetails(ctx context.Context, gpi models.GetAdminInp
 					GROUP BY impart_wealth_id
 					) AS makeup
 					ON makeup.impart_wealth_id = user.impart_wealth_id
+
+					LEFT JOIN (
+					SELECT impart_wealth_id,
+						COUNT(CASE WHEN kind = 'warning' THEN 1 END) AS strikes,
+						MAX(CASE WHEN kind = 'suspension' THEN expires_at END) AS suspended_until,
+						MAX(kind = 'read_only') AS read_only
+					FROM user_sanctions
+					WHERE revoked_at IS NULL AND (expires_at IS NULL OR expires_at > UTC_TIMESTAMP(3))
+					GROUP BY impart_wealth_id
+					) AS standing
+					ON standing.impart_wealth_id = user.impart_wealth_id
 					
 					where user.deleted_at is null
 					`)
@@ -409,7 +422,6 @@ func (m *mysqlStore) GetPostDetails(ctx context.Context, gpi models.GetAdminInpu
 func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUserInput) (string, impart.Error) {
 	msg := ""
 	var existingHiveId uint64
-	cfg, _ := config.GetImpart()
 	userToUpdate, err := m.GetUser(ctx, gpi.ImpartWealthID)
 	if err != nil {
 		return msg, impart.NewError(impart.ErrBadRequest, "Unable to find the user")
@@ -442,23 +454,19 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 		userToUpdate.HiveUpdatedAt = impart.CurrentUTC()
 		_, err = userToUpdate.Update(ctx, m.db, boil.Infer())
 		if err != nil {
-			m.logger.Error("Update HiveUpdatedAt failed", zap.Any("user", userToUpdate))
+			impart.CtxLogger(ctx, m.logger).Error("Update HiveUpdatedAt failed", zap.Any("user", userToUpdate))
 		}
 		go func() {
 			err = m.UpdateHiveUserDemographic(ctx, answerIds, existingHiveId, DefaultHiveId, false, true, false)
 			if err != nil {
-				m.logger.Error("UpdateHiveUserDemographic update failed", zap.String("Email", userToUpdate.Email),
+				impart.CtxLogger(ctx, m.logger).Error("UpdateHiveUserDemographic update failed", zap.String("Email", userToUpdate.Email),
 					zap.Error(err))
 			}
 		}()
 		msg = "User added to waitlist."
 
-		mailChimpParams := &members.UpdateParams{
-			MergeFields: map[string]interface{}{"STATUS": impart.WaitList},
-		}
-		_, err = members.Update(cfg.MailchimpAudienceId, userToUpdate.Email, mailChimpParams)
-		if err != nil {
-			m.logger.Error("MailChimp update failed", zap.String("Email", userToUpdate.Email),
+		if err := marketing.QueueSync(ctx, m.db, userToUpdate.ImpartWealthID); err != nil {
+			impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing sync", zap.String("impartWealthID", userToUpdate.ImpartWealthID),
 				zap.Error(err))
 		}
 
@@ -488,7 +496,7 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 			if userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String != "" {
 				err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, userToUpdate.ImpartWealthID, userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String)
 				if err != nil {
-					m.logger.Error("SubscribeTopic", zap.String("DeviceToken", userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String),
+					impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String),
 						zap.Error(err))
 				}
 			}
@@ -525,12 +533,12 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 		userToUpdate.HiveUpdatedAt = impart.CurrentUTC()
 		_, err = userToUpdate.Update(ctx, m.db, boil.Infer())
 		if err != nil {
-			m.logger.Error("Update HiveUpdatedAt failed", zap.Any("user", userToUpdate))
+			impart.CtxLogger(ctx, m.logger).Error("Update HiveUpdatedAt failed", zap.Any("user", userToUpdate))
 		}
 		go func() {
 			err = m.UpdateHiveUserDemographic(ctx, answerIds, existingHiveId, gpi.HiveID, false, true, false)
 			if err != nil {
-				m.logger.Error("UpdateHiveUserDemographic update failed", zap.String("Email", userToUpdate.Email),
+				impart.CtxLogger(ctx, m.logger).Error("UpdateHiveUserDemographic update failed", zap.String("Email", userToUpdate.Email),
 					zap.Error(err))
 			}
 		}()
@@ -559,14 +567,14 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 			if isNotificationEnabled {
 				deviceDetails, devErr := m.GetUserDevices(ctx, "", userToUpdate.ImpartWealthID, "")
 				if devErr != nil {
-					m.logger.Error("unable to find device", zap.Error(err))
+					impart.CtxLogger(ctx, m.logger).Error("unable to find device", zap.Error(err))
 				}
 				if len(deviceDetails) > 0 {
 					for _, device := range deviceDetails {
 						if (device.LastloginAt == null.Time{}) {
-							endpointARN, err := m.notificationService.GetEndPointArn(ctx, device.DeviceToken, "")
+							endpointARN, err := m.notificationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(device.Platform), device.DeviceToken, "")
 							if err != nil {
-								m.logger.Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
+								impart.CtxLogger(ctx, m.logger).Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
 									zap.Error(err))
 							}
 							if endpointARN != "" && nwHive.NotificationTopicArn.String != "" {
@@ -581,6 +589,7 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 						HiveID:         nwHive.HiveID,
 						ImpartWealthID: userToUpdate.ImpartWealthID,
 						Email:          userToUpdate.Email,
+						Category:       impart.HiveWelcomeNotification,
 					}
 					alert := impart.Alert{
 						Title: aws.String(impart.AssignHiveTitle),
@@ -588,7 +597,7 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 					}
 					err = m.notificationService.Notify(ctx, notificationData, alert, userToUpdate.ImpartWealthID)
 					if err != nil {
-						m.logger.Error("push-notification : error attempting to send hive notification ",
+						impart.CtxLogger(ctx, m.logger).Error("push-notification : error attempting to send hive notification ",
 							zap.Any("postData", notificationData),
 							zap.Any("postData", alert),
 							zap.Error(err))
@@ -596,12 +605,8 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 				}
 			}
 		}()
-		mailChimpParams := &members.UpdateParams{
-			MergeFields: map[string]interface{}{"STATUS": impart.Hive},
-		}
-		_, err = members.Update(cfg.MailchimpAudienceId, userToUpdate.Email, mailChimpParams)
-		if err != nil {
-			m.logger.Error("MailChimp update failed", zap.String("Email", userToUpdate.Email),
+		if err := marketing.QueueSync(ctx, m.db, userToUpdate.ImpartWealthID); err != nil {
+			impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing sync", zap.String("impartWealthID", userToUpdate.ImpartWealthID),
 				zap.Error(err))
 		}
 
@@ -645,9 +650,9 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 			go func() {
 				for _, device := range deviceDetails {
 					if (device.LastloginAt == null.Time{}) {
-						endpointARN, err := m.notificationService.GetEndPointArn(ctx, device.DeviceToken, "")
+						endpointARN, err := m.notificationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(device.Platform), device.DeviceToken, "")
 						if err != nil {
-							m.logger.Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
+							impart.CtxLogger(ctx, m.logger).Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
 								zap.Error(err))
 						}
 						if endpointARN != "" {
@@ -679,7 +684,7 @@ func (m *mysqlStore) EditUserDetails(ctx context.Context, gpi models.WaitListUse
 			if userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String != "" {
 				err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, userToUpdate.ImpartWealthID, userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String)
 				if err != nil {
-					m.logger.Error("SubscribeTopic", zap.String("DeviceToken", userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String),
+					impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", userToUpdate.R.MemberHiveHives[0].NotificationTopicArn.String),
 						zap.Error(err))
 				}
 			}
@@ -714,7 +719,6 @@ func (m *mysqlStore) EditBulkUserDetails(ctx context.Context, userUpdatesInput m
 	userOutput.Action = userUpdatesInput.Action
 	userOutput.Users = userUpdatesInput.Users
 	impartWealthIDs := make([]interface{}, len(userUpdatesInput.Users))
-	cfg, _ := config.GetImpart()
 	for i, user := range userUpdatesInput.Users {
 		userOutput.Users[i].Message = "No update activity."
 		userOutput.Users[i].Status = false
@@ -722,7 +726,7 @@ func (m *mysqlStore) EditBulkUserDetails(ctx context.Context, userUpdatesInput m
 			impartWealthIDs = append(impartWealthIDs, (user.ImpartWealthID))
 		}
 	}
-	m.logger.Info("User list created")
+	impart.CtxLogger(ctx, m.logger).Info("User list created")
 	userOutputRslt := userOutput
 	includeUsers := 2
 	includeSuperadmin := impart.IncludeAll
@@ -750,37 +754,24 @@ func (m *mysqlStore) EditBulkUserDetails(ctx context.Context, userUpdatesInput m
 		return userOutputRslt
 	}
 	lenUser := len(userOutputRslt.Users)
-	status := ""
-	if userOutputRslt.Type == impart.AddToWaitlist {
-		status = impart.WaitList
-	} else if userOutputRslt.Type == impart.AddToHive {
-		status = impart.Hive
-	}
-	m.logger.Info("status updated")
+	impart.CtxLogger(ctx, m.logger).Info("status updated")
 	for _, user := range updateUsers {
 		for cnt := 0; cnt < lenUser; cnt++ {
 			if userOutputs.Users[cnt].ImpartWealthID == user.ImpartWealthID {
 				userOutputs.Users[cnt].Message = "User updated."
 				userOutputs.Users[cnt].Status = true
-				m.logger.Info("User status updating", zap.String("impartWealthID", user.ImpartWealthID))
+				impart.CtxLogger(ctx, m.logger).Info("User status updating", zap.String("impartWealthID", user.ImpartWealthID))
 				break
 			}
 		}
 		if userOutputRslt.Type == impart.AddToWaitlist || userOutputRslt.Type == impart.AddToHive {
-			go func() {
-				mailChimpParams := &members.UpdateParams{
-					MergeFields: map[string]interface{}{"STATUS": status},
-				}
-				_, err = members.Update(cfg.MailchimpAudienceId, user.Email, mailChimpParams)
-				if err != nil {
-					m.logger.Info("mailchimp failed")
-					m.logger.Error("MailChimp update failed", zap.String("Email", user.Email),
-						zap.Error(err))
-				}
-			}()
+			if err := marketing.QueueSync(ctx, m.db, user.ImpartWealthID); err != nil {
+				impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing sync", zap.String("impartWealthID", user.ImpartWealthID),
+					zap.Error(err))
+			}
 		}
 	}
-	m.logger.Info("all process completed")
+	impart.CtxLogger(ctx, m.logger).Info("all process completed")
 	return userOutputs
 }
 
@@ -814,7 +805,6 @@ func (m *mysqlStore) DeleteBulkUserDetails(ctx context.Context, userUpdatesInput
 		return userOutputRslt
 	}
 	lenUser := len(userOutputRslt.Users)
-	cfg, _ := config.GetImpart()
 	for _, user := range deleteUser {
 		for cnt := 0; cnt < lenUser; cnt++ {
 			if userOutputRslt.Users[cnt].ImpartWealthID == user.ImpartWealthID {
@@ -823,10 +813,9 @@ func (m *mysqlStore) DeleteBulkUserDetails(ctx context.Context, userUpdatesInput
 				break
 			}
 		}
-		err = members.Delete(cfg.MailchimpAudienceId, user.Email)
-		if err != nil {
-			m.logger.Error("Delete user requset failed in Mailchimp.", zap.String("deleteUser", user.ImpartWealthID),
-				zap.String("contextUser", user.ImpartWealthID))
+		if err := marketing.QueueRemoval(ctx, m.db, user.Email); err != nil {
+			impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing removal", zap.String("deleteUser", user.ImpartWealthID),
+				zap.Error(err))
 		}
 	}
 	return userOutputRslt
@@ -939,7 +928,7 @@ func (m *mysqlStore) GetHiveDetails(ctx context.Context, gpi models.GetAdminInpu
 	offset %d `, gpi.SortBy, gpi.Limit, gpi.Offset)
 	err := queries.Raw(query).Bind(ctx, m.db, &hiveDetails)
 	if err != nil {
-		m.logger.Error("error in data fetching", zap.Any("err", err))
+		impart.CtxLogger(ctx, m.logger).Error("error in data fetching", zap.Any("err", err))
 	}
 
 	if len(hiveDetails) < gpi.Limit {
@@ -953,7 +942,6 @@ func (m *mysqlStore) GetHiveDetails(ctx context.Context, gpi models.GetAdminInpu
 
 func (m *mysqlStore) UserEmailDetailsUpdate(ctx context.Context, gpi models.WebAppUserInput) impart.Error {
 
-	cfg, _ := config.GetImpart()
 	userData, err := m.GetUser(ctx, gpi.ImpartWealthID)
 	if err != nil {
 		return impart.NewError(impart.ErrBadRequest, "Unable to find the user")
@@ -964,28 +952,16 @@ func (m *mysqlStore) UserEmailDetailsUpdate(ctx context.Context, gpi models.WebA
 		if err != nil {
 			return impart.NewError(impart.ErrBadRequest, "Email unsubscribe failed.")
 		}
-		mailChimpParams := &members.UpdateParams{
-			Status: members.StatusUnsubscribed,
-		}
-		_, err = members.Update(cfg.MailchimpAudienceId, userData.Email, mailChimpParams)
-		if err != nil {
-			m.logger.Error("MailChimp update failed", zap.String("Email", userData.Email),
-				zap.Error(err))
-		}
 	} else {
 		userData.EmailSubscribe = true
 		_, err = userData.Update(ctx, m.db, boil.Infer())
 		if err != nil {
 			return impart.NewError(impart.ErrBadRequest, "Email subscribe failed.")
 		}
-		mailChimpParams := &members.UpdateParams{
-			Status: members.StatusSubscribed,
-		}
-		_, err = members.Update(cfg.MailchimpAudienceId, userData.Email, mailChimpParams)
-		if err != nil {
-			m.logger.Error("MailChimp update failed", zap.String("Email", userData.Email),
-				zap.Error(err))
-		}
+	}
+	if err := marketing.QueueSubscription(ctx, m.db, userData.ImpartWealthID); err != nil {
+		impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing subscription", zap.String("Email", userData.Email),
+			zap.Error(err))
 	}
 
 	return nil
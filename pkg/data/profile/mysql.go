@@ -10,11 +10,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/beeker1121/mailchimp-go/lists/members"
 	"github.com/google/uuid"
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	authdata "github.com/impartwealthapp/backend/pkg/data/auth"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
@@ -112,7 +111,7 @@ func rollbackIfError(tx *sql.Tx, err error, logger *zap.Logger) error {
 
 func (m *mysqlStore) CreateUserProfile(ctx context.Context, user *dbmodels.User, profile *dbmodels.Profile) error {
 	if user == nil || profile == nil {
-		m.logger.Error("user or profile is nil")
+		impart.CtxLogger(ctx, m.logger).Error("user or profile is nil")
 		return impart.ErrBadRequest
 	}
 	tx, err := m.db.BeginTx(ctx, nil)
@@ -188,7 +187,7 @@ func (m *mysqlStore) GetQuestionnaire(ctx context.Context, name string, version
 
 	questionnaire, err := dbmodels.Questionnaires(qms...).One(ctx, m.db)
 	if err != nil {
-		m.logger.Error("couldn't fetch questionnaire version",
+		impart.CtxLogger(ctx, m.logger).Error("couldn't fetch questionnaire version",
 			zap.String("name", name), zap.Error(err), zap.Error(err))
 		return nil, err
 	}
@@ -205,7 +204,7 @@ func (m *mysqlStore) GetAllCurrentQuestionnaires(ctx context.Context) (dbmodels.
 		Load(Rels(dbmodels.QuestionnaireRels.Questions, dbmodels.QuestionRels.Answers))).
 		All(ctx, m.db)
 	if err != nil {
-		m.logger.Error("couldn't fetch latest version for questionnaire", zap.Error(err))
+		impart.CtxLogger(ctx, m.logger).Error("couldn't fetch latest version for questionnaire", zap.Error(err))
 		return nil, err
 	}
 
@@ -330,7 +329,7 @@ func (m *mysqlStore) GetUserDevice(ctx context.Context, token string, impartID s
 // CreateUserDevice
 func (m *mysqlStore) CreateUserDevice(ctx context.Context, device *dbmodels.UserDevice) (*dbmodels.UserDevice, error) {
 	if device == nil {
-		m.logger.Error("device is nil")
+		impart.CtxLogger(ctx, m.logger).Error("device is nil")
 		return nil, impart.ErrBadRequest
 	}
 	uuid := uuid.New()
@@ -346,7 +345,7 @@ func (m *mysqlStore) CreateUserDevice(ctx context.Context, device *dbmodels.User
 // AddUserConfigurations
 func (m *mysqlStore) CreateUserConfigurations(ctx context.Context, conf *dbmodels.UserConfiguration) (*dbmodels.UserConfiguration, error) {
 	if conf.ImpartWealthID == "" {
-		m.logger.Error("impartWealthID is nil")
+		impart.CtxLogger(ctx, m.logger).Error("impartWealthID is nil")
 		return nil, impart.ErrBadRequest
 	}
 	err := conf.Insert(ctx, m.db, boil.Infer())
@@ -359,7 +358,7 @@ func (m *mysqlStore) CreateUserConfigurations(ctx context.Context, conf *dbmodel
 // Edit User Configurations
 func (m *mysqlStore) EditUserConfigurations(ctx context.Context, conf *dbmodels.UserConfiguration) (*dbmodels.UserConfiguration, error) {
 	if conf.ImpartWealthID == "" {
-		m.logger.Error("impartWealthID is nil")
+		impart.CtxLogger(ctx, m.logger).Error("impartWealthID is nil")
 		return nil, impart.ErrBadRequest
 	}
 	if _, err := conf.Update(ctx, m.db, boil.Infer()); err != nil {
@@ -371,7 +370,7 @@ func (m *mysqlStore) EditUserConfigurations(ctx context.Context, conf *dbmodels.
 // GetUserConfigurations
 func (m *mysqlStore) GetUserConfigurations(ctx context.Context, impartWealthID string) (*dbmodels.UserConfiguration, error) {
 	if impartWealthID == "" {
-		m.logger.Error("impartWealthID is nil")
+		impart.CtxLogger(ctx, m.logger).Error("impartWealthID is nil")
 		return nil, impart.ErrBadRequest
 	}
 	where := []QueryMod{
@@ -386,6 +385,29 @@ func (m *mysqlStore) GetUserConfigurations(ctx context.Context, impartWealthID s
 	return configurations, nil
 }
 
+// GetNotificationPreferences
+func (m *mysqlStore) GetNotificationPreferences(ctx context.Context, impartWealthID string) (dbmodels.UserNotificationPreferenceSlice, error) {
+	return dbmodels.UserNotificationPreferences(
+		dbmodels.UserNotificationPreferenceWhere.ImpartWealthID.EQ(impartWealthID),
+	).All(ctx, m.db)
+}
+
+// SaveNotificationPreferences
+// insert or update the given category preferences
+func (m *mysqlStore) SaveNotificationPreferences(ctx context.Context, prefs dbmodels.UserNotificationPreferenceSlice) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, p := range prefs {
+		err = p.Upsert(ctx, tx, boil.Whitelist(dbmodels.UserNotificationPreferenceColumns.Enabled, dbmodels.UserNotificationPreferenceColumns.UpdatedAt), boil.Infer())
+		if err != nil {
+			return rollbackIfError(tx, err, m.logger)
+		}
+	}
+	return tx.Commit()
+}
+
 // GetUserNotificationMappData
 func (m *mysqlStore) GetUserNotificationMappData(input models.MapArgumentInput) (*dbmodels.NotificationDeviceMapping, error) {
 	where := []QueryMod{}
@@ -470,7 +492,7 @@ func (m *mysqlStore) UpdateExistingNotificationMappData(input models.MapArgument
 // create user notificatoin map data
 func (m *mysqlStore) CreateUserNotificationMappData(ctx context.Context, data *dbmodels.NotificationDeviceMapping) (*dbmodels.NotificationDeviceMapping, error) {
 	if data == nil {
-		m.logger.Error("maping data is nil")
+		impart.CtxLogger(ctx, m.logger).Error("maping data is nil")
 		return nil, impart.ErrBadRequest
 	}
 
@@ -487,7 +509,7 @@ func (m *mysqlStore) BlockUser(ctx context.Context, user *dbmodels.User, status
 	user.Blocked = status
 	_, err := user.Update(ctx, m.db, boil.Infer())
 	if err != nil {
-		m.logger.Error("unable to block user", zap.Any("error", err))
+		impart.CtxLogger(ctx, m.logger).Error("unable to block user", zap.Any("error", err))
 		return fmt.Errorf("unable to block")
 	}
 	return nil
@@ -497,7 +519,7 @@ func (m *mysqlStore) UpdateDeviceToken(ctx context.Context, device *dbmodels.Use
 	device.DeviceToken = deviceToken
 	_, err := device.Update(ctx, m.db, boil.Infer())
 	if err != nil {
-		m.logger.Error("unable to update device token user", zap.Any("error", err))
+		impart.CtxLogger(ctx, m.logger).Error("unable to update device token user", zap.Any("error", err))
 		return fmt.Errorf("unable to update device token")
 	}
 	return nil
@@ -505,7 +527,7 @@ func (m *mysqlStore) UpdateDeviceToken(ctx context.Context, device *dbmodels.Use
 func (m *mysqlStore) UpdateDevice(ctx context.Context, device *dbmodels.UserDevice) error {
 	_, err := device.Update(ctx, m.db, boil.Infer())
 	if err != nil {
-		m.logger.Error("unable to update device", zap.Any("error", err))
+		impart.CtxLogger(ctx, m.logger).Error("unable to update device", zap.Any("error", err))
 		return fmt.Errorf("unable to update")
 	}
 	return nil
@@ -669,7 +691,7 @@ func (m *mysqlStore) DeleteUserProfile(ctx context.Context, gpi models.DeleteUse
 
 	err = m.UpdateProfile(ctx, userToDelete, existingDBProfile)
 	if err != nil {
-		m.logger.Error("Delete user requset failed", zap.String("deleteUser", userToDelete.ImpartWealthID),
+		impart.CtxLogger(ctx, m.logger).Error("Delete user requset failed", zap.String("deleteUser", userToDelete.ImpartWealthID),
 			zap.String("contextUser", userToDelete.ImpartWealthID))
 
 		return impart.NewError(err, "User Deletion failed")
@@ -679,7 +701,7 @@ func (m *mysqlStore) DeleteUserProfile(ctx context.Context, gpi models.DeleteUse
 	postDeleteQuery := DeleteUserPosts(userIds)
 	_, err = queries.Raw(postDeleteQuery).ExecContext(ctx, m.db)
 	if err != nil {
-		m.logger.Error("query failed", zap.Any("query", err), zap.Any("postDeleteQuery", postDeleteQuery))
+		impart.CtxLogger(ctx, m.logger).Error("query failed", zap.Any("query", err), zap.Any("postDeleteQuery", postDeleteQuery))
 	}
 
 	if userToDelete.R.MemberHiveHives != nil {
@@ -687,7 +709,7 @@ func (m *mysqlStore) DeleteUserProfile(ctx context.Context, gpi models.DeleteUse
 			go func() {
 				err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, userToDelete.ImpartWealthID, userToDelete.R.MemberHiveHives[0].NotificationTopicArn.String)
 				if err != nil {
-					m.logger.Error("SubscribeTopic", zap.String("DeviceToken", userToDelete.R.MemberHiveHives[0].NotificationTopicArn.String),
+					impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", userToDelete.R.MemberHiveHives[0].NotificationTopicArn.String),
 						zap.Error(err))
 				}
 			}()
@@ -698,7 +720,7 @@ func (m *mysqlStore) DeleteUserProfile(ctx context.Context, gpi models.DeleteUse
 		userToDelete = models.UpdateToUserDB(userToDelete, gpi, false, screenName, userEmail)
 		err = m.UpdateProfile(ctx, userToDelete, existingDBProfile)
 		if err != nil {
-			m.logger.Error("Delete user requset failed in auth 0 then revert the server", zap.String("deleteUser", userToDelete.ImpartWealthID),
+			impart.CtxLogger(ctx, m.logger).Error("Delete user requset failed in auth 0 then revert the server", zap.String("deleteUser", userToDelete.ImpartWealthID),
 				zap.String("contextUser", userToDelete.ImpartWealthID))
 		}
 		return impart.NewError(err, "User Deletion failed")
@@ -715,20 +737,16 @@ func (m *mysqlStore) DeleteUserProfile(ctx context.Context, gpi models.DeleteUse
 		userToDelete = models.UpdateToUserDB(userToDelete, gpi, true, screenName, userEmail)
 		err = m.UpdateProfile(ctx, userToDelete, existingDBProfile)
 		if err != nil {
-			m.logger.Error("Delete user requset failed in auth 0 then revert the server- user failed.", zap.String("deleteUser", userToDelete.ImpartWealthID),
+			impart.CtxLogger(ctx, m.logger).Error("Delete user requset failed in auth 0 then revert the server- user failed.", zap.String("deleteUser", userToDelete.ImpartWealthID),
 				zap.String("contextUser", userToDelete.ImpartWealthID))
 		}
 		return impart.NewError(err, "User Deletion failed")
 	}
-	// delete user from mailChimp
-	cfg, _ := config.GetImpart()
-	go func() {
-		err = members.Delete(cfg.MailchimpAudienceId, orgEmail)
-		if err != nil {
-			m.logger.Error("Delete user requset failed in MailChimp", zap.String("deleteUser", userToDelete.ImpartWealthID),
-				zap.String("contextUser", userToDelete.ImpartWealthID))
-		}
-	}()
+	// remove the user from the marketing audience
+	if err := marketing.QueueRemoval(ctx, m.db, orgEmail); err != nil {
+		impart.CtxLogger(ctx, m.logger).Error("unable to queue the marketing removal", zap.String("deleteUser", userToDelete.ImpartWealthID),
+			zap.Error(err))
+	}
 	go impart.UserDemographicsUpdate(ctx, m.db, true, true)
 	return nil
 }
@@ -762,7 +780,7 @@ func (m *mysqlStore) UpdateHiveUserDemographic(ctx context.Context, answerIds []
 	}
 	_, err = queries.Raw(query).ExecContext(ctx, m.db)
 	if err != nil {
-		m.logger.Error("hive_user_demographic update failed", zap.String("query", query),
+		impart.CtxLogger(ctx, m.logger).Error("hive_user_demographic update failed", zap.String("query", query),
 			zap.Error(err))
 		return err
 	}
@@ -831,7 +849,7 @@ func (m *mysqlStore) DeleteBulkUserProfile(ctx context.Context, userDetails dbmo
 				go func() {
 					err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, user.ImpartWealthID, user.R.MemberHiveHives[0].NotificationTopicArn.String)
 					if err != nil {
-						m.logger.Error("SubscribeTopic", zap.String("DeviceToken", user.R.MemberHiveHives[0].NotificationTopicArn.String),
+						impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", user.R.MemberHiveHives[0].NotificationTopicArn.String),
 							zap.Error(err))
 					}
 				}()
@@ -856,7 +874,7 @@ func (m *mysqlStore) DeleteBulkUserProfile(ctx context.Context, userDetails dbmo
 	}
 	_, err = queries.Raw(query).ExecContext(ctx, m.db)
 	if err != nil {
-		m.logger.Error("query failed", zap.Any("query", err))
+		impart.CtxLogger(ctx, m.logger).Error("query failed", zap.Any("query", err))
 		return err
 	}
 	go func() {
@@ -867,7 +885,7 @@ func (m *mysqlStore) DeleteBulkUserProfile(ctx context.Context, userDetails dbmo
 			}
 			err = mngmnt.User.Update(*&user.AuthenticationID, &userUp)
 			if err != nil {
-				m.logger.Error("Auth update failed", zap.Any("user.Email", user.Email), zap.Any("query", err))
+				impart.CtxLogger(ctx, m.logger).Error("Auth update failed", zap.Any("user.Email", user.Email), zap.Any("query", err))
 			}
 		}
 	}()
@@ -895,7 +913,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 					go func() {
 						err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, user.ImpartWealthID, user.R.MemberHiveHives[0].NotificationTopicArn.String)
 						if err != nil {
-							m.logger.Error("SubscribeTopic", zap.String("DeviceToken", user.R.MemberHiveHives[0].NotificationTopicArn.String),
+							impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", user.R.MemberHiveHives[0].NotificationTopicArn.String),
 								zap.Error(err))
 						}
 					}()
@@ -913,7 +931,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 						if existingHive.NotificationTopicArn.String != "" {
 							err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, user.ImpartWealthID, existingHive.NotificationTopicArn.String)
 							if err != nil {
-								m.logger.Error("SubscribeTopic", zap.String("DeviceToken", existingHive.NotificationTopicArn.String),
+								impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", existingHive.NotificationTopicArn.String),
 									zap.Error(err))
 							}
 						}
@@ -921,14 +939,14 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 				}
 			}
 		} else if userUpdate.Type == impart.AddToHive {
-			m.logger.Info("addtohive started", zap.String("query", impart.AddToHive))
-			m.logger.Info("user", zap.String("query", user.ImpartWealthID))
+			impart.CtxLogger(ctx, m.logger).Info("addtohive started", zap.String("query", impart.AddToHive))
+			impart.CtxLogger(ctx, m.logger).Info("user", zap.String("query", user.ImpartWealthID))
 			for _, h := range user.R.MemberHiveHives {
 				existinghiveid = h.HiveID
 			}
 			existingHive, _ = dbmodels.FindHive(ctx, m.db, existinghiveid)
-			m.logger.Info("addtohive started- existing hive", zap.Any("existingHive", existingHive))
-			m.logger.Info("user-hive", zap.String("query", fmt.Sprintf("%d", existinghiveid)))
+			impart.CtxLogger(ctx, m.logger).Info("addtohive started- existing hive", zap.Any("existingHive", existingHive))
+			impart.CtxLogger(ctx, m.logger).Info("user-hive", zap.String("query", fmt.Sprintf("%d", existinghiveid)))
 			if existinghiveid != userUpdate.HiveID {
 				impartWealthIds = fmt.Sprintf("%s '%s' ,", impartWealthIds, user.ImpartWealthID)
 				isMailSent := false
@@ -953,7 +971,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 						go func() {
 							err := m.notificationService.UnsubscribeTopicForAllDevice(ctx, user.ImpartWealthID, existingHive.NotificationTopicArn.String)
 							if err != nil {
-								m.logger.Error("SubscribeTopic", zap.String("DeviceToken", existingHive.NotificationTopicArn.String),
+								impart.CtxLogger(ctx, m.logger).Error("SubscribeTopic", zap.String("DeviceToken", existingHive.NotificationTopicArn.String),
 									zap.Error(err))
 							}
 						}()
@@ -963,14 +981,14 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 					go func() {
 						deviceDetails, devErr := m.GetUserDevices(ctx, "", user.ImpartWealthID, "")
 						if devErr != nil {
-							m.logger.Error("unable to find device", zap.Error(devErr))
+							impart.CtxLogger(ctx, m.logger).Error("unable to find device", zap.Error(devErr))
 						}
 						if len(deviceDetails) > 0 {
 							for _, device := range deviceDetails {
 								if (device.LastloginAt == null.Time{}) {
-									endpointARN, err := m.notificationService.GetEndPointArn(ctx, device.DeviceToken, "")
+									endpointARN, err := m.notificationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(device.Platform), device.DeviceToken, "")
 									if err != nil {
-										m.logger.Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
+										impart.CtxLogger(ctx, m.logger).Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
 											zap.Error(err))
 									}
 									if endpointARN != "" && newHive.NotificationTopicArn.String != "" {
@@ -985,6 +1003,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 								HiveID:         newHive.HiveID,
 								ImpartWealthID: user.ImpartWealthID,
 								Email:          user.Email,
+								Category:       impart.HiveWelcomeNotification,
 							}
 							alert := impart.Alert{
 								Title: aws.String(impart.AssignHiveTitle),
@@ -992,7 +1011,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 							}
 							err := m.notificationService.Notify(ctx, notificationData, alert, user.ImpartWealthID)
 							if err != nil {
-								m.logger.Error("push-notification : error attempting to send hive notification ",
+								impart.CtxLogger(ctx, m.logger).Error("push-notification : error attempting to send hive notification ",
 									zap.Any("postData", notificationData),
 									zap.Any("postData", alert),
 									zap.Error(err))
@@ -1022,9 +1041,9 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 					go func() {
 						for _, device := range deviceDetails {
 							if (device.LastloginAt == null.Time{}) {
-								endpointARN, err := m.notificationService.GetEndPointArn(ctx, device.DeviceToken, "")
+								endpointARN, err := m.notificationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(device.Platform), device.DeviceToken, "")
 								if err != nil {
-									m.logger.Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
+									impart.CtxLogger(ctx, m.logger).Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
 										zap.Error(err))
 								}
 								if endpointARN != "" {
@@ -1053,13 +1072,13 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 		}
 		_, err := queries.Raw(updateQuery).ExecContext(ctx, m.db)
 		if err != nil {
-			m.logger.Error("unable to excute query ", zap.String("query", updateQuery),
+			impart.CtxLogger(ctx, m.logger).Error("unable to excute query ", zap.String("query", updateQuery),
 				zap.Error(err))
 			return userUpdate, err
 		}
 		_, err = userDetails.UpdateAll(ctx, m.db, dbmodels.M{"hive_updated_at": impart.CurrentUTC()})
 		if err != nil {
-			m.logger.Error("hive Update Failed", zap.Any("query", userDetails),
+			impart.CtxLogger(ctx, m.logger).Error("hive Update Failed", zap.Any("query", userDetails),
 				zap.Error(err))
 		}
 		go impart.UserDemographicsUpdate(ctx, m.db, true, false)
@@ -1072,7 +1091,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 			dbmodels.M{"admin": true,
 				"avatar_background": adminColor})
 		if err != nil {
-			m.logger.Error("hive Update Failed", zap.Any("query", userDetails),
+			impart.CtxLogger(ctx, m.logger).Error("hive Update Failed", zap.Any("query", userDetails),
 				zap.Error(err))
 			return userUpdate, err
 		}
@@ -1087,7 +1106,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 				"admin":             true,
 				"avatar_background": adminColor})
 		if err != nil {
-			m.logger.Error("hive Update Failed", zap.Any("query", userDetails),
+			impart.CtxLogger(ctx, m.logger).Error("hive Update Failed", zap.Any("query", userDetails),
 				zap.Error(err))
 			return userUpdate, err
 		}
@@ -1095,7 +1114,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 		_, err := userDetails.UpdateAll(ctx, m.db,
 			dbmodels.M{"super_admin": false})
 		if err != nil {
-			m.logger.Error("hive Update Failed", zap.Any("query", userDetails),
+			impart.CtxLogger(ctx, m.logger).Error("hive Update Failed", zap.Any("query", userDetails),
 				zap.Error(err))
 		}
 		return userUpdate, err
@@ -1111,7 +1130,7 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 
 		_, err := queries.Raw(updateQuery).ExecContext(ctx, m.db)
 		if err != nil {
-			m.logger.Error("unable to excute query", zap.String("query", updateQuery),
+			impart.CtxLogger(ctx, m.logger).Error("unable to excute query", zap.String("query", updateQuery),
 				zap.Error(err))
 			return userUpdate, err
 		}
@@ -1119,73 +1138,6 @@ func (m *mysqlStore) UpdateBulkUserProfile(ctx context.Context, userDetails dbmo
 	return userUpdate, nil
 }
 
-func (m *mysqlStore) CreateMailChimpForExistingUsers(ctx context.Context) error {
-	newcluse := Where(fmt.Sprintf("%s = ?", dbmodels.UserColumns.Blocked), false)
-	usersWhere := []QueryMod{
-		newcluse,
-		Load(dbmodels.UserRels.ImpartWealthProfile),
-		Load(dbmodels.UserRels.MemberHiveHives),
-		Load(dbmodels.UserRels.ImpartWealthUserAnswers),
-		Load(Rels(dbmodels.UserRels.ImpartWealthUserAnswers, dbmodels.UserAnswerRels.Answer, dbmodels.AnswerRels.Question)),
-	}
-
-	out, err := dbmodels.Users(usersWhere...).All(ctx, m.db)
-	status := ""
-	if err != nil {
-		return err
-	}
-	params := &members.GetParams{
-		Status: members.StatusSubscribed,
-	}
-	cfg, _ := config.GetImpart()
-	listMembers, err := members.Get(cfg.MailchimpAudienceId, params)
-	if err != nil {
-		return err
-	}
-	for _, user := range out {
-		userAnswer := impart.GetUserAnswerList()
-		userExist := Contains(listMembers, user.Email)
-		if !userExist {
-			if len(user.R.MemberHiveHives) > 0 {
-				if user.R.MemberHiveHives[0].HiveID == impart.DefaultHiveID {
-					status = impart.WaitList
-				} else {
-					status = impart.Hive
-				}
-			}
-			if len(user.R.ImpartWealthUserAnswers) > 0 {
-				for _, anser := range user.R.ImpartWealthUserAnswers {
-					userAnswer[anser.R.Answer.QuestionID] = fmt.Sprintf("%s,%s", userAnswer[anser.R.Answer.QuestionID], anser.R.Answer.Text)
-					userAnswer[anser.R.Answer.R.Question.QuestionID] = strings.Trim(userAnswer[anser.R.Answer.R.Question.QuestionID], ",")
-				}
-			}
-			mergeFlds := impart.SetMailChimpAnswer(userAnswer, status, "", user.FirstName, user.LastName)
-			params := &members.NewParams{
-				EmailAddress: user.Email,
-				Status:       members.StatusSubscribed,
-				MergeFields:  mergeFlds,
-			}
-			_, err := members.New(cfg.MailchimpAudienceId, params)
-			if err != nil {
-				m.logger.Info("new user requset failed in MailChimp", zap.String("updateuser", user.Email),
-					zap.String("contextUser", user.ImpartWealthID))
-			}
-		}
-
-	}
-
-	return nil
-}
-
-func Contains(users *members.ListMembers, userEmail string) bool {
-	for _, mail := range users.Members {
-		if mail.EmailAddress == userEmail {
-			return true
-		}
-	}
-	return false
-}
-
 func (m *mysqlStore) GetUserAnswer(ctx context.Context, impartWealthId string) (dbmodels.UserAnswerSlice, error) {
 	qm := []QueryMod{
 		dbmodels.UserAnswerWhere.ImpartWealthID.EQ(impartWealthId),
@@ -1240,12 +1192,13 @@ func (m *mysqlStore) GetHiveNotification(ctx context.Context) error {
 		dates := []time.Time{startdate, enddate}
 		userList, err := m.getUserAll(ctx, nil, impart.IncludeAll, 2, impart.DefaultHiveID, dates)
 		if err != nil {
-			m.logger.Error("User fetching for notification error-", zap.Any("error", err))
+			impart.CtxLogger(ctx, m.logger).Error("User fetching for notification error-", zap.Any("error", err))
 			return err
 		}
 		notificationData := impart.NotificationData{
 			EventDatetime: impart.CurrentUTC(),
 			Path:          hive.Redirection,
+			Category:      impart.HiveWelcomeNotification,
 		}
 		for _, user := range userList {
 			isNotificationEnabled := false
@@ -1265,7 +1218,7 @@ func (m *mysqlStore) GetHiveNotification(ctx context.Context) error {
 				Title: aws.String(hive.Title),
 				Body:  aws.String(body),
 			}
-			m.logger.Info("User Details",
+			impart.CtxLogger(ctx, m.logger).Info("User Details",
 				zap.Any("User", user),
 				zap.Any("notificationData", notificationData),
 				zap.Any("alert", alert))
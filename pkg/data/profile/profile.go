@@ -30,6 +30,8 @@ type Store interface {
 	GetUserConfigurations(ctx context.Context, impartWealthID string) (*dbmodels.UserConfiguration, error)
 	CreateUserConfigurations(ctx context.Context, conf *dbmodels.UserConfiguration) (*dbmodels.UserConfiguration, error)
 	EditUserConfigurations(ctx context.Context, conf *dbmodels.UserConfiguration) (*dbmodels.UserConfiguration, error)
+	GetNotificationPreferences(ctx context.Context, impartWealthID string) (dbmodels.UserNotificationPreferenceSlice, error)
+	SaveNotificationPreferences(ctx context.Context, prefs dbmodels.UserNotificationPreferenceSlice) error
 	DeleteExceptUserDevice(ctx context.Context, impartID string, deviceToken string, refToken string) error
 
 	GetUserDevice(ctx context.Context, token string, impartWealthID string, deviceID string) (*dbmodels.UserDevice, error)
@@ -51,7 +53,6 @@ type Store interface {
 	EditBulkUserDetails(ctx context.Context, gpi models.UserUpdate) *models.UserUpdate
 	DeleteBulkUserDetails(ctx context.Context, gpi models.UserUpdate) *models.UserUpdate
 
-	CreateMailChimpForExistingUsers(ctx context.Context) error
 	GetUserAnswer(ctx context.Context, impartWealthId string) (dbmodels.UserAnswerSlice, error)
 	GetHiveDetails(ctx context.Context, gpi models.GetAdminInputs) (models.HiveDetails, *models.NextPage, error)
 	GetHiveNotification(ctx context.Context) error
@@ -0,0 +1,269 @@
+package deletion
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/impartwealthapp/backend/pkg/plaid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// gracePeriod is how long a deletion can be cancelled before the account is purged
+	gracePeriod = 30 * 24 * time.Hour
+	// maxAttempts is how many times a step of a purge is tried before the purge fails
+	maxAttempts = 8
+	purgeBatch  = 20
+)
+
+// activeStatuses are the statuses of a deletion that will purge or is purging the account
+var activeStatuses = []string{dbmodels.AccountDeletionsStatusPending, dbmodels.AccountDeletionsStatusPurging}
+
+type Service interface {
+	// RequestDeletion schedules the purge of the account of the context user after the grace period.
+	// The account keeps working until then so the member can sign in and cancel.
+	RequestDeletion(ctx context.Context, feedback string) (models.AccountDeletion, impart.Error)
+	GetDeletion(ctx context.Context) (models.AccountDeletion, impart.Error)
+	CancelDeletion(ctx context.Context) impart.Error
+	// ScheduleDeletions schedules the purge of accounts deleted by an admin, accounts already
+	// scheduled are skipped.
+	ScheduleDeletions(ctx context.Context, requestedBy string, impartWealthIDs ...string) error
+
+	GetAccountDeletion(ctx context.Context, impartWealthID string) (models.AccountDeletion, impart.Error)
+	CancelAccountDeletion(ctx context.Context, impartWealthID string) impart.Error
+	// RetryAccountDeletion resumes a failed purge, its failed steps get their attempts back
+	RetryAccountDeletion(ctx context.Context, impartWealthID string) impart.Error
+
+	// PurgeDue purges the accounts past their grace period and resumes the purges with steps left
+	// to retry. It is run by the scheduler.
+	PurgeDue(ctx context.Context) error
+}
+
+type service struct {
+	db            *sql.DB
+	logger        *zap.Logger
+	storage       *media.FileUpload
+	notifications impart.NotificationService
+	plaid         plaid.Service
+	audience      marketing.Audience
+	now           func() time.Time
+}
+
+func New(db *sql.DB, storage *media.FileUpload, notifications impart.NotificationService, plaidService plaid.Service, audience marketing.Audience, logger *zap.Logger) Service {
+	return &service{
+		db:            db,
+		logger:        logger,
+		storage:       storage,
+		notifications: notifications,
+		plaid:         plaidService,
+		audience:      audience,
+		now:           impart.CurrentUTC,
+	}
+}
+
+func (s *service) RequestDeletion(ctx context.Context, feedback string) (models.AccountDeletion, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return models.AccountDeletion{}, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	active, err := s.activeDeletion(ctx, ctxUser.ImpartWealthID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch account deletion", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return models.AccountDeletion{}, impart.UnknownError
+	}
+	if active != nil {
+		return models.AccountDeletion{}, impart.NewError(impart.ErrExists,
+			fmt.Sprintf("the account is already scheduled for deletion on %s", active.PurgeAfter.Format(time.RFC1123)))
+	}
+	d := s.newDeletion(ctxUser, ctxUser.ImpartWealthID)
+	if feedback = strings.TrimSpace(feedback); feedback != "" {
+		d.Feedback = null.StringFrom(feedback)
+	}
+	if err := d.Insert(ctx, s.db, boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to create account deletion", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return models.AccountDeletion{}, impart.UnknownError
+	}
+	s.notify(ctx, d.ImpartWealthID, "Your account is scheduled for deletion",
+		fmt.Sprintf("Your account and its data will be deleted on %s. You can cancel the deletion from your account settings until then.",
+			d.PurgeAfter.Format(time.RFC1123)))
+	return models.AccountDeletionFromDBModel(d, nil), nil
+}
+
+func (s *service) GetDeletion(ctx context.Context) (models.AccountDeletion, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return models.AccountDeletion{}, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	d, err := dbmodels.AccountDeletions(
+		dbmodels.AccountDeletionWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.AccountDeletionColumns.DeletionID)),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return models.AccountDeletion{}, impart.NewError(impart.ErrNotFound, "no account deletion requested")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch account deletion", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return models.AccountDeletion{}, impart.UnknownError
+	}
+	return models.AccountDeletionFromDBModel(d, nil), nil
+}
+
+func (s *service) CancelDeletion(ctx context.Context) impart.Error {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	return s.cancel(ctx, ctxUser.ImpartWealthID)
+}
+
+func (s *service) ScheduleDeletions(ctx context.Context, requestedBy string, impartWealthIDs ...string) error {
+	for _, impartWealthID := range impartWealthIDs {
+		active, err := s.activeDeletion(ctx, impartWealthID)
+		if err != nil {
+			return err
+		}
+		if active != nil {
+			continue
+		}
+		// the account is soft deleted by now, the generated queries would skip it
+		var user dbmodels.User
+		err = queries.Raw("select * from user where impart_wealth_id = ?", impartWealthID).Bind(ctx, s.db, &user)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.newDeletion(&user, requestedBy).Insert(ctx, s.db, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) GetAccountDeletion(ctx context.Context, impartWealthID string) (models.AccountDeletion, impart.Error) {
+	d, err := dbmodels.AccountDeletions(
+		dbmodels.AccountDeletionWhere.ImpartWealthID.EQ(impartWealthID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.AccountDeletionColumns.DeletionID)),
+		qm.Load(dbmodels.AccountDeletionRels.DeletionAccountDeletionSteps),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return models.AccountDeletion{}, impart.NewError(impart.ErrNotFound, "no account deletion requested")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch account deletion", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return models.AccountDeletion{}, impart.UnknownError
+	}
+	return models.AccountDeletionFromDBModel(d, sortSteps(d.R.DeletionAccountDeletionSteps)), nil
+}
+
+func (s *service) CancelAccountDeletion(ctx context.Context, impartWealthID string) impart.Error {
+	return s.cancel(ctx, impartWealthID)
+}
+
+func (s *service) RetryAccountDeletion(ctx context.Context, impartWealthID string) impart.Error {
+	d, err := dbmodels.AccountDeletions(
+		dbmodels.AccountDeletionWhere.ImpartWealthID.EQ(impartWealthID),
+		dbmodels.AccountDeletionWhere.Status.EQ(dbmodels.AccountDeletionsStatusFailed),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.AccountDeletionColumns.DeletionID)),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return impart.NewError(impart.ErrNotFound, "no failed account deletion to retry")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch account deletion", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return impart.UnknownError
+	}
+	_, err = dbmodels.AccountDeletionSteps(
+		dbmodels.AccountDeletionStepWhere.DeletionID.EQ(d.DeletionID),
+		dbmodels.AccountDeletionStepWhere.Status.EQ(dbmodels.AccountDeletionStepsStatusFailed),
+	).UpdateAll(ctx, s.db, dbmodels.M{
+		dbmodels.AccountDeletionStepColumns.Attempts:  0,
+		dbmodels.AccountDeletionStepColumns.UpdatedAt: s.now(),
+	})
+	if err == nil {
+		d.Status = dbmodels.AccountDeletionsStatusPurging
+		_, err = d.Update(ctx, s.db, boil.Whitelist(dbmodels.AccountDeletionColumns.Status))
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to retry account deletion", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return impart.UnknownError
+	}
+	return nil
+}
+
+// cancel stops a purge that has not started yet
+func (s *service) cancel(ctx context.Context, impartWealthID string) impart.Error {
+	d, err := s.activeDeletion(ctx, impartWealthID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch account deletion", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return impart.UnknownError
+	}
+	if d == nil {
+		return impart.NewError(impart.ErrNotFound, "no account deletion to cancel")
+	}
+	if d.Status != dbmodels.AccountDeletionsStatusPending {
+		return impart.NewError(impart.ErrBadRequest, "the account is already being deleted")
+	}
+	d.Status = dbmodels.AccountDeletionsStatusCancelled
+	d.CancelledAt = null.TimeFrom(s.now())
+	if _, err := d.Update(ctx, s.db, boil.Whitelist(dbmodels.AccountDeletionColumns.Status, dbmodels.AccountDeletionColumns.CancelledAt)); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to cancel account deletion", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return impart.UnknownError
+	}
+	return nil
+}
+
+func (s *service) activeDeletion(ctx context.Context, impartWealthID string) (*dbmodels.AccountDeletion, error) {
+	d, err := dbmodels.AccountDeletions(
+		dbmodels.AccountDeletionWhere.ImpartWealthID.EQ(impartWealthID),
+		dbmodels.AccountDeletionWhere.Status.IN(activeStatuses),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return d, err
+}
+
+// newDeletion keeps what the purge needs to reach the external services once the user is gone.
+// An account deleted by an admin already had its email suffixed with its id.
+func (s *service) newDeletion(user *dbmodels.User, requestedBy string) *dbmodels.AccountDeletion {
+	now := s.now()
+	return &dbmodels.AccountDeletion{
+		ImpartWealthID:   user.ImpartWealthID,
+		AuthenticationID: user.AuthenticationID,
+		Email:            strings.TrimSuffix(user.Email, "-"+user.ImpartWealthID),
+		Status:           dbmodels.AccountDeletionsStatusPending,
+		RequestedBy:      requestedBy,
+		CreatedAt:        now,
+		PurgeAfter:       now.Add(gracePeriod),
+	}
+}
+
+func (s *service) notify(ctx context.Context, impartWealthID, title, body string) {
+	data := impart.NotificationData{
+		EventDatetime: s.now(),
+		Category:      impart.AccountNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String(title),
+		Body:  aws.String(body),
+	}
+	if err := s.notifications.Notify(ctx, data, alert, impartWealthID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("push-notification : error attempting to send account deletion notification",
+			zap.String("impartWealthID", impartWealthID), zap.Error(err))
+	}
+}
@@ -0,0 +1,326 @@
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	authdata "github.com/impartwealthapp/backend/pkg/data/auth"
+	profiledata "github.com/impartwealthapp/backend/pkg/data/profile"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+	"gopkg.in/auth0.v5/management"
+)
+
+// purgeSteps are run in this order. Every step can be run again after a partial failure, mysql
+// runs once the others are done since they read what they remove from the database.
+var purgeSteps = []string{
+	dbmodels.AccountDeletionStepsStepSNS,
+	dbmodels.AccountDeletionStepsStepPlaid,
+	dbmodels.AccountDeletionStepsStepMailchimp,
+	dbmodels.AccountDeletionStepsStepMedia,
+	dbmodels.AccountDeletionStepsStepAuth0,
+	dbmodels.AccountDeletionStepsStepMysql,
+}
+
+func (s *service) PurgeDue(ctx context.Context) error {
+	deletions, err := dbmodels.AccountDeletions(
+		dbmodels.AccountDeletionWhere.Status.IN(activeStatuses),
+		dbmodels.AccountDeletionWhere.PurgeAfter.LTE(s.now()),
+		qm.OrderBy(dbmodels.AccountDeletionColumns.PurgeAfter),
+		qm.Limit(purgeBatch),
+	).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	var failed, purged int
+	for _, d := range deletions {
+		if err := s.purge(ctx, d); err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to purge account", zap.String("impartWealthID", d.ImpartWealthID), zap.Error(err))
+			failed++
+			continue
+		}
+		if d.Status == dbmodels.AccountDeletionsStatusPurged {
+			purged++
+		}
+	}
+	impart.CtxLogger(ctx, s.logger).Info("account-purge : done", zap.Int("deletions", len(deletions)), zap.Int("purged", purged), zap.Int("failed", failed))
+	if failed > 0 {
+		return fmt.Errorf("unable to purge %d of %d accounts", failed, len(deletions))
+	}
+	return nil
+}
+
+// purge runs the steps left to run. A failing step is recorded and retried on a later run, the
+// returned error is for the bookkeeping only.
+func (s *service) purge(ctx context.Context, d *dbmodels.AccountDeletion) error {
+	if d.Status == dbmodels.AccountDeletionsStatusPending {
+		if err := s.startPurge(ctx, d); err != nil {
+			return err
+		}
+	}
+	steps, err := dbmodels.AccountDeletionSteps(
+		dbmodels.AccountDeletionStepWhere.DeletionID.EQ(d.DeletionID),
+	).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	steps = sortSteps(steps)
+	err = s.runSteps(d, steps, func(step string) error {
+		return s.runStep(ctx, d, step)
+	}, func(step *dbmodels.AccountDeletionStep) error {
+		_, err := step.Update(ctx, s.db, boil.Infer())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Status = purgeStatus(steps)
+	cols := []string{dbmodels.AccountDeletionColumns.Status}
+	if d.Status == dbmodels.AccountDeletionsStatusPurged {
+		// nothing is left to reach, so what identified the member goes too
+		d.Email = ""
+		d.AuthenticationID = ""
+		d.Feedback = null.String{}
+		d.CompletedAt = null.TimeFrom(s.now())
+		cols = append(cols, dbmodels.AccountDeletionColumns.Email, dbmodels.AccountDeletionColumns.AuthenticationID,
+			dbmodels.AccountDeletionColumns.Feedback, dbmodels.AccountDeletionColumns.CompletedAt)
+	}
+	_, err = d.Update(ctx, s.db, boil.Whitelist(cols...))
+	return err
+}
+
+// startPurge creates the steps of the purge, from then on the deletion can't be cancelled
+func (s *service) startPurge(ctx context.Context, d *dbmodels.AccountDeletion) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	for _, name := range purgeSteps {
+		step := &dbmodels.AccountDeletionStep{
+			DeletionID: d.DeletionID,
+			Step:       name,
+			Status:     dbmodels.AccountDeletionStepsStatusPending,
+			UpdatedAt:  now,
+		}
+		if err := step.Insert(ctx, tx, boil.Infer()); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	// the member is signed out for good while the steps run, an admin deletion already did this
+	if _, err := tx.ExecContext(ctx, "update user set deleted_at = ? where impart_wealth_id = ? and deleted_at is null",
+		now, d.ImpartWealthID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	d.Status = dbmodels.AccountDeletionsStatusPurging
+	if _, err := d.Update(ctx, tx, boil.Whitelist(dbmodels.AccountDeletionColumns.Status)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// runSteps runs the due steps in order and saves the outcome of each, a step that fails is
+// left for a later run and does not stop the steps after it.
+func (s *service) runSteps(d *dbmodels.AccountDeletion, steps dbmodels.AccountDeletionStepSlice,
+	run func(step string) error, save func(step *dbmodels.AccountDeletionStep) error) error {
+	for _, step := range steps {
+		if !runnable(steps, step) {
+			continue
+		}
+		err := run(step.Step)
+		step.Attempts++
+		step.UpdatedAt = s.now()
+		if err != nil {
+			s.logger.Warn("account purge step failed", zap.String("impartWealthID", d.ImpartWealthID),
+				zap.String("step", step.Step), zap.Uint("attempts", step.Attempts), zap.Error(err))
+			step.Status = dbmodels.AccountDeletionStepsStatusFailed
+			step.LastError = null.StringFrom(truncate(err.Error(), 1000))
+		} else {
+			step.Status = dbmodels.AccountDeletionStepsStatusDone
+			step.LastError = null.String{}
+			step.CompletedAt = null.TimeFrom(step.UpdatedAt)
+		}
+		if err := save(step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) runStep(ctx context.Context, d *dbmodels.AccountDeletion, step string) error {
+	switch step {
+	case dbmodels.AccountDeletionStepsStepSNS:
+		return s.notifications.RemoveAllEndpoints(ctx, d.ImpartWealthID)
+	case dbmodels.AccountDeletionStepsStepPlaid:
+		return s.plaid.RemoveUserItems(ctx, d.ImpartWealthID)
+	case dbmodels.AccountDeletionStepsStepMailchimp:
+		return s.purgeMailchimp(ctx, d.Email)
+	case dbmodels.AccountDeletionStepsStepMedia:
+		return s.purgeMedia(ctx, d.ImpartWealthID)
+	case dbmodels.AccountDeletionStepsStepAuth0:
+		return s.purgeAuth0(d.AuthenticationID)
+	case dbmodels.AccountDeletionStepsStepMysql:
+		return s.purgeDatabase(ctx, d.ImpartWealthID)
+	}
+	return fmt.Errorf("unknown purge step %q", step)
+}
+
+// runnable tells if the step is due, mysql waits for every other step to be done
+func runnable(steps dbmodels.AccountDeletionStepSlice, step *dbmodels.AccountDeletionStep) bool {
+	switch {
+	case step.Status == dbmodels.AccountDeletionStepsStatusDone:
+		return false
+	case step.Status == dbmodels.AccountDeletionStepsStatusFailed && step.Attempts >= maxAttempts:
+		return false
+	case step.Step != dbmodels.AccountDeletionStepsStepMysql:
+		return true
+	}
+	for _, other := range steps {
+		if other.Step != step.Step && other.Status != dbmodels.AccountDeletionStepsStatusDone {
+			return false
+		}
+	}
+	return true
+}
+
+// purgeStatus is purged once every step is done, and failed when a step is out of attempts
+func purgeStatus(steps dbmodels.AccountDeletionStepSlice) string {
+	done := 0
+	for _, step := range steps {
+		switch {
+		case step.Status == dbmodels.AccountDeletionStepsStatusDone:
+			done++
+		case step.Status == dbmodels.AccountDeletionStepsStatusFailed && step.Attempts >= maxAttempts:
+			return dbmodels.AccountDeletionsStatusFailed
+		}
+	}
+	if done == len(purgeSteps) {
+		return dbmodels.AccountDeletionsStatusPurged
+	}
+	return dbmodels.AccountDeletionsStatusPurging
+}
+
+// sortSteps puts the steps in the order they run
+func sortSteps(steps dbmodels.AccountDeletionStepSlice) dbmodels.AccountDeletionStepSlice {
+	order := make(map[string]int, len(purgeSteps))
+	for i, name := range purgeSteps {
+		order[name] = i
+	}
+	sort.SliceStable(steps, func(i, j int) bool { return order[steps[i].Step] < order[steps[j].Step] })
+	return steps
+}
+
+// purgeMailchimp permanently deletes the member from the marketing audience, an archived member
+// would keep their data.
+func (s *service) purgeMailchimp(ctx context.Context, email string) error {
+	if email == "" {
+		return nil
+	}
+	return s.audience.Erase(ctx, email)
+}
+
+// purgeMedia deletes the files the member uploaded, the files of their posts uploaded before
+// files had an owner and their data exports.
+func (s *service) purgeMedia(ctx context.Context, impartWealthID string) error {
+	files, err := dbmodels.Files(
+		qm.Where(fmt.Sprintf("%s = ? or %s in (select pf.%s from %s pf join %s p on p.%s = pf.%s where p.%s = ?)",
+			dbmodels.FileColumns.ImpartWealthID, dbmodels.FileColumns.Fid,
+			dbmodels.PostFileColumns.Fid, dbmodels.TableNames.PostFiles, dbmodels.TableNames.Post,
+			dbmodels.PostColumns.PostID, dbmodels.PostFileColumns.PostID, dbmodels.PostColumns.ImpartWealthID),
+			impartWealthID, impartWealthID),
+	).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	var keys []string
+	for _, f := range files {
+		key := f.StorageKey.String
+		if key == "" {
+			key = s.storage.KeyFromURL(f.URL)
+		}
+		if key == "" {
+			continue
+		}
+		keys = append(keys, media.FileKeys(key, f.FileType, f.ThumbnailURL.Valid)...)
+	}
+	exports, err := s.storage.Store.List(ctx, fmt.Sprintf("%sexports/%s/", s.storage.MediaPath, impartWealthID))
+	if err != nil {
+		return err
+	}
+	for _, e := range exports {
+		keys = append(keys, e.Key)
+	}
+	return s.storage.Store.Delete(ctx, keys...)
+}
+
+func (s *service) purgeAuth0(authenticationID string) error {
+	if authenticationID == "" {
+		return nil
+	}
+	mngmnt, err := authdata.NewImpartManagementClient()
+	if err != nil {
+		return err
+	}
+	if mngmnt == nil {
+		return fmt.Errorf("auth0 management client is not configured")
+	}
+	err = mngmnt.User.Delete(authenticationID)
+	if mErr, ok := err.(management.Error); ok && mErr.Status() == 404 {
+		return nil
+	}
+	return err
+}
+
+// purgeDatabase deletes the user, the tables of what the member owns cascade. The counts the
+// member added to other posts and comments are taken back first, like when an admin deletes a user.
+func (s *service) purgeDatabase(ctx context.Context, impartWealthID string) error {
+	if _, err := queries.Raw(profiledata.DeleteUserPosts(fmt.Sprintf("'%s'", impartWealthID))).ExecContext(ctx, s.db); err != nil {
+		return err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, query := range []string{
+		`delete user_plaid_accounts_log from user_plaid_accounts_log
+			join user_institutions on user_institutions.user_institution_id = user_plaid_accounts_log.user_institution_id
+			where user_institutions.impart_wealth_id = ?`,
+		"delete from user_institutions where impart_wealth_id = ?",
+		"delete from profile where impart_wealth_id = ?",
+		"update user_sanctions set issued_by = null where issued_by = ?",
+		"update user_sanctions set revoked_by = null where revoked_by = ?",
+		"update moderation_decisions set moderator_id = null where moderator_id = ?",
+		"update content_appeals set resolved_by = null where resolved_by = ?",
+		"delete from user where impart_wealth_id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, query, impartWealthID); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if err := impart.UserDemographicsUpdate(ctx, s.db, true, true); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to update demographics after purging an account", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
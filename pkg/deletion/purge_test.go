@@ -0,0 +1,159 @@
+package deletion
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func testSteps(statuses map[string]string) dbmodels.AccountDeletionStepSlice {
+	var steps dbmodels.AccountDeletionStepSlice
+	// created out of order, the purge sorts them
+	for i := len(purgeSteps) - 1; i >= 0; i-- {
+		status, ok := statuses[purgeSteps[i]]
+		if !ok {
+			status = dbmodels.AccountDeletionStepsStatusPending
+		}
+		steps = append(steps, &dbmodels.AccountDeletionStep{Step: purgeSteps[i], Status: status})
+	}
+	return steps
+}
+
+func findStep(steps dbmodels.AccountDeletionStepSlice, name string) *dbmodels.AccountDeletionStep {
+	for _, s := range steps {
+		if s.Step == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func TestSortSteps(t *testing.T) {
+	steps := sortSteps(testSteps(nil))
+	names := make([]string, len(steps))
+	for i, s := range steps {
+		names[i] = s.Step
+	}
+	assert.Equal(t, purgeSteps, names)
+}
+
+func TestRunnable(t *testing.T) {
+	steps := testSteps(nil)
+	assert.True(t, runnable(steps, findStep(steps, dbmodels.AccountDeletionStepsStepSNS)))
+	assert.False(t, runnable(steps, findStep(steps, dbmodels.AccountDeletionStepsStepMysql)), "mysql waits for the other steps")
+
+	steps = testSteps(map[string]string{
+		dbmodels.AccountDeletionStepsStepSNS:       dbmodels.AccountDeletionStepsStatusDone,
+		dbmodels.AccountDeletionStepsStepPlaid:     dbmodels.AccountDeletionStepsStatusFailed,
+		dbmodels.AccountDeletionStepsStepMailchimp: dbmodels.AccountDeletionStepsStatusDone,
+		dbmodels.AccountDeletionStepsStepMedia:     dbmodels.AccountDeletionStepsStatusDone,
+		dbmodels.AccountDeletionStepsStepAuth0:     dbmodels.AccountDeletionStepsStatusDone,
+	})
+	plaid := findStep(steps, dbmodels.AccountDeletionStepsStepPlaid)
+	plaid.Attempts = 3
+	assert.False(t, runnable(steps, findStep(steps, dbmodels.AccountDeletionStepsStepSNS)), "done steps are not run again")
+	assert.True(t, runnable(steps, plaid), "failed steps are retried")
+	assert.False(t, runnable(steps, findStep(steps, dbmodels.AccountDeletionStepsStepMysql)))
+
+	plaid.Attempts = maxAttempts
+	assert.False(t, runnable(steps, plaid), "a step out of attempts waits for a retry")
+
+	plaid.Status = dbmodels.AccountDeletionStepsStatusDone
+	assert.True(t, runnable(steps, findStep(steps, dbmodels.AccountDeletionStepsStepMysql)))
+}
+
+func TestRunSteps(t *testing.T) {
+	s := &service{logger: zap.NewNop(), now: time.Now}
+	d := &dbmodels.AccountDeletion{ImpartWealthID: "1xRvvB2ztPPVYpRLLgArq7KHQ8Q"}
+	steps := sortSteps(testSteps(nil))
+
+	var ran, saved []string
+	run := func(failing string) func(string) error {
+		return func(step string) error {
+			ran = append(ran, step)
+			if step == failing {
+				return errors.New("plaid is down")
+			}
+			return nil
+		}
+	}
+	save := func(step *dbmodels.AccountDeletionStep) error {
+		saved = append(saved, step.Step)
+		return nil
+	}
+
+	// a failing step doesn't stop the others, mysql waits for it
+	assert.NoError(t, s.runSteps(d, steps, run(dbmodels.AccountDeletionStepsStepPlaid), save))
+	assert.Equal(t, purgeSteps[:len(purgeSteps)-1], ran)
+	assert.Equal(t, ran, saved)
+	plaid := findStep(steps, dbmodels.AccountDeletionStepsStepPlaid)
+	assert.Equal(t, dbmodels.AccountDeletionStepsStatusFailed, plaid.Status)
+	assert.Equal(t, "plaid is down", plaid.LastError.String)
+	assert.Equal(t, uint(1), plaid.Attempts)
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPurging, purgeStatus(steps))
+
+	// the retry only runs the failed step, then mysql
+	ran, saved = nil, nil
+	assert.NoError(t, s.runSteps(d, steps, run(""), save))
+	assert.Equal(t, []string{dbmodels.AccountDeletionStepsStepPlaid, dbmodels.AccountDeletionStepsStepMysql}, ran)
+	assert.Equal(t, uint(2), plaid.Attempts)
+	assert.False(t, plaid.LastError.Valid)
+	assert.True(t, plaid.CompletedAt.Valid)
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPurged, purgeStatus(steps))
+
+	// a step out of attempts fails the purge
+	steps = sortSteps(testSteps(nil))
+	for i := 0; i < maxAttempts; i++ {
+		assert.NoError(t, s.runSteps(d, steps, run(dbmodels.AccountDeletionStepsStepPlaid), save))
+	}
+	assert.Equal(t, dbmodels.AccountDeletionsStatusFailed, purgeStatus(steps))
+	ran = nil
+	assert.NoError(t, s.runSteps(d, steps, run(""), save))
+	assert.Empty(t, ran)
+
+	assert.Error(t, s.runSteps(d, sortSteps(testSteps(nil)), run(""), func(*dbmodels.AccountDeletionStep) error {
+		return errors.New("database is down")
+	}))
+}
+
+func TestPurgeStatus(t *testing.T) {
+	steps := testSteps(map[string]string{dbmodels.AccountDeletionStepsStepSNS: dbmodels.AccountDeletionStepsStatusDone})
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPurging, purgeStatus(steps))
+
+	auth0 := findStep(steps, dbmodels.AccountDeletionStepsStepAuth0)
+	auth0.Status = dbmodels.AccountDeletionStepsStatusFailed
+	auth0.Attempts = 1
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPurging, purgeStatus(steps))
+	auth0.Attempts = maxAttempts
+	assert.Equal(t, dbmodels.AccountDeletionsStatusFailed, purgeStatus(steps))
+
+	for _, s := range steps {
+		s.Status = dbmodels.AccountDeletionStepsStatusDone
+	}
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPurged, purgeStatus(steps))
+}
+
+func TestNewDeletion(t *testing.T) {
+	now := time.Date(2022, 2, 16, 9, 30, 40, 0, time.UTC)
+	s := &service{now: func() time.Time { return now }}
+	user := &dbmodels.User{
+		ImpartWealthID:   "1xRvvB2ztPPVYpRLLgArq7KHQ8Q",
+		AuthenticationID: "auth0|1234",
+		Email:            "member@example.com",
+	}
+
+	d := s.newDeletion(user, user.ImpartWealthID)
+	assert.Equal(t, "member@example.com", d.Email)
+	assert.Equal(t, dbmodels.AccountDeletionsStatusPending, d.Status)
+	assert.Equal(t, now.Add(gracePeriod), d.PurgeAfter)
+
+	// an admin deletion suffixes the email before the purge is scheduled
+	user.Email = "member@example.com-1xRvvB2ztPPVYpRLLgArq7KHQ8Q"
+	d = s.newDeletion(user, "2yRvvB2ztPPVYpRLLgArq7KHQ8Q")
+	assert.Equal(t, "member@example.com", d.Email)
+	assert.Equal(t, "2yRvvB2ztPPVYpRLLgArq7KHQ8Q", d.RequestedBy)
+}
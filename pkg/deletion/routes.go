@@ -0,0 +1,87 @@
+package deletion
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"go.uber.org/zap"
+)
+
+type deletionHandler struct {
+	deletionService Service
+	logger          *zap.Logger
+}
+
+// SetupRoutes registers the routes of the deletion requests, a member requests a deletion by
+// deleting their profile.
+func SetupRoutes(version *gin.RouterGroup, deletionService Service, logger *zap.Logger) {
+	handler := &deletionHandler{
+		deletionService: deletionService,
+		logger:          logger,
+	}
+
+	userRoutes := version.Group("/user/deletion")
+	userRoutes.GET("", handler.GetDeletionFunc())
+	userRoutes.DELETE("", handler.CancelDeletionFunc())
+
+	adminRoutes := version.Group("/admin/deletions")
+	adminRoutes.Use(impart.RequireRole(impart.SuperAdminRole))
+	adminRoutes.GET("/:impartWealthId", handler.GetAccountDeletionFunc())
+	adminRoutes.POST("/:impartWealthId/cancel", handler.CancelAccountDeletionFunc())
+	adminRoutes.POST("/:impartWealthId/retry", handler.RetryAccountDeletionFunc())
+}
+
+func (dh *deletionHandler) GetDeletionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		deletion, impartErr := dh.deletionService.GetDeletion(ctx)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, deletion)
+	}
+}
+
+// CancelDeletionFunc keeps the account of the member, only until the purge starts
+func (dh *deletionHandler) CancelDeletionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if impartErr := dh.deletionService.CancelDeletion(ctx); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "account deletion cancelled"})
+	}
+}
+
+// GetAccountDeletionFunc returns the last deletion of the account with the status of each step
+func (dh *deletionHandler) GetAccountDeletionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		deletion, impartErr := dh.deletionService.GetAccountDeletion(ctx, ctx.Param("impartWealthId"))
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, deletion)
+	}
+}
+
+func (dh *deletionHandler) CancelAccountDeletionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if impartErr := dh.deletionService.CancelAccountDeletion(ctx, ctx.Param("impartWealthId")); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "account deletion cancelled"})
+	}
+}
+
+func (dh *deletionHandler) RetryAccountDeletionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if impartErr := dh.deletionService.RetryAccountDeletion(ctx, ctx.Param("impartWealthId")); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusAccepted, gin.H{"status": true, "message": "account deletion resumed"})
+	}
+}
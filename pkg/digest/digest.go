@@ -0,0 +1,364 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	temp "html/template"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	profiledata "github.com/impartwealthapp/backend/pkg/data/profile"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	digestSubject     = "Your week in the Hive"
+	digestPreviewText = "Top posts, replies to your posts and new members in your Hive this week"
+	topPostLimit      = 3
+	digestPeriod      = 7 * 24 * time.Hour
+	// unsubscribeTTL is how long the unsubscribe link of a digest works, well past the 30 days
+	// an unsubscribe link has to keep working
+	unsubscribeTTL = 60 * 24 * time.Hour
+	templatePath   = "./schemas/html/weekly_digest.html"
+)
+
+// Service sends the weekly email digest to every subscribed hive member and
+// handles the unsubscribe link included in each digest.
+type Service interface {
+	SendWeeklyDigest(ctx context.Context) error
+	UnsubscribeToken(impartWealthID string, expires time.Time) string
+	Unsubscribe(ctx context.Context, impartWealthID, token string, expires time.Time) impart.Error
+}
+
+type service struct {
+	db             *sql.DB
+	logger         *zap.Logger
+	emails         impart.EmailService
+	profileStore   profiledata.Store
+	template       *temp.Template
+	unsubscribeURL string
+	secret         []byte
+	now            func() time.Time
+}
+
+// New fails without a dedicated unsubscribe secret, or when the digest template can't be parsed
+func New(cfg *config.Impart, db *sql.DB, emails impart.EmailService, profileStore profiledata.Store, logger *zap.Logger) (Service, error) {
+	if cfg.Email.UnsubscribeSecret == "" {
+		return nil, errors.New("no email unsubscribe secret configured")
+	}
+	t, err := temp.ParseFiles(templatePath)
+	if err != nil {
+		return nil, err
+	}
+	return &service{
+		db:             db,
+		logger:         logger,
+		emails:         emails,
+		profileStore:   profileStore,
+		template:       t,
+		unsubscribeURL: cfg.Email.UnsubscribeURL,
+		secret:         []byte(cfg.Email.UnsubscribeSecret),
+		now:            impart.CurrentUTC,
+	}, nil
+}
+
+// Post is a post as listed in the digest
+type Post struct {
+	PostID       uint64 `boil:"post_id"`
+	Subject      string `boil:"subject"`
+	UpVoteCount  int    `boil:"up_vote_count"`
+	CommentCount int    `boil:"comment_count"`
+}
+
+// Reply counts the new comments left by others on one of the members posts
+type Reply struct {
+	ImpartWealthID string `boil:"impart_wealth_id"`
+	PostID         uint64 `boil:"post_id"`
+	Subject        string `boil:"subject"`
+	Replies        int    `boil:"replies"`
+}
+
+type member struct {
+	ImpartWealthID string `boil:"impart_wealth_id"`
+	Email          string `boil:"email"`
+	ScreenName     string `boil:"screen_name"`
+}
+
+// hiveActivity is the part of the digest shared by every member of a hive
+type hiveActivity struct {
+	HiveID     uint64
+	HiveName   string
+	TopPosts   []Post
+	NewMembers int64
+}
+
+// hiveDigest is the activity of one of the hives of the member
+type hiveDigest struct {
+	HiveName   string
+	TopPosts   []Post
+	Replies    []Reply
+	NewMembers int64
+}
+
+// templateData is passed to the digest html template
+type templateData struct {
+	Year           int
+	ScreenName     string
+	Hives          []hiveDigest
+	UnsubscribeURL string
+}
+
+// recipient collects the hives of a member with something to report
+type recipient struct {
+	member
+	hives []hiveDigest
+}
+
+// recipients keeps the members in the order they were first added
+type recipients struct {
+	list []*recipient
+	byID map[string]*recipient
+}
+
+func (r *recipients) add(m member, section hiveDigest) {
+	if r.byID == nil {
+		r.byID = make(map[string]*recipient)
+	}
+	rc, ok := r.byID[m.ImpartWealthID]
+	if !ok {
+		rc = &recipient{member: m}
+		r.byID[m.ImpartWealthID] = rc
+		r.list = append(r.list, rc)
+	}
+	rc.hives = append(rc.hives, section)
+}
+
+// SendWeeklyDigest emails the last weeks activity to the subscribed members, a single email
+// covers every hive of the member. Members with nothing to report are skipped, a failure for
+// one hive or member does not stop the others.
+func (s *service) SendWeeklyDigest(ctx context.Context) error {
+	since := s.now().Add(-digestPeriod)
+	hives, err := dbmodels.Hives(
+		dbmodels.HiveWhere.DeletedAt.IsNull(),
+		dbmodels.HiveWhere.HiveID.NEQ(impart.DefaultHiveID),
+	).All(ctx, s.db)
+	if err != nil {
+		s.logger.Error("weekly-digest : unable to fetch hives", zap.Error(err))
+		return err
+	}
+	var sent, failed int
+	var to recipients
+	for _, h := range hives {
+		activity, err := s.hiveActivity(ctx, h, since)
+		if err != nil {
+			s.logger.Error("weekly-digest : unable to fetch hive activity", zap.Uint64("hiveId", h.HiveID), zap.Error(err))
+			failed++
+			continue
+		}
+		members, err := s.subscribedMembers(ctx, h.HiveID)
+		if err != nil {
+			s.logger.Error("weekly-digest : unable to fetch hive members", zap.Uint64("hiveId", h.HiveID), zap.Error(err))
+			failed++
+			continue
+		}
+		replies, err := s.replies(ctx, h.HiveID, since)
+		if err != nil {
+			s.logger.Error("weekly-digest : unable to fetch replies", zap.Uint64("hiveId", h.HiveID), zap.Error(err))
+			failed++
+			continue
+		}
+		for _, m := range members {
+			if section, ok := memberHive(activity, replies[m.ImpartWealthID]); ok {
+				to.add(m, section)
+			}
+		}
+	}
+	for _, r := range to.list {
+		email, err := s.render(r.Email, s.memberDigest(r.member, r.hives))
+		if err == nil {
+			err = s.emails.Send(ctx, email)
+		}
+		if err != nil {
+			s.logger.Error("weekly-digest : unable to send digest",
+				zap.String("impartWealthID", r.ImpartWealthID), zap.Error(err))
+			failed++
+			continue
+		}
+		sent++
+	}
+	s.logger.Info("weekly-digest : completed", zap.Int("sent", sent), zap.Int("failed", failed))
+	if failed > 0 {
+		return fmt.Errorf("weekly digest failed for %d members or hives", failed)
+	}
+	return nil
+}
+
+func (s *service) hiveActivity(ctx context.Context, h *dbmodels.Hive, since time.Time) (hiveActivity, error) {
+	activity := hiveActivity{HiveID: h.HiveID, HiveName: h.Name}
+	posts, err := dbmodels.Posts(
+		dbmodels.PostWhere.HiveID.EQ(h.HiveID),
+		dbmodels.PostWhere.DeletedAt.IsNull(),
+		dbmodels.PostWhere.Obfuscated.EQ(false),
+		dbmodels.PostWhere.CreatedAt.GTE(since),
+		qm.OrderBy("up_vote_count + comment_count desc, post_id desc"),
+		qm.Limit(topPostLimit),
+	).All(ctx, s.db)
+	if err != nil {
+		return activity, err
+	}
+	for _, p := range posts {
+		activity.TopPosts = append(activity.TopPosts, Post{
+			PostID:       p.PostID,
+			Subject:      p.Subject,
+			UpVoteCount:  p.UpVoteCount,
+			CommentCount: p.CommentCount,
+		})
+	}
+	activity.NewMembers, err = dbmodels.Users(
+		qm.InnerJoin("hive_members on hive_members.member_impart_wealth_id = user.impart_wealth_id"),
+		qm.Where("hive_members.member_hive_id = ?", h.HiveID),
+		dbmodels.UserWhere.HiveUpdatedAt.GTE(since),
+		dbmodels.UserWhere.DeletedAt.IsNull(),
+	).Count(ctx, s.db)
+	return activity, err
+}
+
+func (s *service) subscribedMembers(ctx context.Context, hiveID uint64) ([]member, error) {
+	var members []member
+	err := queries.Raw(`
+		select user.impart_wealth_id, user.email, user.screen_name
+		from user
+		join hive_members on hive_members.member_impart_wealth_id = user.impart_wealth_id
+		where hive_members.member_hive_id = ?
+		and user.deleted_at is null
+		and user.blocked = false
+		and user.email_subscribe = true
+	`, hiveID).Bind(ctx, s.db, &members)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return members, err
+}
+
+// replies returns the new comments by others on posts in the hive, keyed by the post author
+func (s *service) replies(ctx context.Context, hiveID uint64, since time.Time) (map[string][]Reply, error) {
+	var replies []Reply
+	err := queries.Raw(`
+		select post.impart_wealth_id, post.post_id, post.subject, count(comment.comment_id) as replies
+		from comment
+		join post on post.post_id = comment.post_id
+		where post.hive_id = ?
+		and post.deleted_at is null
+		and comment.deleted_at is null
+		and comment.impart_wealth_id <> post.impart_wealth_id
+		and comment.created_at >= ?
+		group by post.impart_wealth_id, post.post_id, post.subject
+		order by replies desc, post.post_id desc
+	`, hiveID, since).Bind(ctx, s.db, &replies)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	out := make(map[string][]Reply)
+	for _, r := range replies {
+		out[r.ImpartWealthID] = append(out[r.ImpartWealthID], r)
+	}
+	return out, nil
+}
+
+// memberHive is the part of the digest for one hive of the member, false when there is nothing
+// to report
+func memberHive(activity hiveActivity, replies []Reply) (hiveDigest, bool) {
+	if len(activity.TopPosts) == 0 && len(replies) == 0 && activity.NewMembers == 0 {
+		return hiveDigest{}, false
+	}
+	return hiveDigest{
+		HiveName:   activity.HiveName,
+		TopPosts:   activity.TopPosts,
+		Replies:    replies,
+		NewMembers: activity.NewMembers,
+	}, true
+}
+
+// memberDigest builds the template data of the member from their hives
+func (s *service) memberDigest(m member, hives []hiveDigest) templateData {
+	return templateData{
+		Year:           s.now().Year(),
+		ScreenName:     m.ScreenName,
+		Hives:          hives,
+		UnsubscribeURL: s.unsubscribeLink(m.ImpartWealthID),
+	}
+}
+
+func (s *service) render(recipient string, data templateData) (impart.EmailMessage, error) {
+	buf := new(bytes.Buffer)
+	if err := s.template.Execute(buf, data); err != nil {
+		return impart.EmailMessage{}, err
+	}
+	return impart.EmailMessage{
+		To:      recipient,
+		Subject: digestSubject,
+		HTML:    buf.String(),
+		Text:    fmt.Sprintf("%s\n\nUnsubscribe: %s", digestPreviewText, data.UnsubscribeURL),
+	}, nil
+}
+
+func (s *service) unsubscribeLink(impartWealthID string) string {
+	expires := s.now().Add(unsubscribeTTL)
+	q := url.Values{}
+	q.Set("id", impartWealthID)
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("token", s.UnsubscribeToken(impartWealthID, expires))
+	sep := "?"
+	if strings.Contains(s.unsubscribeURL, "?") {
+		sep = "&"
+	}
+	return s.unsubscribeURL + sep + q.Encode()
+}
+
+// UnsubscribeToken signs the impartWealthID and the expiry so the unsubscribe link works without
+// logging in until it expires
+func (s *service) UnsubscribeToken(impartWealthID string, expires time.Time) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(fmt.Sprintf("%s.%d", impartWealthID, expires.Unix())))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *service) validToken(impartWealthID, token string, expires time.Time) bool {
+	if !s.now().Before(expires) {
+		return false
+	}
+	expected, err := hex.DecodeString(s.UnsubscribeToken(impartWealthID, expires))
+	if err != nil {
+		return false
+	}
+	given, err := hex.DecodeString(token)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, given)
+}
+
+// Unsubscribe clears User.EmailSubscribe for the member the token was issued to
+func (s *service) Unsubscribe(ctx context.Context, impartWealthID, token string, expires time.Time) impart.Error {
+	if strings.TrimSpace(impartWealthID) == "" || !s.validToken(impartWealthID, token, expires) {
+		return impart.NewError(impart.ErrUnauthorized, "invalid unsubscribe link")
+	}
+	return s.profileStore.UserEmailDetailsUpdate(ctx, models.WebAppUserInput{
+		ImpartWealthID: impartWealthID,
+		Subscribe:      false,
+	})
+}
@@ -0,0 +1,134 @@
+package digest
+
+import (
+	"context"
+	temp "html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testNow = time.Date(2022, 1, 16, 15, 0, 0, 0, time.UTC)
+
+func testService(t *testing.T) *service {
+	return &service{
+		logger:         zap.NewNop(),
+		template:       temp.Must(temp.ParseFiles("../../schemas/html/weekly_digest.html")),
+		unsubscribeURL: "https://api.impartwealth.com/v1/email/unsubscribe",
+		secret:         []byte("unit-test-secret"),
+		now:            func() time.Time { return testNow },
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(&config.Impart{}, nil, nil, nil, zap.NewNop())
+	assert.Error(t, err, "the unsubscribe secret is required")
+}
+
+func TestUnsubscribeToken(t *testing.T) {
+	s := testService(t)
+	expires := testNow.Add(unsubscribeTTL)
+	token := s.UnsubscribeToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", expires)
+	assert.True(t, s.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", token, expires))
+	assert.False(t, s.validToken("2xRvvB2ztPPVYpRLLgArq7KHQ8Q", token, expires))
+	assert.False(t, s.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", token, expires.Add(time.Hour)), "the expiry is signed")
+	assert.False(t, s.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", "not-hex", expires))
+	assert.False(t, s.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", "", expires))
+
+	other := testService(t)
+	other.secret = []byte("another-secret")
+	assert.False(t, other.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", token, expires))
+
+	expired := testService(t)
+	expired.now = func() time.Time { return expires }
+	assert.False(t, expired.validToken("1xRvvB2ztPPVYpRLLgArq7KHQ8Q", token, expires))
+
+	impartErr := s.Unsubscribe(context.Background(), "1xRvvB2ztPPVYpRLLgArq7KHQ8Q", "00ff", expires)
+	require.NotNil(t, impartErr)
+	assert.Equal(t, impart.ErrUnauthorized, impartErr.Err())
+}
+
+func TestMemberHiveNothingToReport(t *testing.T) {
+	_, ok := memberHive(hiveActivity{HiveName: "quiet hive"}, nil)
+	assert.False(t, ok)
+
+	section, ok := memberHive(hiveActivity{HiveName: "quiet hive", NewMembers: 1}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, "quiet hive", section.HiveName)
+
+	s := testService(t)
+	data := s.memberDigest(member{ImpartWealthID: "abc", ScreenName: "bob"}, []hiveDigest{section})
+	assert.Equal(t, 2022, data.Year)
+	assert.Contains(t, data.UnsubscribeURL, "id=abc")
+	expires := testNow.Add(unsubscribeTTL)
+	assert.Contains(t, data.UnsubscribeURL, "expires=1647529200")
+	assert.Contains(t, data.UnsubscribeURL, "token="+s.UnsubscribeToken("abc", expires))
+}
+
+func TestRecipients(t *testing.T) {
+	var to recipients
+	bob, alice := member{ImpartWealthID: "bob"}, member{ImpartWealthID: "alice"}
+	to.add(bob, hiveDigest{HiveName: "first"})
+	to.add(alice, hiveDigest{HiveName: "first"})
+	to.add(bob, hiveDigest{HiveName: "second"})
+
+	require.Len(t, to.list, 2, "one email per member")
+	assert.Equal(t, "bob", to.list[0].ImpartWealthID)
+	assert.Equal(t, []hiveDigest{{HiveName: "first"}, {HiveName: "second"}}, to.list[0].hives)
+	assert.Len(t, to.list[1].hives, 1)
+}
+
+func TestDigestRenderedToFileSink(t *testing.T) {
+	s := testService(t)
+	dir, err := ioutil.TempDir("", "digest")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	s.emails = impart.NewFileEmailService(dir, "", zap.NewNop())
+
+	activity := hiveActivity{
+		HiveName:   "Hive <One>",
+		TopPosts:   []Post{{PostID: 1, Subject: "Saving for a house", UpVoteCount: 12, CommentCount: 4}},
+		NewMembers: 3,
+	}
+	replies := []Reply{{PostID: 7, Subject: "Index funds?", Replies: 2}}
+	section, ok := memberHive(activity, replies)
+	require.True(t, ok)
+	data := s.memberDigest(member{ImpartWealthID: "abc", Email: "bob@example.com", ScreenName: "bob"}, []hiveDigest{section})
+
+	email, err := s.render("bob@example.com", data)
+	require.NoError(t, err)
+	require.NoError(t, s.emails.Send(context.Background(), email))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	b, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	msg := string(b)
+	assert.Contains(t, msg, "To: bob@example.com")
+	assert.Contains(t, msg, "Subject: "+digestSubject)
+
+	assert.Contains(t, email.HTML, "Hive &lt;One&gt;")
+	assert.Contains(t, email.HTML, "Saving for a house")
+	assert.Contains(t, email.HTML, "2 new replies")
+	assert.Contains(t, email.HTML, "3 people have joined")
+	assert.Contains(t, email.HTML, "Your week in Hive &lt;One&gt;")
+	assert.True(t, strings.Contains(email.HTML, "token="+s.UnsubscribeToken("abc", testNow.Add(unsubscribeTTL))))
+
+	// a member of several hives gets a section per hive
+	data.Hives = append(data.Hives, hiveDigest{HiveName: "Second Hive", NewMembers: 1})
+	email, err = s.render("bob@example.com", data)
+	require.NoError(t, err)
+	assert.Contains(t, email.HTML, "Your week in the Hive")
+	assert.Contains(t, email.HTML, "Second Hive")
+	assert.Contains(t, email.HTML, "1 person has joined Second Hive")
+}
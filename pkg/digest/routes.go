@@ -0,0 +1,55 @@
+package digest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"go.uber.org/zap"
+)
+
+type digestHandler struct {
+	digestService Service
+	logger        *zap.Logger
+}
+
+// SetupRoutes registers the public email routes, the group must not require an api key or
+// a JWT since the links are opened straight from an email client.
+func SetupRoutes(public *gin.RouterGroup, digestService Service, logger *zap.Logger) {
+	handler := &digestHandler{
+		digestService: digestService,
+		logger:        logger,
+	}
+	public.GET("/email/unsubscribe", handler.UnsubscribeFunc())
+}
+
+const unsubscribePage = `<!doctype html>
+<html><head><meta charset="UTF-8"><title>Hive Wealth</title></head>
+<body style="font-family: 'Lato', 'Helvetica Neue', Helvetica, Arial, sans-serif; text-align: center; padding-top: 60px; color: #202020;">
+<h2>%s</h2>
+<p>%s</p>
+</body></html>`
+
+func (dh *digestHandler) UnsubscribeFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		impartWealthID := ctx.Query("id")
+		// a missing or malformed expiry leaves the zero time, which has expired
+		var expires time.Time
+		if unix, err := strconv.ParseInt(ctx.Query("expires"), 10, 64); err == nil {
+			expires = time.Unix(unix, 0)
+		}
+		impartErr := dh.digestService.Unsubscribe(ctx, impartWealthID, ctx.Query("token"), expires)
+		if impartErr != nil {
+			impart.CtxLogger(ctx, dh.logger).Info("unsubscribe failed", zap.String("impartWealthID", impartWealthID), zap.Error(impartErr.Err()))
+			ctx.Header("Content-Type", "text/html; charset=utf-8")
+			ctx.String(impartErr.HttpStatus(), unsubscribePage, "Something went wrong",
+				"We could not unsubscribe you with this link, you can turn off emails from the settings in the app.")
+			return
+		}
+		ctx.Header("Content-Type", "text/html; charset=utf-8")
+		ctx.String(http.StatusOK, unsubscribePage, "You have been unsubscribed",
+			"You will no longer receive the weekly Hive digest or other Hive Wealth emails.")
+	}
+}
@@ -0,0 +1,480 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// exportFile is one json file of the zip
+type exportFile struct {
+	Name string
+	Data interface{}
+}
+
+// The records of the export only hold what the member gave or did, tokens, internal scores and
+// the ids of other members are left out. Deleted content isn't kept so it isn't exported.
+
+type profileRecord struct {
+	ImpartWealthID        string          `json:"impartWealthId"`
+	Email                 string          `json:"email"`
+	ScreenName            string          `json:"screenName"`
+	FirstName             string          `json:"firstName,omitempty"`
+	LastName              string          `json:"lastName,omitempty"`
+	EmailVerified         bool            `json:"emailVerified"`
+	EmailSubscribe        bool            `json:"emailSubscribe"`
+	Feedback              string          `json:"feedback,omitempty"`
+	CreatedAt             time.Time       `json:"createdAt"`
+	UpdatedAt             time.Time       `json:"updatedAt"`
+	LastLoginAt           *time.Time      `json:"lastLoginAt,omitempty"`
+	Attributes            json.RawMessage `json:"attributes,omitempty"`
+	IsUpdateReadCommunity bool            `json:"isUpdateReadCommunity"`
+	Hives                 []hiveRecord    `json:"hives"`
+}
+
+type hiveRecord struct {
+	HiveID uint64 `json:"hiveId"`
+	Name   string `json:"name"`
+}
+
+type answerRecord struct {
+	Questionnaire string    `json:"questionnaire"`
+	Version       uint      `json:"version"`
+	Question      string    `json:"question"`
+	Answer        string    `json:"answer"`
+	AnsweredAt    time.Time `json:"answeredAt"`
+}
+
+type postRecord struct {
+	PostID    uint64       `json:"postId"`
+	HiveID    uint64       `json:"hiveId"`
+	Subject   string       `json:"subject"`
+	Content   string       `json:"content"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+	Edits     []editRecord `json:"edits"`
+}
+
+type commentRecord struct {
+	CommentID       uint64       `json:"commentId"`
+	PostID          uint64       `json:"postId"`
+	ParentCommentID uint64       `json:"parentCommentId,omitempty"`
+	Content         string       `json:"content"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+	Edits           []editRecord `json:"edits"`
+}
+
+// editRecord is an edit of a post or comment, ByAuthor is false when a moderator made it
+type editRecord struct {
+	EditedAt time.Time `json:"editedAt"`
+	ByAuthor bool      `json:"byAuthor"`
+	Deleted  bool      `json:"deleted"`
+	Notes    string    `json:"notes,omitempty"`
+}
+
+type reactionRecord struct {
+	PostID    uint64    `json:"postId"`
+	CommentID uint64    `json:"commentId,omitempty"`
+	Upvoted   bool      `json:"upvoted"`
+	Downvoted bool      `json:"downvoted"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type reportRecord struct {
+	PostID     uint64    `json:"postId"`
+	CommentID  uint64    `json:"commentId,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	ReportedAt time.Time `json:"reportedAt"`
+}
+
+type deviceRecord struct {
+	DeviceID      string     `json:"deviceId"`
+	DeviceName    string     `json:"deviceName"`
+	DeviceVersion string     `json:"deviceVersion"`
+	AppVersion    string     `json:"appVersion"`
+	Platform      string     `json:"platform"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastLoginAt   *time.Time `json:"lastLoginAt,omitempty"`
+}
+
+type notificationSettingsRecord struct {
+	NotificationStatus bool            `json:"notificationStatus"`
+	QuietHoursStart    *uint16         `json:"quietHoursStart,omitempty"`
+	QuietHoursEnd      *uint16         `json:"quietHoursEnd,omitempty"`
+	Timezone           string          `json:"timezone,omitempty"`
+	Preferences        map[string]bool `json:"preferences"`
+}
+
+type institutionRecord struct {
+	Name               string          `json:"name"`
+	PlaidInstitutionID string          `json:"plaidInstitutionId"`
+	WebURL             string          `json:"webUrl,omitempty"`
+	LinkedAt           time.Time       `json:"linkedAt"`
+	Accounts           []accountRecord `json:"accounts"`
+}
+
+type accountRecord struct {
+	Name         string    `json:"name"`
+	OfficialName string    `json:"officialName,omitempty"`
+	Mask         string    `json:"mask,omitempty"`
+	Type         string    `json:"type"`
+	Subtype      string    `json:"subtype,omitempty"`
+	SeenAt       time.Time `json:"seenAt"`
+}
+
+type draftRecord struct {
+	Kind      string          `json:"kind"`
+	HiveID    uint64          `json:"hiveId,omitempty"`
+	PostID    uint64          `json:"postId,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// collect reads the data of the member into the files of the export
+func (s *service) collect(ctx context.Context, user *dbmodels.User) ([]exportFile, error) {
+	id := user.ImpartWealthID
+	var files []exportFile
+	for _, c := range []struct {
+		name  string
+		fetch func(context.Context, *dbmodels.User) (interface{}, error)
+	}{
+		{"profile.json", s.profile},
+		{"questionnaires.json", s.answers},
+		{"posts.json", s.posts},
+		{"comments.json", s.comments},
+		{"drafts.json", s.drafts},
+		{"reactions.json", s.reactions},
+		{"reports.json", s.reports},
+		{"devices.json", s.devices},
+		{"notification_settings.json", s.notificationSettings},
+		{"institutions.json", s.institutions},
+	} {
+		data, err := c.fetch(ctx, user)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export %s of %s: %v", c.name, id, err)
+		}
+		files = append(files, exportFile{Name: c.name, Data: data})
+	}
+	return files, nil
+}
+
+// writeArchive writes the files as indented json into a zip
+func writeArchive(w io.Writer, files []exportFile) error {
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(f.Name)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.Data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func timePtr(t null.Time) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func (s *service) profile(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	out := profileRecord{
+		ImpartWealthID: user.ImpartWealthID,
+		Email:          user.Email,
+		ScreenName:     user.ScreenName,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		EmailVerified:  user.EmailVerified,
+		EmailSubscribe: user.EmailSubscribe,
+		Feedback:       user.Feedback.String,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		LastLoginAt:    timePtr(user.LastloginAt),
+		Hives:          []hiveRecord{},
+	}
+	p, err := dbmodels.FindProfile(ctx, s.db, user.ImpartWealthID)
+	if err == nil {
+		out.Attributes = json.RawMessage(p.Attributes)
+		out.IsUpdateReadCommunity = p.IsUpdateReadCommunity
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+	hives, err := user.MemberHiveHives(qm.OrderBy(fmt.Sprintf("%s.%s", dbmodels.TableNames.Hive, dbmodels.HiveColumns.HiveID))).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hives {
+		out.Hives = append(out.Hives, hiveRecord{HiveID: h.HiveID, Name: h.Name})
+	}
+	return out, nil
+}
+
+func (s *service) answers(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	userAnswers, err := dbmodels.UserAnswers(
+		dbmodels.UserAnswerWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.Load(qm.Rels(dbmodels.UserAnswerRels.Answer, dbmodels.AnswerRels.Question, dbmodels.QuestionRels.Questionnaire)),
+		qm.OrderBy(dbmodels.UserAnswerColumns.CreatedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := []answerRecord{}
+	for _, ua := range userAnswers {
+		a := ua.R.Answer
+		if a == nil || a.R.Question == nil {
+			continue
+		}
+		r := answerRecord{Question: a.R.Question.Text, Answer: a.Text, AnsweredAt: ua.CreatedAt}
+		if qn := a.R.Question.R.Questionnaire; qn != nil {
+			r.Questionnaire, r.Version = qn.Name, qn.Version
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (s *service) posts(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	posts, err := dbmodels.Posts(
+		dbmodels.PostWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.Load(dbmodels.PostRels.PostEdits),
+		qm.OrderBy(dbmodels.PostColumns.PostID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]postRecord, len(posts))
+	for i, p := range posts {
+		out[i] = postRecord{
+			PostID:    p.PostID,
+			HiveID:    p.HiveID,
+			Subject:   p.Subject,
+			Content:   p.Content,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+			Edits:     []editRecord{},
+		}
+		if p.R != nil {
+			for _, e := range p.R.PostEdits {
+				out[i].Edits = append(out[i].Edits, editRecord{
+					EditedAt: e.CreatedAt,
+					ByAuthor: e.ImpartWealthID == user.ImpartWealthID,
+					Deleted:  e.Deleted,
+					Notes:    e.Notes.String,
+				})
+			}
+		}
+		sortEdits(out[i].Edits)
+	}
+	return out, nil
+}
+
+func (s *service) comments(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	comments, err := dbmodels.Comments(
+		dbmodels.CommentWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.Load(dbmodels.CommentRels.CommentEdits),
+		qm.OrderBy(dbmodels.CommentColumns.CommentID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]commentRecord, len(comments))
+	for i, c := range comments {
+		out[i] = commentRecord{
+			CommentID:       c.CommentID,
+			PostID:          c.PostID,
+			ParentCommentID: c.ParentCommentID.Uint64,
+			Content:         c.Content,
+			CreatedAt:       c.CreatedAt,
+			UpdatedAt:       c.UpdatedAt,
+			Edits:           []editRecord{},
+		}
+		if c.R != nil {
+			for _, e := range c.R.CommentEdits {
+				out[i].Edits = append(out[i].Edits, editRecord{
+					EditedAt: e.CreatedAt,
+					ByAuthor: e.ImpartWealthID == user.ImpartWealthID,
+					Deleted:  e.Deleted,
+					Notes:    e.Notes.String,
+				})
+			}
+		}
+		sortEdits(out[i].Edits)
+	}
+	return out, nil
+}
+
+func sortEdits(edits []editRecord) {
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].EditedAt.Before(edits[j].EditedAt) })
+}
+
+func (s *service) drafts(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	drafts, err := dbmodels.Drafts(
+		dbmodels.DraftWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.OrderBy(dbmodels.DraftColumns.DraftID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]draftRecord, len(drafts))
+	for i, d := range drafts {
+		out[i] = draftRecord{
+			Kind:      d.Kind,
+			HiveID:    d.HiveID.Uint64,
+			PostID:    d.PostID.Uint64,
+			Payload:   json.RawMessage(d.Payload),
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		}
+	}
+	return out, nil
+}
+
+func (s *service) reactions(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	postReactions, commentReactions, err := s.fetchReactions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	out := []reactionRecord{}
+	for _, r := range postReactions {
+		if r.Upvoted || r.Downvoted {
+			out = append(out, reactionRecord{PostID: r.PostID, Upvoted: r.Upvoted, Downvoted: r.Downvoted, UpdatedAt: r.UpdatedAt})
+		}
+	}
+	for _, r := range commentReactions {
+		if r.Upvoted || r.Downvoted {
+			out = append(out, reactionRecord{PostID: r.PostID, CommentID: r.CommentID, Upvoted: r.Upvoted, Downvoted: r.Downvoted, UpdatedAt: r.UpdatedAt})
+		}
+	}
+	return out, nil
+}
+
+func (s *service) reports(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	postReactions, commentReactions, err := s.fetchReactions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	out := []reportRecord{}
+	for _, r := range postReactions {
+		if r.Reported {
+			out = append(out, reportRecord{PostID: r.PostID, Reason: r.ReportedReason.String, ReportedAt: r.UpdatedAt})
+		}
+	}
+	for _, r := range commentReactions {
+		if r.Reported {
+			out = append(out, reportRecord{PostID: r.PostID, CommentID: r.CommentID, Reason: r.ReportedReason.String, ReportedAt: r.UpdatedAt})
+		}
+	}
+	return out, nil
+}
+
+func (s *service) fetchReactions(ctx context.Context, user *dbmodels.User) (dbmodels.PostReactionSlice, dbmodels.CommentReactionSlice, error) {
+	postReactions, err := dbmodels.PostReactions(
+		dbmodels.PostReactionWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.OrderBy(dbmodels.PostReactionColumns.PostID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, nil, err
+	}
+	commentReactions, err := dbmodels.CommentReactions(
+		dbmodels.CommentReactionWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.OrderBy(dbmodels.CommentReactionColumns.CommentID),
+	).All(ctx, s.db)
+	return postReactions, commentReactions, err
+}
+
+func (s *service) devices(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	devices, err := dbmodels.UserDevices(
+		dbmodels.UserDeviceWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.OrderBy(dbmodels.UserDeviceColumns.CreatedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]deviceRecord, len(devices))
+	for i, d := range devices {
+		out[i] = deviceRecord{
+			DeviceID:      d.DeviceID,
+			DeviceName:    d.DeviceName,
+			DeviceVersion: d.DeviceVersion,
+			AppVersion:    d.AppVersion,
+			Platform:      d.Platform,
+			CreatedAt:     d.CreatedAt,
+			LastLoginAt:   timePtr(d.LastloginAt),
+		}
+	}
+	return out, nil
+}
+
+func (s *service) notificationSettings(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	out := notificationSettingsRecord{Preferences: map[string]bool{}}
+	cfg, err := dbmodels.UserConfigurations(dbmodels.UserConfigurationWhere.ImpartWealthID.EQ(user.ImpartWealthID)).One(ctx, s.db)
+	if err == nil {
+		out.NotificationStatus = cfg.NotificationStatus
+		out.Timezone = cfg.Timezone
+		if cfg.QuietHoursStart.Valid && cfg.QuietHoursEnd.Valid {
+			out.QuietHoursStart, out.QuietHoursEnd = &cfg.QuietHoursStart.Uint16, &cfg.QuietHoursEnd.Uint16
+		}
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+	prefs, err := dbmodels.UserNotificationPreferences(
+		dbmodels.UserNotificationPreferenceWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range prefs {
+		out.Preferences[p.Category] = p.Enabled
+	}
+	return out, nil
+}
+
+// institutions leaves the plaid access tokens and the account balances out
+func (s *service) institutions(ctx context.Context, user *dbmodels.User) (interface{}, error) {
+	linked, err := dbmodels.UserInstitutions(
+		dbmodels.UserInstitutionWhere.ImpartWealthID.EQ(user.ImpartWealthID),
+		qm.Load(dbmodels.UserInstitutionRels.Institution),
+		qm.Load(dbmodels.UserInstitutionRels.UserPlaidAccountsLogs),
+		qm.OrderBy(dbmodels.UserInstitutionColumns.CreatedAt),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]institutionRecord, len(linked))
+	for i, ui := range linked {
+		out[i] = institutionRecord{LinkedAt: ui.CreatedAt, Accounts: []accountRecord{}}
+		if ui.R == nil {
+			continue
+		}
+		if inst := ui.R.Institution; inst != nil {
+			out[i].Name, out[i].PlaidInstitutionID, out[i].WebURL = inst.InstitutionName, inst.PlaidInstitutionID, inst.Weburl
+		}
+		for _, a := range ui.R.UserPlaidAccountsLogs {
+			out[i].Accounts = append(out[i].Accounts, accountRecord{
+				Name:         a.Name,
+				OfficialName: a.OfficialName,
+				Mask:         a.Mask,
+				Type:         a.Type,
+				Subtype:      a.Subtype,
+				SeenAt:       a.CreatedAt,
+			})
+		}
+	}
+	return out, nil
+}
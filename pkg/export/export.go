@@ -0,0 +1,289 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/segmentio/ksuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// retention is how long a ready export can be downloaded before its file is deleted
+	retention = 7 * 24 * time.Hour
+	// cooldown is how long a member waits between two exports
+	cooldown = 24 * time.Hour
+	// stuckAfter is how long an export can stay processing before another run picks it up again
+	stuckAfter = 30 * time.Minute
+	// processBatch is the most exports a scheduled run builds
+	processBatch = 10
+	// listLimit is the most exports returned to a member
+	listLimit = 10
+)
+
+// Service builds a zip of the data of a member in the background and notifies the member once it
+// can be downloaded. The zip is kept private in the media storage and downloaded with a signed url.
+type Service interface {
+	RequestExport(ctx context.Context) (models.DataExport, impart.Error)
+	GetExports(ctx context.Context) (models.DataExports, impart.Error)
+	// GetExport returns the export with a download url when it is ready
+	GetExport(ctx context.Context, exportID uint64) (models.DataExport, impart.Error)
+	// ProcessPending builds the pending exports and the exports stuck processing, and deletes the
+	// files of expired exports. It is run by the scheduler.
+	ProcessPending(ctx context.Context) error
+}
+
+type service struct {
+	db            *sql.DB
+	logger        *zap.Logger
+	storage       *media.FileUpload
+	notifications impart.NotificationService
+	now           func() time.Time
+}
+
+func New(db *sql.DB, storage *media.FileUpload, notifications impart.NotificationService, logger *zap.Logger) Service {
+	return &service{
+		db:            db,
+		logger:        logger,
+		storage:       storage,
+		notifications: notifications,
+		now:           impart.CurrentUTC,
+	}
+}
+
+// RequestExport queues an export of the data of the context user, a member has one export in
+// progress at a time and waits a day between two exports.
+func (s *service) RequestExport(ctx context.Context) (models.DataExport, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return models.DataExport{}, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	last, err := dbmodels.DataExports(
+		dbmodels.DataExportWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+		dbmodels.DataExportWhere.Status.NEQ(dbmodels.DataExportsStatusFailed),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.DataExportColumns.CreatedAt)),
+	).One(ctx, s.db)
+	if err != nil && err != sql.ErrNoRows {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch data exports", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return models.DataExport{}, impart.UnknownError
+	}
+	now := s.now()
+	if last != nil {
+		switch {
+		case last.Status == dbmodels.DataExportsStatusPending || last.Status == dbmodels.DataExportsStatusProcessing:
+			return models.DataExport{}, impart.NewError(impart.ErrExists, "an export is already in progress")
+		case last.CreatedAt.After(now.Add(-cooldown)):
+			return models.DataExport{}, impart.NewError(impart.ErrExists,
+				fmt.Sprintf("a new export can be requested after %s", last.CreatedAt.Add(cooldown).Format(time.RFC1123)))
+		}
+	}
+	e := &dbmodels.DataExport{
+		ImpartWealthID: ctxUser.ImpartWealthID,
+		Status:         dbmodels.DataExportsStatusPending,
+		CreatedAt:      now,
+	}
+	if err := e.Insert(ctx, s.db, boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to create data export", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return models.DataExport{}, impart.UnknownError
+	}
+	go func(exportID uint64) {
+		if err := s.process(context.Background(), exportID); err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to build data export", zap.Uint64("exportId", exportID), zap.Error(err))
+		}
+	}(e.ExportID)
+	return models.DataExportFromDBModel(e), nil
+}
+
+func (s *service) GetExports(ctx context.Context) (models.DataExports, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return nil, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	exports, err := dbmodels.DataExports(
+		dbmodels.DataExportWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.DataExportColumns.CreatedAt)),
+		qm.Limit(listLimit),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch data exports", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
+		return nil, impart.UnknownError
+	}
+	return models.DataExportsFromDBModel(exports), nil
+}
+
+func (s *service) GetExport(ctx context.Context, exportID uint64) (models.DataExport, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return models.DataExport{}, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	e, err := dbmodels.DataExports(
+		dbmodels.DataExportWhere.ExportID.EQ(exportID),
+		dbmodels.DataExportWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return models.DataExport{}, impart.NewError(impart.ErrNotFound, "export not found")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch data export", zap.Uint64("exportId", exportID), zap.Error(err))
+		return models.DataExport{}, impart.UnknownError
+	}
+	out := models.DataExportFromDBModel(e)
+	if e.Status != dbmodels.DataExportsStatusReady || !e.StorageKey.Valid || e.ExpiresAt.Time.Before(s.now()) {
+		return out, nil
+	}
+	if out.DownloadURL, err = s.storage.SignedURL(ctx, e.StorageKey.String); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to sign data export url", zap.Uint64("exportId", exportID), zap.Error(err))
+		return models.DataExport{}, impart.UnknownError
+	}
+	return out, nil
+}
+
+func (s *service) ProcessPending(ctx context.Context) error {
+	now := s.now()
+	exports, err := dbmodels.DataExports(
+		qm.Select(dbmodels.DataExportColumns.ExportID),
+		qm.Expr(
+			dbmodels.DataExportWhere.Status.EQ(dbmodels.DataExportsStatusPending),
+			qm.Or2(qm.Expr(
+				dbmodels.DataExportWhere.Status.EQ(dbmodels.DataExportsStatusProcessing),
+				dbmodels.DataExportWhere.StartedAt.LT(null.TimeFrom(now.Add(-stuckAfter))),
+			)),
+		),
+		qm.OrderBy(dbmodels.DataExportColumns.ExportID),
+		qm.Limit(processBatch),
+	).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	var failed int
+	for _, e := range exports {
+		if err := s.process(ctx, e.ExportID); err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to build data export", zap.Uint64("exportId", e.ExportID), zap.Error(err))
+			failed++
+		}
+	}
+	expired, err := s.expire(ctx)
+	impart.CtxLogger(ctx, s.logger).Info("data-export : done", zap.Int("exports", len(exports)), zap.Int("failed", failed), zap.Int("expired", expired))
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("unable to build %d of %d data exports", failed, len(exports))
+	}
+	return nil
+}
+
+// process builds one export. It is claimed first so two runs never build the same export, a
+// storage or database error leaves it processing so a later run retries it.
+func (s *service) process(ctx context.Context, exportID uint64) error {
+	now := s.now()
+	res, err := queries.Raw(`update data_exports set status = ?, started_at = ?
+		where export_id = ? and (status = ? or (status = ? and started_at < ?))`,
+		dbmodels.DataExportsStatusProcessing, now, exportID,
+		dbmodels.DataExportsStatusPending, dbmodels.DataExportsStatusProcessing, now.Add(-stuckAfter),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	if claimed, err := res.RowsAffected(); err != nil || claimed == 0 {
+		// built or claimed by another run
+		return err
+	}
+	e, err := dbmodels.FindDataExport(ctx, s.db, exportID)
+	if err != nil {
+		return err
+	}
+	user, err := dbmodels.FindUser(ctx, s.db, e.ImpartWealthID)
+	if err == sql.ErrNoRows {
+		// the account was deleted while the export was pending
+		e.Status = dbmodels.DataExportsStatusFailed
+		_, err = e.Update(ctx, s.db, boil.Whitelist(dbmodels.DataExportColumns.Status))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	files, err := s.collect(ctx, user)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := writeArchive(&buf, files); err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%sexports/%s/%s.zip", s.storage.MediaPath, user.ImpartWealthID, ksuid.New().String())
+	if err := s.storage.Store.Put(ctx, key, "application/zip", bytes.NewReader(buf.Bytes())); err != nil {
+		return err
+	}
+	e.Status = dbmodels.DataExportsStatusReady
+	e.StorageKey = null.StringFrom(key)
+	e.Size = uint64(buf.Len())
+	e.CompletedAt = null.TimeFrom(s.now())
+	e.ExpiresAt = null.TimeFrom(e.CompletedAt.Time.Add(retention))
+	if _, err := e.Update(ctx, s.db, boil.Whitelist(
+		dbmodels.DataExportColumns.Status,
+		dbmodels.DataExportColumns.StorageKey,
+		dbmodels.DataExportColumns.Size,
+		dbmodels.DataExportColumns.CompletedAt,
+		dbmodels.DataExportColumns.ExpiresAt,
+	)); err != nil {
+		return err
+	}
+	s.notifyReady(ctx, e)
+	return nil
+}
+
+// expire deletes the files of the exports past their expiry, the rows are kept as expired
+func (s *service) expire(ctx context.Context) (int, error) {
+	exports, err := dbmodels.DataExports(
+		dbmodels.DataExportWhere.Status.EQ(dbmodels.DataExportsStatusReady),
+		dbmodels.DataExportWhere.ExpiresAt.LT(null.TimeFrom(s.now())),
+		qm.Limit(processBatch*10),
+	).All(ctx, s.db)
+	if err != nil {
+		return 0, err
+	}
+	var expired int
+	for _, e := range exports {
+		if e.StorageKey.Valid {
+			if err := s.storage.Store.Delete(ctx, e.StorageKey.String); err != nil {
+				return expired, err
+			}
+		}
+		e.Status = dbmodels.DataExportsStatusExpired
+		e.StorageKey = null.String{}
+		if _, err := e.Update(ctx, s.db, boil.Whitelist(dbmodels.DataExportColumns.Status, dbmodels.DataExportColumns.StorageKey)); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+func (s *service) notifyReady(ctx context.Context, e *dbmodels.DataExport) {
+	data := impart.NotificationData{
+		EventDatetime: s.now(),
+		Category:      impart.AccountNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String("Your data export is ready"),
+		Body: aws.String(fmt.Sprintf("You can download a copy of your data from your account settings until %s.",
+			e.ExpiresAt.Time.Format(time.RFC1123))),
+	}
+	if err := s.notifications.Notify(ctx, data, alert, e.ImpartWealthID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("push-notification : error attempting to send data export notification",
+			zap.Uint64("exportId", e.ExportID), zap.Error(err))
+	}
+}
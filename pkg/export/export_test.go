@@ -0,0 +1,43 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteArchive(t *testing.T) {
+	linkedAt := time.Date(2022, 2, 14, 10, 12, 30, 0, time.UTC)
+	files := []exportFile{
+		{Name: "devices.json", Data: []deviceRecord{{DeviceID: "device-1", Platform: "ios", CreatedAt: linkedAt}}},
+		{Name: "institutions.json", Data: []institutionRecord{{Name: "Bank", PlaidInstitutionID: "ins_1", LinkedAt: linkedAt}}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, files))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 2)
+	assert.Equal(t, "devices.json", zr.File[0].Name)
+	assert.Equal(t, "institutions.json", zr.File[1].Name)
+
+	rc, err := zr.File[1].Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	b, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+
+	var institutions []map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &institutions))
+	require.Len(t, institutions, 1)
+	assert.Equal(t, "ins_1", institutions[0]["plaidInstitutionId"])
+	assert.Equal(t, "2022-02-14T10:12:30Z", institutions[0]["linkedAt"])
+	assert.NotContains(t, string(b), "accessToken")
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"go.uber.org/zap"
+)
+
+type exportHandler struct {
+	exportService Service
+	logger        *zap.Logger
+}
+
+func SetupRoutes(version *gin.RouterGroup, exportService Service, logger *zap.Logger) {
+	handler := &exportHandler{
+		exportService: exportService,
+		logger:        logger,
+	}
+
+	exportRoutes := version.Group("/user/exports")
+	exportRoutes.GET("", handler.GetExportsFunc())
+	exportRoutes.POST("", handler.RequestExportFunc())
+	exportRoutes.GET("/:exportId", handler.GetExportFunc())
+}
+
+// RequestExportFunc queues an export of the data of the member, they are notified when it is ready
+func (eh *exportHandler) RequestExportFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		export, impartErr := eh.exportService.RequestExport(ctx)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusAccepted, export)
+	}
+}
+
+func (eh *exportHandler) GetExportsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		exports, impartErr := eh.exportService.GetExports(ctx)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, exports)
+	}
+}
+
+func (eh *exportHandler) GetExportFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		exportID, err := strconv.ParseUint(ctx.Param("exportId"), 10, 64)
+		if err != nil {
+			impartErr := impart.NewError(impart.ErrBadRequest, "invalid export id passed in")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		export, impartErr := eh.exportService.GetExport(ctx, exportID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, export)
+	}
+}
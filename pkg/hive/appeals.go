@@ -0,0 +1,356 @@
+package hive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxAppealLength matches the content_appeals.message column
+	maxAppealLength = 1000
+	// maxDecisionCommentLength matches the moderation_decisions.comment column
+	maxDecisionCommentLength = 500
+)
+
+var appealStatuses = []string{
+	dbmodels.ContentAppealsStatusPending,
+	dbmodels.ContentAppealsStatusGranted,
+	dbmodels.ContentAppealsStatusDenied,
+}
+
+// contentWhere selects the rows of the post itself, or of one of its comments
+func contentWhere(postIDColumn, commentIDColumn string, postID, commentID uint64) []qm.QueryMod {
+	if commentID > 0 {
+		return []qm.QueryMod{qm.Where(commentIDColumn+" = ?", commentID)}
+	}
+	return []qm.QueryMod{qm.Where(postIDColumn+" = ?", postID), qm.Where(commentIDColumn + " is null")}
+}
+
+func decisionWhere(postID, commentID uint64) []qm.QueryMod {
+	return contentWhere(dbmodels.ModerationDecisionColumns.PostID, dbmodels.ModerationDecisionColumns.CommentID, postID, commentID)
+}
+
+func appealWhere(postID, commentID uint64) []qm.QueryMod {
+	return contentWhere(dbmodels.ContentAppealColumns.PostID, dbmodels.ContentAppealColumns.CommentID, postID, commentID)
+}
+
+// moderatedContentFor reads the post, or the comment when commentID is set, which has to be on the post
+func (s *service) moderatedContentFor(ctx context.Context, postID, commentID uint64) (moderatedContent, impart.Error) {
+	if commentID > 0 {
+		c, ok := s.commentVisibility(ctx, commentID)
+		if !ok || c.PostID != postID {
+			return c, impart.NewError(impart.ErrNotFound, fmt.Sprintf("could not find comment %v", commentID))
+		}
+		return c, nil
+	}
+	c, ok := s.postVisibility(ctx, postID)
+	if !ok {
+		return c, impart.NewError(impart.ErrNotFound, fmt.Sprintf("could not find post %v", postID))
+	}
+	return c, nil
+}
+
+// recordReview adds a review to the moderation history, a removal opens the appeal window
+func (s *service) recordReview(ctx context.Context, c moderatedContent, remove bool, comment string) {
+	action := dbmodels.ModerationDecisionsActionApproved
+	if remove {
+		action = dbmodels.ModerationDecisionsActionRemoved
+	}
+	if _, err := s.recordDecision(ctx, c, action, comment); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to record moderation decision", zap.Uint64("postId", c.PostID),
+			zap.Uint64("commentId", c.CommentID), zap.String("action", action), zap.Error(err))
+	}
+}
+
+func (s *service) recordDecision(ctx context.Context, c moderatedContent, action, comment string) (*dbmodels.ModerationDecision, error) {
+	if r := []rune(comment); len(r) > maxDecisionCommentLength {
+		comment = string(r[:maxDecisionCommentLength])
+	}
+	decision := &dbmodels.ModerationDecision{
+		PostID:        c.PostID,
+		Action:        action,
+		ReviewComment: comment,
+		ModeratorID:   null.StringFrom(impart.GetCtxUser(ctx).ImpartWealthID),
+		CreatedAt:     impart.CurrentUTC(),
+	}
+	if c.CommentID > 0 {
+		decision.CommentID = null.Uint64From(c.CommentID)
+	}
+	return decision, decision.Insert(ctx, s.db, boil.Infer())
+}
+
+// appealableRemoval is the latest decision on the content when it is a removal the author can
+// still appeal, with the end of the appeal window.
+func (s *service) appealableRemoval(ctx context.Context, c moderatedContent) (*dbmodels.ModerationDecision, time.Time, error) {
+	mods := append(decisionWhere(c.PostID, c.CommentID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.ModerationDecisionColumns.DecisionID)))
+	latest, err := dbmodels.ModerationDecisions(mods...).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	deadline := latest.CreatedAt.Add(s.appealWindow)
+	if !c.Hidden || latest.Action != dbmodels.ModerationDecisionsActionRemoved || impart.CurrentUTC().After(deadline) {
+		return nil, time.Time{}, nil
+	}
+	appealed, err := dbmodels.ContentAppeals(dbmodels.ContentAppealWhere.DecisionID.EQ(latest.DecisionID)).Exists(ctx, s.db)
+	if err != nil || appealed {
+		return nil, time.Time{}, err
+	}
+	return latest, deadline, nil
+}
+
+// GetModerationHistory lists the decisions and appeals on the content for its author and admins,
+// the author does not see which moderators were involved.
+func (s *service) GetModerationHistory(ctx context.Context, postID, commentID uint64) (models.ModerationHistory, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	c, impartErr := s.moderatedContentFor(ctx, postID, commentID)
+	if impartErr != nil {
+		return models.ModerationHistory{}, impartErr
+	}
+	if !ctxUser.Admin && c.ImpartWealthID != ctxUser.ImpartWealthID {
+		return models.ModerationHistory{}, impart.NewError(impart.ErrUnauthorized, fmt.Sprintf("unable to view the moderation history of a %s that's not yours", c.kind()))
+	}
+
+	decisions, err := dbmodels.ModerationDecisions(append(decisionWhere(postID, commentID),
+		qm.OrderBy(fmt.Sprintf("%s asc", dbmodels.ModerationDecisionColumns.DecisionID)))...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch moderation decisions", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+		return models.ModerationHistory{}, impart.NewError(impart.ErrUnknown, "unable to fetch moderation history")
+	}
+	appeals, err := dbmodels.ContentAppeals(append(appealWhere(postID, commentID),
+		qm.OrderBy(fmt.Sprintf("%s asc", dbmodels.ContentAppealColumns.AppealID)))...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch appeals", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+		return models.ModerationHistory{}, impart.NewError(impart.ErrUnknown, "unable to fetch moderation history")
+	}
+	history := models.ModerationHistory{
+		PostID:    c.PostID,
+		CommentID: c.CommentID,
+		Decisions: models.ModerationDecisionsFromDBModel(decisions),
+		Appeals:   models.ContentAppealsFromDBModel(appeals),
+	}
+	removal, deadline, err := s.appealableRemoval(ctx, c)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to check the appeal window", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+	} else if removal != nil {
+		history.AppealDeadline = &deadline
+	}
+	if !ctxUser.Admin {
+		history.RedactModerators()
+	}
+	return history, nil
+}
+
+// Appeal lets the author contest the latest removal of their content within the appeal window
+func (s *service) Appeal(ctx context.Context, postID, commentID uint64, message string) (models.ContentAppeal, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	message = strings.TrimSpace(message)
+	if message == "" || len([]rune(message)) > maxAppealLength {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrBadRequest, "message must be between 1 and 1000 characters", impart.Content)
+	}
+	c, impartErr := s.moderatedContentFor(ctx, postID, commentID)
+	if impartErr != nil {
+		return models.ContentAppeal{}, impartErr
+	}
+	if c.ImpartWealthID != ctxUser.ImpartWealthID {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnauthorized, fmt.Sprintf("unable to appeal a %s that's not yours", c.kind()))
+	}
+	removal, _, err := s.appealableRemoval(ctx, c)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to check the appeal window", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to appeal")
+	}
+	if removal == nil {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("the %s has no removal that can be appealed", c.kind()))
+	}
+
+	appeal := &dbmodels.ContentAppeal{
+		DecisionID:     removal.DecisionID,
+		PostID:         c.PostID,
+		HiveID:         c.HiveID,
+		ImpartWealthID: ctxUser.ImpartWealthID,
+		Message:        message,
+		Status:         dbmodels.ContentAppealsStatusPending,
+		CreatedAt:      impart.CurrentUTC(),
+	}
+	if c.CommentID > 0 {
+		appeal.CommentID = null.Uint64From(c.CommentID)
+	}
+	if err := appeal.Insert(ctx, s.db, boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to create appeal", zap.Uint64("decisionId", removal.DecisionID), zap.Error(err))
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to appeal")
+	}
+	return models.ContentAppealFromDBModel(appeal), nil
+}
+
+// GetAppeals is the appeal queue of the hive, oldest first so appeals are resolved in order
+func (s *service) GetAppeals(ctx context.Context, hiveID uint64, status string, limit, offset int) (models.ContentAppeals, *models.NextPage, impart.Error) {
+	if status == "" {
+		status = dbmodels.ContentAppealsStatusPending
+	}
+	valid := false
+	for _, v := range appealStatuses {
+		valid = valid || v == status
+	}
+	if !valid {
+		return nil, nil, impart.NewError(impart.ErrBadRequest, "status must be one of pending, granted or denied")
+	}
+	if limit <= 0 {
+		limit = impart.DefaultLimit
+	}
+	if limit > impart.MaxLimit {
+		limit = impart.MaxLimit
+	}
+	appeals, err := dbmodels.ContentAppeals(
+		dbmodels.ContentAppealWhere.HiveID.EQ(hiveID),
+		dbmodels.ContentAppealWhere.Status.EQ(status),
+		qm.OrderBy(fmt.Sprintf("%s asc", dbmodels.ContentAppealColumns.AppealID)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch appeals", zap.Uint64("hiveId", hiveID), zap.Error(err))
+		return nil, nil, impart.NewError(impart.ErrUnknown, "unable to fetch appeals")
+	}
+	var nextPage *models.NextPage
+	if len(appeals) == limit {
+		nextPage = &models.NextPage{Offset: offset + len(appeals)}
+	}
+	return models.ContentAppealsFromDBModel(appeals), nextPage, nil
+}
+
+// ResolveAppeal grants or denies a pending appeal of the hive. It has to be resolved by a different
+// moderator than the one who removed the content, granting it restores the content.
+func (s *service) ResolveAppeal(ctx context.Context, hiveID, appealID uint64, grant bool, comment string) (models.ContentAppeal, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	comment = strings.TrimSpace(comment)
+	if len([]rune(comment)) > maxDecisionCommentLength {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrBadRequest, "comment must be at most 500 characters", impart.Content)
+	}
+	appeal, err := dbmodels.ContentAppeals(
+		dbmodels.ContentAppealWhere.AppealID.EQ(appealID),
+		dbmodels.ContentAppealWhere.HiveID.EQ(hiveID),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrNotFound, "unable to find the appeal")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch appeal", zap.Uint64("appealId", appealID), zap.Error(err))
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to resolve appeal")
+	}
+	if appeal.Status != dbmodels.ContentAppealsStatusPending {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrBadRequest, "the appeal is already resolved")
+	}
+	removal, err := dbmodels.FindModerationDecision(ctx, s.db, appeal.DecisionID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch the appealed decision", zap.Uint64("appealId", appealID), zap.Error(err))
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to resolve appeal")
+	}
+	if removal.ModeratorID.String == ctxUser.ImpartWealthID {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnauthorized, "the appeal must be resolved by a different moderator than the one who removed the content")
+	}
+
+	status, action := dbmodels.ContentAppealsStatusDenied, dbmodels.ModerationDecisionsActionAppealDenied
+	if grant {
+		status, action = dbmodels.ContentAppealsStatusGranted, dbmodels.ModerationDecisionsActionAppealGranted
+	}
+	// claiming the pending appeal keeps two moderators from resolving it at the same time
+	claimed, err := dbmodels.ContentAppeals(
+		dbmodels.ContentAppealWhere.AppealID.EQ(appealID),
+		dbmodels.ContentAppealWhere.Status.EQ(dbmodels.ContentAppealsStatusPending),
+	).UpdateAll(ctx, s.db, dbmodels.M{
+		dbmodels.ContentAppealColumns.Status:            status,
+		dbmodels.ContentAppealColumns.ResolvedAt:        null.TimeFrom(impart.CurrentUTC()),
+		dbmodels.ContentAppealColumns.ResolvedBy:        null.StringFrom(ctxUser.ImpartWealthID),
+		dbmodels.ContentAppealColumns.ResolutionComment: comment,
+	})
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to resolve appeal", zap.Uint64("appealId", appealID), zap.Error(err))
+		return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to resolve appeal")
+	}
+	if claimed == 0 {
+		return models.ContentAppeal{}, impart.NewError(impart.ErrBadRequest, "the appeal is already resolved")
+	}
+
+	c := moderatedContent{
+		HiveID:         appeal.HiveID,
+		PostID:         appeal.PostID,
+		CommentID:      appeal.CommentID.Uint64,
+		ImpartWealthID: appeal.ImpartWealthID,
+		Hidden:         !grant,
+	}
+	if grant {
+		if c.CommentID > 0 {
+			err = s.reactionData.ReviewComment(ctx, c.CommentID, &comment, false)
+		} else {
+			err = s.reactionData.ReviewPost(ctx, c.PostID, &comment, false)
+		}
+		if err != nil && err != impart.ErrNoOp {
+			impart.CtxLogger(ctx, s.logger).Error("unable to restore appealed content", zap.Uint64("appealId", appealID), zap.Error(err))
+			// leave the appeal in the queue so it can be resolved again
+			if _, rerr := dbmodels.ContentAppeals(dbmodels.ContentAppealWhere.AppealID.EQ(appealID)).UpdateAll(ctx, s.db, dbmodels.M{
+				dbmodels.ContentAppealColumns.Status:            dbmodels.ContentAppealsStatusPending,
+				dbmodels.ContentAppealColumns.ResolvedAt:        null.Time{},
+				dbmodels.ContentAppealColumns.ResolvedBy:        null.String{},
+				dbmodels.ContentAppealColumns.ResolutionComment: "",
+			}); rerr != nil {
+				impart.CtxLogger(ctx, s.logger).Error("unable to reopen appeal", zap.Uint64("appealId", appealID), zap.Error(rerr))
+			}
+			return models.ContentAppeal{}, impart.NewError(impart.ErrUnknown, "unable to restore the content")
+		}
+		// content removed while held never notified its mentions
+		s.notifyMentions(ctx, c.HiveID, c.PostID, c.CommentID, c.ImpartWealthID)
+	}
+	if _, err := s.recordDecision(ctx, c, action, comment); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to record moderation decision", zap.Uint64("appealId", appealID), zap.Error(err))
+	}
+	s.notifyAppealResolved(ctx, c, grant, comment)
+
+	if err := appeal.Reload(ctx, s.db); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch appeal", zap.Uint64("appealId", appealID), zap.Error(err))
+		return models.ContentAppeal{}, impart.UnknownError
+	}
+	return models.ContentAppealFromDBModel(appeal), nil
+}
+
+func (s *service) notifyAppealResolved(ctx context.Context, c moderatedContent, granted bool, comment string) {
+	title := "Your appeal was denied"
+	body := fmt.Sprintf("An admin reviewed your appeal and your %s stays hidden.", c.kind())
+	if granted {
+		title = "Your appeal was granted"
+		body = fmt.Sprintf("An admin reviewed your appeal and restored your %s.", c.kind())
+	}
+	if comment != "" {
+		body = fmt.Sprintf("%s %s", body, comment)
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		HiveID:        c.HiveID,
+		PostID:        c.PostID,
+		CommentID:     c.CommentID,
+		Category:      impart.ModerationNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String(title),
+		Body:  aws.String(body),
+	}
+	if err := s.notificationService.Notify(ctx, data, alert, c.ImpartWealthID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("push-notification : error attempting to send appeal notification",
+			zap.Any("data", data), zap.Error(err))
+	}
+}
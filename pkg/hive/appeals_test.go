@@ -0,0 +1,142 @@
+// +build integration
+
+package hive
+
+import (
+	"context"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (s *ServiceTestSuite) TestReportReviewAppealResolve() {
+	author, reporter := s.contextWithUser(false), s.contextWithUser(false)
+	moderator, otherModerator := s.contextWithUser(true), s.contextWithUser(true)
+	hiveID, postID := s.bootstrapPost(author, false)
+
+	_, impartErr := s.svc.ReportPost(reporter, postID, "spam", false)
+	s.Require().Nil(impartErr)
+	_, impartErr = s.svc.Appeal(author, postID, 0, "not spam")
+	s.Error(impartErr, "a report alone can't be appealed")
+
+	post, impartErr := s.svc.ReviewPost(moderator, postID, "spam", true)
+	s.Require().Nil(impartErr)
+	s.True(post.Obfuscated)
+	_, impartErr = s.svc.ReviewPost(moderator, postID, "spam", true)
+	s.Error(impartErr, "the post is already removed")
+
+	history, impartErr := s.svc.GetModerationHistory(author, postID, 0)
+	s.Require().Nil(impartErr)
+	s.Require().Len(history.Decisions, 1)
+	s.Equal(dbmodels.ModerationDecisionsActionRemoved, history.Decisions[0].Action)
+	s.NotNil(history.AppealDeadline)
+
+	appeal, impartErr := s.svc.Appeal(author, postID, 0, "not spam")
+	s.Require().Nil(impartErr)
+	s.Equal(dbmodels.ContentAppealsStatusPending, appeal.Status)
+	_, impartErr = s.svc.Appeal(author, postID, 0, "still not spam")
+	s.Error(impartErr, "the removal is already appealed")
+
+	_, impartErr = s.svc.ResolveAppeal(otherModerator, hiveID+1, appeal.AppealID, true, "")
+	s.Require().Error(impartErr)
+	s.Equal(impart.ErrNotFound, impartErr.Err(), "the appeal is scoped to its hive")
+	_, impartErr = s.svc.ResolveAppeal(moderator, hiveID, appeal.AppealID, true, "")
+	s.Require().Error(impartErr)
+	s.Equal(impart.ErrUnauthorized, impartErr.Err(), "the moderator who removed the post can't resolve the appeal")
+
+	appeal, impartErr = s.svc.ResolveAppeal(otherModerator, hiveID, appeal.AppealID, true, "restored")
+	s.Require().Nil(impartErr)
+	s.Equal(dbmodels.ContentAppealsStatusGranted, appeal.Status)
+	p, err := dbmodels.FindPost(context.TODO(), s.db, postID)
+	s.Require().NoError(err)
+	s.False(p.Obfuscated)
+	s.True(p.Reviewed)
+}
+
+func (s *ServiceTestSuite) TestRemovalRaisesReportWeight() {
+	author, reporter, moderator := s.contextWithUser(false), s.contextWithUser(false), s.contextWithUser(true)
+	reportWeight := func(postID uint64) uint16 {
+		_, impartErr := s.svc.ReportPost(reporter, postID, "spam", false)
+		s.Require().Nil(impartErr)
+		r, err := dbmodels.FindPostReaction(context.TODO(), s.db, postID, impart.GetCtxUser(reporter).ImpartWealthID)
+		s.Require().NoError(err)
+		return r.ReportWeight
+	}
+	_, removedID := s.bootstrapPost(author, false)
+	_, deletedID := s.bootstrapPost(author, false)
+	s.Equal(uint16(100), reportWeight(removedID))
+	s.Equal(uint16(100), reportWeight(deletedID))
+
+	s.Require().Nil(s.svc.DeletePost(author, deletedID))
+	_, postID := s.bootstrapPost(author, false)
+	s.Equal(uint16(100), reportWeight(postID), "an author deleting their post doesn't uphold the report")
+
+	_, impartErr := s.svc.ReviewPost(moderator, removedID, "spam", true)
+	s.Require().Nil(impartErr)
+	_, postID = s.bootstrapPost(author, false)
+	s.Equal(uint16(150), reportWeight(postID), "a moderator removal upholds the report")
+}
+
+func (s *ServiceTestSuite) TestRemoveHeldPost() {
+	author, moderator, otherModerator := s.contextWithUser(false), s.contextWithUser(true), s.contextWithUser(true)
+	hiveID, postID := s.bootstrapPost(author, true)
+
+	_, impartErr := s.svc.ReviewPost(moderator, postID, "", true)
+	s.Require().Nil(impartErr)
+	p, err := dbmodels.FindPost(context.TODO(), s.db, postID)
+	s.Require().NoError(err)
+	s.True(p.Obfuscated)
+	s.False(p.Held)
+
+	appeal, impartErr := s.svc.Appeal(author, postID, 0, "please")
+	s.Require().Nil(impartErr)
+	appeal, impartErr = s.svc.ResolveAppeal(otherModerator, hiveID, appeal.AppealID, false, "")
+	s.Require().Nil(impartErr)
+	s.Equal(dbmodels.ContentAppealsStatusDenied, appeal.Status)
+	p, err = dbmodels.FindPost(context.TODO(), s.db, postID)
+	s.Require().NoError(err)
+	s.True(p.Obfuscated)
+}
+
+func (s *ServiceTestSuite) TestWithdrawApproval() {
+	author, reporter, moderator := s.contextWithUser(false), s.contextWithUser(false), s.contextWithUser(true)
+	_, postID := s.bootstrapPost(author, false)
+
+	_, impartErr := s.svc.ReportPost(reporter, postID, "", false)
+	s.Require().Nil(impartErr)
+	_, impartErr = s.svc.ReviewPost(moderator, postID, "", false)
+	s.Require().Nil(impartErr)
+	_, impartErr = s.svc.ReviewPost(moderator, postID, "changed my mind", true)
+	s.Require().Nil(impartErr)
+
+	history, impartErr := s.svc.GetModerationHistory(author, postID, 0)
+	s.Require().Nil(impartErr)
+	s.Require().Len(history.Decisions, 2)
+	s.Equal(dbmodels.ModerationDecisionsActionApproved, history.Decisions[0].Action)
+	s.Equal(dbmodels.ModerationDecisionsActionRemoved, history.Decisions[1].Action)
+	s.NotNil(history.AppealDeadline)
+}
+
+func (s *ServiceTestSuite) TestMentionsOfHeldPost() {
+	author, mentioned, moderator := s.contextWithUser(false), s.contextWithUser(false), s.contextWithUser(true)
+	hiveID, postID := s.bootstrapPost(author, true)
+	member := impart.GetCtxUser(mentioned)
+	member.ScreenName = "mentioned1"
+	_, err := member.Update(context.TODO(), s.db, boil.Whitelist(dbmodels.UserColumns.ScreenName))
+	s.Require().NoError(err)
+	s.Require().NoError(member.AddMemberHiveHives(context.TODO(), s.db, false, &dbmodels.Hive{HiveID: hiveID}))
+
+	authorID := impart.GetCtxUser(author).ImpartWealthID
+	mentions := s.svc.updateMentions(author, hiveID, postID, 0, authorID, "thanks @Mentioned1 and @nobodyhere", false)
+	s.Require().Len(mentions, 1)
+	s.Equal(member.ImpartWealthID, mentions[0].ImpartWealthID)
+	m, err := dbmodels.Mentions(dbmodels.MentionWhere.PostID.EQ(postID)).One(context.TODO(), s.db)
+	s.Require().NoError(err)
+	s.False(m.NotifiedAt.Valid, "the post is held")
+
+	_, impartErr := s.svc.ReviewPost(moderator, postID, "", false)
+	s.Require().Nil(impartErr)
+	s.Require().NoError(m.Reload(context.TODO(), s.db))
+	s.True(m.NotifiedAt.Valid, "the mention is notified once the post is approved")
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"go.uber.org/zap"
 )
@@ -27,7 +28,9 @@ func (s *service) GetComments(ctx context.Context, postID uint64, limit, offset
 		}
 	}
 	ctxUser := impart.GetCtxUser(ctx)
-	return models.CommentsFromDBModelSlice(dbComments, ctxUser), nextPage, nil
+	out := models.CommentsFromDBModelSlice(dbComments, ctxUser)
+	s.attachCommentMentions(ctx, out)
+	return out, nextPage, nil
 }
 
 func (s *service) GetComment(ctx context.Context, commentID uint64) (models.Comment, impart.Error) {
@@ -40,7 +43,9 @@ func (s *service) GetComment(ctx context.Context, commentID uint64) (models.Comm
 		}
 	}
 	ctxUser := impart.GetCtxUser(ctx)
-	return models.CommentFromDBModel(dbComment, ctxUser), nil
+	out := models.CommentFromDBModel(dbComment, ctxUser)
+	out.Mentions = s.loadMentions(ctx, true, dbmodels.MentionWhere.CommentID.EQ(null.Uint64From(commentID)))[commentID]
+	return out, nil
 }
 
 func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comment, impart.Error) {
@@ -51,6 +56,13 @@ func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comm
 		return empty, impart.NewError(impart.ErrBadRequest, "post is less than 1 character1", impart.Content)
 	}
 	ctxUser := impart.GetCtxUser(ctx)
+	if impartErr := s.checkStanding(ctx, ctxUser, true); impartErr != nil {
+		return empty, impartErr
+	}
+	held, impartErr := checkProfanity(ctxUser.Admin, c.Content.Markdown)
+	if impartErr != nil {
+		return empty, impartErr
+	}
 	newComment := &dbmodels.Comment{
 		PostID:         c.PostID,
 		ImpartWealthID: ctxUser.ImpartWealthID,
@@ -59,6 +71,8 @@ func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comm
 		LastReplyTS:    impart.CurrentUTC(),
 		UpVoteCount:    0,
 		DownVoteCount:  0,
+		Held:           held,
+		Obfuscated:     held,
 	}
 	// check a parent is exists
 	if c.ParentCommentID > 0 {
@@ -67,15 +81,30 @@ func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comm
 
 	comment, err := s.commentData.NewComment(ctx, newComment)
 	if err != nil {
-		s.logger.Error("error creating comment", zap.Error(err), zap.Any("comment", comment))
+		impart.CtxLogger(ctx, s.logger).Error("error creating comment", zap.Error(err), zap.Any("comment", comment))
 		return models.Comment{}, impart.NewError(impart.ErrUnknown, fmt.Sprintf("error creating NewComment for user %s", c.ImpartWealthID))
 	}
 	out := models.CommentFromDBModel(comment, ctxUser)
 	dbPost, err := s.postData.GetPost(ctx, c.PostID)
 	if err != nil {
-		s.logger.Error("error getting post from newly created comment")
+		impart.CtxLogger(ctx, s.logger).Error("error getting post from newly created comment")
+		return out, nil
+	}
+	metrics.CommentCreated(dbPost.HiveID)
+	out.Mentions = s.updateMentions(ctx, dbPost.HiveID, dbPost.PostID, out.CommentID, ctxUser.ImpartWealthID, c.Content.Markdown, !held)
+	// commenting on a post follows it
+	if err := s.followPost(ctx, dbPost.PostID, ctxUser.ImpartWealthID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to follow commented post", zap.Uint64("postId", dbPost.PostID), zap.Error(err))
+	}
+	// nobody is notified about a held comment until it is approved
+	if held {
 		return out, nil
 	}
+	// the post author and mentioned members have their own notification
+	notified := make([]string, 0, len(out.Mentions)+1)
+	for _, m := range out.Mentions {
+		notified = append(notified, m.ImpartWealthID)
+	}
 
 	// check parent comment exists, then call
 	if c.ParentCommentID > 0 {
@@ -87,7 +116,7 @@ func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comm
 			ActionType: types.NewComment,
 		})
 		if err != nil {
-			s.logger.Error("error happened on notify reaction", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("error happened on notify reaction", zap.Error(err))
 		}
 	} else {
 		// send post
@@ -98,10 +127,11 @@ func (s *service) NewComment(ctx context.Context, c models.Comment) (models.Comm
 			ActionType: types.NewPostComment,
 		})
 		if err != nil {
-			s.logger.Error("error happened on notify reaction", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("error happened on notify reaction", zap.Error(err))
 		}
-
+		notified = append(notified, dbPost.ImpartWealthID)
 	}
+	s.notifyFollowers(ctx, dbPost, out.CommentID, notified...)
 
 	return out, nil
 }
@@ -111,22 +141,36 @@ func (s *service) EditComment(ctx context.Context, editedComment models.Comment)
 	ctxUser := impart.GetCtxUser(ctx)
 	existingComment, err := s.commentData.GetComment(ctx, editedComment.CommentID)
 	if err != nil {
-		s.logger.Error("error fetcing post trying to edit", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetcing post trying to edit", zap.Error(err))
 		return empty, impart.UnknownError
 	}
 	if !ctxUser.Admin && existingComment.ImpartWealthID != ctxUser.ImpartWealthID {
 		return empty, impart.NewError(impart.ErrUnauthorized, "unable to edit a comment that's not yours", impart.ImpartWealthID)
 	}
+	if impartErr := s.checkStanding(ctx, ctxUser, false); impartErr != nil {
+		return empty, impartErr
+	}
+	held, impartErr := checkProfanity(ctxUser.Admin, editedComment.Content.Markdown)
+	if impartErr != nil {
+		return empty, impartErr
+	}
 	existingComment.Content = editedComment.Content.Markdown
+	existingComment.Held = held
 	c, err := s.commentData.EditComment(ctx, existingComment)
 	if err != nil {
 		return empty, impart.UnknownError
 	}
-	return models.CommentFromDBModel(c, ctxUser), nil
+	out := models.CommentFromDBModel(c, ctxUser)
+	if dbPost, err := dbmodels.FindPost(ctx, s.db, c.PostID, dbmodels.PostColumns.PostID, dbmodels.PostColumns.HiveID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("error getting post of edited comment", zap.Uint64("commentId", c.CommentID), zap.Error(err))
+	} else {
+		out.Mentions = s.updateMentions(ctx, dbPost.HiveID, dbPost.PostID, c.CommentID, c.ImpartWealthID, editedComment.Content.Markdown, !held)
+	}
+	return out, nil
 }
 
 func (s *service) DeleteComment(ctx context.Context, commentID uint64) impart.Error {
-	s.logger.Debug("received comment delete request",
+	impart.CtxLogger(ctx, s.logger).Debug("received comment delete request",
 		zap.Uint64("commentId", commentID))
 
 	ctxUser := impart.GetCtxUser(ctx)
@@ -138,6 +182,9 @@ func (s *service) DeleteComment(ctx context.Context, commentID uint64) impart.Er
 	if !ctxUser.Admin && existingComment.ImpartWealthID != ctxUser.ImpartWealthID {
 		return impart.NewError(impart.ErrUnauthorized, "unable to delete a comment that's not yours")
 	}
+	if impartErr := s.checkStanding(ctx, ctxUser, false); impartErr != nil {
+		return impartErr
+	}
 
 	err = s.commentData.DeleteComment(ctx, commentID)
 	if err != nil {
@@ -155,13 +202,17 @@ func (s *service) ReportComment(ctx context.Context, commentID uint64, reason st
 	if !remove && reason == "" {
 		return empty, impart.NewError(impart.ErrBadRequest, "must provide a reason for reporting")
 	}
+	if impartErr := s.checkStanding(ctx, impart.GetCtxUser(ctx), false); impartErr != nil {
+		return empty, impartErr
+	}
 	if reason != "" {
 		dbReason = &reason
 	}
 
+	before, _ := s.commentVisibility(ctx, commentID)
 	err := s.reactionData.ReportComment(ctx, commentID, dbReason, remove)
 	if err != nil {
-		s.logger.Error("couldn't report comment", zap.Error(err), zap.Uint64("commentId", commentID))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't report comment", zap.Error(err), zap.Uint64("commentId", commentID))
 		switch err {
 		case impart.ErrNoOp:
 			return empty, impart.NewError(impart.ErrNoOp, "You have already reported this comment", impart.Report)
@@ -173,13 +224,19 @@ func (s *service) ReportComment(ctx context.Context, commentID uint64, reason st
 			return empty, impart.UnknownError
 		}
 	}
+	if after, ok := s.commentVisibility(ctx, commentID); ok {
+		if !remove {
+			metrics.Reported(after.HiveID, after.kind())
+		}
+		s.reported(ctx, before, after)
+	}
 
 	out, err := s.reactionData.GetUserTrack(ctx, data.ContentInput{
 		Type: data.Comment,
 		Id:   commentID,
 	})
 	if err != nil {
-		s.logger.Error("couldn't get updated user track object", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't get updated user track object", zap.Error(err))
 		return empty, impart.UnknownError
 	}
 	return out, nil
@@ -193,9 +250,10 @@ func (s *service) ReviewComment(ctx context.Context, commentID uint64, reason st
 		dbReason = &reason
 	}
 
+	before, _ := s.commentVisibility(ctx, commentID)
 	err := s.reactionData.ReviewComment(ctx, commentID, dbReason, remove)
 	if err != nil {
-		s.logger.Error("couldn't report comment", zap.Error(err), zap.Uint64("commentId", commentID))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't report comment", zap.Error(err), zap.Uint64("commentId", commentID))
 		switch err {
 		case impart.ErrNoOp:
 			return empty, impart.NewError(impart.ErrNoOp, "comment is already in the input review state", impart.Report)
@@ -206,6 +264,11 @@ func (s *service) ReviewComment(ctx context.Context, commentID uint64, reason st
 		}
 	}
 
+	if after, ok := s.commentVisibility(ctx, commentID); ok {
+		s.reviewed(ctx, before, after)
+		s.recordReview(ctx, after, remove, reason)
+	}
+
 	dbComment, err := s.commentData.GetComment(ctx, commentID)
 	if err != nil {
 		if err == impart.ErrNotFound {
@@ -241,11 +304,12 @@ func (s *service) SendCommentNotification(input models.CommentNotificationInput)
 		EventDatetime: impart.CurrentUTC(),
 		PostID:        dbComment.PostID,
 		CommentID:     dbComment.CommentID,
+		Category:      notificationCategory(input.ActionType),
 	}
 
 	// generate notification context
 	out, err := s.BuildCommentNotificationData(ctxUser, input)
-	s.logger.Debug("push-notification : sending comment notification",
+	impart.CtxLogger(input.Ctx, s.logger).Debug("push-notification : sending comment notification",
 		zap.Any("data", models.PostNotificationInput{
 			CommentID:  input.CommentID,
 			PostID:     input.PostID,
@@ -280,7 +344,7 @@ func (s *service) SendCommentNotification(input models.CommentNotificationInput)
 		if dbComment.R.ImpartWealth != nil && strings.TrimSpace(dbComment.R.ImpartWealth.ImpartWealthID) != "" {
 			err = s.sendNotification(notificationData, out.Alert, dbComment.R.ImpartWealth.ImpartWealthID)
 			if err != nil {
-				s.logger.Error("push-notification : error attempting to send post comment notification ", zap.Any("postData", out), zap.Error(err))
+				impart.CtxLogger(input.Ctx, s.logger).Error("push-notification : error attempting to send post comment notification ", zap.Any("postData", out), zap.Error(err))
 			}
 		}
 	}()
@@ -292,7 +356,7 @@ func (s *service) SendCommentNotification(input models.CommentNotificationInput)
 			if strings.TrimSpace(out.PostOwnerWealthID) != "" {
 				err = s.sendNotification(notificationData, out.PostOwnerAlert, out.PostOwnerWealthID)
 				if err != nil {
-					s.logger.Error("push-notification : error attempting to send post comment notification post owner ", zap.Any("postData", out), zap.Any("postData", out), zap.Error(err))
+					impart.CtxLogger(input.Ctx, s.logger).Error("push-notification : error attempting to send post comment notification post owner ", zap.Any("postData", out), zap.Any("postData", out), zap.Error(err))
 				}
 			}
 		}()
@@ -0,0 +1,244 @@
+package hive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// maxDrafts caps the drafts a member can keep
+const maxDrafts = 50
+
+// GetDrafts lists the drafts of the context user, most recently updated first, optionally only
+// of one kind or the comment drafts of one post.
+func (s *service) GetDrafts(ctx context.Context, kind string, postID uint64, limit, offset int) (models.Drafts, *models.NextPage, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if limit <= 0 {
+		limit = impart.DefaultLimit
+	} else if limit > impart.MaxLimit {
+		limit = impart.MaxLimit
+	}
+	where := []qm.QueryMod{
+		dbmodels.DraftWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+	}
+	switch kind {
+	case "":
+	case dbmodels.DraftsKindPost, dbmodels.DraftsKindComment:
+		where = append(where, dbmodels.DraftWhere.Kind.EQ(kind))
+	default:
+		return nil, nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid draft kind %s", kind))
+	}
+	if postID > 0 {
+		where = append(where, dbmodels.DraftWhere.PostID.EQ(null.Uint64From(postID)))
+	}
+	dbDrafts, err := dbmodels.Drafts(append(where,
+		qm.OrderBy(dbmodels.DraftColumns.UpdatedAt+" desc, "+dbmodels.DraftColumns.DraftID+" desc"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch drafts", zap.Error(err))
+		return nil, nil, impart.UnknownError
+	}
+	out, err := models.DraftsFromDBModel(dbDrafts)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to read drafts", zap.Error(err))
+		return nil, nil, impart.UnknownError
+	}
+	var nextPage *models.NextPage
+	if len(out) == limit {
+		nextPage = &models.NextPage{Offset: offset + len(out)}
+	}
+	return out, nextPage, nil
+}
+
+func (s *service) GetDraft(ctx context.Context, draftID uint64) (models.Draft, impart.Error) {
+	dbDraft, impartErr := s.ownDraft(ctx, draftID)
+	if impartErr != nil {
+		return models.Draft{}, impartErr
+	}
+	out, err := models.DraftFromDBModel(dbDraft)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to read draft", zap.Uint64("draftId", draftID), zap.Error(err))
+		return models.Draft{}, impart.UnknownError
+	}
+	return out, nil
+}
+
+// SaveDraft creates a draft, or replaces the content of an existing draft when DraftID is set
+func (s *service) SaveDraft(ctx context.Context, draft models.Draft) (models.Draft, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if draft.Kind == "" && draft.Comment != nil {
+		draft.Kind = dbmodels.DraftsKindComment
+	} else if draft.Kind == "" && draft.Post != nil {
+		draft.Kind = dbmodels.DraftsKindPost
+	}
+	var dbDraft *dbmodels.Draft
+	if draft.DraftID > 0 {
+		var impartErr impart.Error
+		if dbDraft, impartErr = s.ownDraft(ctx, draft.DraftID); impartErr != nil {
+			return models.Draft{}, impartErr
+		}
+		if draft.Kind != dbDraft.Kind {
+			return models.Draft{}, impart.NewError(impart.ErrBadRequest, "unable to change the kind of a draft")
+		}
+	} else {
+		count, err := dbmodels.Drafts(dbmodels.DraftWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID)).Count(ctx, s.db)
+		if err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to count drafts", zap.Error(err))
+			return models.Draft{}, impart.UnknownError
+		}
+		if count >= maxDrafts {
+			return models.Draft{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("unable to keep more than %d drafts", maxDrafts))
+		}
+		dbDraft = &dbmodels.Draft{
+			ImpartWealthID: ctxUser.ImpartWealthID,
+			Kind:           draft.Kind,
+			CreatedAt:      impart.CurrentUTC(),
+		}
+	}
+
+	dbDraft.HiveID, dbDraft.PostID = null.Uint64{}, null.Uint64{}
+	switch draft.Kind {
+	case dbmodels.DraftsKindPost:
+		if draft.Post == nil {
+			return models.Draft{}, impart.NewError(impart.ErrBadRequest, "a post draft needs a post")
+		}
+		draft.Comment = nil
+		if draft.Post.HiveID > 0 {
+			if impartErr := s.validateHiveAccess(ctx, draft.Post.HiveID); impartErr != nil {
+				return models.Draft{}, impartErr
+			}
+			dbDraft.HiveID = null.Uint64From(draft.Post.HiveID)
+		}
+	case dbmodels.DraftsKindComment:
+		if draft.Comment == nil || draft.Comment.PostID == 0 {
+			return models.Draft{}, impart.NewError(impart.ErrBadRequest, "a comment draft needs the post it comments on", impart.PostID)
+		}
+		draft.Post = nil
+		dbPost, err := dbmodels.FindPost(ctx, s.db, draft.Comment.PostID, dbmodels.PostColumns.PostID, dbmodels.PostColumns.HiveID)
+		if err != nil {
+			return models.Draft{}, impart.NewError(impart.ErrNotFound, "unable to find the post", impart.PostID)
+		}
+		if impartErr := s.validateHiveAccess(ctx, dbPost.HiveID); impartErr != nil {
+			return models.Draft{}, impartErr
+		}
+		dbDraft.PostID = null.Uint64From(dbPost.PostID)
+	default:
+		return models.Draft{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid draft kind %s", draft.Kind))
+	}
+	payload, err := draft.Payload()
+	if err != nil {
+		return models.Draft{}, impart.NewError(impart.ErrBadRequest, "unable to store the draft")
+	}
+	dbDraft.Payload = payload
+	dbDraft.UpdatedAt = impart.CurrentUTC()
+	if dbDraft.DraftID > 0 {
+		_, err = dbDraft.Update(ctx, s.db, boil.Infer())
+	} else {
+		err = dbDraft.Insert(ctx, s.db, boil.Infer())
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to save draft", zap.Uint64("draftId", dbDraft.DraftID), zap.Error(err))
+		return models.Draft{}, impart.UnknownError
+	}
+	out, err := models.DraftFromDBModel(dbDraft)
+	if err != nil {
+		return models.Draft{}, impart.UnknownError
+	}
+	return out, nil
+}
+
+func (s *service) DeleteDraft(ctx context.Context, draftID uint64) impart.Error {
+	dbDraft, impartErr := s.ownDraft(ctx, draftID)
+	if impartErr != nil {
+		return impartErr
+	}
+	if _, err := dbDraft.Delete(ctx, s.db); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to delete draft", zap.Uint64("draftId", draftID), zap.Error(err))
+		return impart.UnknownError
+	}
+	return nil
+}
+
+// PublishDraft posts the draft the same way a new post or comment is created and then deletes it.
+// The returned draft holds the published post or comment, a post to several hives returns the draft.
+func (s *service) PublishDraft(ctx context.Context, draftID uint64) (models.Draft, impart.Error) {
+	draft, impartErr := s.GetDraft(ctx, draftID)
+	if impartErr != nil {
+		return draft, impartErr
+	}
+	switch draft.Kind {
+	case dbmodels.DraftsKindPost:
+		p := ValidationPost(*draft.Post)
+		if impartErr = ValidateInputs(p); impartErr != nil {
+			return draft, impartErr
+		}
+		if p.HiveID == 0 && len(p.Hives) > 0 {
+			if !impart.GetCtxUser(ctx).Admin {
+				return draft, impart.NewError(impart.ErrUnauthorized, "only admins can post to several hives")
+			}
+			if impartErr = s.NewPostForMultipleHives(ctx, p); impartErr != nil {
+				return draft, impartErr
+			}
+			break
+		}
+		if p.HiveID == 0 {
+			return draft, impart.NewError(impart.ErrBadRequest, "the draft has no hive to post to", impart.HiveID)
+		}
+		if impartErr = s.validateHiveAccess(ctx, p.HiveID); impartErr != nil {
+			return draft, impartErr
+		}
+		if p, impartErr = s.NewPost(ctx, p); impartErr != nil {
+			return draft, impartErr
+		}
+		draft.Post = &p
+	case dbmodels.DraftsKindComment:
+		if impartErr = s.validateHiveAccess(ctx, s.postHive(ctx, draft.PostID)); impartErr != nil {
+			return draft, impartErr
+		}
+		c := ValidateCommentInput(*draft.Comment)
+		if c, impartErr = s.NewComment(ctx, c); impartErr != nil {
+			return draft, impartErr
+		}
+		draft.Comment = &c
+	}
+	if impartErr := s.DeleteDraft(ctx, draftID); impartErr != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to delete published draft", zap.Uint64("draftId", draftID))
+	}
+	return draft, nil
+}
+
+// ownDraft finds a draft of the context user
+func (s *service) ownDraft(ctx context.Context, draftID uint64) (*dbmodels.Draft, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	dbDraft, err := dbmodels.Drafts(
+		dbmodels.DraftWhere.DraftID.EQ(draftID),
+		dbmodels.DraftWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+	).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return nil, impart.NewError(impart.ErrNotFound, "draft not found")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch draft", zap.Uint64("draftId", draftID), zap.Error(err))
+		return nil, impart.UnknownError
+	}
+	return dbDraft, nil
+}
+
+// postHive is the hive of the post, zero when the post can't be found
+func (s *service) postHive(ctx context.Context, postID uint64) uint64 {
+	dbPost, err := dbmodels.FindPost(ctx, s.db, postID, dbmodels.PostColumns.PostID, dbmodels.PostColumns.HiveID)
+	if err != nil {
+		return 0
+	}
+	return dbPost.HiveID
+}
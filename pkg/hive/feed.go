@@ -0,0 +1,70 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+
+	data "github.com/impartwealthapp/backend/pkg/data/hive"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"go.uber.org/zap"
+)
+
+// GetFeed merges the posts of every hive the context user can access, or of the hives in
+// gpi.HiveIDs. Admins access every hive, so their feed is not limited to a list of hives.
+func (s *service) GetFeed(ctx context.Context, gpi data.GetPostsInput) (models.Posts, *models.NextPage, impart.Error) {
+	empty := make(models.Posts, 0)
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return empty, nil, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	gpi.HiveID = 0
+	gpi.AllHives = false
+	if ctxUser.Admin {
+		gpi.AllHives = len(gpi.HiveIDs) == 0
+	} else {
+		var memberHives []uint64
+		if ctxUser.R != nil {
+			for _, h := range ctxUser.R.MemberHiveHives {
+				memberHives = append(memberHives, h.HiveID)
+			}
+		}
+		if len(gpi.HiveIDs) == 0 {
+			gpi.HiveIDs = memberHives
+		}
+		for _, hiveID := range gpi.HiveIDs {
+			if !containsHive(memberHives, hiveID) {
+				return empty, nil, impart.NewError(impart.ErrUnauthorized, fmt.Sprintf("user is not a member of hive %d", hiveID), impart.HiveID)
+			}
+		}
+		if len(gpi.HiveIDs) == 0 {
+			return empty, nil, nil
+		}
+	}
+
+	dbPosts, nextPage, err := s.postData.GetPosts(ctx, gpi)
+	if err != nil && err != impart.ErrNotFound {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch feed posts", zap.Error(err))
+		return empty, nil, impart.NewError(err, "error getting posts")
+	}
+	if len(dbPosts) == 0 {
+		return empty, nil, nil
+	}
+	out := models.PostsFromDB(dbPosts, ctxUser)
+	if out, err = s.postData.GetReportedUser(ctx, out); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("error fetching data", zap.Error(err))
+	}
+	s.attachPostMentions(ctx, out)
+	s.attachPostFollows(ctx, out)
+	s.signPostFiles(ctx, out)
+	return out, nextPage, nil
+}
+
+func containsHive(hiveIDs []uint64, hiveID uint64) bool {
+	for _, id := range hiveIDs {
+		if id == hiveID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// +build integration
+
+package hive
+
+import (
+	data "github.com/impartwealthapp/backend/pkg/data/hive"
+	"github.com/impartwealthapp/backend/pkg/impart"
+)
+
+func (s *ServiceTestSuite) TestGetFeed() {
+	hiveA, hiveB := s.bootstrapHive(), s.bootstrapHive()
+	member, admin := s.contextWithUser(false, hiveA), s.contextWithUser(true)
+	author := s.contextWithUser(false, hiveA, hiveB)
+	postA := s.bootstrapHivePost(author, hiveA, false)
+	postB := s.bootstrapHivePost(author, hiveB, false)
+	heldA := s.bootstrapHivePost(author, hiveA, true)
+
+	posts, _, impartErr := s.svc.GetFeed(member, data.GetPostsInput{})
+	s.Require().Nil(impartErr)
+	s.Require().Len(posts, 1, "members only see the hives they are in, without held posts")
+	s.Equal(postA, posts[0].PostID)
+	s.Equal(hiveA, posts[0].HiveID)
+
+	_, _, impartErr = s.svc.GetFeed(member, data.GetPostsInput{HiveIDs: []uint64{hiveB}})
+	s.Require().Error(impartErr)
+	s.Equal(impart.ErrUnauthorized, impartErr.Err())
+
+	posts, _, impartErr = s.svc.GetFeed(admin, data.GetPostsInput{})
+	s.Require().Nil(impartErr)
+	s.Require().Len(posts, 3, "admins see every hive")
+	s.Equal([]uint64{heldA, postB, postA}, []uint64{posts[0].PostID, posts[1].PostID, posts[2].PostID}, "newest first")
+
+	posts, nextPage, impartErr := s.svc.GetFeed(admin, data.GetPostsInput{HiveIDs: []uint64{hiveB}})
+	s.Require().Nil(impartErr)
+	s.Require().Len(posts, 1)
+	s.Equal(postB, posts[0].PostID)
+	s.Nil(nextPage)
+
+	posts, nextPage, impartErr = s.svc.GetFeed(admin, data.GetPostsInput{Limit: 2})
+	s.Require().Nil(impartErr)
+	s.Len(posts, 2)
+	s.Require().NotNil(nextPage)
+	posts, _, impartErr = s.svc.GetFeed(admin, data.GetPostsInput{Limit: 2, Offset: nextPage.Offset})
+	s.Require().Nil(impartErr)
+	s.Require().Len(posts, 1)
+	s.Equal(postA, posts[0].PostID)
+}
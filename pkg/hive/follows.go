@@ -0,0 +1,139 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	data "github.com/impartwealthapp/backend/pkg/data/hive"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// FollowPost subscribes the context user to new comments on the post
+func (s *service) FollowPost(ctx context.Context, postID uint64) (models.PostCommentTrack, impart.Error) {
+	return s.setFollowing(ctx, postID, true)
+}
+
+// UnfollowPost stops the notifications of new comments on the post for the context user
+func (s *service) UnfollowPost(ctx context.Context, postID uint64) (models.PostCommentTrack, impart.Error) {
+	return s.setFollowing(ctx, postID, false)
+}
+
+func (s *service) setFollowing(ctx context.Context, postID uint64, follow bool) (models.PostCommentTrack, impart.Error) {
+	var empty models.PostCommentTrack
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return empty, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	dbPost, err := dbmodels.FindPost(ctx, s.db, postID, dbmodels.PostColumns.PostID, dbmodels.PostColumns.HiveID)
+	if err != nil {
+		return empty, impart.NewError(impart.ErrNotFound, "unable to find the post", impart.PostID)
+	}
+	if impartErr := s.validateHiveAccess(ctx, dbPost.HiveID); impartErr != nil {
+		return empty, impartErr
+	}
+	if follow {
+		err = s.followPost(ctx, postID, ctxUser.ImpartWealthID)
+	} else {
+		_, err = dbmodels.PostFollows(
+			dbmodels.PostFollowWhere.PostID.EQ(postID),
+			dbmodels.PostFollowWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+		).DeleteAll(ctx, s.db)
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to update post follow", zap.Uint64("postId", postID), zap.Bool("follow", follow), zap.Error(err))
+		return empty, impart.UnknownError
+	}
+
+	out, err := s.reactionData.GetUserTrack(ctx, data.ContentInput{Id: postID, Type: data.Post})
+	if err != nil && err != impart.ErrNotFound {
+		impart.CtxLogger(ctx, s.logger).Error("error getting tracked post", zap.Uint64("postId", postID), zap.Error(err))
+		return empty, impart.NewError(err, "unable to retrieve tracked content")
+	}
+	if err == impart.ErrNotFound {
+		out = models.PostCommentTrack{ImpartWealthID: ctxUser.ImpartWealthID, ContentID: postID, PostID: postID}
+	}
+	out.Following = follow
+	return out, nil
+}
+
+// followPost adds the follow unless the member already follows the post
+func (s *service) followPost(ctx context.Context, postID uint64, impartWealthID string) error {
+	_, err := queries.Raw("insert ignore into post_follows (post_id, impart_wealth_id, created_at) values (?, ?, ?)",
+		postID, impartWealthID, impart.CurrentUTC()).ExecContext(ctx, s.db)
+	return err
+}
+
+// followedPosts returns which of the posts the context user follows
+func (s *service) followedPosts(ctx context.Context, postIDs ...uint64) map[uint64]bool {
+	out := make(map[uint64]bool)
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil || len(postIDs) == 0 {
+		return out
+	}
+	follows, err := dbmodels.PostFollows(
+		dbmodels.PostFollowWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID),
+		dbmodels.PostFollowWhere.PostID.IN(postIDs),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to load post follows", zap.Error(err))
+		return out
+	}
+	for _, f := range follows {
+		out[f.PostID] = true
+	}
+	return out
+}
+
+func (s *service) attachPostFollows(ctx context.Context, posts models.Posts) {
+	ids := make([]uint64, len(posts))
+	for i, p := range posts {
+		ids[i] = p.PostID
+	}
+	following := s.followedPosts(ctx, ids...)
+	for i := range posts {
+		posts[i].PostCommentTrack.Following = following[posts[i].PostID]
+	}
+}
+
+// notifyFollowers sends a new comment to the members following the post who are still in the hive,
+// except the commenter and the members in skip who were already notified about it. The followers are
+// loaded in the request, the notifications are sent in the background.
+func (s *service) notifyFollowers(ctx context.Context, dbPost *dbmodels.Post, commentID uint64, skip ...string) {
+	ctxUser := impart.GetCtxUser(ctx)
+	skip = append(skip, ctxUser.ImpartWealthID)
+	followers, err := dbmodels.PostFollows(
+		qm.InnerJoin("user u on u.impart_wealth_id = post_follows.impart_wealth_id"),
+		qm.InnerJoin("hive_members hm on hm.member_impart_wealth_id = post_follows.impart_wealth_id"),
+		qm.Where("hm.member_hive_id = ?", dbPost.HiveID),
+		qm.Where("u.blocked = ?", false),
+		qm.Where("u.deleted_at is null"),
+		dbmodels.PostFollowWhere.PostID.EQ(dbPost.PostID),
+		dbmodels.PostFollowWhere.ImpartWealthID.NIN(skip),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to load post followers", zap.Uint64("postId", dbPost.PostID), zap.Error(err))
+		return
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		HiveID:        dbPost.HiveID,
+		PostID:        dbPost.PostID,
+		CommentID:     commentID,
+		Category:      impart.FollowedPostNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String("New Activity on a Post You Follow"),
+		Body:  aws.String(fmt.Sprintf("%s commented on %s", ctxUser.ScreenName, dbPost.Subject)),
+	}
+	ids := make([]string, len(followers))
+	for i, f := range followers {
+		ids[i] = f.ImpartWealthID
+	}
+	go s.notifyMembers(impart.CtxLogger(ctx, s.logger), data, alert, ids)
+}
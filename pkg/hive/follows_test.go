@@ -0,0 +1,57 @@
+// +build integration
+
+package hive
+
+import (
+	"context"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ServiceTestSuite) TestFollowPost() {
+	hiveID := s.bootstrapHive()
+	author, follower, outsider := s.contextWithUser(false, hiveID), s.contextWithUser(false, hiveID), s.contextWithUser(false)
+	postID := s.bootstrapHivePost(author, hiveID, false)
+
+	track, impartErr := s.svc.FollowPost(follower, postID)
+	s.Require().Nil(impartErr)
+	s.True(track.Following)
+	_, impartErr = s.svc.FollowPost(follower, postID)
+	s.Nil(impartErr, "following twice changes nothing")
+	s.True(s.svc.followedPosts(follower, postID)[postID])
+	s.False(s.svc.followedPosts(author, postID)[postID])
+
+	_, impartErr = s.svc.FollowPost(outsider, postID)
+	s.Require().Error(impartErr)
+	s.Equal(impart.ErrUnauthorized, impartErr.Err(), "only members of the hive can follow its posts")
+
+	track, impartErr = s.svc.UnfollowPost(follower, postID)
+	s.Require().Nil(impartErr)
+	s.False(track.Following)
+	s.False(s.svc.followedPosts(follower, postID)[postID])
+}
+
+func (s *ServiceTestSuite) TestNotifyFollowers() {
+	hiveID := s.bootstrapHive()
+	author, follower, commenter := s.contextWithUser(false, hiveID), s.contextWithUser(false, hiveID), s.contextWithUser(false, hiveID)
+	left := s.contextWithUser(false, hiveID)
+	postID := s.bootstrapHivePost(author, hiveID, false)
+	for _, ctx := range []context.Context{author, follower, commenter, left} {
+		s.Require().NoError(s.svc.followPost(context.TODO(), postID, impart.GetCtxUser(ctx).ImpartWealthID))
+	}
+	// followers who left the hive are not notified
+	s.Require().NoError(impart.GetCtxUser(left).RemoveMemberHiveHives(context.TODO(), s.db, &dbmodels.Hive{HiveID: hiveID}))
+
+	dbPost, err := dbmodels.FindPost(context.TODO(), s.db, postID)
+	s.Require().NoError(err)
+	// the author already had their own notification
+	s.svc.notifyFollowers(commenter, dbPost, 1, impart.GetCtxUser(author).ImpartWealthID)
+	// the notifications are sent in the background
+	want := []string{impart.GetCtxUser(follower).ImpartWealthID}
+	s.Eventually(func() bool {
+		return assert.ObjectsAreEqual(want, s.notified.notifiedOf(impart.FollowedPostNotification))
+	}, time.Second, 10*time.Millisecond, "the commenter is skipped")
+}
@@ -13,6 +13,7 @@ import (
 	data "github.com/impartwealthapp/backend/pkg/data/hive"
 	"github.com/impartwealthapp/backend/pkg/data/types"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
@@ -23,8 +24,10 @@ import (
 	"go.uber.org/zap"
 )
 
-// VoteInput is the input to register an upvote or downvote on a comment or post.
+// VoteInput is the input to register an upvote or downvote on a comment or post. HiveID is the
+// hive of the route, it labels the vote metrics.
 type VoteInput struct {
+	HiveID            uint64
 	PostID, CommentID uint64
 	Upvote            bool
 	Increment         bool
@@ -32,13 +35,18 @@ type VoteInput struct {
 
 func (s *service) Votes(ctx context.Context, v VoteInput) (models.PostCommentTrack, impart.Error) {
 	var out models.PostCommentTrack
-	s.logger.Debug("received vote request", zap.Any("input", v))
+	impart.CtxLogger(ctx, s.logger).Debug("received vote request", zap.Any("input", v))
+	if impartErr := s.checkStanding(ctx, impart.GetCtxUser(ctx), false); impartErr != nil {
+		return out, impartErr
+	}
 
 	var in data.ContentInput
+	kind := "post"
 
 	if v.CommentID > 0 {
 		in.Id = v.CommentID
 		in.Type = data.Comment
+		kind = "comment"
 	} else {
 		in.Id = v.PostID
 		in.Type = data.Post
@@ -64,31 +72,48 @@ func (s *service) Votes(ctx context.Context, v VoteInput) (models.PostCommentTra
 	}
 
 	if err != nil {
-		s.logger.Error("error on vote", zap.Error(err), zap.Any("vote", v))
+		impart.CtxLogger(ctx, s.logger).Error("error on vote", zap.Error(err), zap.Any("vote", v))
 	} else {
+		metrics.Voted(v.HiveID, kind, string(actionType))
 		// send notification on up,down,take votes
 		err = s.SendNotificationOnVote(ctx, actionType, v, in)
 		if err != nil {
-			s.logger.Error("error on vote notification", zap.Error(err), zap.Any("vote", v))
+			impart.CtxLogger(ctx, s.logger).Error("error on vote notification", zap.Error(err), zap.Any("vote", v))
 		}
 	}
 	out, err = s.reactionData.GetUserTrack(ctx, in)
 	if err != nil {
-		s.logger.Error("error getting updated tracked item track store", zap.Error(err), zap.Any("vote", v))
+		impart.CtxLogger(ctx, s.logger).Error("error getting updated tracked item track store", zap.Error(err), zap.Any("vote", v))
 		return out, impart.NewError(err, "unable to retrieve recently tracked content")
 	}
+	if in.Type == data.Post {
+		out.Following = s.followedPosts(ctx, in.Id)[in.Id]
+	}
 
 	return out, nil
 }
 
-func (s *service) Logger() *zap.Logger {
-	return s.logger
+// Logger is the service logger with the request fields of ctx, see impart.CtxLogger
+func (s *service) Logger(ctx context.Context) *zap.Logger {
+	return impart.CtxLogger(ctx, s.logger)
 }
 
 func (s *service) sendNotification(data impart.NotificationData, alert impart.Alert, impartWealthId string) error {
 	return s.notificationService.Notify(context.TODO(), data, alert, impartWealthId)
 }
 
+// notifyMembers notifies the members one by one. It is meant to run after the request is served
+// so it doesn't use its context.
+func (s *service) notifyMembers(logger *zap.Logger, data impart.NotificationData, alert impart.Alert, impartWealthIDs []string) {
+	ctx := context.Background()
+	for _, id := range impartWealthIDs {
+		if err := s.notificationService.Notify(ctx, data, alert, id); err != nil {
+			logger.Error("push-notification : error attempting to send notification",
+				zap.String("impartWealthId", id), zap.Any("data", data), zap.Error(err))
+		}
+	}
+}
+
 // REturns unauthorized if
 func (s *service) validateHiveAccess(ctx context.Context, hiveID uint64) impart.Error {
 	ctxUser := impart.GetCtxUser(ctx)
@@ -115,7 +140,7 @@ func (s *service) GetHive(ctx context.Context, hiveID uint64) (models.Hive, impa
 
 	dbHive, err := s.hiveData.GetHive(ctx, hiveID)
 	if err != nil {
-		s.logger.Error("error getting hive", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error getting hive", zap.Error(err))
 		if err == impart.ErrNotFound {
 			return models.Hive{}, impart.NewError(err, fmt.Sprintf("hive %v not found", hiveID))
 		}
@@ -124,7 +149,7 @@ func (s *service) GetHive(ctx context.Context, hiveID uint64) (models.Hive, impa
 
 	hive, err := models.HiveFromDB(dbHive)
 	if err != nil {
-		s.logger.Error("couldn't convert db model to hive", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't convert db model to hive", zap.Error(err))
 		return models.Hive{}, impart.NewError(impart.ErrUnknown, "bad db model")
 	}
 	return hive, nil
@@ -155,23 +180,23 @@ func (s *service) CreateHive(ctx context.Context, hive models.Hive) (models.Hive
 	// 	return models.Hive{}, impart.NewError(impart.ErrUnauthorized, "non-admin users cannot create hives.")
 	// }
 	if len(strings.TrimSpace(hive.HiveName)) < 3 {
-		s.logger.Error("Hive Creation Failed", zap.Any("Hivename must be greater than or equal to 3.", hive.HiveName))
+		impart.CtxLogger(ctx, s.logger).Error("Hive Creation Failed", zap.Any("Hivename must be greater than or equal to 3.", hive.HiveName))
 		return models.Hive{}, impart.NewError(impart.ErrBadRequest, "Hivename must be greater than or equal to 3.")
 	}
 	if len(strings.TrimSpace(hive.HiveName)) > 60 {
-		s.logger.Error("Hive Creation Failed", zap.Any("Hivename must be less than or equal to 60.", hive.HiveName))
+		impart.CtxLogger(ctx, s.logger).Error("Hive Creation Failed", zap.Any("Hivename must be less than or equal to 60.", hive.HiveName))
 		return models.Hive{}, impart.NewError(impart.ErrBadRequest, "Hivename must be less than or equal to 60.")
 	}
 
 	dbh, err := hive.ToDBModel()
 	if err != nil {
-		s.logger.Error("Hive Creation Failed", zap.Any("Hivename.", hive.HiveName),
+		impart.CtxLogger(ctx, s.logger).Error("Hive Creation Failed", zap.Any("Hivename.", hive.HiveName),
 			zap.Error(err))
 		return models.Hive{}, impart.NewError(impart.ErrUnknown, "unable to convert hives to  dbmodel")
 	}
 	dbh, err = s.hiveData.NewHive(ctx, dbh)
 	if err != nil {
-		s.logger.Error("Hive Creation Failed", zap.Any("Hivename.", hive.HiveName),
+		impart.CtxLogger(ctx, s.logger).Error("Hive Creation Failed", zap.Any("Hivename.", hive.HiveName),
 			zap.Error(err))
 		if strings.Contains(err.Error(), "Duplicate") {
 			return hive, impart.NewError(impart.ErrUnknown, "Hive name already exists.", impart.HiveID)
@@ -182,17 +207,17 @@ func (s *service) CreateHive(ctx context.Context, hive models.Hive) (models.Hive
 	cfg, _ := config.GetImpart()
 	topicInput := fmt.Sprintf("SNSHiveNotification-%s-%d", cfg.Env, dbh.HiveID)
 	topicInput = strings.Replace(topicInput, " ", "-", -1)
-	s.logger.Info("Topic", zap.Any("topicInput", topicInput))
+	impart.CtxLogger(ctx, s.logger).Info("Topic", zap.Any("topicInput", topicInput))
 	topic, err := s.notificationService.CreateNotificationTopic(ctx, topicInput)
 	if err != nil {
-		s.logger.Error("error creating hive topic", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error creating hive topic", zap.Error(err))
 	}
-	s.logger.Info("Topic details", zap.Any("topic", topic))
+	impart.CtxLogger(ctx, s.logger).Info("Topic details", zap.Any("topic", topic))
 	if topic != nil {
-		s.logger.Info("Topic details is not null", zap.Any("topicARn", topic.TopicArn))
+		impart.CtxLogger(ctx, s.logger).Info("Topic details is not null", zap.Any("topicARn", topic.TopicArn))
 		dbh.NotificationTopicArn = null.StringFrom(*topic.TopicArn)
 		if _, err = dbh.Update(ctx, s.db, boil.Infer()); err != nil {
-			s.logger.Error("Topic details update failed in Db", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("Topic details update failed in Db", zap.Error(err))
 		}
 	}
 
@@ -264,7 +289,11 @@ func (s *service) GetReportedUser(ctx context.Context, posts models.Posts) (mode
 }
 
 func (s *service) GetReportedContents(ctx context.Context, gpi data.GetReportedContentInput) (models.PostComments, *models.NextPage, error) {
-	return s.hiveData.GetReportedContents(ctx, gpi)
+	out, nextPage, err := s.hiveData.GetReportedContents(ctx, gpi)
+	for i := range out {
+		out[i].Files = s.signFiles(ctx, out[i].HiveID, out[i].ImpartWealthID, out[i].Obfuscated, out[i].Files)
+	}
+	return out, nextPage, err
 }
 
 func (s *service) DeleteHive(ctx context.Context, hiveID uint64) impart.Error {
@@ -274,7 +303,7 @@ func (s *service) DeleteHive(ctx context.Context, hiveID uint64) impart.Error {
 	ctxUser := impart.GetCtxUser(ctx)
 	_, err := s.hiveData.GetHive(ctx, hiveID)
 	if err != nil {
-		s.logger.Error("error fetching hive trying to edit", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetching hive trying to edit", zap.Error(err))
 		return impart.NewError(impart.ErrBadRequest, "Unable to find the hive.")
 	}
 	clientId := impart.GetCtxClientID(ctx)
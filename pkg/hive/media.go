@@ -0,0 +1,171 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// signPostFiles replaces the stored urls of the files of the posts with signed urls that expire
+func (s *service) signPostFiles(ctx context.Context, posts models.Posts) {
+	for i := range posts {
+		s.signPostFile(ctx, &posts[i])
+	}
+}
+
+func (s *service) signPostFile(ctx context.Context, p *models.Post) {
+	p.Files = s.signFiles(ctx, p.HiveID, p.ImpartWealthID, p.Obfuscated, p.Files)
+}
+
+// signFiles signs the files of a post for members of its hive, the files of a hidden post are
+// only signed for its author and admins. Anyone else gets the files without urls.
+func (s *service) signFiles(ctx context.Context, hiveID uint64, authorID string, hidden bool, in []models.File) []models.File {
+	if len(in) == 0 {
+		return in
+	}
+	visible := s.validateHiveAccess(ctx, hiveID) == nil
+	if ctxUser := impart.GetCtxUser(ctx); visible && hidden && !ctxUser.Admin {
+		visible = ctxUser.ImpartWealthID == authorID
+	}
+	files := make([]models.File, len(in))
+	for i, f := range in {
+		key := f.StorageKey
+		if key == "" {
+			key = s.storage.KeyFromURL(f.URL)
+		}
+		hasRenditions := f.ThumbnailURL != ""
+		f.URL, f.ThumbnailURL, f.MediumURL, f.Content = "", "", "", ""
+		if visible {
+			f.URL = s.signedURL(ctx, f, key)
+			if hasRenditions {
+				f.MediumURL = s.signedURL(ctx, f, media.FileRenditionKey(key, f.FileType, media.RenditionMedium))
+				f.ThumbnailURL = s.signedURL(ctx, f, media.FileRenditionKey(key, f.FileType, media.RenditionThumbnail))
+			}
+		}
+		files[i] = f
+	}
+	return files
+}
+
+func (s *service) signedURL(ctx context.Context, f models.File, key string) string {
+	signed, err := s.storage.SignedURL(ctx, key)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to sign media url", zap.Int("fileId", f.FID), zap.String("key", key), zap.Error(err))
+		return ""
+	}
+	return signed
+}
+
+// ReadLocalMedia opens the file a signed url of a local storage reads, it is the local
+// counterpart of a presigned s3 get.
+func (s *service) ReadLocalMedia(ctx context.Context, key, expires, signature string) (io.ReadCloser, media.ObjectInfo, impart.Error) {
+	if !s.storage.SignsLocally() {
+		return nil, media.ObjectInfo{}, impart.NewError(impart.ErrNotFound, "media is read from the media storage")
+	}
+	if err := s.MediaStorage.VerifyLocalSignature(http.MethodGet, key, "", expires, signature, impart.CurrentUTC()); err != nil {
+		return nil, media.ObjectInfo{}, impart.NewError(impart.ErrUnauthorized, err.Error())
+	}
+	info, err := s.storage.Store.Stat(ctx, key)
+	if err == nil {
+		var body io.ReadCloser
+		if body, err = s.storage.Store.Get(ctx, key, 0); err == nil {
+			return body, info, nil
+		}
+	}
+	if err == media.ErrNotUploaded || err == media.ErrInvalidKey {
+		return nil, media.ObjectInfo{}, impart.NewError(impart.ErrNotFound, "file not found")
+	}
+	impart.CtxLogger(ctx, s.logger).Error("unable to read media", zap.String("key", key), zap.Error(err))
+	return nil, media.ObjectInfo{}, impart.UnknownError
+}
+
+// postFileKeys returns the stored files of the posts by file id, with the keys of the file and its renditions
+func (s *service) postFileKeys(ctx context.Context, postIDs []uint64) (map[uint64][]string, error) {
+	postFiles, err := dbmodels.PostFiles(
+		dbmodels.PostFileWhere.PostID.IN(postIDs),
+		qm.Load(dbmodels.PostFileRels.FidFile),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[uint64][]string, len(postFiles))
+	for _, pf := range postFiles {
+		f := pf.R.FidFile
+		if f == nil {
+			continue
+		}
+		key := f.StorageKey.String
+		if key == "" {
+			key = s.storage.KeyFromURL(f.URL)
+		}
+		out[f.Fid] = media.FileKeys(key, f.FileType, f.ThumbnailURL.Valid)
+	}
+	return out, nil
+}
+
+// revokePostFiles makes the files of a hidden post private, files stored before files
+// were private could still be read with their public url.
+func (s *service) revokePostFiles(ctx context.Context, postIDs ...uint64) {
+	if len(postIDs) == 0 {
+		return
+	}
+	files, err := s.postFileKeys(ctx, postIDs)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch post files to revoke", zap.Uint64s("postIds", postIDs), zap.Error(err))
+		return
+	}
+	var keys []string
+	for _, fileKeys := range files {
+		keys = append(keys, fileKeys...)
+	}
+	if err := s.storage.MakePrivate(ctx, keys...); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to revoke post files", zap.Uint64s("postIds", postIDs), zap.Error(err))
+	}
+}
+
+// deletePostFiles deletes the stored files of deleted posts that no other post uses, a post to
+// several hives shares its files. The file rows are kept with the deleted posts.
+func (s *service) deletePostFiles(ctx context.Context, postIDs ...uint64) {
+	if len(postIDs) == 0 {
+		return
+	}
+	files, err := s.postFileKeys(ctx, postIDs)
+	if err != nil || len(files) == 0 {
+		if err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to fetch post files to delete", zap.Uint64s("postIds", postIDs), zap.Error(err))
+		}
+		return
+	}
+	fileIDs := make([]uint64, 0, len(files))
+	for fid := range files {
+		fileIDs = append(fileIDs, fid)
+	}
+	inUse, err := dbmodels.PostFiles(
+		qm.InnerJoin(fmt.Sprintf("%s p on p.%s = %s.%s", dbmodels.TableNames.Post, dbmodels.PostColumns.PostID,
+			dbmodels.TableNames.PostFiles, dbmodels.PostFileColumns.PostID)),
+		qm.Where(fmt.Sprintf("p.%s is null", dbmodels.PostColumns.DeletedAt)),
+		dbmodels.PostFileWhere.Fid.IN(fileIDs),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch post files in use", zap.Uint64s("postIds", postIDs), zap.Error(err))
+		return
+	}
+	for _, pf := range inUse {
+		delete(files, pf.Fid)
+	}
+	var keys []string
+	for _, fileKeys := range files {
+		keys = append(keys, fileKeys...)
+	}
+	if err := s.storage.Store.Delete(ctx, keys...); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to delete post files", zap.Uint64s("postIds", postIDs), zap.Error(err))
+	}
+}
@@ -0,0 +1,244 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// mentionPattern matches @screenName at the start of the text or after a character that can't
+// be part of a screen name, so email addresses are not taken for mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^[:alnum:]@])@([[:alnum:]]+)`)
+
+const (
+	// screen names are 8 to 15 letters and digits
+	minMentionLength = 8
+	maxMentionLength = 15
+	// maxMentions caps the members notified by a single post or comment
+	maxMentions = 20
+)
+
+// parseMentions returns the distinct lowercase screen names mentioned in the markdown, in order
+func parseMentions(markdown string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range mentionPattern.FindAllStringSubmatch(markdown, -1) {
+		name := strings.ToLower(m[1])
+		if len(name) < minMentionLength || len(name) > maxMentionLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == maxMentions {
+			break
+		}
+	}
+	return names
+}
+
+// resolveMentions looks up the mentioned members, leaving out the author, blocked users and
+// anyone who is not a member of the hive. They are returned in the order of the markdown.
+func (s *service) resolveMentions(ctx context.Context, hiveID uint64, authorID, markdown string) dbmodels.UserSlice {
+	names := parseMentions(markdown)
+	if len(names) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = name
+	}
+	found, err := dbmodels.Users(
+		qm.Select(dbmodels.UserTableColumns.ImpartWealthID, dbmodels.UserTableColumns.ScreenName),
+		qm.InnerJoin("hive_members hm on hm.member_impart_wealth_id = `user`.`impart_wealth_id`"),
+		qm.Where("hm.member_hive_id = ?", hiveID),
+		qm.WhereIn(dbmodels.UserTableColumns.ScreenName+" in ?", args...),
+		dbmodels.UserWhere.Blocked.EQ(false),
+		dbmodels.UserWhere.ImpartWealthID.NEQ(authorID),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to resolve mentions", zap.Strings("screenNames", names), zap.Error(err))
+		return nil
+	}
+	byName := make(map[string]*dbmodels.User, len(found))
+	for _, u := range found {
+		byName[strings.ToLower(u.ScreenName)] = u
+	}
+	users := make(dbmodels.UserSlice, 0, len(found))
+	for _, name := range names {
+		if u, ok := byName[name]; ok {
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+// saveMentions replaces the stored mentions of the post, or of the comment when commentID is set.
+// Members who stay mentioned keep their mention, so they are not notified again.
+func (s *service) saveMentions(ctx context.Context, postID, commentID uint64, users dbmodels.UserSlice) error {
+	existing, err := dbmodels.Mentions(contentWhere(dbmodels.MentionColumns.PostID, dbmodels.MentionColumns.CommentID, postID, commentID)...).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]bool, len(users))
+	for _, u := range users {
+		wanted[u.ImpartWealthID] = true
+	}
+	stored := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		if !wanted[m.ImpartWealthID] {
+			if _, err := m.Delete(ctx, s.db); err != nil {
+				return err
+			}
+			continue
+		}
+		stored[m.ImpartWealthID] = true
+	}
+	for _, u := range users {
+		if stored[u.ImpartWealthID] {
+			continue
+		}
+		m := &dbmodels.Mention{
+			PostID:         postID,
+			ImpartWealthID: u.ImpartWealthID,
+			CreatedAt:      impart.CurrentUTC(),
+		}
+		if commentID > 0 {
+			m.CommentID = null.Uint64From(commentID)
+		}
+		if err := m.Insert(ctx, s.db, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateMentions stores the mentions in the markdown and notifies the newly mentioned members.
+// Mentions in content held for review are notified when it is approved.
+func (s *service) updateMentions(ctx context.Context, hiveID, postID, commentID uint64, authorID, markdown string, notify bool) models.Mentions {
+	users := s.resolveMentions(ctx, hiveID, authorID, markdown)
+	if err := s.saveMentions(ctx, postID, commentID, users); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to save mentions", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+	}
+	if notify {
+		s.notifyMentions(ctx, hiveID, postID, commentID, authorID)
+	}
+	return models.MentionsFromDBModel(users)
+}
+
+// notifyMentions notifies the members mentioned in the content who were not notified yet. They are
+// marked notified in the request, the notifications are sent in the background.
+func (s *service) notifyMentions(ctx context.Context, hiveID, postID, commentID uint64, authorID string) {
+	mods := append(contentWhere(dbmodels.MentionColumns.PostID, dbmodels.MentionColumns.CommentID, postID, commentID),
+		dbmodels.MentionWhere.NotifiedAt.IsNull())
+	pending, err := dbmodels.Mentions(mods...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch mentions to notify", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+	if _, err := pending.UpdateAll(ctx, s.db, dbmodels.M{dbmodels.MentionColumns.NotifiedAt: null.TimeFrom(impart.CurrentUTC())}); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to mark mentions notified", zap.Uint64("postId", postID), zap.Uint64("commentId", commentID), zap.Error(err))
+		return
+	}
+
+	ids := make([]string, len(pending))
+	for i, m := range pending {
+		ids[i] = m.ImpartWealthID
+	}
+	go s.sendMentions(impart.CtxLogger(ctx, s.logger), hiveID, postID, commentID, authorID, ids)
+}
+
+// sendMentions notifies the mentioned members after the request is served, so it doesn't use its context
+func (s *service) sendMentions(logger *zap.Logger, hiveID, postID, commentID uint64, authorID string, impartWealthIDs []string) {
+	kind := "post"
+	if commentID > 0 {
+		kind = "comment"
+	}
+	body := fmt.Sprintf("You were mentioned in a %s.", kind)
+	if author, err := dbmodels.FindUser(context.Background(), s.db, authorID, dbmodels.UserColumns.ScreenName); err == nil {
+		body = fmt.Sprintf("%s mentioned you in a %s.", author.ScreenName, kind)
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		HiveID:        hiveID,
+		PostID:        postID,
+		CommentID:     commentID,
+		Category:      impart.MentionNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String("You were mentioned"),
+		Body:  aws.String(body),
+	}
+	s.notifyMembers(logger, data, alert, impartWealthIDs)
+}
+
+// loadMentions returns the mentioned members of the selected rows, keyed by post id or comment id
+func (s *service) loadMentions(ctx context.Context, byComment bool, mods ...qm.QueryMod) map[uint64]models.Mentions {
+	mods = append(mods,
+		qm.Load(dbmodels.MentionRels.ImpartWealth),
+		qm.OrderBy(dbmodels.MentionColumns.MentionID),
+	)
+	mentions, err := dbmodels.Mentions(mods...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to load mentions", zap.Error(err))
+		return nil
+	}
+	out := make(map[uint64]models.Mentions)
+	for _, m := range mentions {
+		u := m.R.ImpartWealth
+		if u == nil || u.Blocked {
+			continue
+		}
+		key := m.PostID
+		if byComment {
+			key = m.CommentID.Uint64
+		}
+		out[key] = append(out[key], models.MentionFromDBModel(u))
+	}
+	return out
+}
+
+func (s *service) attachPostMentions(ctx context.Context, posts models.Posts) {
+	if len(posts) == 0 {
+		return
+	}
+	ids := make([]interface{}, len(posts))
+	for i, p := range posts {
+		ids[i] = p.PostID
+	}
+	mentions := s.loadMentions(ctx, false,
+		qm.WhereIn(dbmodels.MentionColumns.PostID+" in ?", ids...),
+		dbmodels.MentionWhere.CommentID.IsNull(),
+	)
+	for i := range posts {
+		posts[i].Mentions = mentions[posts[i].PostID]
+	}
+}
+
+func (s *service) attachCommentMentions(ctx context.Context, comments models.Comments) {
+	if len(comments) == 0 {
+		return
+	}
+	ids := make([]interface{}, len(comments))
+	for i, c := range comments {
+		ids[i] = c.CommentID
+	}
+	mentions := s.loadMentions(ctx, true,
+		qm.WhereIn(dbmodels.MentionColumns.CommentID+" in ?", ids...),
+	)
+	for i := range comments {
+		comments[i].Mentions = mentions[comments[i].CommentID]
+	}
+}
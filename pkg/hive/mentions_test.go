@@ -0,0 +1,24 @@
+package hive
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	names := parseMentions("@FirstUser thanks, and @seconduser too. cc @FirstUser, mail me at someone@example1234.com")
+	assert.Equal(t, []string{"firstuser", "seconduser"}, names)
+
+	// too short, too long and not alphanumeric
+	assert.Empty(t, parseMentions("@short @waytoolongscreenname1 @@doubleatsign"))
+	assert.Equal(t, []string{"underscor"}, parseMentions("hey @underscor_e"))
+
+	var many []string
+	for i := 0; i < maxMentions+5; i++ {
+		many = append(many, fmt.Sprintf("@member%04d", i))
+	}
+	assert.Len(t, parseMentions(strings.Join(many, " ")), maxMentions)
+}
@@ -0,0 +1,173 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/impartwealthapp/backend/pkg/moderation"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// moderatedContent is the visibility of a post or comment before and after a report or review
+type moderatedContent struct {
+	HiveID         uint64
+	PostID         uint64
+	CommentID      uint64
+	ImpartWealthID string
+	Hidden         bool
+	Held           bool
+}
+
+func (c moderatedContent) kind() string {
+	if c.CommentID > 0 {
+		return "comment"
+	}
+	return "post"
+}
+
+// postVisibility reads whether the post is hidden by reports, held content is not counted
+// as it is already waiting for review.
+func (s *service) postVisibility(ctx context.Context, postID uint64) (moderatedContent, bool) {
+	p, err := dbmodels.FindPost(ctx, s.db, postID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to read post visibility", zap.Uint64("postId", postID), zap.Error(err))
+		return moderatedContent{}, false
+	}
+	return moderatedContent{
+		HiveID:         p.HiveID,
+		PostID:         p.PostID,
+		ImpartWealthID: p.ImpartWealthID,
+		Hidden:         p.Obfuscated && !p.Held,
+		Held:           p.Held,
+	}, true
+}
+
+func (s *service) commentVisibility(ctx context.Context, commentID uint64) (moderatedContent, bool) {
+	c, err := dbmodels.Comments(
+		dbmodels.CommentWhere.CommentID.EQ(commentID),
+		qm.Load(dbmodels.CommentRels.Post),
+	).One(ctx, s.db)
+	if err != nil || c.R == nil || c.R.Post == nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to read comment visibility", zap.Uint64("commentId", commentID), zap.Error(err))
+		return moderatedContent{}, false
+	}
+	return moderatedContent{
+		HiveID:         c.R.Post.HiveID,
+		PostID:         c.PostID,
+		CommentID:      c.CommentID,
+		ImpartWealthID: c.ImpartWealthID,
+		Hidden:         c.Obfuscated && !c.Held,
+		Held:           c.Held,
+	}, true
+}
+
+// reported notifies the author and escalates to the hive admins when the report hid the content
+func (s *service) reported(ctx context.Context, before, after moderatedContent) {
+	if before.Hidden || !after.Hidden {
+		return
+	}
+	if after.CommentID == 0 {
+		s.revokePostFiles(ctx, after.PostID)
+	}
+	s.notifyAuthorVisibility(ctx, after, false)
+	s.escalate(ctx, after)
+}
+
+// reviewed notifies the author when the review hid or restored the content, and the mentioned
+// members once held content is approved
+func (s *service) reviewed(ctx context.Context, before, after moderatedContent) {
+	if before.Held && !after.Held && !after.Hidden {
+		s.notifyMentions(ctx, after.HiveID, after.PostID, after.CommentID, after.ImpartWealthID)
+	}
+	if before.Hidden == after.Hidden {
+		return
+	}
+	if after.Hidden && after.CommentID == 0 {
+		s.revokePostFiles(ctx, after.PostID)
+	}
+	s.notifyAuthorVisibility(ctx, after, true)
+}
+
+// notifyAuthorVisibility tells the author their content was hidden, removed or restored, in the background
+func (s *service) notifyAuthorVisibility(ctx context.Context, c moderatedContent, reviewed bool) {
+	title := fmt.Sprintf("Your %s is visible again", c.kind())
+	body := fmt.Sprintf("An admin reviewed your %s and restored it.", c.kind())
+	if c.Hidden && reviewed {
+		title = fmt.Sprintf("Your %s was removed", c.kind())
+		body = fmt.Sprintf("An admin reviewed your %s and removed it, you can appeal the decision.", c.kind())
+	} else if c.Hidden {
+		title = fmt.Sprintf("Your %s was hidden", c.kind())
+		body = fmt.Sprintf("Your %s was reported by members of your Hive and is hidden until an admin reviews it.", c.kind())
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		HiveID:        c.HiveID,
+		PostID:        c.PostID,
+		CommentID:     c.CommentID,
+		Category:      impart.ModerationNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String(title),
+		Body:  aws.String(body),
+	}
+	go s.notifyMembers(impart.CtxLogger(ctx, s.logger), data, alert, []string{c.ImpartWealthID})
+}
+
+// escalate notifies the admins of the hive, or the super admins when the hive has none. The admins
+// are loaded in the request, the notifications are sent in the background.
+func (s *service) escalate(ctx context.Context, c moderatedContent) {
+	admins, err := dbmodels.Users(
+		qm.InnerJoin("hive_admins ha on ha.admin_impart_wealth_id = `user`.`impart_wealth_id`"),
+		qm.Where("ha.admin_hive_id = ?", c.HiveID),
+	).All(ctx, s.db)
+	if err == nil && len(admins) == 0 {
+		admins, err = dbmodels.Users(dbmodels.UserWhere.SuperAdmin.EQ(true)).All(ctx, s.db)
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch admins to escalate reported content", zap.Uint64("hiveId", c.HiveID), zap.Error(err))
+		return
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		HiveID:        c.HiveID,
+		PostID:        c.PostID,
+		CommentID:     c.CommentID,
+		Category:      impart.ModerationNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String("Reported content needs review"),
+		Body:  aws.String(fmt.Sprintf("A %s was hidden after reports from members of the Hive.", c.kind())),
+	}
+	ids := make([]string, len(admins))
+	for i, admin := range admins {
+		ids[i] = admin.ImpartWealthID
+	}
+	go s.notifyMembers(impart.CtxLogger(ctx, s.logger), data, alert, ids)
+}
+
+// checkStanding rejects the activity when a sanction of the member forbids it. A suspension
+// stops new posts and comments, read only also stops edits, votes, reports and deletes.
+func (s *service) checkStanding(ctx context.Context, ctxUser *dbmodels.User, posting bool) impart.Error {
+	standing, err := moderation.Standing(ctx, s.db, ctxUser.ImpartWealthID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch member standing", zap.String("impartWealthId", ctxUser.ImpartWealthID), zap.Error(err))
+		return impart.NewError(impart.ErrUnknown, "unable to check your account standing")
+	}
+	if standing.ReadOnly {
+		msg := "your account is read only"
+		if standing.ReadOnlyUntil != nil {
+			msg = fmt.Sprintf("%s until %s", msg, standing.ReadOnlyUntil.Format(time.RFC3339))
+		}
+		return impart.NewError(impart.ErrUnauthorized, msg, impart.Sanction)
+	}
+	if posting && standing.Suspended {
+		return impart.NewError(impart.ErrUnauthorized,
+			fmt.Sprintf("your account is suspended from posting until %s", standing.SuspendedUntil.Format(time.RFC3339)), impart.Sanction)
+	}
+	return nil
+}
@@ -18,7 +18,7 @@ func (s *service) HiveProfilePercentiles(ctx context.Context, hiveID uint64) (ta
 
 	dbProfile, err := s.profileData.GetProfile(ctx, ctxUser.ImpartWealthID)
 	if err != nil {
-		s.logger.Error("error getting db profile", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error getting db profile", zap.Error(err))
 		return tags.TagComparisons{}, impart.NewError(impart.ErrUnknown, "unable to get profile")
 	}
 	dbHive, err := s.hiveData.GetHive(ctx, hiveID)
@@ -28,13 +28,13 @@ func (s *service) HiveProfilePercentiles(ctx context.Context, hiveID uint64) (ta
 
 	hive, err := models.HiveFromDB(dbHive)
 	if err != nil {
-		s.logger.Error("error converting db hive", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error converting db hive", zap.Error(err))
 		return tags.TagComparisons{}, impart.NewError(impart.ErrUnknown, "unable to build hive")
 	}
 
 	profile, err := models.ProfileFromDBModel(ctxUser, dbProfile)
 	if err != nil {
-		s.logger.Error("error getting db profile", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error getting db profile", zap.Error(err))
 		return tags.TagComparisons{}, impart.NewError(impart.ErrUnknown, "unable to build profile")
 	}
 
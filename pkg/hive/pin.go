@@ -25,17 +25,17 @@ func (s *service) PinPost(ctx context.Context, hiveID, postID uint64, pin bool,
 		if err == impart.ErrNoOp {
 			return nil
 		}
-		s.logger.Error("error pining post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error pining post", zap.Error(err))
 		return impart.NewError(impart.ErrUnknown, "unable to pin post")
 	}
 	dbHive, err := s.hiveData.GetHive(ctx, hiveID)
 	if err != nil {
-		s.logger.Error("error pining post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error pining post", zap.Error(err))
 		return impart.NewError(impart.ErrUnknown, "unable to pin post")
 	}
 	dbPost, err := s.postData.GetPost(ctx, postID)
 	if err != nil {
-		s.logger.Error("error pining post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error pining post", zap.Error(err))
 		return impart.NewError(impart.ErrUnknown, "unable to pin post")
 	}
 	if pin && dbHive.PinnedPostID.Uint64 == dbPost.PostID {
@@ -46,10 +46,11 @@ func (s *service) PinPost(ctx context.Context, hiveID, postID uint64, pin bool,
 		additionalData := impart.NotificationData{
 			EventDatetime: impart.CurrentUTC(),
 			PostID:        dbPost.PostID,
+			Category:      impart.AdminAnnouncementNotification,
 		}
 		err = s.notificationService.NotifyTopic(ctx, additionalData, pushNotification, dbHive.NotificationTopicArn.String)
 		if err != nil {
-			s.logger.Error("error sending notification to topic", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("error sending notification to topic", zap.Error(err))
 		}
 
 	}
@@ -68,7 +69,7 @@ func (s *service) PinPostForBulkPostAction(ctx context.Context, postHive map[uin
 		if err == impart.ErrNoOp {
 			return nil
 		}
-		s.logger.Error("error pining post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error pining post", zap.Error(err))
 		return impart.NewError(impart.ErrUnknown, "unable to pin post")
 	}
 	if pin {
@@ -88,6 +89,7 @@ func (s *service) PinPostForBulkPostAction(ctx context.Context, postHive map[uin
 				additionalData := impart.NotificationData{
 					EventDatetime: impart.CurrentUTC(),
 					PostID:        post,
+					Category:      impart.AdminAnnouncementNotification,
 				}
 				hiveOut := dbmodels.Hive{}
 				for _, hiveSlice := range dbHive {
@@ -97,7 +99,7 @@ func (s *service) PinPostForBulkPostAction(ctx context.Context, postHive map[uin
 				}
 				err = s.notificationService.NotifyTopic(ctx, additionalData, pushNotification, hiveOut.NotificationTopicArn.String)
 				if err != nil {
-					s.logger.Error("error sending notification to topic", zap.Error(err))
+					impart.CtxLogger(ctx, s.logger).Error("error sending notification to topic", zap.Error(err))
 				}
 			}
 		}()
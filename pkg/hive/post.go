@@ -9,9 +9,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/impartwealthapp/backend/pkg/media"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
-	"github.com/otiai10/opengraph/v2"
+	"github.com/impartwealthapp/backend/pkg/linkpreview"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
@@ -19,6 +18,7 @@ import (
 	data "github.com/impartwealthapp/backend/pkg/data/hive"
 	"github.com/impartwealthapp/backend/pkg/data/types"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -29,6 +29,10 @@ const DefaultCommentLimit = 25
 func (s *service) NewPost(ctx context.Context, post models.Post) (models.Post, impart.Error) {
 	ctxUser := impart.GetCtxUser(ctx)
 
+	if impartErr := s.checkStanding(ctx, ctxUser, true); impartErr != nil {
+		return models.Post{}, impartErr
+	}
+
 	if len(strings.TrimSpace(post.Subject)) < 2 {
 		return models.Post{}, impart.NewError(impart.ErrBadRequest, "subject is less than 2 characters", impart.Subject)
 	}
@@ -54,8 +58,20 @@ func (s *service) NewPost(ctx context.Context, post models.Post) (models.Post, i
 			post.IsPinnedPost = false
 		}
 	}
+	held, impartErr := checkProfanity(isAdminActivity, post.Subject, post.Content.Markdown)
+	if impartErr != nil {
+		return models.Post{}, impartErr
+	}
+	uploads, impartErr := s.checkUploads(ctx, 0, post.FileIDs)
+	if impartErr != nil {
+		return models.Post{}, impartErr
+	}
 	post.ImpartWealthID = ctxUser.ImpartWealthID
 	dbPost := post.ToDBModel()
+	if held {
+		dbPost.Held = true
+		dbPost.Obfuscated = true
+	}
 	dbPost.CreatedAt = impart.CurrentUTC()
 	dbPost.LastCommentTS = impart.CurrentUTC()
 	tagsSlice := make(dbmodels.TagSlice, len(post.TagIDs), len(post.TagIDs))
@@ -65,20 +81,22 @@ func (s *service) NewPost(ctx context.Context, post models.Post) (models.Post, i
 	dbPost, err := s.postData.NewPost(ctx, dbPost, tagsSlice)
 
 	if err != nil {
-		s.logger.Error("unable to create a new post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("unable to create a new post", zap.Error(err))
 		return models.Post{}, impart.UnknownError
 	}
+	metrics.PostCreated(dbPost.HiveID)
 	if shouldPin {
 		// if err := s.hiveData.PinPost(ctx, dbPost.HiveID, dbPost.PostID, true); err != nil {
 		// 	s.logger.Error("couldn't pin post", zap.Error(err))
 		// }
 
 		if err := s.PinPost(ctx, dbPost.HiveID, dbPost.PostID, true, isAdminActivity); err != nil {
-			s.logger.Error("couldn't pin post", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("couldn't pin post", zap.Error(err))
 		}
 
 	}
 	p := models.PostFromDB(dbPost, ctxUser)
+	p.Mentions = s.updateMentions(ctx, dbPost.HiveID, dbPost.PostID, 0, ctxUser.ImpartWealthID, post.Content.Markdown, !held)
 	// add post files
 	if isAdminActivity {
 		post.Files = s.ValidatePostFilesName(ctx, ctxUser, post.Files)
@@ -89,14 +107,18 @@ func (s *service) NewPost(ctx context.Context, post models.Post) (models.Post, i
 		postvideo, _ := s.AddPostVideo(ctx, p.PostID, post.Video, isAdminActivity, nil)
 		p.Video = postvideo
 
-		// add post videos
-		postUrl, _ := s.AddPostUrl(ctx, p.PostID, post.Url, isAdminActivity, nil)
-		p.UrlData = postUrl
-
 		// update post files
 		p.Files = postFiles
 	}
+	if len(uploads) > 0 {
+		p.Files = append(p.Files, s.attachUploads(ctx, uploads, dbPost.PostID)...)
+	}
 
+	// add post urls
+	postUrl, _ := s.AddPostUrls(ctx, p.PostID, post.Url, post.Content.Markdown, nil)
+	p.UrlData = postUrl
+
+	s.signPostFile(ctx, &p)
 	return p, nil
 }
 
@@ -109,12 +131,23 @@ func (s *service) EditPost(ctx context.Context, inPost models.Post) (models.Post
 	var shouldPin bool
 	name := ""
 	if err != nil {
-		s.logger.Error("error fetching post trying to edit", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetching post trying to edit", zap.Error(err))
 		return models.Post{}, impart.NewError(impart.ErrUnauthorized, "error fetching post trying to edit")
 	}
 	if existingPost.ImpartWealthID != ctxUser.ImpartWealthID {
 		return models.Post{}, impart.NewError(impart.ErrUnauthorized, "unable to edit a post that's not yours", impart.ImpartWealthID)
 	}
+	if impartErr := s.checkStanding(ctx, ctxUser, false); impartErr != nil {
+		return models.Post{}, impartErr
+	}
+	held, impartErr := checkProfanity(ctxUser.Admin, inPost.Subject, inPost.Content.Markdown)
+	if impartErr != nil {
+		return models.Post{}, impartErr
+	}
+	uploads, impartErr := s.checkUploads(ctx, inPost.PostID, inPost.FileIDs)
+	if impartErr != nil {
+		return models.Post{}, impartErr
+	}
 	tagsSlice := make(dbmodels.TagSlice, len(inPost.TagIDs), len(inPost.TagIDs))
 	for i, t := range inPost.TagIDs {
 		tagsSlice[i] = &dbmodels.Tag{TagID: uint(t)}
@@ -122,7 +155,7 @@ func (s *service) EditPost(ctx context.Context, inPost models.Post) (models.Post
 	if ctxUser.Admin {
 		shouldPin = true
 		postVideo = inPost.Video.PostVideoToDBModel(inPost.PostID)
-		postUrl = inPost.UrlData.PostUrlToDBModel(inPost.PostID, inPost.Url)
+		postUrl = s.editPostUrl(ctx, inPost).PostUrlToDBModel(inPost.PostID)
 		if len(inPost.Files) > 0 {
 			name = inPost.Files[0].FileName
 			if inPost.Files[0].Content == "" && inPost.Files[0].URL != "" {
@@ -132,20 +165,33 @@ func (s *service) EditPost(ctx context.Context, inPost models.Post) (models.Post
 				postFiles = s.ValidatePostFilesName(ctx, ctxUser, inPost.Files)
 				postFiles, _ = s.AddPostFiles(ctx, postFiles)
 			}
+		} else if len(inPost.FileIDs) > 0 {
+			name = "noUpdate"
 		} else {
 			name = "nofile"
 		}
 	}
-	p, err := s.postData.EditPost(ctx, inPost.ToDBModel(), tagsSlice, shouldPin, postVideo, postUrl, postFiles, name)
+	dbPost := inPost.ToDBModel()
+	dbPost.Held = held
+	p, err := s.postData.EditPost(ctx, dbPost, tagsSlice, shouldPin, postVideo, postUrl, postFiles, name)
 	if err != nil {
 		return models.Post{}, impart.UnknownError
 	}
-	return models.PostFromDB(p, ctxUser), nil
+	if len(postFiles) > 0 {
+		s.processPostedFiles(ctx, p, postFiles)
+	}
+	out := models.PostFromDB(p, ctxUser)
+	out.Mentions = s.updateMentions(ctx, p.HiveID, p.PostID, 0, p.ImpartWealthID, inPost.Content.Markdown, !held)
+	if len(uploads) > 0 {
+		out.Files = append(out.Files, s.attachUploads(ctx, uploads, p.PostID)...)
+	}
+	s.signPostFile(ctx, &out)
+	return out, nil
 }
 
 func (s *service) GetPost(ctx context.Context, postID uint64, includeComments bool) (models.Post, impart.Error) {
 	defer func(start time.Time) {
-		s.logger.Debug("total post retrieve time", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
+		impart.CtxLogger(ctx, s.logger).Debug("total post retrieve time", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
 	}(time.Now())
 
 	var out models.Post
@@ -154,13 +200,13 @@ func (s *service) GetPost(ctx context.Context, postID uint64, includeComments bo
 	var dbPost *dbmodels.Post
 	eg.Go(func() error {
 		defer func(start time.Time) {
-			s.logger.Debug("single post retrieve time", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
+			impart.CtxLogger(ctx, s.logger).Debug("single post retrieve time", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
 		}(time.Now())
 
 		var err error
 		dbPost, err = s.postData.GetPost(ctx, postID)
 		if err != nil {
-			s.logger.Error("error getting post data", zap.Error(err),
+			impart.CtxLogger(ctx, s.logger).Error("error getting post data", zap.Error(err),
 				zap.Uint64("postID", postID))
 			return err
 		}
@@ -171,13 +217,13 @@ func (s *service) GetPost(ctx context.Context, postID uint64, includeComments bo
 	var nextCommentPage *models.NextPage
 	if includeComments {
 		//var nextPage *models.NextPage
-		s.logger.Debug("Received GetPost request and include comments = true",
+		impart.CtxLogger(ctx, s.logger).Debug("Received GetPost request and include comments = true",
 			zap.Uint64("postID", postID), zap.Bool("comment", includeComments))
 
 		eg.Go(func() error {
 			var err error
 			defer func(start time.Time) {
-				s.logger.Debug("retrieved comments for post", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
+				impart.CtxLogger(ctx, s.logger).Debug("retrieved comments for post", zap.Uint64("postId", postID), zap.Duration("elapsed", time.Since(start)))
 			}(time.Now())
 
 			comments, nextCommentPage, err = s.commentData.GetComments(ctx, postID, DefaultCommentLimit, 0)
@@ -192,6 +238,13 @@ func (s *service) GetPost(ctx context.Context, postID uint64, includeComments bo
 	out = models.PostFromDB(dbPost, ctxUser)
 	out.Comments = models.CommentsFromDBModelSlice(comments, ctxUser)
 	out.NextCommentPage = nextCommentPage
+	out.Mentions = s.loadMentions(ctx, false,
+		dbmodels.MentionWhere.PostID.EQ(postID),
+		dbmodels.MentionWhere.CommentID.IsNull(),
+	)[postID]
+	s.attachCommentMentions(ctx, out.Comments)
+	out.PostCommentTrack.Following = s.followedPosts(ctx, postID)[postID]
+	s.signPostFile(ctx, &out)
 
 	return out, nil
 }
@@ -210,7 +263,7 @@ func (s *service) GetPosts(ctx context.Context, gpi data.GetPostsInput) (models.
 			return nil
 		}
 		if postsError != nil {
-			s.logger.Error("unable to fetch posts", zap.Error(postsError))
+			impart.CtxLogger(ctx, s.logger).Error("unable to fetch posts", zap.Error(postsError))
 		}
 		return postsError
 	})
@@ -223,13 +276,13 @@ func (s *service) GetPosts(ctx context.Context, gpi data.GetPostsInput) (models.
 		var pinnedError error
 		hive, pinnedError := s.hiveData.GetHive(ctx, gpi.HiveID)
 		if pinnedError != nil {
-			s.logger.Error("unable to fetch hive", zap.Error(pinnedError))
+			impart.CtxLogger(ctx, s.logger).Error("unable to fetch hive", zap.Error(pinnedError))
 			return pinnedError
 		}
 		if hive.PinnedPostID.Valid && hive.PinnedPostID.Uint64 > 0 {
 			pinnedPost, pinnedError = s.postData.GetPost(ctx, hive.PinnedPostID.Uint64)
 			if pinnedError != nil {
-				s.logger.Error("unable to get pinned post", zap.Error(pinnedError))
+				impart.CtxLogger(ctx, s.logger).Error("unable to get pinned post", zap.Error(pinnedError))
 			}
 			if pinnedPost != nil && len(gpi.TagIDs) > 0 {
 				var pinnedPostExist bool
@@ -250,7 +303,7 @@ func (s *service) GetPosts(ctx context.Context, gpi data.GetPostsInput) (models.
 
 	err := eg.Wait()
 	if err != nil {
-		s.logger.Error("error fetching data", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetching data", zap.Error(err))
 		return empty, nextPage, impart.NewError(err, "error getting posts")
 	}
 	if dbPosts == nil {
@@ -275,8 +328,11 @@ func (s *service) GetPosts(ctx context.Context, gpi data.GetPostsInput) (models.
 	out := models.PostsFromDB(dbPosts, ctxUser)
 	out, err = s.postData.GetReportedUser(ctx, out)
 	if err != nil {
-		s.logger.Error("error fetching data", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetching data", zap.Error(err))
 	}
+	s.attachPostMentions(ctx, out)
+	s.attachPostFollows(ctx, out)
+	s.signPostFiles(ctx, out)
 	return out, nextPage, nil
 }
 
@@ -284,7 +340,7 @@ func (s *service) DeletePost(ctx context.Context, postID uint64) impart.Error {
 	ctxUser := impart.GetCtxUser(ctx)
 	existingPost, err := s.postData.GetPost(ctx, postID)
 	if err != nil {
-		s.logger.Error("error fetching post trying to edit", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("error fetching post trying to edit", zap.Error(err))
 		return impart.NewError(impart.ErrBadRequest, "unable to find the post")
 	}
 	clientId := impart.GetCtxClientID(ctx)
@@ -295,11 +351,15 @@ func (s *service) DeletePost(ctx context.Context, postID uint64) impart.Error {
 	} else if !ctxUser.Admin && existingPost.ImpartWealthID != ctxUser.ImpartWealthID {
 		return impart.NewError(impart.ErrUnauthorized, "unable to edit a post that's not yours")
 	}
+	if impartErr := s.checkStanding(ctx, ctxUser, false); impartErr != nil {
+		return impartErr
+	}
 
 	err = s.postData.DeletePost(ctx, postID)
 	if err != nil {
 		return impart.UnknownError
 	}
+	s.deletePostFiles(ctx, postID)
 
 	return nil
 }
@@ -311,12 +371,16 @@ func (s *service) ReportPost(ctx context.Context, postId uint64, reason string,
 	if !remove && reason == "" {
 		return empty, impart.NewError(impart.ErrBadRequest, "must provide a reason for reporting")
 	}
+	if impartErr := s.checkStanding(ctx, impart.GetCtxUser(ctx), false); impartErr != nil {
+		return empty, impartErr
+	}
 	if reason != "" {
 		dbReason = &reason
 	}
+	before, _ := s.postVisibility(ctx, postId)
 	err := s.reactionData.ReportPost(ctx, postId, dbReason, remove)
 	if err != nil {
-		s.logger.Error("couldn't report post", zap.Error(err), zap.Uint64("postId", postId))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't report post", zap.Error(err), zap.Uint64("postId", postId))
 		switch err {
 		case impart.ErrNoOp:
 			return empty, impart.NewError(impart.ErrNoOp, "You have already reported this Post")
@@ -328,12 +392,18 @@ func (s *service) ReportPost(ctx context.Context, postId uint64, reason string,
 			return empty, impart.UnknownError
 		}
 	}
+	if after, ok := s.postVisibility(ctx, postId); ok {
+		if !remove {
+			metrics.Reported(after.HiveID, after.kind())
+		}
+		s.reported(ctx, before, after)
+	}
 	out, err := s.reactionData.GetUserTrack(ctx, data.ContentInput{
 		Type: data.Post,
 		Id:   postId,
 	})
 	if err != nil {
-		s.logger.Error("couldn't get updated user track object", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't get updated user track object", zap.Error(err))
 		return empty, impart.UnknownError
 	}
 	return out, nil
@@ -346,12 +416,13 @@ func (s *service) ReviewPost(ctx context.Context, postId uint64, comment string,
 	if comment != "" {
 		dbReason = &comment
 	}
+	before, _ := s.postVisibility(ctx, postId)
 	err := s.reactionData.ReviewPost(ctx, postId, dbReason, remove)
 	if err != nil {
-		s.logger.Error("couldn't review post", zap.Error(err), zap.Uint64("postId", postId))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't review post", zap.Error(err), zap.Uint64("postId", postId))
 		switch err {
 		case impart.ErrNoOp:
-			return empty, impart.NewError(impart.ErrNoOp, "Post is not reported.")
+			return empty, impart.NewError(impart.ErrNoOp, "Post is already in the input review state.")
 		case impart.ErrNotFound:
 			return empty, impart.NewError(err, fmt.Sprintf("could not find post %v to review", postId))
 		case impart.ErrBadRequest:
@@ -360,13 +431,19 @@ func (s *service) ReviewPost(ctx context.Context, postId uint64, comment string,
 			return empty, impart.UnknownError
 		}
 	}
+	if after, ok := s.postVisibility(ctx, postId); ok {
+		s.reviewed(ctx, before, after)
+		s.recordReview(ctx, after, remove, comment)
+	}
 	dbPost, err := s.postData.GetPost(ctx, postId)
 	if err != nil {
-		s.logger.Error("couldn't get post information", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("couldn't get post information", zap.Error(err))
 		return empty, impart.UnknownError
 	}
 	ctxUser := impart.GetCtxUser(ctx)
-	return models.PostFromDB(dbPost, ctxUser), nil
+	out := models.PostFromDB(dbPost, ctxUser)
+	s.signPostFile(ctx, &out)
+	return out, nil
 }
 
 //  SendPostNotification
@@ -388,6 +465,7 @@ func (s *service) SendPostNotification(input models.PostNotificationInput) impar
 		EventDatetime: impart.CurrentUTC(),
 		PostID:        input.PostID,
 		CommentID:     input.CommentID,
+		Category:      notificationCategory(input.ActionType),
 	}
 
 	// generate notification context
@@ -401,7 +479,7 @@ func (s *service) SendPostNotification(input models.PostNotificationInput) impar
 		return nil
 	}
 
-	s.logger.Debug("push-notification : sending post notification",
+	impart.CtxLogger(input.Ctx, s.logger).Debug("push-notification : sending post notification",
 		zap.Any("data", models.PostNotificationInput{
 			CommentID:  input.CommentID,
 			PostID:     input.PostID,
@@ -419,7 +497,7 @@ func (s *service) SendPostNotification(input models.PostNotificationInput) impar
 		if dbPost.R.ImpartWealth != nil && strings.TrimSpace(dbPost.R.ImpartWealth.ImpartWealthID) != "" {
 			err = s.sendNotification(notificationData, out.Alert, dbPost.R.ImpartWealth.ImpartWealthID)
 			if err != nil {
-				s.logger.Error("push-notification : error attempting to send post notification ", zap.Any("postData", out), zap.Error(err))
+				impart.CtxLogger(input.Ctx, s.logger).Error("push-notification : error attempting to send post notification ", zap.Any("postData", out), zap.Error(err))
 			}
 		}
 	}()
@@ -428,6 +506,19 @@ func (s *service) SendPostNotification(input models.PostNotificationInput) impar
 	return nil
 }
 
+// notificationCategory maps a notification action to the preference category it is filtered by
+func notificationCategory(actionType types.Type) impart.NotificationCategory {
+	switch actionType {
+	case types.UpVote:
+		return impart.VoteNotification
+	case types.NewComment:
+		return impart.CommentReplyNotification
+	case types.NewPostComment:
+		return impart.PostCommentNotification
+	}
+	return ""
+}
+
 //
 // From here , all the notification action workflow
 //
@@ -490,14 +581,14 @@ func (s *service) AddPostVideo(ctx context.Context, postID uint64, postVideo mod
 
 			tx, err := s.db.BeginTx(ctx, nil)
 			if err != nil {
-				s.logger.Error("error attempting to creating bulk post_videos  data tag ", zap.Any("post", query), zap.Error(err))
+				impart.CtxLogger(ctx, s.logger).Error("error attempting to creating bulk post_videos  data tag ", zap.Any("post", query), zap.Error(err))
 				return models.PostVideo{}, nil
 			}
 			defer impart.CommitRollbackLogger(tx, err, s.logger)
 
 			_, err = queries.Raw(query).ExecContext(ctx, s.db)
 			if err != nil {
-				s.logger.Error("error attempting to creating bulk post_videos  data tag ", zap.Any("post", query), zap.Error(err))
+				impart.CtxLogger(ctx, s.logger).Error("error attempting to creating bulk post_videos  data tag ", zap.Any("post", query), zap.Error(err))
 				return models.PostVideo{}, nil
 			}
 		} else {
@@ -509,7 +600,7 @@ func (s *service) AddPostVideo(ctx context.Context, postID uint64, postVideo mod
 			}
 			input, err := s.postData.NewPostVideo(ctx, input)
 			if err != nil {
-				s.logger.Error("error attempting to Save post video data ", zap.Any("postVideo", input), zap.Error(err))
+				impart.CtxLogger(ctx, s.logger).Error("error attempting to Save post video data ", zap.Any("postVideo", input), zap.Error(err))
 				return models.PostVideo{}, nil
 			}
 			postVideo = models.PostVideoFromDB(input)
@@ -519,83 +610,120 @@ func (s *service) AddPostVideo(ctx context.Context, postID uint64, postVideo mod
 	return models.PostVideo{}, nil
 }
 
-func (s *service) AddPostUrl(ctx context.Context, postID uint64, postUrl string, isAdminActivity bool, postHive map[uint64]uint64) (models.PostUrl, impart.Error) {
-	var imageUrl string
-	if isAdminActivity && (postUrl != "") {
-		match, _ := regexp.MatchString(`^(?:f|ht)tps?://`, postUrl)
-		if !match {
-			postUrl = "http://" + postUrl
-		}
-		ogp, err := opengraph.Fetch(postUrl)
+// maxPostUrls caps how many links of a post get a preview
+const maxPostUrls = 3
 
-		if err != nil {
-			s.logger.Error("error attempting to fetch URL Data", zap.Any("postURL", postUrl), zap.Error(err))
-			//return models.PostUrl{}, nil
+// AddPostUrls saves the preview of the posted url and of the links found in the content, for the
+// post or for every post of a bulk post. The posted url is kept even when no preview could be
+// fetched, links from the content only when they have one. The first saved url is returned.
+func (s *service) AddPostUrls(ctx context.Context, postID uint64, postUrl, content string, postHive map[uint64]uint64) (models.PostUrl, impart.Error) {
+	var links []string
+	seen := make(map[string]bool)
+	for _, link := range append([]string{postUrl}, linkpreview.FindURLs(content)...) {
+		normalized, err := linkpreview.Normalize(link)
+		if err != nil || seen[normalized] {
+			continue
 		}
-		if ogp != nil && ogp.Image != nil && len(ogp.Image) > 0 {
-			imageUrl = ogp.Image[0].URL
-		} else {
-			imageUrl = ""
+		seen[normalized] = true
+		links = append(links, normalized)
+		if len(links) == maxPostUrls {
+			break
 		}
-		//fmt.Println("the data", imageUrl)
-		if len(postHive) > 0 {
-			query := "insert into post_urls(title,url,imageUrl,description,post_id) values"
-			for _, post := range postHive {
-				qry := fmt.Sprintf("('%s','%s','%s','%s',%d),", ogp.Title, postUrl, imageUrl, ogp.Description, post)
-				query = fmt.Sprintf("%s %s", query, qry)
-			}
-			query = strings.Trim(query, ",")
-			query = fmt.Sprintf("%s ;", query)
+	}
+	_, err := linkpreview.Normalize(postUrl)
+	explicit := err == nil
 
-			tx, err := s.db.BeginTx(ctx, nil)
-			if err != nil {
-				s.logger.Error("error attempting to Save post url data ", zap.Any("posturl", postUrl), zap.Error(err))
-				return models.PostUrl{}, nil
-			}
-			defer impart.CommitRollbackLogger(tx, err, s.logger)
+	// fetched concurrently, each fetch is bounded by the link preview timeout
+	fetched := make([]models.PostUrl, len(links))
+	errs := make([]error, len(links))
+	var wg sync.WaitGroup
+	for i, link := range links {
+		wg.Add(1)
+		go func(i int, link string) {
+			defer wg.Done()
+			fetched[i], errs[i] = s.linkPreview.Preview(ctx, link)
+		}(i, link)
+	}
+	wg.Wait()
 
-			_, err = queries.Raw(query).ExecContext(ctx, s.db)
-			if err != nil {
-				s.logger.Error("error attempting to Save post video data ", zap.Any("postVideo", postUrl), zap.Error(err))
-				return models.PostUrl{}, nil
-			}
-			return models.PostUrl{}, nil
-		} else {
-			input := &dbmodels.PostURL{
-				Title:       ogp.Title,
-				ImageUrl:    imageUrl,
-				URL:         null.StringFrom(postUrl),
-				PostID:      postID,
-				Description: ogp.Description,
+	var previews []models.PostUrl
+	for i, link := range links {
+		if errs[i] != nil {
+			if i == 0 && explicit {
+				impart.CtxLogger(ctx, s.logger).Info("unable to fetch url preview", zap.String("postURL", link), zap.Error(errs[i]))
+				previews = append(previews, models.PostUrl{Url: link})
 			}
-			inputData, err := s.postData.NewPostUrl(ctx, input)
-			if err != nil {
-				s.logger.Error("error attempting to Save post video data ", zap.Any("postVideo", input), zap.Error(err))
-				return models.PostUrl{}, nil
+			continue
+		}
+		previews = append(previews, fetched[i])
+	}
+
+	postIDs := []uint64{postID}
+	if len(postHive) > 0 {
+		postIDs = postIDs[:0]
+		for _, id := range postHive {
+			postIDs = append(postIDs, id)
+		}
+	}
+	var values []string
+	var args []interface{}
+	for _, id := range postIDs {
+		for _, preview := range previews {
+			// post_urls.url is NVARCHAR(250)
+			if len([]rune(preview.Url)) > 250 {
+				continue
 			}
-			PostedUrl := models.PostUrlFromDB(inputData)
-			return PostedUrl, nil
+			values = append(values, "(?,?,?,?,?)")
+			args = append(args, preview.Title, preview.Url, preview.ImageUrl, preview.Description, id)
 		}
 	}
-	return models.PostUrl{}, nil
+	if len(values) == 0 {
+		return models.PostUrl{}, nil
+	}
+	query := fmt.Sprintf("insert into post_urls(title,url,imageUrl,description,post_id) values %s;", strings.Join(values, ","))
+	if _, err := queries.Raw(query, args...).ExecContext(ctx, s.db); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("error attempting to Save post url data ", zap.Any("postURLs", previews), zap.Error(err))
+		return models.PostUrl{}, impart.NewError(impart.ErrUnknown, "unable to save post urls")
+	}
+	return previews[0], nil
+}
+
+// editPostUrl previews the url of an edited post, an empty title removes the saved url
+func (s *service) editPostUrl(ctx context.Context, inPost models.Post) models.PostUrl {
+	if strings.TrimSpace(inPost.Url) == "" {
+		return models.PostUrl{}
+	}
+	preview, err := s.linkPreview.Preview(ctx, inPost.Url)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Info("unable to fetch url preview", zap.String("postURL", inPost.Url), zap.Error(err))
+		return models.PostUrl{Url: inPost.Url}
+	}
+	return preview
+}
+
+// LinkPreview returns the open graph details of a link for the post composer
+func (s *service) LinkPreview(ctx context.Context, rawURL string) (models.PostUrl, impart.Error) {
+	preview, err := s.linkPreview.Preview(ctx, rawURL)
+	switch err {
+	case nil:
+		return preview, nil
+	case linkpreview.ErrInvalidURL:
+		return models.PostUrl{}, impart.NewError(impart.ErrBadRequest, "invalid url", impart.URL)
+	case linkpreview.ErrBlockedAddress:
+		return models.PostUrl{}, impart.NewError(impart.ErrBadRequest, "url is not allowed", impart.URL)
+	default:
+		return models.PostUrl{}, impart.NewError(impart.ErrNotFound, "no preview available for the url", impart.URL)
+	}
 }
 
 // add post file
 func (s *service) AddPostFiles(ctx context.Context, postFiles []models.File) ([]models.File, impart.Error) {
 	var fileResponse []models.File
 	if len(postFiles) > 0 {
-		mediaObject := media.New(media.StorageConfigurations{
-			Storage:   s.MediaStorage.Storage,
-			MediaPath: s.MediaStorage.MediaPath,
-			S3Storage: media.S3Storage{
-				BucketName:   s.MediaStorage.BucketName,
-				BucketRegion: s.MediaStorage.BucketRegion,
-			},
-		})
 		// upload multiple files
-		file, err := mediaObject.UploadMultipleFile(postFiles)
+		file, err := s.storage.UploadMultipleFile(postFiles)
 		if err != nil {
-			s.logger.Error("error attempting to Save post file data ", zap.Any("files", file), zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("error attempting to Save post file data ", zap.Any("files", file), zap.Error(err))
 			return file, impart.NewError(err, fmt.Sprintf("error on post files storage %v", err))
 		}
 		return file, nil
@@ -627,16 +755,8 @@ func (s *service) ValidatePostFilesName(ctx context.Context, ctxUser *dbmodels.U
 
 // upload file
 func (s *service) UploadFile(files []models.File) error {
-	mediaObject := media.New(media.StorageConfigurations{
-		Storage:   s.MediaStorage.Storage,
-		MediaPath: s.MediaStorage.MediaPath,
-		S3Storage: media.S3Storage{
-			BucketName:   s.MediaStorage.BucketName,
-			BucketRegion: s.MediaStorage.BucketRegion,
-		},
-	})
 	// upload multiple files
-	_, err := mediaObject.UploadMultipleFile(files)
+	_, err := s.storage.UploadMultipleFile(files)
 	if err != nil {
 		s.logger.Error("error attempting to upload file data ", zap.Error(err))
 		return err
@@ -652,15 +772,21 @@ func (s *service) AddPostFilesDB(ctx context.Context, post *dbmodels.Post, file
 
 		if len(file) > 0 {
 			var postFielRelationMap []*dbmodels.PostFile
+			var fileIDs []uint64
+			defer func() { s.mediaProcessor.Enqueue(fileIDs...) }()
 			//upload the files to table
 			for index, f := range file {
 				fileModel := &dbmodels.File{
-					FileName: f.FileName,
-					FileType: f.FileType,
-					URL:      f.URL,
+					FileName:         f.FileName,
+					FileType:         f.FileType,
+					URL:              f.URL,
+					StorageKey:       null.StringFrom(postedFileKey(s.MediaStorage, f)),
+					ProcessingStatus: dbmodels.FilesProcessingStatusPending,
 				}
 				if err := fileModel.Insert(ctx, s.db, boil.Infer()); err != nil {
-					s.logger.Error("error attempting to Save files ", zap.Any("files", f), zap.Error(err))
+					impart.CtxLogger(ctx, s.logger).Error("error attempting to Save files ", zap.Any("files", f), zap.Error(err))
+				} else {
+					fileIDs = append(fileIDs, fileModel.Fid)
 				}
 
 				file[index].FID = int(fileModel.Fid)
@@ -695,13 +821,13 @@ func (s *service) AddPostFilesDB(ctx context.Context, post *dbmodels.Post, file
 
 				_, err = queries.Raw(query).ExecContext(ctx, s.db)
 				if err != nil {
-					s.logger.Error("error attempting to creating bulk post  data tag ", zap.Any("post", query), zap.Error(err))
+					impart.CtxLogger(ctx, s.logger).Error("error attempting to creating bulk post  data tag ", zap.Any("post", query), zap.Error(err))
 				}
 				tx.Commit()
 			} else {
 				err := post.AddPostFiles(ctx, s.db, true, postFielRelationMap...)
 				if err != nil {
-					s.logger.Error("error attempting to map post files ",
+					impart.CtxLogger(ctx, s.logger).Error("error attempting to map post files ",
 						zap.Any("data", postFielRelationMap),
 						zap.Any("err", err),
 						zap.Error(err),
@@ -742,6 +868,12 @@ func (s *service) EditBulkPostDetails(ctx context.Context, postUpdateInput model
 	err = s.postData.DeletePostFromList(ctx, updateUsers)
 	if err != nil {
 
+	} else {
+		deleted := make([]uint64, len(updateUsers))
+		for i, post := range updateUsers {
+			deleted[i] = post.PostID
+		}
+		s.deletePostFiles(ctx, deleted...)
 	}
 	lenPost := len(postOutputRslt.Posts)
 	for _, post := range updateUsers {
@@ -759,6 +891,10 @@ func (s *service) EditBulkPostDetails(ctx context.Context, postUpdateInput model
 func (s *service) NewPostForMultipleHives(ctx context.Context, post models.Post) impart.Error {
 	ctxUser := impart.GetCtxUser(ctx)
 
+	if impartErr := s.checkStanding(ctx, ctxUser, true); impartErr != nil {
+		return impartErr
+	}
+
 	if len(strings.TrimSpace(post.Subject)) < 2 {
 		return impart.NewError(impart.ErrBadRequest, "subject is less than 2 characters", impart.Subject)
 	}
@@ -787,6 +923,10 @@ func (s *service) NewPostForMultipleHives(ctx context.Context, post models.Post)
 			post.IsPinnedPost = false
 		}
 	}
+	uploads, impartErr := s.checkUploads(ctx, 0, post.FileIDs)
+	if impartErr != nil {
+		return impartErr
+	}
 	post.ImpartWealthID = ctxUser.ImpartWealthID
 	tagsSlice := make(dbmodels.TagSlice, len(post.TagIDs))
 	for i, t := range post.TagIDs {
@@ -794,12 +934,15 @@ func (s *service) NewPostForMultipleHives(ctx context.Context, post models.Post)
 	}
 	postDetails, err := s.postData.NewPostForMultipleHives(ctx, post, tagsSlice)
 	if err != nil {
-		s.logger.Error("unable to create a new post", zap.Error(err))
+		impart.CtxLogger(ctx, s.logger).Error("unable to create a new post", zap.Error(err))
 		return impart.NewError(impart.ErrBadRequest, "Unable to create post.")
 	}
+	for hiveID := range postDetails {
+		metrics.PostCreated(hiveID)
+	}
 	if shouldPin {
 		if err := s.PinPostForBulkPostAction(ctx, postDetails, true, isAdminActivity); err != nil {
-			s.logger.Error("couldn't pin post", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("couldn't pin post", zap.Error(err))
 		}
 	}
 
@@ -812,15 +955,22 @@ func (s *service) NewPostForMultipleHives(ctx context.Context, post models.Post)
 		// add post videos
 		_, err := s.AddPostVideo(ctx, 0, post.Video, isAdminActivity, postDetails)
 		if err != nil {
-			s.logger.Error("couldn't add post video ", zap.Error(err))
+			impart.CtxLogger(ctx, s.logger).Error("couldn't add post video ", zap.Error(err))
 		}
 
-		// add post url
-		_, err = s.AddPostUrl(ctx, 0, post.Url, isAdminActivity, postDetails)
-		if err != nil {
-			s.logger.Error("couldn't add post url ", zap.Error(err))
+	}
+
+	if len(uploads) > 0 {
+		postIDs := make([]uint64, 0, len(postDetails))
+		for _, postID := range postDetails {
+			postIDs = append(postIDs, postID)
 		}
+		s.attachUploads(ctx, uploads, postIDs...)
+	}
 
+	// add post urls
+	if _, err := s.AddPostUrls(ctx, 0, post.Url, post.Content.Markdown, postDetails); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("couldn't add post url ", zap.Error(err))
 	}
 
 	return nil
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
-	"regexp"
+	"net/url"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -20,7 +22,6 @@ import (
 	"github.com/impartwealthapp/backend/pkg/impart"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/leebenson/conform"
-	"github.com/otiai10/opengraph/v2"
 	"github.com/xeipuuv/gojsonschema"
 	"go.uber.org/zap"
 )
@@ -47,6 +48,8 @@ func SetupRoutes(version *gin.RouterGroup, db *sql.DB, hiveData hivedata.Hives,
 	hiveRoutes.PUT(":hiveId", handler.EditHiveFunc())
 	hiveRoutes.GET("/:hiveId/percentiles/:impartWealthId", handler.GetHivePercentilesFunc())
 	hiveRoutes.GET("/:hiveId/reported-list", handler.GetReportedContents())
+	hiveRoutes.GET("/:hiveId/appeals", handler.GetAppealsFunc())
+	hiveRoutes.PATCH("/:hiveId/appeals/:appealId", handler.ResolveAppealFunc())
 	hiveRoutes.DELETE("/:hiveId", handler.DeleteHiveFunc())
 	//OG details
 	hiveRoutes.POST("/:hiveId/og-details", handler.CreatePostOgDetails())
@@ -59,6 +62,10 @@ func SetupRoutes(version *gin.RouterGroup, db *sql.DB, hiveData hivedata.Hives,
 	postRoutes.PUT("/:postId", handler.EditPostFunc())
 	postRoutes.POST("/:postId", handler.PostCommentReactionFunc())
 	postRoutes.DELETE("/:postId", handler.DeletePostFunc())
+	postRoutes.GET("/:postId/moderation", handler.GetModerationHistoryFunc())
+	postRoutes.POST("/:postId/appeal", handler.AppealFunc())
+	postRoutes.POST("/:postId/follow", handler.FollowPostFunc(true))
+	postRoutes.DELETE("/:postId/follow", handler.FollowPostFunc(false))
 
 	//comments
 	commentRoutes := postRoutes.Group("/:postId/comments")
@@ -69,6 +76,28 @@ func SetupRoutes(version *gin.RouterGroup, db *sql.DB, hiveData hivedata.Hives,
 	commentRoutes.PUT(":commentId", handler.EditCommentFunc())
 	commentRoutes.POST(":commentId", handler.PostCommentReactionFunc())
 	commentRoutes.DELETE(":commentId", handler.DeleteCommentFunc())
+	commentRoutes.GET(":commentId/moderation", handler.GetModerationHistoryFunc())
+	commentRoutes.POST(":commentId/appeal", handler.AppealFunc())
+
+	//home feed across the hives of the user
+	feedRoutes := version.Group("/feed")
+	feedRoutes.Use(hiveAuthorizationHandler(db, logger))
+	feedRoutes.GET("", handler.GetFeedFunc())
+
+	//unpublished posts and comments of the user
+	draftRoutes := version.Group("/drafts")
+	draftRoutes.Use(hiveAuthorizationHandler(db, logger))
+	draftRoutes.GET("", handler.GetDraftsFunc())
+	draftRoutes.POST("", handler.SaveDraftFunc())
+	draftRoutes.GET("/:draftId", handler.GetDraftFunc())
+	draftRoutes.PUT("/:draftId", handler.SaveDraftFunc())
+	draftRoutes.DELETE("/:draftId", handler.DeleteDraftFunc())
+	draftRoutes.POST("/:draftId/publish", handler.PublishDraftFunc())
+
+	//files uploaded straight to the media storage and referenced by id from posts
+	uploadRoutes := version.Group("/uploads")
+	uploadRoutes.Use(hiveAuthorizationHandler(db, logger))
+	uploadRoutes.POST("", handler.NewUploadFunc())
 
 	adminRoutes := version.Group("/admin")
 	adminRoutes.PATCH("/posts", handler.EditBulkPostDetails())
@@ -87,7 +116,7 @@ func hiveAuthorizationHandler(db *sql.DB, logger *zap.Logger) gin.HandlerFunc {
 		ctxUser := impart.GetCtxUser(ctx)
 		hives, err := ctxUser.MemberHiveHives().All(ctx, db)
 		if err != nil && err != sql.ErrNoRows {
-			logger.Error("unable to get hive memberships", zap.Error(err))
+			impart.CtxLogger(ctx, logger).Error("unable to get hive memberships", zap.Error(err))
 			return
 		}
 		ctx.Set(impart.HiveMembershipsContextKey, hives)
@@ -135,7 +164,7 @@ func (hh *hiveHandler) GetHivesFunc() gin.HandlerFunc {
 		if hiveIDstr == "" {
 			hives, err := models.HivesFromDB(dbHives)
 			if err != nil {
-				hh.logger.Error("error converting hive", zap.Error(err))
+				impart.CtxLogger(ctx, hh.logger).Error("error converting hive", zap.Error(err))
 				ctx.JSON(http.StatusInternalServerError, nil)
 			}
 			ctx.JSON(http.StatusOK, hives)
@@ -153,7 +182,7 @@ func (hh *hiveHandler) GetHivesFunc() gin.HandlerFunc {
 			if dbh.HiveID == hiveId {
 				h, err = models.HiveFromDB(dbh)
 				if err != nil {
-					hh.logger.Error("error converting hive", zap.Error(err))
+					impart.CtxLogger(ctx, hh.logger).Error("error converting hive", zap.Error(err))
 					ctx.JSON(impart.UnknownError.HttpStatus(), impart.ErrorResponse(impart.UnknownError))
 					return
 				}
@@ -163,7 +192,7 @@ func (hh *hiveHandler) GetHivesFunc() gin.HandlerFunc {
 		// check the hive found or not
 		if h.HiveID == 0 {
 			iErr := impart.NewError(impart.ErrNotFound, "unable to find hive for given id", impart.HiveID)
-			hh.logger.Error("no hive found for id", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("no hive found for id", zap.Error(err))
 			ctx.JSON(iErr.HttpStatus(), impart.ErrorResponse(iErr))
 			return
 		}
@@ -188,7 +217,7 @@ func (hh *hiveHandler) CreateHiveFunc() gin.HandlerFunc {
 		hive := models.Hive{}
 		err = json.Unmarshal(b, &hive)
 		if err != nil {
-			hh.logger.Error("Unable to unmarshal JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to unmarshal JSON Body",
 				zap.Error(err),
 				zap.Any("request", b),
 			)
@@ -303,14 +332,19 @@ func (hh *hiveHandler) GetPostsFunc() gin.HandlerFunc {
 		}
 
 		var err error
-		gpi.Limit, gpi.Offset, err = parseLimitOffset(ctx)
+		gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx)
 		if err != nil {
-			hh.logger.Error("couldn't parse limit and offset", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("couldn't parse limit and offset", zap.Error(err))
 			impartErr = impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 
+		if gpi.Sort, gpi.TopSince, impartErr = parsePostSort(params, impart.CurrentUTC()); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+
 		if lastCommentSort := strings.TrimSpace(params.Get("sortByLatestComment")); lastCommentSort != "" {
 			if parsedLastCommentSort, err := strconv.ParseBool(lastCommentSort); err != nil {
 				ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
@@ -333,24 +367,68 @@ func (hh *hiveHandler) GetPostsFunc() gin.HandlerFunc {
 		})
 	}
 }
-func parseLimitOffset(ctx *gin.Context) (limit int, offset int, err error) {
-	params := ctx.Request.URL.Query()
 
-	if limitParam := strings.TrimSpace(params.Get("limit")); limitParam != "" {
-		if limit, err = strconv.Atoi(limitParam); err != nil {
-			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impart.NewError(err, "invalid limit passed in")))
+// GetFeedFunc returns the posts of every hive of the user, optionally only of the hives and tags passed in
+func (hh *hiveHandler) GetFeedFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gpi := hivedata.GetPostsInput{}
+		params := ctx.Request.URL.Query()
+		for _, s := range params["hives"] {
+			hiveID, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("unable to parse hive %v", s), impart.HiveID)
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			gpi.HiveIDs = append(gpi.HiveIDs, hiveID)
+		}
+		for _, s := range params["tags"] {
+			if parsed, err := strconv.Atoi(s); err == nil {
+				gpi.TagIDs = append(gpi.TagIDs, parsed)
+			}
+		}
+		var err error
+		if gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx); err != nil {
+			return
+		}
+		var impartErr impart.Error
+		if gpi.Sort, gpi.TopSince, impartErr = parsePostSort(params, impart.CurrentUTC()); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-	}
 
-	if offsetParam := strings.TrimSpace(params.Get("offset")); offsetParam != "" {
-		if offset, err = strconv.Atoi(offsetParam); err != nil {
-			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impart.NewError(err, "invalid limit passed in")))
+		posts, nextPage, impartErr := hh.hiveService.GetFeed(ctx, gpi)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
+		ctx.JSON(http.StatusOK, models.PagedPostsResponse{
+			Posts:    posts,
+			NextPage: nextPage,
+		})
 	}
+}
 
-	return
+// parsePostSort reads the ranking of the posts feed, sort=hot or sort=top with period=week, month or all
+func parsePostSort(params url.Values, now time.Time) (hivedata.PostSort, time.Time, impart.Error) {
+	var since time.Time
+	sort := hivedata.PostSort(strings.ToLower(strings.TrimSpace(params.Get("sort"))))
+	switch sort {
+	case hivedata.SortNewest, hivedata.SortHot:
+	case hivedata.SortTop:
+		switch period := strings.ToLower(strings.TrimSpace(params.Get("period"))); period {
+		case "", "all":
+		case "week":
+			since = now.AddDate(0, 0, -7)
+		case "month":
+			since = now.AddDate(0, -1, 0)
+		default:
+			return sort, since, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid period %s", period))
+		}
+	default:
+		return sort, since, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid sort %s", sort))
+	}
+	return sort, since, nil
 }
 
 func (hh *hiveHandler) GetPostFunc() gin.HandlerFunc {
@@ -385,7 +463,7 @@ func (hh *hiveHandler) GetPostFunc() gin.HandlerFunc {
 		// append reported users with post response
 		out, err := hh.hiveService.GetReportedUser(ctx, models.Posts{post})
 		if err != nil {
-			hh.logger.Error("error fetching reported users", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("error fetching reported users", zap.Error(err))
 		} else if len(out) > 0 {
 			post = out[0]
 		}
@@ -400,7 +478,7 @@ func (hh *hiveHandler) CreatePostFunc() gin.HandlerFunc {
 		var hiveId uint64
 		var impartErr impart.Error
 		if hiveId, impartErr = ctxUint64Param(ctx, "hiveId"); impartErr != nil {
-			hh.logger.Error("Unable to parse hiveID", zap.Error(impartErr))
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to parse hiveID", zap.Error(impartErr))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -408,7 +486,7 @@ func (hh *hiveHandler) CreatePostFunc() gin.HandlerFunc {
 
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			hh.logger.Error("Unable to Deserialize JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to Deserialize JSON Body",
 				zap.Error(err),
 			)
 			//store the error log into s3
@@ -428,7 +506,7 @@ func (hh *hiveHandler) CreatePostFunc() gin.HandlerFunc {
 		p := models.Post{}
 		err = json.Unmarshal(b, &p)
 		if err != nil {
-			hh.logger.Error("Unable to unmarshal JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to unmarshal JSON Body",
 				zap.Error(err),
 				zap.Any("request", b),
 			)
@@ -448,26 +526,26 @@ func (hh *hiveHandler) CreatePostFunc() gin.HandlerFunc {
 		p = ValidationPost(p)
 		impartErr = ValidateInputs(p)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-		hh.logger.Debug("creating", zap.Any("post", p))
+		impart.CtxLogger(ctx, hh.logger).Debug("creating", zap.Any("post", p))
 
 		if p.HiveID != hiveId {
 			impartErr = impart.NewError(impart.ErrBadRequest, "hiveID in route does not match hiveID in post body")
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 
 		p, impartErr = hh.hiveService.NewPost(ctx, p)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-		hh.logger.Debug("created post, returning", zap.Any("createdPost", p))
+		impart.CtxLogger(ctx, hh.logger).Debug("created post, returning", zap.Any("createdPost", p))
 
 		ctx.JSON(http.StatusOK, p)
 	}
@@ -505,7 +583,7 @@ func (hh *hiveHandler) EditPostFunc() gin.HandlerFunc {
 			}
 
 			if impartErr := hh.hiveService.PinPost(ctx, hiveId, postId, pin, true); impartErr != nil {
-				hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+				impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 				return
 			}
@@ -518,22 +596,22 @@ func (hh *hiveHandler) EditPostFunc() gin.HandlerFunc {
 		err := ctx.ShouldBindJSON(&p)
 		p = ValidationPost(p)
 		if err != nil {
-			hh.logger.Error("deserialization error", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("deserialization error", zap.Error(err))
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Post")
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 		if postId != p.PostID {
 			impartErr := impart.NewError(impart.ErrBadRequest, "post IDs do not match")
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 		p, impartErr = hh.hiveService.EditPost(ctx, p)
 		if impartErr != nil {
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			return
 		}
 
@@ -574,6 +652,10 @@ func (hh *hiveHandler) PostCommentReactionFunc() gin.HandlerFunc {
 				PostID:    postId,
 				CommentID: commentId,
 			}
+			if v.HiveID, impartErr = ctxUint64Param(ctx, "hiveId"); impartErr != nil {
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
 
 			if upVoteParam != "" {
 				var err error
@@ -696,7 +778,7 @@ func (hh *hiveHandler) DeletePostFunc() gin.HandlerFunc {
 
 		impartErr = hh.hiveService.DeletePost(ctx, postId)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -735,14 +817,14 @@ func (hh *hiveHandler) GetCommentsFunc() gin.HandlerFunc {
 			return
 		}
 
-		limit, offset, err := parseLimitOffset(ctx)
+		limit, offset, err := impart.ParseLimitOffset(ctx)
 		if err != nil {
 			return
 		}
 
 		comments, nextPage, impartErr := hh.hiveService.GetComments(ctx, postId, limit, offset)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -777,17 +859,17 @@ func (hh *hiveHandler) CreateCommentFunc() gin.HandlerFunc {
 		stdErr := ctx.ShouldBindJSON(&c)
 		if stdErr != nil {
 			err := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Comment")
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
-		hh.logger.Debug("creating", zap.Any("comment", c))
+		impart.CtxLogger(ctx, hh.logger).Debug("creating", zap.Any("comment", c))
 
 		c = ValidateCommentInput(c)
 
 		if c.PostID != postId {
 			err := impart.NewError(impart.ErrBadRequest, "PostID in route does not match PostID in comment body")
-			hh.logger.Error("bad request - mismatch postID", zap.Any("comment", c), zap.Error(err.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error("bad request - mismatch postID", zap.Any("comment", c), zap.Error(err.Err()))
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
@@ -799,11 +881,11 @@ func (hh *hiveHandler) CreateCommentFunc() gin.HandlerFunc {
 
 		c, err := hh.hiveService.NewComment(ctx, c)
 		if err != nil {
-			hh.logger.Error(err.Msg(), zap.Error(err.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(err.Msg(), zap.Error(err.Err()))
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
-		hh.logger.Debug("created comment, returning", zap.Any("createdComment", c))
+		impart.CtxLogger(ctx, hh.logger).Debug("created comment, returning", zap.Any("createdComment", c))
 
 		ctx.JSON(http.StatusOK, c)
 	}
@@ -828,7 +910,7 @@ func (hh *hiveHandler) EditCommentFunc() gin.HandlerFunc {
 
 		err := ctx.ShouldBindJSON(&c)
 		if err != nil {
-			hh.logger.Error("error binding json", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("error binding json", zap.Error(err))
 			err := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Comment")
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
@@ -839,14 +921,14 @@ func (hh *hiveHandler) EditCommentFunc() gin.HandlerFunc {
 
 		if c.PostID != postId {
 			err := impart.NewError(impart.ErrBadRequest, "PostID in route does not match PostID in comment body")
-			hh.logger.Error("bad request - mismatch postID", zap.Any("comment", c), zap.Error(err.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error("bad request - mismatch postID", zap.Any("comment", c), zap.Error(err.Err()))
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
 
 		if c.CommentID != commentId {
 			err := impart.NewError(impart.ErrBadRequest, "CommentID in route does not match CommentID in comment body")
-			hh.logger.Error("bad request - mismatch CommentID", zap.Any("comment", c), zap.Error(err.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error("bad request - mismatch CommentID", zap.Any("comment", c), zap.Error(err.Err()))
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
@@ -907,7 +989,7 @@ func (hh *hiveHandler) GetReportedContents() gin.HandlerFunc {
 
 		gpi.Limit, gpi.Offset, gpi.OffsetPost, gpi.OffsetComment, err = parseReportedLimitOffset(ctx)
 		if err != nil {
-			hh.logger.Error("couldn't parse limit and offset", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("couldn't parse limit and offset", zap.Error(err))
 			impartErr = impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
@@ -959,58 +1041,42 @@ func parseReportedLimitOffset(ctx *gin.Context) (limit int, offset int, offsetpo
 	return
 }
 
+// CreatePostOgDetails returns the link preview for a url, for any member composing a post
 func (hh *hiveHandler) CreatePostOgDetails() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		var imageUrl string
-		ctxUser := impart.GetCtxUser(ctx)
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			hh.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
+			return
 		}
 		p := models.OGUrl{}
 		stdErr := json.Unmarshal(b, &p)
 		if stdErr != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Profile")
-			hh.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Error())
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		if strings.TrimSpace(p.Url) == "" {
+			impartErr := impart.NewError(impart.ErrBadRequest, "url is required", impart.URL)
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 
-		if ctxUser.Admin && (p.Url != "") {
-			match, _ := regexp.MatchString(`^(?:f|ht)tps?://`, p.Url)
-			if !match {
-				p.Url = "http://" + p.Url
-			}
-			ogp, err := opengraph.Fetch(p.Url)
-
-			if err != nil {
-				hh.logger.Error("error attempting to fetch URL Data", zap.Any("postURL", p.Url), zap.Error(err))
+		outputData, impartErr := hh.hiveService.LinkPreview(ctx, p.Url)
+		if impartErr != nil {
+			if impartErr.Err() == impart.ErrNotFound {
+				// pages without a preview are not an error for the composer
 				ctx.JSON(http.StatusOK, models.PostUrl{})
 				return
 			}
-			if ogp != nil && ogp.Image != nil && len(ogp.Image) > 0 {
-				imageUrl = ogp.Image[0].URL
-			} else {
-				imageUrl = ""
-			}
-			outputData := models.PostUrl{
-				Title:       ogp.Title,
-				ImageUrl:    imageUrl,
-				Url:         p.Url,
-				Description: ogp.Description,
-			}
-
-			ctx.JSON(http.StatusOK, outputData)
-		} else {
-			impartErr := impart.NewError(impart.ErrBadRequest, "Only Admin has access for the Get Og Details Api")
-			hh.logger.Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-
+		ctx.JSON(http.StatusOK, outputData)
 	}
 }
 
@@ -1052,7 +1118,7 @@ func (ph *hiveHandler) EditBulkPostDetails() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1073,7 +1139,7 @@ func (ph *hiveHandler) EditBulkPostDetails() gin.HandlerFunc {
 		input := models.PostUpdate{}
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -1090,7 +1156,7 @@ func (hh *hiveHandler) HiveBulkOperations() gin.HandlerFunc {
 
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			hh.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1111,7 +1177,7 @@ func (hh *hiveHandler) HiveBulkOperations() gin.HandlerFunc {
 		input := models.HiveUpdate{}
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			hh.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -1132,7 +1198,7 @@ func (hh *hiveHandler) CreatePostForMultipleHiveFunc() gin.HandlerFunc {
 
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			hh.logger.Error("Unable to Deserialize JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to Deserialize JSON Body",
 				zap.Error(err),
 			)
 			//store the error log into s3
@@ -1152,7 +1218,7 @@ func (hh *hiveHandler) CreatePostForMultipleHiveFunc() gin.HandlerFunc {
 		p := models.Post{}
 		err = json.Unmarshal(b, &p)
 		if err != nil {
-			hh.logger.Error("Unable to unmarshal JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to unmarshal JSON Body",
 				zap.Error(err),
 				zap.Any("request", b),
 			)
@@ -1172,19 +1238,19 @@ func (hh *hiveHandler) CreatePostForMultipleHiveFunc() gin.HandlerFunc {
 		p = ValidationPost(p)
 		impartErr = ValidateInputs(p)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-		hh.logger.Debug("creating", zap.Any("post", p))
+		impart.CtxLogger(ctx, hh.logger).Debug("creating", zap.Any("post", p))
 
 		impartErr = hh.hiveService.NewPostForMultipleHives(ctx, p)
 		if impartErr != nil {
-			hh.logger.Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-		hh.logger.Debug("created post, returning", zap.Any("createdPost", p))
+		impart.CtxLogger(ctx, hh.logger).Debug("created post, returning", zap.Any("createdPost", p))
 
 		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "Posts Created"})
 	}
@@ -1210,7 +1276,7 @@ func (hh *hiveHandler) CreateHiveulesFunc() gin.HandlerFunc {
 		hiveRule := models.HiveRule{}
 		err = json.Unmarshal(requestBody, &hiveRule)
 		if err != nil {
-			hh.logger.Error("Unable to unmarshal JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to unmarshal JSON Body",
 				zap.Error(err),
 				zap.Any("request", requestBody),
 			)
@@ -1237,9 +1303,9 @@ func (hh *hiveHandler) GetHiveulesFunc() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		gpi := models.GetHiveInput{}
 		var err error
-		gpi.Limit, gpi.Offset, err = parseLimitOffset(ctx)
+		gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx)
 		if err != nil {
-			hh.logger.Error("couldn't parse limit and offset", zap.Error(err))
+			impart.CtxLogger(ctx, hh.logger).Error("couldn't parse limit and offset", zap.Error(err))
 			impartErr := impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
@@ -1284,7 +1350,7 @@ func (hh *hiveHandler) EditHiveRulesFunc() gin.HandlerFunc {
 		hiveRule := models.HiveRule{}
 		err = json.Unmarshal(requestBody, &hiveRule)
 		if err != nil {
-			hh.logger.Error("Unable to unmarshal JSON Body",
+			impart.CtxLogger(ctx, hh.logger).Error("Unable to unmarshal JSON Body",
 				zap.Error(err),
 				zap.Any("request", requestBody),
 			)
@@ -1306,3 +1372,311 @@ func (hh *hiveHandler) EditHiveRulesFunc() gin.HandlerFunc {
 		}
 	}
 }
+
+// contentParams reads the post id and, on the comment routes, the comment id
+func contentParams(ctx *gin.Context) (postID, commentID uint64, impartErr impart.Error) {
+	if postID, impartErr = ctxUint64Param(ctx, "postId"); impartErr != nil {
+		return
+	}
+	if _, ok := ctx.Params.Get("commentId"); ok {
+		commentID, impartErr = ctxUint64Param(ctx, "commentId")
+	}
+	return
+}
+
+// GetModerationHistoryFunc returns the reviews and appeals of a post or comment to its author and admins
+func (hh *hiveHandler) GetModerationHistoryFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		postID, commentID, impartErr := contentParams(ctx)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		history, impartErr := hh.hiveService.GetModerationHistory(ctx, postID, commentID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, history)
+	}
+}
+
+// AppealFunc lets the author appeal the removal of a post or comment
+func (hh *hiveHandler) AppealFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		postID, commentID, impartErr := contentParams(ctx)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		input := models.NewAppealInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, hh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to an appeal")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		appeal, impartErr := hh.hiveService.Appeal(ctx, postID, commentID, input.Message)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusCreated, appeal)
+	}
+}
+
+// GetAppealsFunc is the appeal queue of the hive for admins, pending appeals unless a status is passed
+func (hh *hiveHandler) GetAppealsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !impart.GetCtxUser(ctx).Admin {
+			impartErr := impart.NewError(impart.ErrUnauthorized, "You are a not hive admin")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		hiveID, impartErr := ctxUint64Param(ctx, "hiveId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		limit, offset, err := impart.ParseLimitOffset(ctx)
+		if err != nil {
+			return
+		}
+		appeals, nextPage, impartErr := hh.hiveService.GetAppeals(ctx, hiveID, strings.TrimSpace(ctx.Query("status")), limit, offset)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.PagedAppealsResponse{
+			Appeals:  appeals,
+			NextPage: nextPage,
+		})
+	}
+}
+
+func (hh *hiveHandler) ResolveAppealFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !impart.GetCtxUser(ctx).Admin {
+			impartErr := impart.NewError(impart.ErrUnauthorized, "You are a not hive admin")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		hiveID, impartErr := ctxUint64Param(ctx, "hiveId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		appealID, impartErr := ctxUint64Param(ctx, "appealId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		input := models.ResolveAppealInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, hh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to an appeal resolution")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		appeal, impartErr := hh.hiveService.ResolveAppeal(ctx, hiveID, appealID, *input.Grant, input.Comment)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, appeal)
+	}
+}
+
+// FollowPostFunc follows or unfollows a post for the context user
+func (hh *hiveHandler) FollowPostFunc(follow bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		postID, impartErr := ctxUint64Param(ctx, "postId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		var track models.PostCommentTrack
+		if follow {
+			track, impartErr = hh.hiveService.FollowPost(ctx, postID)
+		} else {
+			track, impartErr = hh.hiveService.UnfollowPost(ctx, postID)
+		}
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, track)
+	}
+}
+
+// GetDraftsFunc lists the drafts of the user, optionally of one kind or the comment drafts of one post
+func (hh *hiveHandler) GetDraftsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params := ctx.Request.URL.Query()
+		var postID uint64
+		if postIDStr := strings.TrimSpace(params.Get("postId")); postIDStr != "" {
+			var err error
+			if postID, err = strconv.ParseUint(postIDStr, 10, 64); err != nil {
+				impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("unable to parse %v", postIDStr), impart.PostID)
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+		}
+		limit, offset, err := impart.ParseLimitOffset(ctx)
+		if err != nil {
+			return
+		}
+		drafts, nextPage, impartErr := hh.hiveService.GetDrafts(ctx, strings.TrimSpace(params.Get("kind")), postID, limit, offset)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.PagedDraftsResponse{
+			Drafts:   drafts,
+			NextPage: nextPage,
+		})
+	}
+}
+
+func (hh *hiveHandler) GetDraftFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		draftID, impartErr := ctxUint64Param(ctx, "draftId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		draft, impartErr := hh.hiveService.GetDraft(ctx, draftID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, draft)
+	}
+}
+
+// SaveDraftFunc creates a draft, or replaces the draft in the route
+func (hh *hiveHandler) SaveDraftFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var draftID uint64
+		if _, ok := ctx.Params.Get("draftId"); ok {
+			var impartErr impart.Error
+			if draftID, impartErr = ctxUint64Param(ctx, "draftId"); impartErr != nil {
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+		}
+		draft := models.Draft{}
+		if err := ctx.ShouldBindJSON(&draft); err != nil {
+			impart.CtxLogger(ctx, hh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Draft")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		draft.DraftID = draftID
+		draft, impartErr := hh.hiveService.SaveDraft(ctx, draft)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		status := http.StatusOK
+		if draftID == 0 {
+			status = http.StatusCreated
+		}
+		ctx.JSON(status, draft)
+	}
+}
+
+func (hh *hiveHandler) DeleteDraftFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		draftID, impartErr := ctxUint64Param(ctx, "draftId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		if impartErr = hh.hiveService.DeleteDraft(ctx, draftID); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "draft deleted"})
+	}
+}
+
+// PublishDraftFunc posts the draft and returns it with the published post or comment
+func (hh *hiveHandler) PublishDraftFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		draftID, impartErr := ctxUint64Param(ctx, "draftId")
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		draft, impartErr := hh.hiveService.PublishDraft(ctx, draftID)
+		if impartErr != nil {
+			impart.CtxLogger(ctx, hh.logger).Error(impartErr.Msg(), zap.Error(impartErr.Err()))
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, draft)
+	}
+}
+
+// SetupMediaRoutes registers the routes a local media storage receives uploads on and serves files
+// from, the group must not require an api key or a JWT since the signed urls are used like presigned s3 urls.
+func SetupMediaRoutes(public *gin.RouterGroup, hiveService Service, logger *zap.Logger) {
+	handler := &hiveHandler{
+		hiveService: hiveService,
+		logger:      logger,
+	}
+	public.PUT("/uploads", handler.PutLocalUploadFunc())
+	public.GET("/media", handler.GetLocalMediaFunc())
+}
+
+func (hh *hiveHandler) NewUploadFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		upload := models.Upload{}
+		if err := ctx.ShouldBindJSON(&upload); err != nil {
+			impart.CtxLogger(ctx, hh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to an Upload")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		slot, impartErr := hh.hiveService.NewUpload(ctx, upload)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusCreated, slot)
+	}
+}
+
+func (hh *hiveHandler) PutLocalUploadFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.Query("key")
+		impartErr := hh.hiveService.PutLocalUpload(ctx, key, ctx.ContentType(), ctx.Query("expires"), ctx.Query("signature"),
+			ctx.Request.Body)
+		if impartErr != nil {
+			impart.CtxLogger(ctx, hh.logger).Info("local upload failed", zap.String("key", key), zap.Error(impartErr.Err()))
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.Status(http.StatusOK)
+	}
+}
+
+func (hh *hiveHandler) GetLocalMediaFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.Query("key")
+		body, info, impartErr := hh.hiveService.ReadLocalMedia(ctx, key, ctx.Query("expires"), ctx.Query("signature"))
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		defer body.Close()
+		contentType := info.ContentType
+		if contentType == "" {
+			contentType = mime.TypeByExtension(path.Ext(key))
+		}
+		ctx.DataFromReader(http.StatusOK, info.Size, contentType, body, map[string]string{
+			"Cache-Control": "private, max-age=300",
+		})
+	}
+}
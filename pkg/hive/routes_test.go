@@ -0,0 +1,42 @@
+package hive
+
+import (
+	"net/url"
+	"testing"
+
+	hivedata "github.com/impartwealthapp/backend/pkg/data/hive"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePostSort(t *testing.T) {
+	now := impart.CurrentUTC()
+
+	sort, since, err := parsePostSort(url.Values{}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, hivedata.SortNewest, sort)
+	assert.True(t, since.IsZero())
+
+	sort, since, err = parsePostSort(url.Values{"sort": {"Hot"}, "period": {"week"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, hivedata.SortHot, sort)
+	assert.True(t, since.IsZero())
+
+	sort, since, err = parsePostSort(url.Values{"sort": {"top"}, "period": {"week"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, hivedata.SortTop, sort)
+	assert.Equal(t, now.AddDate(0, 0, -7), since)
+
+	_, since, err = parsePostSort(url.Values{"sort": {"top"}, "period": {"month"}}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.AddDate(0, -1, 0), since)
+
+	_, since, err = parsePostSort(url.Values{"sort": {"top"}}, now)
+	assert.Nil(t, err)
+	assert.True(t, since.IsZero())
+
+	_, _, err = parsePostSort(url.Values{"sort": {"top"}, "period": {"year"}}, now)
+	assert.NotNil(t, err)
+	_, _, err = parsePostSort(url.Values{"sort": {"random"}}, now)
+	assert.NotNil(t, err)
+}
@@ -3,12 +3,15 @@ package hive
 import (
 	"context"
 	"database/sql"
+	"io"
+	"time"
 
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 
 	data "github.com/impartwealthapp/backend/pkg/data/hive"
 	profiledata "github.com/impartwealthapp/backend/pkg/data/profile"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/linkpreview"
 	"github.com/impartwealthapp/backend/pkg/media"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
@@ -40,6 +43,8 @@ type Service interface {
 	AddPostFilesDB(ctx context.Context, post *dbmodels.Post, file []models.File, isAdminActivity bool, postHive map[uint64]uint64) ([]models.File, impart.Error)
 	ValidatePostFilesName(ctx context.Context, ctxUser *dbmodels.User, postFiles []models.File) []models.File
 	NewPostForMultipleHives(ctx context.Context, post models.Post) impart.Error
+	AddPostUrls(ctx context.Context, postID uint64, postUrl, content string, postHive map[uint64]uint64) (models.PostUrl, impart.Error)
+	LinkPreview(ctx context.Context, rawURL string) (models.PostUrl, impart.Error)
 
 	GetComments(ctx context.Context, postID uint64, limit, offset int) (models.Comments, *models.NextPage, impart.Error)
 	GetComment(ctx context.Context, commentID uint64) (models.Comment, impart.Error)
@@ -49,6 +54,26 @@ type Service interface {
 	ReportComment(ctx context.Context, commentID uint64, reason string, remove bool) (models.PostCommentTrack, impart.Error)
 	ReviewComment(ctx context.Context, commentId uint64, comment string, remove bool) (models.Comment, impart.Error)
 
+	GetModerationHistory(ctx context.Context, postID, commentID uint64) (models.ModerationHistory, impart.Error)
+	Appeal(ctx context.Context, postID, commentID uint64, message string) (models.ContentAppeal, impart.Error)
+	GetAppeals(ctx context.Context, hiveID uint64, status string, limit, offset int) (models.ContentAppeals, *models.NextPage, impart.Error)
+	ResolveAppeal(ctx context.Context, hiveID, appealID uint64, grant bool, comment string) (models.ContentAppeal, impart.Error)
+
+	GetFeed(ctx context.Context, gpi data.GetPostsInput) (models.Posts, *models.NextPage, impart.Error)
+
+	FollowPost(ctx context.Context, postID uint64) (models.PostCommentTrack, impart.Error)
+	UnfollowPost(ctx context.Context, postID uint64) (models.PostCommentTrack, impart.Error)
+
+	GetDrafts(ctx context.Context, kind string, postID uint64, limit, offset int) (models.Drafts, *models.NextPage, impart.Error)
+	GetDraft(ctx context.Context, draftID uint64) (models.Draft, impart.Error)
+	SaveDraft(ctx context.Context, draft models.Draft) (models.Draft, impart.Error)
+	DeleteDraft(ctx context.Context, draftID uint64) impart.Error
+	PublishDraft(ctx context.Context, draftID uint64) (models.Draft, impart.Error)
+
+	NewUpload(ctx context.Context, upload models.Upload) (models.UploadSlot, impart.Error)
+	PutLocalUpload(ctx context.Context, key, contentType, expires, signature string, body io.Reader) impart.Error
+	ReadLocalMedia(ctx context.Context, key, expires, signature string) (io.ReadCloser, media.ObjectInfo, impart.Error)
+
 	SendCommentNotification(input models.CommentNotificationInput) impart.Error
 	SendPostNotification(input models.PostNotificationInput) impart.Error
 
@@ -78,18 +103,23 @@ type service struct {
 	notificationService impart.NotificationService
 	db                  *sql.DB
 	MediaStorage        media.StorageConfigurations
+	storage             *media.FileUpload
+	mediaProcessor      media.Processor
+	linkPreview         linkpreview.Service
+	appealWindow        time.Duration
 }
 
 // New creates a new Hive Service
-func New(cfg *config.Impart, db *sql.DB, logger *zap.Logger, media media.StorageConfigurations) Service {
+func New(cfg *config.Impart, db *sql.DB, logger *zap.Logger, mediaStorage media.StorageConfigurations) Service {
 	hd := data.NewHiveService(db, logger)
 	var notificationSvc impart.NotificationService
 	if cfg.Env == config.Local {
-		notificationSvc = impart.NewNoopNotificationService()
+		notificationSvc = impart.NewInboxNotificationService(impart.NewNoopNotificationService(), db, logger)
 	} else {
-		notificationSvc = impart.NewImpartNotificationService(db, cfg.Env.String(), cfg.Region, cfg.IOSNotificationARN, logger)
+		notificationSvc = impart.NewImpartNotificationService(db, cfg.Env.String(), cfg.Region, cfg.IOSNotificationARN, cfg.AndroidNotificationARN, logger)
 	}
 	profileData := profiledata.NewMySQLStore(db, logger, notificationSvc)
+	storage := media.New(mediaStorage)
 	svc := &service{
 		logger:              logger,
 		db:                  db,
@@ -99,7 +129,11 @@ func New(cfg *config.Impart, db *sql.DB, logger *zap.Logger, media media.Storage
 		reactionData:        hd,
 		notificationService: notificationSvc,
 		profileData:         profileData,
-		MediaStorage:        media,
+		MediaStorage:        mediaStorage,
+		storage:             storage,
+		mediaProcessor:      media.NewProcessor(db, storage, logger),
+		linkPreview:         linkpreview.New(cfg, db, logger),
+		appealWindow:        cfg.AppealWindow,
 	}
 
 	return svc
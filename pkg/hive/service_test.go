@@ -0,0 +1,166 @@
+// +build integration
+
+package hive
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	data "github.com/impartwealthapp/backend/pkg/data/hive"
+	"github.com/impartwealthapp/backend/pkg/data/migrater"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	logger   *zap.Logger
+	cfg      *config.Impart
+	db       *sql.DB
+	svc      *service
+	notified *recordingNotificationService
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+const pathToMigrationsDir = "../../schemas/migrations"
+
+// recordingNotificationService keeps the members notified of each category
+type recordingNotificationService struct {
+	impart.NotificationService
+	mu    sync.Mutex
+	users map[impart.NotificationCategory][]string
+}
+
+func (n *recordingNotificationService) Notify(ctx context.Context, data impart.NotificationData, alert impart.Alert, impartWealthID string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.users[data.Category] = append(n.users[data.Category], impartWealthID)
+	return nil
+}
+
+// notifiedOf returns the members notified of the category so far
+func (n *recordingNotificationService) notifiedOf(category impart.NotificationCategory) []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.users[category]...)
+}
+
+func (n *recordingNotificationService) reset() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.users = make(map[impart.NotificationCategory][]string)
+}
+
+func (s *ServiceTestSuite) SetupSuite() {
+	var err error
+	s.logger = zap.NewNop()
+	s.cfg, err = config.GetImpart()
+	s.Require().NoError(err)
+	s.cfg.MigrationsPath = pathToMigrationsDir
+	s.db, err = s.cfg.GetDBConnection()
+	s.Require().NoError(err)
+	s.notified = &recordingNotificationService{NotificationService: impart.NewNoopNotificationService()}
+	hd := data.NewHiveService(s.db, s.logger)
+	s.svc = &service{
+		logger:              s.logger,
+		db:                  s.db,
+		hiveData:            hd,
+		postData:            hd,
+		commentData:         hd,
+		reactionData:        hd,
+		notificationService: s.notified,
+		storage:             media.New(media.StorageConfigurations{Storage: "memory"}),
+		appealWindow:        time.Hour,
+	}
+
+	migrationDB, err := s.cfg.GetMigrationDBConnection()
+	s.Require().NoError(err)
+	err = migrater.RunMigrationsDown(migrationDB, s.cfg.MigrationsPath, s.logger, nil)
+	if err != nil && err != migrate.ErrNoChange {
+		s.FailNow("DB Down Error %v", err)
+	}
+}
+
+func (s *ServiceTestSuite) TearDownSuite() {
+	s.db.Close()
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.notified.reset()
+	migrationDB, err := s.cfg.GetMigrationDBConnection()
+	s.Require().NoError(err)
+	defer migrationDB.Close()
+	err = migrater.RunMigrationsUp(migrationDB, s.cfg.MigrationsPath, s.logger, nil)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	migrationDB, err := s.cfg.GetMigrationDBConnection()
+	s.Require().NoError(err)
+	defer migrationDB.Close()
+	err = migrater.RunMigrationsDown(migrationDB, s.cfg.MigrationsPath, s.logger, nil)
+	s.Require().NoError(err)
+}
+
+// contextWithUser creates a user, member of the hives, and returns a context as that user
+func (s *ServiceTestSuite) contextWithUser(admin bool, hiveIDs ...uint64) context.Context {
+	id := ksuid.New().String()
+	user := &dbmodels.User{
+		ImpartWealthID:   id,
+		AuthenticationID: id,
+		Email:            id,
+		ScreenName:       id,
+		CreatedAt:        impart.CurrentUTC(),
+		UpdatedAt:        impart.CurrentUTC(),
+		DeviceToken:      "d",
+		AwsSNSAppArn:     "f",
+		Admin:            admin,
+	}
+	s.Require().NoError(user.Insert(context.TODO(), s.db, boil.Infer()))
+	user.R = user.R.NewStruct()
+	for _, hiveID := range hiveIDs {
+		hive := &dbmodels.Hive{HiveID: hiveID}
+		s.Require().NoError(user.AddMemberHiveHives(context.TODO(), s.db, false, hive))
+	}
+	return context.WithValue(context.Background(), impart.UserRequestContextKey, user)
+}
+
+func (s *ServiceTestSuite) bootstrapHive() uint64 {
+	hive := &dbmodels.Hive{Name: "test hive", Description: "test hive", ReportHideThreshold: 1}
+	s.Require().NoError(hive.Insert(context.TODO(), s.db, boil.Infer()))
+	return hive.HiveID
+}
+
+func (s *ServiceTestSuite) bootstrapHivePost(author context.Context, hiveID uint64, held bool) uint64 {
+	post := &dbmodels.Post{
+		HiveID:         hiveID,
+		ImpartWealthID: impart.GetCtxUser(author).ImpartWealthID,
+		CreatedAt:      impart.CurrentUTC(),
+		Subject:        "subject",
+		Content:        "some content",
+		LastCommentTS:  impart.CurrentUTC(),
+		Held:           held,
+		Obfuscated:     held,
+	}
+	s.Require().NoError(post.Insert(context.TODO(), s.db, boil.Infer()))
+	return post.PostID
+}
+
+// bootstrapPost creates a post of the author in a new hive where a single report hides it
+func (s *ServiceTestSuite) bootstrapPost(author context.Context, held bool) (uint64, uint64) {
+	hiveID := s.bootstrapHive()
+	return hiveID, s.bootstrapHivePost(author, hiveID, held)
+}
@@ -0,0 +1,254 @@
+package hive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/media"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/segmentio/ksuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxPostUploads caps the uploaded files referenced by a post
+	maxPostUploads = 10
+	// sniffLen is how much of a file is read to detect its content type
+	sniffLen = 512
+)
+
+// NewUpload creates a pending file owned by the context user and returns the signed url the
+// client puts the file to. The file is only attached once a post references its id.
+func (s *service) NewUpload(ctx context.Context, upload models.Upload) (models.UploadSlot, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	if ctxUser == nil {
+		return models.UploadSlot{}, impart.NewError(impart.ErrUnauthorized, "unable to fetch context user")
+	}
+	if impartErr := s.checkStanding(ctx, ctxUser, true); impartErr != nil {
+		return models.UploadSlot{}, impartErr
+	}
+	if strings.TrimSpace(upload.FileName) == "" {
+		return models.UploadSlot{}, impart.NewError(impart.ErrBadRequest, "file name is required")
+	}
+	switch s.MediaStorage.ValidateUpload(upload.ContentType, upload.Size) {
+	case nil:
+	case media.ErrContentType:
+		return models.UploadSlot{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("content type %s is not allowed", upload.ContentType))
+	default:
+		return models.UploadSlot{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file size must be between 1 and %d bytes", s.MediaStorage.Upload.MaxBytes))
+	}
+
+	// same path and name as a file posted in the body, prefixed so two uploads never share a key
+	f := s.ValidatePostFilesName(ctx, ctxUser, []models.File{{FileName: upload.FileName}})[0]
+	key := fmt.Sprintf("%s%s%s_%s", s.MediaStorage.MediaPath, f.FilePath, ksuid.New().String(), f.FileName)
+	now := impart.CurrentUTC()
+	dbFile := &dbmodels.File{
+		FileName:         f.FileName,
+		FileType:         upload.ContentType,
+		URL:              s.storage.FileURL(key),
+		ImpartWealthID:   null.StringFrom(ctxUser.ImpartWealthID),
+		StorageKey:       null.StringFrom(key),
+		Size:             uint64(upload.Size),
+		Status:           dbmodels.FilesStatusPending,
+		ProcessingStatus: dbmodels.FilesProcessingStatusPending,
+		CreatedAt:        null.TimeFrom(now),
+		ExpiresAt:        null.TimeFrom(now.Add(s.MediaStorage.Upload.URLExpiry)),
+	}
+	if err := dbFile.Insert(ctx, s.db, boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to create upload", zap.Error(err))
+		return models.UploadSlot{}, impart.UnknownError
+	}
+	presigned, err := s.storage.PresignUpload(ctx, key, upload.ContentType, now)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to sign upload url", zap.Uint64("fileId", dbFile.Fid), zap.Error(err))
+		return models.UploadSlot{}, impart.UnknownError
+	}
+	return models.UploadSlot{
+		FileID:    dbFile.Fid,
+		UploadURL: presigned.URL,
+		Method:    presigned.Method,
+		Headers:   presigned.Headers,
+		ExpiresAt: presigned.ExpiresAt,
+	}, nil
+}
+
+// PutLocalUpload receives a file put to the signed url of a local storage, it is the local
+// counterpart of the presigned s3 put so the signature stands in for the user.
+func (s *service) PutLocalUpload(ctx context.Context, key, contentType, expires, signature string, body io.Reader) impart.Error {
+	if !s.storage.SignsLocally() {
+		return impart.NewError(impart.ErrNotFound, "uploads go to the media storage")
+	}
+	if err := s.MediaStorage.VerifyLocalSignature(http.MethodPut, key, contentType, expires, signature, impart.CurrentUTC()); err != nil {
+		return impart.NewError(impart.ErrUnauthorized, err.Error())
+	}
+	dbFile, err := dbmodels.Files(dbmodels.FileWhere.StorageKey.EQ(null.StringFrom(key))).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return impart.NewError(impart.ErrNotFound, "file not found")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch upload", zap.String("key", key), zap.Error(err))
+		return impart.UnknownError
+	}
+	if dbFile.Status == dbmodels.FilesStatusAttached {
+		return impart.NewError(impart.ErrBadRequest, "file is already attached")
+	}
+	err = s.storage.PutLocalUpload(ctx, key, contentType, int64(dbFile.Size), body)
+	if err == media.ErrFileSize {
+		return impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file must be %d bytes", dbFile.Size))
+	}
+	if err == media.ErrInvalidKey {
+		return impart.NewError(impart.ErrBadRequest, err.Error())
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to store upload", zap.Uint64("fileId", dbFile.Fid), zap.Error(err))
+		return impart.UnknownError
+	}
+	dbFile.Status = dbmodels.FilesStatusUploaded
+	if _, err := dbFile.Update(ctx, s.db, boil.Whitelist(dbmodels.FileColumns.Status)); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to update upload", zap.Uint64("fileId", dbFile.Fid), zap.Error(err))
+		return impart.UnknownError
+	}
+	return nil
+}
+
+// checkUploads verifies the files referenced by a post belong to the context user and were stored
+// with the declared type and size. Files already attached to the post are left out.
+func (s *service) checkUploads(ctx context.Context, postID uint64, fileIDs []uint64) (dbmodels.FileSlice, impart.Error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+	if len(fileIDs) > maxPostUploads {
+		return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("a post can't have more than %d files", maxPostUploads))
+	}
+	ctxUser := impart.GetCtxUser(ctx)
+	dbFiles, err := dbmodels.Files(
+		dbmodels.FileWhere.Fid.IN(fileIDs),
+		dbmodels.FileWhere.ImpartWealthID.EQ(null.StringFrom(ctxUser.ImpartWealthID)),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch uploads", zap.Error(err))
+		return nil, impart.UnknownError
+	}
+	found := make(map[uint64]*dbmodels.File, len(dbFiles))
+	for _, f := range dbFiles {
+		found[f.Fid] = f
+	}
+	seen := make(map[uint64]bool, len(fileIDs))
+	var out dbmodels.FileSlice
+	for _, id := range fileIDs {
+		f, ok := found[id]
+		if !ok {
+			return nil, impart.NewError(impart.ErrNotFound, fmt.Sprintf("file %d not found", id))
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if f.Status == dbmodels.FilesStatusAttached {
+			attached, err := dbmodels.PostFiles(
+				dbmodels.PostFileWhere.PostID.EQ(postID),
+				dbmodels.PostFileWhere.Fid.EQ(id),
+			).Exists(ctx, s.db)
+			if err != nil {
+				impart.CtxLogger(ctx, s.logger).Error("unable to fetch post files", zap.Uint64("fileId", id), zap.Error(err))
+				return nil, impart.UnknownError
+			}
+			if !attached {
+				return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file %d is attached to another post", id))
+			}
+			continue
+		}
+		info, err := s.storage.StatUpload(ctx, f.StorageKey.String)
+		if err == media.ErrNotUploaded {
+			return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file %d has not been uploaded", id))
+		}
+		if err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to stat upload", zap.Uint64("fileId", id), zap.Error(err))
+			return nil, impart.UnknownError
+		}
+		if info.Size != int64(f.Size) {
+			return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file %d is not the declared size", id))
+		}
+		if info.ContentType != "" && info.ContentType != f.FileType {
+			return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file %d is not the declared content type", id))
+		}
+		head, err := s.storage.ReadObject(ctx, f.StorageKey.String, sniffLen)
+		if err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to read upload", zap.Uint64("fileId", id), zap.Error(err))
+			return nil, impart.UnknownError
+		}
+		if media.DetectContentType(head) != f.FileType {
+			return nil, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("file %d is not the declared content type", id))
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// attachUploads adds checked files to the posts, a post to several hives shares the same files
+func (s *service) attachUploads(ctx context.Context, dbFiles dbmodels.FileSlice, postIDs ...uint64) []models.File {
+	out := make([]models.File, 0, len(dbFiles))
+	var fileIDs []uint64
+	defer func() { s.mediaProcessor.Enqueue(fileIDs...) }()
+	for _, f := range dbFiles {
+		f.Status = dbmodels.FilesStatusAttached
+		f.ProcessingStatus = dbmodels.FilesProcessingStatusPending
+		f.ExpiresAt = null.Time{}
+		if _, err := f.Update(ctx, s.db, boil.Whitelist(dbmodels.FileColumns.Status, dbmodels.FileColumns.ProcessingStatus, dbmodels.FileColumns.ExpiresAt)); err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to attach upload", zap.Uint64("fileId", f.Fid), zap.Error(err))
+			continue
+		}
+		for _, postID := range postIDs {
+			pf := &dbmodels.PostFile{PostID: postID, Fid: f.Fid}
+			if err := pf.Insert(ctx, s.db, boil.Infer()); err != nil {
+				impart.CtxLogger(ctx, s.logger).Error("unable to add post file", zap.Uint64("postId", postID), zap.Uint64("fileId", f.Fid), zap.Error(err))
+			}
+		}
+		fileIDs = append(fileIDs, f.Fid)
+		out = append(out, models.FileFromDBModel(f))
+	}
+	return out
+}
+
+// postedFileKey is where a file posted in the body of a post was stored
+func postedFileKey(cfg media.StorageConfigurations, f models.File) string {
+	return fmt.Sprintf("%s%s%s", cfg.MediaPath, f.FilePath, f.FileName)
+}
+
+// processPostedFiles queues the files of an edited post that were replaced by files posted in the body
+func (s *service) processPostedFiles(ctx context.Context, dbPost *dbmodels.Post, posted []models.File) {
+	if dbPost.R == nil {
+		return
+	}
+	byURL := make(map[string]models.File, len(posted))
+	for _, f := range posted {
+		byURL[f.URL] = f
+	}
+	var fileIDs []uint64
+	for _, pf := range dbPost.R.PostFiles {
+		f := pf.R.FidFile
+		if f == nil {
+			continue
+		}
+		postedFile, ok := byURL[f.URL]
+		if !ok {
+			continue
+		}
+		f.StorageKey = null.StringFrom(postedFileKey(s.MediaStorage, postedFile))
+		f.ProcessingStatus = dbmodels.FilesProcessingStatusPending
+		if _, err := f.Update(ctx, s.db, boil.Whitelist(dbmodels.FileColumns.StorageKey, dbmodels.FileColumns.ProcessingStatus)); err != nil {
+			impart.CtxLogger(ctx, s.logger).Error("unable to queue post file", zap.Uint64("fileId", f.Fid), zap.Error(err))
+			continue
+		}
+		fileIDs = append(fileIDs, f.Fid)
+	}
+	s.mediaProcessor.Enqueue(fileIDs...)
+}
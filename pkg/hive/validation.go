@@ -39,6 +39,7 @@ func ValidationPost(post models.Post) models.Post {
 	conform.Strings(&updatePost)
 	updatePost.TagIDs = post.TagIDs
 	updatePost.Hives = post.Hives
+	updatePost.FileIDs = post.FileIDs
 
 	// profanity detection and removal
 	updatePost.Subject, _ = impart.CensorWord(post.Subject)
@@ -55,6 +56,24 @@ func ValidateCommentInput(c models.Comment) models.Comment {
 	return c
 }
 
+// checkProfanity rejects content using a reject word and reports whether content using a hold
+// word must wait for review, admin content is not checked.
+func checkProfanity(isAdminActivity bool, texts ...string) (bool, impart.Error) {
+	if isAdminActivity {
+		return false, nil
+	}
+	held := false
+	for _, text := range texts {
+		switch impart.CheckProfanity(text).Severity {
+		case impart.RejectProfanity:
+			return false, impart.NewError(impart.ErrBadRequest, "content contains language that is not allowed", impart.Content)
+		case impart.HoldProfanity:
+			held = true
+		}
+	}
+	return held, nil
+}
+
 func ValidateInputs(post models.Post) impart.Error {
 	if (post.Video != models.PostVideo{}) {
 		if post.Video.ReferenceId == "" {
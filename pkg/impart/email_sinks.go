@@ -0,0 +1,153 @@
+package impart
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const defaultEmailSender = "support@impartwealth.com"
+
+// smtpEmailService delivers email to a plain smtp server without auth, meant for a local
+// catcher such as mailhog rather than a real relay.
+type smtpEmailService struct {
+	addr   string
+	from   string
+	logger *zap.Logger
+}
+
+func NewSMTPEmailService(addr, from string, logger *zap.Logger) EmailService {
+	if from == "" {
+		from = defaultEmailSender
+	}
+	return &smtpEmailService{
+		addr:   addr,
+		from:   from,
+		logger: logger,
+	}
+}
+
+func (es *smtpEmailService) EmailSending(ctx context.Context, recipient, template string) error {
+	email, err := templateEmail(recipient, template)
+	if err != nil {
+		return err
+	}
+	return es.Send(ctx, email)
+}
+
+func (es *smtpEmailService) Send(ctx context.Context, email EmailMessage) error {
+	msg, err := buildMIMEMessage(es.from, email, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := smtp.SendMail(es.addr, nil, es.from, []string{email.To}, msg); err != nil {
+		es.logger.Error("unable to send email over smtp", zap.String("addr", es.addr),
+			zap.String("to", email.To), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// fileEmailService writes every email as an .eml file into a directory, for development and tests
+type fileEmailService struct {
+	dir    string
+	from   string
+	logger *zap.Logger
+}
+
+func NewFileEmailService(dir, from string, logger *zap.Logger) EmailService {
+	if from == "" {
+		from = defaultEmailSender
+	}
+	return &fileEmailService{
+		dir:    dir,
+		from:   from,
+		logger: logger,
+	}
+}
+
+func (es *fileEmailService) EmailSending(ctx context.Context, recipient, template string) error {
+	email, err := templateEmail(recipient, template)
+	if err != nil {
+		return err
+	}
+	return es.Send(ctx, email)
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+func (es *fileEmailService) Send(ctx context.Context, email EmailMessage) error {
+	now := time.Now()
+	msg, err := buildMIMEMessage(es.from, email, now)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(es.dir, 0755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.eml", now.UnixNano(), unsafeFileChars.ReplaceAllString(email.To, "_"))
+	path := filepath.Join(es.dir, name)
+	if err := ioutil.WriteFile(path, msg, 0644); err != nil {
+		es.logger.Error("unable to write email file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	es.logger.Debug("email written", zap.String("path", path), zap.String("to", email.To))
+	return nil
+}
+
+// buildMIMEMessage assembles a multipart/alternative message with the text and html bodies
+func buildMIMEMessage(from string, email EmailMessage, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", email.Text},
+		{"text/html; charset=UTF-8", email.HTML},
+	}
+	for _, p := range parts {
+		if p.content == "" {
+			continue
+		}
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(p.content)); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", email.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", email.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", mw.Boundary())
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
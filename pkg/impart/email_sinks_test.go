@@ -0,0 +1,52 @@
+package impart
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMIMEMessage(t *testing.T) {
+	email := EmailMessage{
+		To:      "member@example.com",
+		Subject: Hive_mail_subject,
+		HTML:    "<p>hello</p>",
+		Text:    "hello",
+	}
+	b, err := buildMIMEMessage(defaultEmailSender, email, time.Date(2022, 1, 16, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(b))
+	require.NoError(t, err)
+	assert.Equal(t, "member@example.com", msg.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, Hive_mail_subject, subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	var contentTypes, bodies []string
+	for {
+		p, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		body, err := ioutil.ReadAll(p)
+		require.NoError(t, err)
+		contentTypes = append(contentTypes, strings.Split(p.Header.Get("Content-Type"), ";")[0])
+		bodies = append(bodies, string(body))
+	}
+	assert.Equal(t, []string{"text/plain", "text/html"}, contentTypes)
+	assert.Equal(t, []string{"hello", "<p>hello</p>"}, bodies)
+}
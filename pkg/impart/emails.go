@@ -14,18 +14,29 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"go.uber.org/zap"
 )
 
+// EmailMessage is a rendered message ready to be handed to an EmailService
+type EmailMessage struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
 type EmailService interface {
 	EmailSending(ctx context.Context, recipient, template string) error
+	Send(ctx context.Context, email EmailMessage) error
 }
 type noopEmailService struct {
 }
 
 type sesAppleEmailService struct {
 	stage string
+	from  string
 	*ses.SES
 	*zap.Logger
 	db *sql.DB
@@ -40,9 +51,11 @@ func NewImpartEmailService(db *sql.DB, stage, region string, logger *zap.Logger)
 	if err != nil {
 		logger.Fatal("unable to create aws session", zap.Error(err))
 	}
+	metrics.InstrumentAWS(sess)
 
 	sesAppleEmailService := &sesAppleEmailService{
 		stage:  stage,
+		from:   defaultEmailSender,
 		Logger: logger,
 		SES:    ses.New(sess),
 		db:     db,
@@ -54,6 +67,23 @@ func NewImpartEmailService(db *sql.DB, stage, region string, logger *zap.Logger)
 	return sesAppleEmailService
 }
 
+// NewEmailService returns the EmailService for the configured sink, ses unless told otherwise.
+func NewEmailService(cfg *config.Impart, db *sql.DB, logger *zap.Logger) EmailService {
+	switch cfg.Email.Sink {
+	case config.SMTPEmailSink:
+		return NewSMTPEmailService(cfg.Email.SMTPAddr, cfg.Email.From, logger)
+	case config.FileEmailSink:
+		return NewFileEmailService(cfg.Email.FileDir, cfg.Email.From, logger)
+	case config.NoopEmailSink:
+		return NewNoopEmailService()
+	}
+	es := NewImpartEmailService(db, string(cfg.Env), cfg.Region, logger).(*sesAppleEmailService)
+	if cfg.Email.From != "" {
+		es.from = cfg.Email.From
+	}
+	return es
+}
+
 func NewNoopEmailService() EmailService {
 	return &noopEmailService{}
 }
@@ -62,11 +92,12 @@ func (ns *noopEmailService) EmailSending(ctx context.Context, recipient, templat
 	return nil
 }
 
-func (ns *sesAppleEmailService) EmailSending(ctx context.Context, recipient, template string) error {
-
-	// sender := "Yvette Butler <beta@impartwealth.com>"
-	sender := "support@impartwealth.com"
+func (ns *noopEmailService) Send(ctx context.Context, email EmailMessage) error {
+	return nil
+}
 
+// templateEmail renders one of the fixed account emails from ./schemas/html
+func templateEmail(recipient, template string) (EmailMessage, error) {
 	subject := Hive_mail_subject
 	textBody := Hive_mail_previewtext
 	if template == Waitlist_mail {
@@ -82,19 +113,30 @@ func (ns *sesAppleEmailService) EmailSending(ctx context.Context, recipient, tem
 	newtemp, err := temp.ParseFiles(fmt.Sprintf("%s", "./schemas/html/"+template+".html"))
 	if err != nil {
 		Logger.Error("template failed", zap.Any("err", err))
+		return EmailMessage{}, err
 	}
 	buf := new(bytes.Buffer)
 	if err = newtemp.Execute(buf, templateData); err != nil {
 		Logger.Error("template failed", zap.Any("err", err))
+		return EmailMessage{}, err
 	}
-	htmlBody := buf.String()
-
-	// htmlBody, err := ioutil.ReadFile(fmt.Sprintf("%s", "./schemas/html/"+template+".html"))
+	return EmailMessage{
+		To:      recipient,
+		Subject: subject,
+		HTML:    buf.String(),
+		Text:    textBody,
+	}, nil
+}
 
+func (ns *sesAppleEmailService) EmailSending(ctx context.Context, recipient, template string) error {
+	email, err := templateEmail(recipient, template)
 	if err != nil {
-		Logger.Error("err", zap.Any("err", err))
+		return err
 	}
+	return ns.Send(ctx, email)
+}
 
+func (ns *sesAppleEmailService) Send(ctx context.Context, email EmailMessage) error {
 	// The character encoding for the email.
 	charSet := "UTF-8"
 
@@ -104,33 +146,33 @@ func (ns *sesAppleEmailService) EmailSending(ctx context.Context, recipient, tem
 		Destination: &ses.Destination{
 			CcAddresses: []*string{},
 			ToAddresses: []*string{
-				aws.String(recipient),
+				aws.String(email.To),
 			},
 		},
 		Message: &ses.Message{
 			Body: &ses.Body{
 				Html: &ses.Content{
 					Charset: aws.String(charSet),
-					Data:    aws.String(string(htmlBody)),
+					Data:    aws.String(email.HTML),
 				},
 				Text: &ses.Content{
 					Charset: aws.String(charSet),
-					Data:    aws.String(textBody),
+					Data:    aws.String(email.Text),
 				},
 			},
 			Subject: &ses.Content{
 				Charset: aws.String(charSet),
-				Data:    aws.String(subject),
+				Data:    aws.String(email.Subject),
 			},
 		},
-		Source: aws.String(sender),
+		Source: aws.String(ns.from),
 
 		// Uncomment to use a configuration set
 		//ConfigurationSetName: aws.String(ConfigurationSet),
 	}
 
 	// Attempt to send the email.
-	result, err := ns.SendEmail(input)
+	result, err := ns.SendEmailWithContext(ctx, input)
 
 	// Display error messages if they occur.
 	if err != nil {
@@ -163,14 +205,14 @@ func (ns *sesAppleEmailService) EmailSending(ctx context.Context, recipient, tem
 		}
 	}
 
-	fmt.Println("Email Sent to address: " + recipient)
+	fmt.Println("Email Sent to address: " + email.To)
 	fmt.Println(result)
 	return nil
 }
 
 func SendAWSEMails(ctx context.Context, db *sql.DB, user *dbmodels.User, mailType string) {
 	cfg, _ := config.GetImpart()
-	emailSending := NewImpartEmailService(db, string(cfg.Env), cfg.Region, Logger)
+	emailSending := NewEmailService(cfg, db, Logger)
 	err := emailSending.EmailSending(ctx, user.Email, mailType)
 	if err != nil {
 		Logger.Error("Hive eamil sending Falied", zap.Any("error", err),
@@ -16,6 +16,13 @@ var (
 	HiveName       ErrorKey = "HiveName"
 	HiveRuleName   ErrorKey = "ruleName"
 	Limit          ErrorKey = "limit"
+	Category       ErrorKey = "category"
+	Timezone       ErrorKey = "timezone"
+	QuietHours     ErrorKey = "quietHours"
+	URL            ErrorKey = "url"
+	Word           ErrorKey = "word"
+	Severity       ErrorKey = "severity"
+	Sanction       ErrorKey = "sanction"
 )
 
 // From the arguments, first index should be key
@@ -40,6 +40,9 @@ var ErrInvalidAPIKey = errors.New("invalid api key")
 //Error validation Error
 var ErrValidationError = errors.New("validation error")
 
+// ErrTooManyRequests is returned when the client went over a rate limit
+var ErrTooManyRequests = errors.New("too many requests")
+
 type Error interface {
 	error
 	HttpStatus() int
@@ -100,6 +103,8 @@ func (e impartError) HttpStatus() int {
 		statusCode = http.StatusConflict
 	case ErrNoOp:
 		statusCode = http.StatusNotModified
+	case ErrTooManyRequests:
+		statusCode = http.StatusTooManyRequests
 	default:
 		statusCode = http.StatusInternalServerError
 	}
@@ -0,0 +1,77 @@
+package impart
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"go.uber.org/zap"
+)
+
+// inboxNotificationService wraps a NotificationService and records every notification
+// in the recipients in-app inbox before it is pushed. The inbox is recorded regardless
+// of push preferences, those only decide whether the device is interrupted.
+type inboxNotificationService struct {
+	NotificationService
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewInboxNotificationService(ns NotificationService, db *sql.DB, logger *zap.Logger) NotificationService {
+	return &inboxNotificationService{
+		NotificationService: ns,
+		db:                  db,
+		logger:              logger,
+	}
+}
+
+func (is *inboxNotificationService) Notify(ctx context.Context, data NotificationData, alert Alert, impartWealthID string) error {
+	if strings.TrimSpace(impartWealthID) != "" {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		n := &dbmodels.UserNotification{
+			ImpartWealthID: impartWealthID,
+			Category:       data.Category.String(),
+			Title:          aws.StringValue(alert.Title),
+			Body:           aws.StringValue(alert.Body),
+			Data:           b,
+			CreatedAt:      CurrentUTC(),
+		}
+		if err := n.Insert(ctx, is.db, boil.Infer()); err != nil {
+			is.logger.Error("notification-inbox : unable to record notification",
+				zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		}
+	}
+	return is.NotificationService.Notify(ctx, data, alert, impartWealthID)
+}
+
+// NotifyTopic records the notification for every member of the hive the topic belongs to
+func (is *inboxNotificationService) NotifyTopic(ctx context.Context, data NotificationData, alert Alert, topicARN string) error {
+	if strings.TrimSpace(topicARN) == "" {
+		return nil
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = queries.Raw(`
+		insert into user_notifications (impart_wealth_id, category, title, body, data, created_at)
+		select hive_members.member_impart_wealth_id, ?, ?, ?, ?, ?
+		from hive_members
+		join hive on hive.hive_id = hive_members.member_hive_id and hive.deleted_at is null
+		join user on user.impart_wealth_id = hive_members.member_impart_wealth_id and user.deleted_at is null
+		where hive.notification_topic_arn = ?
+	`, data.Category.String(), aws.StringValue(alert.Title), aws.StringValue(alert.Body), string(b), CurrentUTC(), topicARN).ExecContext(ctx, is.db)
+	if err != nil {
+		is.logger.Error("notification-inbox : unable to record topic notification",
+			zap.String("topicARN", topicARN), zap.Error(err))
+	}
+	return is.NotificationService.NotifyTopic(ctx, data, alert, topicARN)
+}
@@ -0,0 +1,115 @@
+package impart
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// DevicePlatform is the push platform of a user device, each has its own SNS platform application
+type DevicePlatform string
+
+const (
+	IOSPlatform     DevicePlatform = "ios"
+	AndroidPlatform DevicePlatform = "android"
+)
+
+var DevicePlatforms = []DevicePlatform{IOSPlatform, AndroidPlatform}
+
+// ParseDevicePlatform returns the platform for the given name,
+// devices registered before android support have no platform and are ios.
+func ParseDevicePlatform(platform string) DevicePlatform {
+	p := DevicePlatform(strings.ToLower(strings.TrimSpace(platform)))
+	if p == "" {
+		return IOSPlatform
+	}
+	return p
+}
+
+func (p DevicePlatform) String() string {
+	return string(p)
+}
+
+func (p DevicePlatform) Valid() bool {
+	for _, v := range DevicePlatforms {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+type gcmNotification struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	Sound string  `json:"sound,omitempty"`
+}
+
+// gcmMessage is the FCM legacy payload SNS forwards to android devices,
+// data values have to be strings so the custom data is sent json encoded.
+type gcmMessage struct {
+	Notification gcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data"`
+}
+
+func apnsPayload(data NotificationData, alert Alert) (string, error) {
+	b, err := json.Marshal(apnsMessageWrapper{
+		APNSData: APNSMessage{
+			Alert: alert,
+			Sound: aws.String("default"),
+			Data:  data,
+			Badge: aws.Int(0),
+		},
+	})
+	return string(b), err
+}
+
+func gcmPayload(data NotificationData, alert Alert) (string, error) {
+	customData, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(gcmMessage{
+		Notification: gcmNotification{
+			Title: alert.Title,
+			Body:  alert.Body,
+			Sound: "default",
+		},
+		Data: map[string]string{
+			"custom_data": string(customData),
+		},
+	})
+	return string(b), err
+}
+
+// buildSNSMessage builds the SNS json message structure with a payload for each of the platforms;
+// topic messages include every platform as endpoints of all platforms are subscribed.
+func buildSNSMessage(data NotificationData, alert Alert, platforms ...DevicePlatform) (string, error) {
+	if alert.Body == nil {
+		return "", fmt.Errorf("notification alert has no body")
+	}
+	msg := awsSNSMessage{Default: *alert.Body}
+	for _, platform := range platforms {
+		switch platform {
+		case IOSPlatform:
+			payload, err := apnsPayload(data, alert)
+			if err != nil {
+				return "", err
+			}
+			msg.APNS = payload
+			msg.APNSSandbox = payload
+		case AndroidPlatform:
+			payload, err := gcmPayload(data, alert)
+			if err != nil {
+				return "", err
+			}
+			msg.GCM = payload
+		default:
+			return "", fmt.Errorf("unsupported device platform %s", platform)
+		}
+	}
+	b, err := json.Marshal(msg)
+	return string(b), err
+}
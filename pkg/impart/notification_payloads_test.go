@@ -0,0 +1,122 @@
+package impart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIOSApplicationARN     = "arn:aws:sns:us-east-1:000000000000:app/APNS/impart_wealth"
+	testAndroidApplicationARN = "arn:aws:sns:us-east-1:000000000000:app/GCM/impart_wealth"
+)
+
+var testAlert = Alert{Title: aws.String("unit test title"), Body: aws.String("unit test body")}
+
+func TestParseDevicePlatform(t *testing.T) {
+	assert.Equal(t, IOSPlatform, ParseDevicePlatform(""))
+	assert.Equal(t, AndroidPlatform, ParseDevicePlatform(" Android "))
+	assert.True(t, ParseDevicePlatform("ios").Valid())
+	assert.False(t, ParseDevicePlatform("windows").Valid())
+}
+
+func TestBuildSNSMessage(t *testing.T) {
+	data := NotificationData{PostID: 12, Category: VoteNotification}
+
+	msg, err := buildSNSMessage(data, testAlert, DevicePlatforms...)
+	require.NoError(t, err)
+	var out map[string]string
+	require.NoError(t, json.Unmarshal([]byte(msg), &out))
+	assert.Equal(t, "unit test body", out["default"])
+	assert.Contains(t, out["APNS"], `"aps"`)
+	assert.Equal(t, out["APNS"], out["APNS_SANDBOX"])
+
+	var gcm gcmMessage
+	require.NoError(t, json.Unmarshal([]byte(out["GCM"]), &gcm))
+	assert.Equal(t, "unit test title", *gcm.Notification.Title)
+	var custom NotificationData
+	require.NoError(t, json.Unmarshal([]byte(gcm.Data["custom_data"]), &custom))
+	assert.Equal(t, uint64(12), custom.PostID)
+	assert.Equal(t, VoteNotification, custom.Category)
+
+	msg, err = buildSNSMessage(data, testAlert, AndroidPlatform)
+	require.NoError(t, err)
+	out = nil
+	require.NoError(t, json.Unmarshal([]byte(msg), &out))
+	assert.NotContains(t, out, "APNS")
+	assert.Contains(t, out, "GCM")
+
+	_, err = buildSNSMessage(data, Alert{}, IOSPlatform)
+	assert.Error(t, err)
+	_, err = buildSNSMessage(data, testAlert, DevicePlatform("windows"))
+	assert.Error(t, err)
+}
+
+func TestNotifyDeviceByPlatform(t *testing.T) {
+	stub := newSNSStub(t)
+	ns := stub.service(t, map[DevicePlatform]string{
+		IOSPlatform:     testIOSApplicationARN,
+		AndroidPlatform: testAndroidApplicationARN,
+	})
+	ctx := context.Background()
+
+	arn, err := ns.NotifyDevice(ctx, nd, testAlert, AndroidPlatform, "android-token", "")
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:sns:us-east-1:000000000000:endpoint/GCM/impart_wealth/android-token", arn)
+	published := stub.lastPublished()
+	assert.Equal(t, arn, published["TargetArn"])
+	assert.Equal(t, "json", published["MessageStructure"])
+	assert.Contains(t, published["Message"], `"GCM"`)
+	assert.NotContains(t, published["Message"], `"APNS"`)
+
+	arn, err = ns.NotifyDevice(ctx, nd, testAlert, IOSPlatform, "ios-token", "")
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:sns:us-east-1:000000000000:endpoint/APNS/impart_wealth/ios-token", arn)
+	assert.Contains(t, stub.lastPublished()["Message"], `"APNS"`)
+	assert.NotContains(t, stub.lastPublished()["Message"], `"GCM"`)
+}
+
+func TestSyncTokenEndpoint(t *testing.T) {
+	stub := newSNSStub(t)
+	ns := stub.service(t, map[DevicePlatform]string{
+		IOSPlatform: testIOSApplicationARN,
+	})
+	ctx := context.Background()
+
+	// no android platform application configured
+	_, err := ns.SyncTokenEndpoint(ctx, AndroidPlatform, "android-token", "")
+	assert.Error(t, err)
+
+	arn, err := ns.SyncTokenEndpoint(ctx, IOSPlatform, "ios-token", "")
+	require.NoError(t, err)
+
+	// a disabled endpoint with a stale token is updated and re-enabled
+	stub.endpoints[arn]["Enabled"] = "false"
+	synced, err := ns.SyncTokenEndpoint(ctx, IOSPlatform, "new-ios-token", arn)
+	require.NoError(t, err)
+	assert.Equal(t, arn, synced)
+	assert.Equal(t, "true", stub.endpoints[arn]["Enabled"])
+	assert.Equal(t, "new-ios-token", stub.endpoints[arn]["Token"])
+
+	// a deleted endpoint is created again
+	delete(stub.endpoints, arn)
+	synced, err = ns.SyncTokenEndpoint(ctx, IOSPlatform, "ios-token", arn)
+	require.NoError(t, err)
+	assert.Contains(t, stub.endpoints, synced)
+}
+
+func TestNotifyTopicAllPlatforms(t *testing.T) {
+	stub := newSNSStub(t)
+	ns := stub.service(t, nil)
+	topicARN := "arn:aws:sns:us-east-1:000000000000:hive-1"
+
+	require.NoError(t, ns.NotifyTopic(context.Background(), nd, testAlert, topicARN))
+	published := stub.lastPublished()
+	assert.Equal(t, topicARN, published["TopicArn"])
+	assert.Contains(t, published["Message"], `"APNS"`)
+	assert.Contains(t, published["Message"], `"GCM"`)
+}
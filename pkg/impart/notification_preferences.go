@@ -0,0 +1,235 @@
+package impart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+type NotificationCategory string
+
+const (
+	PostCommentNotification       NotificationCategory = "post_comment"
+	CommentReplyNotification      NotificationCategory = "comment_reply"
+	VoteNotification              NotificationCategory = "vote"
+	WeeklyDigestNotification      NotificationCategory = "weekly_digest"
+	TrendingPostNotification      NotificationCategory = "trending_post"
+	AdminAnnouncementNotification NotificationCategory = "admin_announcement"
+	HiveWelcomeNotification       NotificationCategory = "hive_welcome"
+	MentionNotification           NotificationCategory = "mention"
+	FollowedPostNotification      NotificationCategory = "followed_post"
+
+	// AccountNotification is about the account itself, like a data export being ready, and
+	// ModerationNotification about content hidden or restored and sanctions. They are not in
+	// NotificationCategories since a member can't opt out of them.
+	AccountNotification    NotificationCategory = "account"
+	ModerationNotification NotificationCategory = "moderation"
+)
+
+// NotificationCategories are all the categories a user can opt out of
+var NotificationCategories = []NotificationCategory{
+	PostCommentNotification,
+	CommentReplyNotification,
+	VoteNotification,
+	WeeklyDigestNotification,
+	TrendingPostNotification,
+	AdminAnnouncementNotification,
+	HiveWelcomeNotification,
+	MentionNotification,
+	FollowedPostNotification,
+}
+
+func (c NotificationCategory) String() string {
+	return string(c)
+}
+
+func (c NotificationCategory) Valid() bool {
+	for _, v := range NotificationCategories {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+const minutesPerDay = 24 * 60
+
+// InQuietHours reports whether now falls in the quiet window [start, end), both given
+// as minutes from midnight in loc. Windows may wrap midnight, start == end means no quiet hours.
+func InQuietHours(start, end uint16, loc *time.Location, now time.Time) bool {
+	if start == end || start >= minutesPerDay || end >= minutesPerDay {
+		return false
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	minute := uint16(local.Hour()*60 + local.Minute())
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// preferenceNotificationService wraps a NotificationService and drops pushes for users
+// who opted out of the notification category, or who are currently in their quiet hours.
+// Suppressed pushes are not queued, they are dropped.
+type preferenceNotificationService struct {
+	NotificationService
+	db     *sql.DB
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func NewPreferenceNotificationService(ns NotificationService, db *sql.DB, logger *zap.Logger) NotificationService {
+	return &preferenceNotificationService{
+		NotificationService: ns,
+		db:                  db,
+		logger:              logger,
+		now:                 CurrentUTC,
+	}
+}
+
+func (ps *preferenceNotificationService) Notify(ctx context.Context, data NotificationData, alert Alert, impartWealthID string) error {
+	suppressed, err := ps.suppressedRecipients(ctx, data.Category, []string{impartWealthID})
+	if err != nil {
+		return err
+	}
+	if suppressed[impartWealthID] {
+		ps.logger.Debug("push-notification : suppressed by user preferences",
+			zap.String("impartWealthID", impartWealthID),
+			zap.String("category", data.Category.String()))
+		return nil
+	}
+	return ps.NotificationService.Notify(ctx, data, alert, impartWealthID)
+}
+
+// NotifyTopic publishes to the hive topic when no member has the push suppressed. Only the
+// members with an opt out or quiet hours are looked up on the way, when one of them is
+// suppressed the hive is fanned out to each remaining member in the background.
+func (ps *preferenceNotificationService) NotifyTopic(ctx context.Context, data NotificationData, alert Alert, topicARN string) error {
+	if strings.TrimSpace(topicARN) == "" {
+		return nil
+	}
+	var candidates []topicMember
+	err := queries.Raw(`
+		select hive_members.member_impart_wealth_id as impart_wealth_id
+		from hive_members
+		join hive on hive.hive_id = hive_members.member_hive_id and hive.deleted_at is null
+		where hive.notification_topic_arn = ?
+		and (hive_members.member_impart_wealth_id in (
+				select impart_wealth_id from user_notification_preferences where category = ? and enabled = false
+			)
+			or hive_members.member_impart_wealth_id in (
+				select impart_wealth_id from user_configurations where quiet_hours_start is not null and quiet_hours_end is not null
+			))
+	`, topicARN, data.Category.String()).Bind(ctx, ps.db, &candidates)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(candidates))
+	for i, m := range candidates {
+		ids[i] = m.ImpartWealthID
+	}
+	suppressed, err := ps.suppressedRecipients(ctx, data.Category, ids)
+	if err != nil {
+		return err
+	}
+	if len(suppressed) == 0 {
+		return ps.NotificationService.NotifyTopic(ctx, data, alert, topicARN)
+	}
+	go ps.fanOut(CtxLogger(ctx, ps.logger), data, alert, topicARN, suppressed)
+	return nil
+}
+
+type topicMember struct {
+	ImpartWealthID string `boil:"impart_wealth_id"`
+}
+
+// fanOut notifies the members of the hive topic one by one, skipping the suppressed ones. It
+// runs after the request is served so it doesn't use its context.
+func (ps *preferenceNotificationService) fanOut(logger *zap.Logger, data NotificationData, alert Alert, topicARN string, suppressed map[string]bool) {
+	ctx := context.Background()
+	var members []topicMember
+	err := queries.Raw(`
+		select hive_members.member_impart_wealth_id as impart_wealth_id
+		from hive_members
+		join hive on hive.hive_id = hive_members.member_hive_id and hive.deleted_at is null
+		join user on user.impart_wealth_id = hive_members.member_impart_wealth_id and user.deleted_at is null
+		where hive.notification_topic_arn = ?
+	`, topicARN).Bind(ctx, ps.db, &members)
+	if err != nil {
+		logger.Error("push-notification : unable to fetch topic members to fan out", zap.String("topicARN", topicARN), zap.Error(err))
+		return
+	}
+
+	logger.Debug("push-notification : fanning out topic notification",
+		zap.String("topicARN", topicARN),
+		zap.Int("members", len(members)),
+		zap.Int("suppressed", len(suppressed)))
+
+	for _, m := range members {
+		if suppressed[m.ImpartWealthID] {
+			continue
+		}
+		if err := ps.NotificationService.Notify(ctx, data, alert, m.ImpartWealthID); err != nil {
+			logger.Error("push-notification : error sending topic fan out notification",
+				zap.String("impartWealthID", m.ImpartWealthID), zap.Error(err))
+		}
+	}
+}
+
+// suppressedRecipients returns the subset of impartWealthIDs which should not receive a push
+// of the category right now.
+func (ps *preferenceNotificationService) suppressedRecipients(ctx context.Context, category NotificationCategory, impartWealthIDs []string) (map[string]bool, error) {
+	out := make(map[string]bool)
+	if len(impartWealthIDs) == 0 {
+		return out, nil
+	}
+	ids := make([]interface{}, len(impartWealthIDs))
+	for i, id := range impartWealthIDs {
+		ids[i] = id
+	}
+
+	// opt outs stored before a category became mandatory are ignored
+	if category.Valid() {
+		optOuts, err := dbmodels.UserNotificationPreferences(
+			dbmodels.UserNotificationPreferenceWhere.Category.EQ(category.String()),
+			dbmodels.UserNotificationPreferenceWhere.Enabled.EQ(false),
+			qm.WhereIn(fmt.Sprintf("%s in ?", dbmodels.UserNotificationPreferenceColumns.ImpartWealthID), ids...),
+		).All(ctx, ps.db)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range optOuts {
+			out[p.ImpartWealthID] = true
+		}
+	}
+
+	configs, err := dbmodels.UserConfigurations(
+		dbmodels.UserConfigurationWhere.QuietHoursStart.IsNotNull(),
+		dbmodels.UserConfigurationWhere.QuietHoursEnd.IsNotNull(),
+		qm.WhereIn(fmt.Sprintf("%s in ?", dbmodels.UserConfigurationColumns.ImpartWealthID), ids...),
+	).All(ctx, ps.db)
+	if err != nil {
+		return nil, err
+	}
+	now := ps.now()
+	for _, c := range configs {
+		loc, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		if InQuietHours(c.QuietHoursStart.Uint16, c.QuietHoursEnd.Uint16, loc, now) {
+			out[c.ImpartWealthID] = true
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package impart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInQuietHours(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone database unavailable")
+	}
+	// 03:30 UTC is 23:30 the previous day in New York (EDT)
+	now := time.Date(2021, 7, 1, 3, 30, 0, 0, time.UTC)
+
+	type testCase struct {
+		start, end uint16
+		loc        *time.Location
+		expected   bool
+	}
+	cases := []testCase{
+		{0, 0, time.UTC, false},
+		{3 * 60, 4 * 60, time.UTC, true},
+		{4 * 60, 5 * 60, time.UTC, false},
+		{3*60 + 30, 4 * 60, time.UTC, true},
+		{3 * 60, 3*60 + 30, time.UTC, false},
+		{22 * 60, 7 * 60, time.UTC, true},
+		{22 * 60, 3 * 60, time.UTC, false},
+		{22 * 60, 7 * 60, newYork, true},
+		{23*60 + 45, 7 * 60, newYork, false},
+		{0, 7 * 60, newYork, false},
+		{3 * 60, 4 * 60, nil, true},
+		{minutesPerDay, 60, time.UTC, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, InQuietHours(c.start, c.end, c.loc, now), "start %d end %d loc %v", c.start, c.end, c.loc)
+	}
+}
+
+func TestNotificationCategoryValid(t *testing.T) {
+	for _, c := range NotificationCategories {
+		assert.True(t, c.Valid())
+	}
+	assert.False(t, NotificationCategory("unknown").Valid())
+	assert.False(t, NotificationCategory("").Valid())
+	assert.False(t, AccountNotification.Valid(), "members can't opt out of account notifications")
+	assert.False(t, ModerationNotification.Valid(), "members can't opt out of moderation notifications")
+}
@@ -3,13 +3,13 @@ package impart
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -28,7 +28,7 @@ const notificationProfilePropertyPath = "notificationProfile.awsPlatformEndpoint
 
 type NotificationService interface {
 	Notify(ctx context.Context, data NotificationData, alert Alert, impartWealthID string) error
-	NotifyAppleDevice(ctx context.Context, data NotificationData, alert Alert, deviceToken, platformEndpointARN string) (sentPlatformEndpointARN string, err error)
+	NotifyDevice(ctx context.Context, data NotificationData, alert Alert, platform DevicePlatform, deviceToken, platformEndpointARN string) (sentPlatformEndpointARN string, err error)
 	NotifyTopic(ctx context.Context, data NotificationData, alert Alert, topicARN string) error
 	// subscribtion to topic methods
 	SubscribeTopic(ctx context.Context, impartWealthID, topicARN, platformEndpointARN string) error
@@ -36,23 +36,27 @@ type NotificationService interface {
 	UnsubscribeAll(ctx context.Context, impartWealthID string) error
 	UnsubscribeTopicForDevice(ctx context.Context, impartWealthID, topicARN, platformEndpointARN string) error
 	UnsubscribeTopicForAllDevice(ctx context.Context, impartWealthID, topicARN string) (err error)
+	// RemoveAllEndpoints removes the topic subscriptions and platform endpoints of every device of
+	// the member, it is used to purge a deleted account and can be retried.
+	RemoveAllEndpoints(ctx context.Context, impartWealthID string) error
 
 	// SyncTokenEndpoint is meant to be called when a profiles deviceToken has been updated - this will ensure that the platformApplication
 	// has the right device token, and the endpoint is enabled.
-	SyncTokenEndpoint(ctx context.Context, deviceToken, platformEndpointARN string) (string, error)
-	GetEndPointArn(ctx context.Context, deviceToken, platformEndpointARN string) (string, error)
+	SyncTokenEndpoint(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error)
+	GetEndPointArn(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error)
 
 	CreateNotificationTopic(ctx context.Context, topicARN string) (*sns.CreateTopicOutput, error)
 }
 
 type NotificationData struct {
-	EventDatetime  time.Time `json:"eventDatetime"`
-	PostID         uint64    `json:"postId,omitempty"`
-	CommentID      uint64    `json:"commentId,omitempty"`
-	HiveID         uint64    `json:"hiveId,omitempty"`
-	Path           string    `json:"path,omitempty"`
-	Email          string    `json:"email,omitempty"`
-	ImpartWealthID string    `json:"impartWealthId,omitempty"`
+	EventDatetime  time.Time            `json:"eventDatetime"`
+	PostID         uint64               `json:"postId,omitempty"`
+	CommentID      uint64               `json:"commentId,omitempty"`
+	HiveID         uint64               `json:"hiveId,omitempty"`
+	Path           string               `json:"path,omitempty"`
+	Email          string               `json:"email,omitempty"`
+	ImpartWealthID string               `json:"impartWealthId,omitempty"`
+	Category       NotificationCategory `json:"category,omitempty"`
 }
 
 type noopNotificationService struct {
@@ -66,7 +70,7 @@ func (n noopNotificationService) UnsubscribeAll(ctx context.Context, impartWealt
 	return nil
 }
 
-func (n noopNotificationService) SyncTokenEndpoint(ctx context.Context, deviceToken, platformEndpointARN string) (string, error) {
+func (n noopNotificationService) SyncTokenEndpoint(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
 	return "", nil
 }
 
@@ -82,7 +86,7 @@ func (n noopNotificationService) UnsubscribeTopic(ctx context.Context, impartWea
 	return nil
 }
 
-func (n noopNotificationService) NotifyAppleDevice(ctx context.Context, data NotificationData, alert Alert, deviceToken, platformEndpointARN string) (string, error) {
+func (n noopNotificationService) NotifyDevice(ctx context.Context, data NotificationData, alert Alert, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
 	return "", nil
 }
 
@@ -90,7 +94,7 @@ func (n noopNotificationService) UnsubscribeTopicForDevice(ctx context.Context,
 	return nil
 }
 
-func (n noopNotificationService) GetEndPointArn(ctx context.Context, deviceToken, platformEndpointARN string) (string, error) {
+func (n noopNotificationService) GetEndPointArn(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
 	return "", nil
 }
 
@@ -98,6 +102,10 @@ func (n noopNotificationService) UnsubscribeTopicForAllDevice(ctx context.Contex
 	return nil
 }
 
+func (n noopNotificationService) RemoveAllEndpoints(ctx context.Context, impartWealthID string) error {
+	return nil
+}
+
 func (ns *noopNotificationService) EmailSending(ctx context.Context, topicARN string) error {
 	return nil
 }
@@ -106,13 +114,14 @@ func NewNoopNotificationService() NotificationService {
 	return &noopNotificationService{}
 }
 
-type snsAppleNotificationService struct {
+type snsNotificationService struct {
 	stage string
 	*sns.SNS
 	*ses.SES
 	*zap.Logger
-	platformApplicationARN string
-	db                     *sql.DB
+	// SNS platform application of each device platform
+	platformApplicationARNs map[DevicePlatform]string
+	db                      *sql.DB
 }
 
 type Alert struct {
@@ -138,13 +147,13 @@ type apnsMessageWrapper struct {
 }
 
 type awsSNSMessage struct {
-	APNS        string `json:"APNS"`
-	APNSSandbox string `json:"APNS_SANDBOX"`
+	APNS        string `json:"APNS,omitempty"`
+	APNSSandbox string `json:"APNS_SANDBOX,omitempty"`
 	Default     string `json:"default"`
-	GCM         string `json:"GCM"`
+	GCM         string `json:"GCM,omitempty"`
 }
 
-func NewImpartNotificationService(db *sql.DB, stage, region, platformApplicationARN string, logger *zap.Logger) NotificationService {
+func NewImpartNotificationService(db *sql.DB, stage, region, iosPlatformApplicationARN, androidPlatformApplicationARN string, logger *zap.Logger) NotificationService {
 
 	//SNS not available in us-east-2
 	if strings.EqualFold(region, "us-east-2") {
@@ -157,25 +166,32 @@ func NewImpartNotificationService(db *sql.DB, stage, region, platformApplication
 	if err != nil {
 		logger.Fatal("unable to create aws session", zap.Error(err))
 	}
+	metrics.InstrumentAWS(sess)
 
-	snsAppleNotificationService := &snsAppleNotificationService{
-		stage:                  stage,
-		Logger:                 logger,
-		SNS:                    sns.New(sess),
-		platformApplicationARN: platformApplicationARN,
-		db:                     db,
-	}
+	snsNotificationService := newSNSNotificationService(db, stage, sns.New(sess), map[DevicePlatform]string{
+		IOSPlatform:     iosPlatformApplicationARN,
+		AndroidPlatform: androidPlatformApplicationARN,
+	}, logger)
 
 	logger.Debug("created new NotificationService",
 		zap.String("stage", stage),
-		zap.String("arn", platformApplicationARN))
+		zap.String("iosArn", iosPlatformApplicationARN),
+		zap.String("androidArn", androidPlatformApplicationARN))
 
-	return snsAppleNotificationService
+	return NewInboxNotificationService(NewPreferenceNotificationService(snsNotificationService, db, logger), db, logger)
 }
 
-func (ns *snsAppleNotificationService) NotifyTopic(ctx context.Context, data NotificationData, alert Alert, topicARN string) error {
-	var b []byte
-	var err error
+func newSNSNotificationService(db *sql.DB, stage string, client *sns.SNS, platformApplicationARNs map[DevicePlatform]string, logger *zap.Logger) *snsNotificationService {
+	return &snsNotificationService{
+		stage:                   stage,
+		Logger:                  logger,
+		SNS:                     client,
+		platformApplicationARNs: platformApplicationARNs,
+		db:                      db,
+	}
+}
+
+func (ns *snsNotificationService) NotifyTopic(ctx context.Context, data NotificationData, alert Alert, topicARN string) error {
 	if strings.TrimSpace(topicARN) == "" {
 		return nil
 	}
@@ -183,31 +199,16 @@ func (ns *snsAppleNotificationService) NotifyTopic(ctx context.Context, data Not
 	ns.Logger.Debug("sending push notification",
 		zap.Any("data", data),
 		zap.Any("msg", alert),
-		zap.String("platformEndpoint", topicARN),
-		zap.String("arn", ns.platformApplicationARN))
-
-	if b, err = json.Marshal(apnsMessageWrapper{
-		APNSData: APNSMessage{
-			Alert: alert,
-			Sound: aws.String("default"),
-			Data:  data,
-			Badge: aws.Int(0),
-		},
-	}); err != nil {
-		return err
-	}
-
-	msg := awsSNSMessage{Default: *alert.Body}
-
-	msg.APNS = string(b)
-	msg.APNSSandbox = string(b)
+		zap.String("platformEndpoint", topicARN))
 
-	if b, err = json.Marshal(msg); err != nil {
+	// topics have endpoints of every platform subscribed
+	msg, err := buildSNSMessage(data, alert, DevicePlatforms...)
+	if err != nil {
 		return err
 	}
 
 	input := &sns.PublishInput{
-		Message:          aws.String(string(b)),
+		Message:          aws.String(msg),
 		MessageStructure: aws.String("json"),
 		TopicArn:         aws.String(topicARN),
 	}
@@ -232,7 +233,7 @@ func (ns *snsAppleNotificationService) NotifyTopic(ctx context.Context, data Not
 // Notification only send to active devices of user
 //
 // only fectch 5 active devices of user
-func (ns *snsAppleNotificationService) Notify(ctx context.Context, data NotificationData, alert Alert, impartWealthID string) error {
+func (ns *snsNotificationService) Notify(ctx context.Context, data NotificationData, alert Alert, impartWealthID string) error {
 	activeDevices, err := dbmodels.NotificationDeviceMappings(
 		dbmodels.NotificationDeviceMappingWhere.ImpartWealthID.EQ(impartWealthID),
 		dbmodels.NotificationDeviceMappingWhere.NotifyStatus.EQ(true),
@@ -281,7 +282,7 @@ func (ns *snsAppleNotificationService) Notify(ctx context.Context, data Notifica
 			zap.Any("impartWealthID", impartWealthID),
 		)
 
-		_, err := ns.NotifyAppleDevice(ctx, data, alert, userDevice.DeviceToken, u.NotifyArn)
+		_, err := ns.NotifyDevice(ctx, data, alert, ParseDevicePlatform(userDevice.Platform), userDevice.DeviceToken, u.NotifyArn)
 		if err != nil {
 			ns.Logger.Error("push-notification : unable to notify to the device",
 				zap.Any("device", userDevice),
@@ -300,43 +301,25 @@ func (ns *snsAppleNotificationService) Notify(ctx context.Context, data Notifica
 	return nil
 }
 
-func (ns *snsAppleNotificationService) NotifyAppleDevice(ctx context.Context, data NotificationData, alert Alert, deviceToken, platformEndpointARN string) (string, error) {
-	var b []byte
-	var err error
-
-	platformEndpointARN, err = ns.SyncTokenEndpoint(ctx, deviceToken, platformEndpointARN)
+func (ns *snsNotificationService) NotifyDevice(ctx context.Context, data NotificationData, alert Alert, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
+	platformEndpointARN, err := ns.SyncTokenEndpoint(ctx, platform, deviceToken, platformEndpointARN)
 	if err != nil {
 		return "", err
 	}
 
 	ns.Logger.Debug("sending push notification",
 		zap.Any("msg", alert),
+		zap.String("platform", platform.String()),
 		zap.String("deviceToken", deviceToken),
-		zap.String("platformEndpoint", platformEndpointARN),
-		zap.String("arn", ns.platformApplicationARN))
-
-	if b, err = json.Marshal(apnsMessageWrapper{
-		APNSData: APNSMessage{
-			Alert: alert,
-			Sound: aws.String("default"),
-			Data:  data,
-			Badge: aws.Int(0),
-		},
-	}); err != nil {
-		return "", err
-	}
-
-	msg := awsSNSMessage{Default: *alert.Body}
+		zap.String("platformEndpoint", platformEndpointARN))
 
-	msg.APNS = string(b)
-	msg.APNSSandbox = string(b)
-
-	if b, err = json.Marshal(msg); err != nil {
+	msg, err := buildSNSMessage(data, alert, platform)
+	if err != nil {
 		return "", err
 	}
 
 	input := &sns.PublishInput{
-		Message:          aws.String(string(b)),
+		Message:          aws.String(msg),
 		MessageStructure: aws.String("json"),
 		TargetArn:        aws.String(platformEndpointARN),
 	}
@@ -344,12 +327,12 @@ func (ns *snsAppleNotificationService) NotifyAppleDevice(ctx context.Context, da
 	return platformEndpointARN, err
 }
 
-func (ns *snsAppleNotificationService) GetEndPointArn(ctx context.Context, deviceToken, platformEndpointARN string) (string, error) {
+func (ns *snsNotificationService) GetEndPointArn(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
 	var err error
 	// No stored endpoint ARN
 	if strings.TrimSpace(platformEndpointARN) == "" {
 		ns.Logger.Debug("didn't receive a stored endpoint - attempting to create one.")
-		platformEndpointARN, err = ns.createEndpoint(ctx, deviceToken)
+		platformEndpointARN, err = ns.createEndpoint(ctx, platform, deviceToken)
 		if err != nil {
 			ns.Logger.Error("error creating endpoint", zap.Error(err))
 			return "", err
@@ -364,12 +347,12 @@ func (ns *snsAppleNotificationService) GetEndPointArn(ctx context.Context, devic
 	return platformEndpointARN, nil
 }
 
-func (ns *snsAppleNotificationService) SyncTokenEndpoint(ctx context.Context, deviceToken, platformEndpointARN string) (string, error) {
+func (ns *snsNotificationService) SyncTokenEndpoint(ctx context.Context, platform DevicePlatform, deviceToken, platformEndpointARN string) (string, error) {
 	var err error
 	// No stored endpoint ARN
 	if strings.TrimSpace(platformEndpointARN) == "" {
 		ns.Logger.Debug("didn't receive a stored endpoint - attempting to create one.")
-		platformEndpointARN, err = ns.createEndpoint(ctx, deviceToken)
+		platformEndpointARN, err = ns.createEndpoint(ctx, platform, deviceToken)
 		if err != nil {
 			ns.Logger.Error("error creating endpoint", zap.Error(err))
 			return "", err
@@ -396,7 +379,7 @@ func (ns *snsAppleNotificationService) SyncTokenEndpoint(ctx context.Context, de
 		} else {
 			//It is a not found exception, so just create it
 			ns.Debug("endpoint not found, creating a new fresh endpoint")
-			return ns.createEndpoint(ctx, deviceToken)
+			return ns.createEndpoint(ctx, platform, deviceToken)
 		}
 	}
 	// the endpoint was found, ensure it is enabled and has the right deviceToken
@@ -428,10 +411,15 @@ func (ns *snsAppleNotificationService) SyncTokenEndpoint(ctx context.Context, de
 	return platformEndpointARN, nil
 }
 
-func (ns *snsAppleNotificationService) createEndpoint(ctx context.Context, deviceToken string) (string, error) {
+// createEndpoint registers the device token with the SNS platform application of its platform
+func (ns *snsNotificationService) createEndpoint(ctx context.Context, platform DevicePlatform, deviceToken string) (string, error) {
+	platformApplicationARN := ns.platformApplicationARNs[platform]
+	if strings.TrimSpace(platformApplicationARN) == "" {
+		return "", fmt.Errorf("no sns platform application configured for %s devices", platform)
+	}
 	endpointRequest := sns.CreatePlatformEndpointInput{
 		Token:                  aws.String(deviceToken),
-		PlatformApplicationArn: aws.String(ns.platformApplicationARN),
+		PlatformApplicationArn: aws.String(platformApplicationARN),
 	}
 	endpointResponse, err := ns.CreatePlatformEndpoint(&endpointRequest)
 	if err != nil {
@@ -441,7 +429,7 @@ func (ns *snsAppleNotificationService) createEndpoint(ctx context.Context, devic
 	return *endpointResponse.EndpointArn, nil
 }
 
-func (ns *snsAppleNotificationService) SubscribeTopic(ctx context.Context, impartWealthId, topicARN, platformEndpointARN string) error {
+func (ns *snsNotificationService) SubscribeTopic(ctx context.Context, impartWealthId, topicARN, platformEndpointARN string) error {
 
 	currentSubscriptions, err := dbmodels.NotificationSubscriptions(
 		dbmodels.NotificationSubscriptionWhere.PlatformEndpointArn.EQ(platformEndpointARN)).All(ctx, ns.db)
@@ -487,7 +475,7 @@ func (ns *snsAppleNotificationService) SubscribeTopic(ctx context.Context, impar
 	return err
 }
 
-func (ns *snsAppleNotificationService) UnsubscribeTopic(ctx context.Context, impartWealthId, SubscriptionARN string) (err error) {
+func (ns *snsNotificationService) UnsubscribeTopic(ctx context.Context, impartWealthId, SubscriptionARN string) (err error) {
 	req := sns.UnsubscribeInput{
 		SubscriptionArn: aws.String(SubscriptionARN),
 	}
@@ -509,7 +497,7 @@ func (ns *snsAppleNotificationService) UnsubscribeTopic(ctx context.Context, imp
 	return nil
 }
 
-func (ns *snsAppleNotificationService) UnsubscribeTopicForDevice(ctx context.Context, impartWealthID, topicARN, platformEndpointARN string) (err error) {
+func (ns *snsNotificationService) UnsubscribeTopicForDevice(ctx context.Context, impartWealthID, topicARN, platformEndpointARN string) (err error) {
 	currentSubscriptions, err := dbmodels.NotificationSubscriptions(
 		dbmodels.NotificationSubscriptionWhere.PlatformEndpointArn.EQ(platformEndpointARN)).All(ctx, ns.db)
 	if err != nil {
@@ -534,7 +522,7 @@ func (ns *snsAppleNotificationService) UnsubscribeTopicForDevice(ctx context.Con
 	return nil
 }
 
-func (ns *snsAppleNotificationService) UnsubscribeTopicForAllDevice(ctx context.Context, impartWealthID, topicARN string) (err error) {
+func (ns *snsNotificationService) UnsubscribeTopicForAllDevice(ctx context.Context, impartWealthID, topicARN string) (err error) {
 	currentSubscriptions, err := dbmodels.NotificationSubscriptions(
 		dbmodels.NotificationSubscriptionWhere.ImpartWealthID.EQ(impartWealthID)).All(ctx, ns.db)
 	if err != nil {
@@ -560,7 +548,7 @@ func (ns *snsAppleNotificationService) UnsubscribeTopicForAllDevice(ctx context.
 	return nil
 }
 
-func (ns *snsAppleNotificationService) UnsubscribeAll(ctx context.Context, impartWealthID string) error {
+func (ns *snsNotificationService) UnsubscribeAll(ctx context.Context, impartWealthID string) error {
 	user, err := dbmodels.Users(dbmodels.UserWhere.ImpartWealthID.EQ(impartWealthID)).One(ctx, ns.db)
 	if err != nil {
 		return err
@@ -588,12 +576,55 @@ func (ns *snsAppleNotificationService) UnsubscribeAll(ctx context.Context, impar
 	return err
 }
 
+// RemoveAllEndpoints deletes the subscriptions and endpoints from SNS before their rows, the ones
+// already gone from SNS are skipped.
+func (ns *snsNotificationService) RemoveAllEndpoints(ctx context.Context, impartWealthID string) error {
+	subscriptions, err := dbmodels.NotificationSubscriptions(
+		dbmodels.NotificationSubscriptionWhere.ImpartWealthID.EQ(impartWealthID)).All(ctx, ns.db)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subscriptions {
+		if _, err := ns.UnsubscribeWithContext(ctx, &sns.UnsubscribeInput{
+			SubscriptionArn: aws.String(sub.SubscriptionArn),
+		}); err != nil && !isSNSNotFound(err) {
+			return err
+		}
+	}
+	if _, err := subscriptions.DeleteAll(ctx, ns.db); err != nil {
+		return err
+	}
+
+	mappings, err := dbmodels.NotificationDeviceMappings(
+		dbmodels.NotificationDeviceMappingWhere.ImpartWealthID.EQ(impartWealthID)).All(ctx, ns.db)
+	if err != nil {
+		return err
+	}
+	for _, m := range mappings {
+		if m.NotifyArn == "" {
+			continue
+		}
+		if _, err := ns.DeleteEndpointWithContext(ctx, &sns.DeleteEndpointInput{
+			EndpointArn: aws.String(m.NotifyArn),
+		}); err != nil && !isSNSNotFound(err) {
+			return err
+		}
+	}
+	_, err = mappings.DeleteAll(ctx, ns.db)
+	return err
+}
+
+func isSNSNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == sns.ErrCodeNotFoundException
+}
+
 func (n noopNotificationService) CreateNotificationTopic(ctx context.Context, topicARN string) (*sns.CreateTopicOutput, error) {
 	return nil, nil
 }
 
 /// Create topic for each hive
-func (ns *snsAppleNotificationService) CreateNotificationTopic(ctx context.Context, topicARN string) (*sns.CreateTopicOutput, error) {
+func (ns *snsNotificationService) CreateNotificationTopic(ctx context.Context, topicARN string) (*sns.CreateTopicOutput, error) {
 	topic := &topicARN
 	input := &sns.CreateTopicInput{Name: topic}
 	topicOutput, err := ns.CreateTopic(input)
@@ -611,7 +642,7 @@ const title = "This Week’s Activity"
 const titleMostPopularPost = "This Week’s Trending Post"
 const bodyMostPopularPost = "Check out the most popular post in your Hive this week"
 
-func NotifyWeeklyActivity(db *sql.DB, logger *zap.Logger) {
+func NotifyWeeklyActivity(db *sql.DB, logger *zap.Logger) error {
 	lastweekTime := CurrentUTC().AddDate(0, 0, -7)
 	type PostCount struct {
 		HiveID               uint64      `json:"hive_id"`
@@ -632,11 +663,12 @@ func NotifyWeeklyActivity(db *sql.DB, logger *zap.Logger) {
 
 	if err != nil {
 		logger.Error("error while fetching data ", zap.Error(err))
-		return
+		return err
 	}
 	logger.Info("NotifyWeeklyActivity fetching completed", zap.Any("data", weeklyPosts))
 	cfg, _ := config.GetImpart()
-	notification := NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, logger)
+	notification := NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, cfg.AndroidNotificationARN, logger)
+	var notifyErr error
 	for _, hive := range weeklyPosts {
 		pushNotification := Alert{
 			Title: aws.String(title),
@@ -647,6 +679,7 @@ func NotifyWeeklyActivity(db *sql.DB, logger *zap.Logger) {
 		additionalData := NotificationData{
 			EventDatetime: CurrentUTC(),
 			HiveID:        hive.HiveID,
+			Category:      WeeklyDigestNotification,
 		}
 		logger.Info("Notification", zap.Any("hive", hive))
 		Logger.Info("Notification",
@@ -654,15 +687,15 @@ func NotifyWeeklyActivity(db *sql.DB, logger *zap.Logger) {
 			zap.Any("additionalData", additionalData),
 			zap.Any("hive", hive),
 		)
-		err = notification.NotifyTopic(context.TODO(), additionalData, pushNotification, hive.NotificationTopicArn.String)
-		if err != nil {
+		if err := notification.NotifyTopic(context.TODO(), additionalData, pushNotification, hive.NotificationTopicArn.String); err != nil {
 			logger.Error("error sending notification to topic", zap.Error(err))
+			notifyErr = err
 		}
 	}
-
+	return notifyErr
 }
 
-func NotifyWeeklyMostPopularPost(db *sql.DB, logger *zap.Logger) {
+func NotifyWeeklyMostPopularPost(db *sql.DB, logger *zap.Logger) error {
 	logger.Info("NotifyWeeklyMostPopularPost- start")
 	lastweekTime := CurrentUTC().AddDate(0, 0, -7)
 
@@ -691,12 +724,13 @@ func NotifyWeeklyMostPopularPost(db *sql.DB, logger *zap.Logger) {
 
 	if err != nil {
 		logger.Error("error while fetching data ", zap.Error(err))
-		return
+		return err
 	}
 	logger.Info("Data fetching completed ", zap.Any("popularPosts", popularPosts))
 	cfg, _ := config.GetImpart()
+	var notifyErr error
 	if cfg.Env != config.Local {
-		notification := NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, logger)
+		notification := NewImpartNotificationService(db, string(cfg.Env), cfg.Region, cfg.IOSNotificationARN, cfg.AndroidNotificationARN, logger)
 		logger.Info("NotifyWeeklyMostPopularPost- fetching complted")
 		for _, hive := range popularPosts {
 			logger.Info("NotifyWeeklyMostPopularPost-Post Details", zap.Any("hive", hive))
@@ -707,19 +741,21 @@ func NotifyWeeklyMostPopularPost(db *sql.DB, logger *zap.Logger) {
 			additionalData := NotificationData{
 				EventDatetime: CurrentUTC(),
 				PostID:        hive.PostID,
+				Category:      TrendingPostNotification,
 			}
 			Logger.Info("NotifyWeeklyMostPopularPost",
 				zap.Any("pushNotification", pushNotification),
 				zap.Any("additionalData", additionalData),
 				zap.Any("hive", hive),
 			)
-			err = notification.NotifyTopic(context.TODO(), additionalData, pushNotification, hive.NotificationTopicArn.String)
-			if err != nil {
+			if err := notification.NotifyTopic(context.TODO(), additionalData, pushNotification, hive.NotificationTopicArn.String); err != nil {
 				logger.Error("error sending notification to topic", zap.Error(err))
+				notifyErr = err
 			}
 
 		}
 	}
+	return notifyErr
 }
 
 type HiveNotificationDatas []HiveNotificationData
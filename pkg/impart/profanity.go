@@ -4,48 +4,240 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
-	gocensorword "github.com/pcpratheesh/go-censorword"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"go.uber.org/zap"
 )
 
-var ProfanityDetector *gocensorword.CensorWordDetection
 var Logger *zap.Logger
 
-func InitProfanityDetector(db *sql.DB, logger *zap.Logger) *gocensorword.CensorWordDetection {
-	Logger = logger
-	ProfanityDetector = gocensorword.NewDetector().SetCensorReplaceChar("*").WithSanitizeSpecialCharacters(false).WithTextNormalization(false)
-	censorList := GetProfanityList(db)
-	ProfanityDetector.CustomCensorList(censorList)
-	ProfanityDetector.KeepPrefixChar = true // this will keep the first letter
-	ProfanityDetector.ReplaceCheckPattern = `\b(?i)%s\b`
-	// ProfanityDetector.Logger = Logger
-	return ProfanityDetector
+// ProfanitySeverity is what happens to content using a listed word,
+// ordered from least to most severe.
+type ProfanitySeverity string
+
+const (
+	NoProfanity     ProfanitySeverity = ""
+	MaskProfanity   ProfanitySeverity = "mask"
+	HoldProfanity   ProfanitySeverity = "hold"
+	RejectProfanity ProfanitySeverity = "reject"
+)
+
+var ProfanitySeverities = []ProfanitySeverity{MaskProfanity, HoldProfanity, RejectProfanity}
+
+func (s ProfanitySeverity) String() string {
+	return string(s)
 }
 
-func GetProfanityList(db *sql.DB) []string {
-	var list []string
-	profanityList, _ := dbmodels.ProfanityWordsLists(
-		dbmodels.ProfanityWordsListWhere.Enabled.EQ(true),
-	).All(context.Background(), db)
-	for _, val := range profanityList {
-		list = append(list, val.Word)
+func (s ProfanitySeverity) Valid() bool {
+	for _, v := range ProfanitySeverities {
+		if s == v {
+			return true
+		}
 	}
-	return list
+	return false
 }
 
-func CensorWord(word string) (string, error) {
-	if len(ProfanityDetector.CensorList) > 0 {
-		filteredWord, err := ProfanityDetector.CensorWord(word)
-		if err != nil {
-			Logger.Error(fmt.Sprintf("error on censor %v", err))
-			return word, nil
+func (s ProfanitySeverity) rank() int {
+	switch s {
+	case MaskProfanity:
+		return 1
+	case HoldProfanity:
+		return 2
+	case RejectProfanity:
+		return 3
+	}
+	return 0
+}
+
+// ProfanityWord is an enabled entry of the word list
+type ProfanityWord struct {
+	Word     string
+	Severity ProfanitySeverity
+}
+
+// ProfanityResult is the outcome of checking a text, Text has the mask words replaced
+// while hold and reject words are left for the reviewer to see.
+type ProfanityResult struct {
+	Text     string
+	Severity ProfanitySeverity
+	Words    []string
+}
+
+type compiledProfanityWord struct {
+	ProfanityWord
+	pattern *regexp.Regexp
+}
+
+// ProfanityFilter holds the compiled word list, it is safe for concurrent use and can be
+// reloaded while requests are being served.
+type ProfanityFilter struct {
+	mu      sync.RWMutex
+	words   []compiledProfanityWord
+	version string
+}
+
+// NewProfanityFilter compiles the words, longer words are matched first so a phrase
+// is masked as a whole.
+func NewProfanityFilter(words []ProfanityWord) *ProfanityFilter {
+	f := &ProfanityFilter{}
+	f.set(words, "")
+	return f
+}
+
+func (f *ProfanityFilter) set(words []ProfanityWord, version string) {
+	compiled := make([]compiledProfanityWord, 0, len(words))
+	for _, w := range words {
+		word := strings.TrimSpace(w.Word)
+		if word == "" {
+			continue
+		}
+		severity := w.Severity
+		if !severity.Valid() {
+			severity = MaskProfanity
+		}
+		compiled = append(compiled, compiledProfanityWord{
+			ProfanityWord: ProfanityWord{Word: word, Severity: severity},
+			pattern:       regexp.MustCompile(fmt.Sprintf(`(?i)\b%s\b`, regexp.QuoteMeta(word))),
+		})
+	}
+	sort.SliceStable(compiled, func(i, j int) bool {
+		return len(compiled[i].Word) > len(compiled[j].Word)
+	})
+	f.mu.Lock()
+	f.words = compiled
+	f.version = version
+	f.mu.Unlock()
+}
+
+// Check masks the mask words in the text, keeping their first letter, and reports the
+// most severe word found.
+func (f *ProfanityFilter) Check(text string) ProfanityResult {
+	result := ProfanityResult{Text: text}
+	if f == nil {
+		return result
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, w := range f.words {
+		if !w.pattern.MatchString(result.Text) {
+			continue
+		}
+		result.Words = append(result.Words, w.Word)
+		if w.Severity.rank() > result.Severity.rank() {
+			result.Severity = w.Severity
+		}
+		if w.Severity == MaskProfanity {
+			result.Text = w.pattern.ReplaceAllStringFunc(result.Text, maskWord)
+		}
+	}
+	return result
+}
+
+func maskWord(word string) string {
+	r := []rune(word)
+	if len(r) == 0 {
+		return word
+	}
+	return string(r[0]) + strings.Repeat("*", len(r)-1)
+}
+
+// Reload reads the enabled words from the database when the list changed since the last load
+func (f *ProfanityFilter) Reload(ctx context.Context, db *sql.DB) error {
+	version, err := profanityListVersion(ctx, db)
+	if err != nil {
+		return err
+	}
+	f.mu.RLock()
+	current := f.version
+	f.mu.RUnlock()
+	if version == current {
+		return nil
+	}
+	words, err := GetProfanityList(ctx, db)
+	if err != nil {
+		return err
+	}
+	f.set(words, version)
+	if Logger != nil {
+		Logger.Info("profanity list loaded", zap.Int("words", len(words)), zap.String("version", version))
+	}
+	return nil
+}
+
+// Watch reloads the list every interval until the context is done, so a change made through
+// any instance reaches all of them.
+func (f *ProfanityFilter) Watch(ctx context.Context, db *sql.DB, interval time.Duration, logger *zap.Logger) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.Reload(ctx, db); err != nil {
+				logger.Error("unable to reload profanity list", zap.Error(err))
+			}
 		}
-		return filteredWord, nil
 	}
+}
 
-	return word, nil
+// profanityListVersion changes whenever a word is added, updated or removed
+func profanityListVersion(ctx context.Context, db *sql.DB) (string, error) {
+	var v struct {
+		Words     int64     `boil:"words"`
+		UpdatedAt null.Time `boil:"updated_at"`
+	}
+	err := queries.Raw(`
+		select count(*) as words, max(updated_at) as updated_at
+		from profanity_words_list
+	`).Bind(ctx, db, &v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d-%d", v.Words, v.UpdatedAt.Time.UnixNano()), nil
 }
 
-var censorList = []string{}
+var ProfanityDetector *ProfanityFilter
+
+func InitProfanityDetector(db *sql.DB, logger *zap.Logger) *ProfanityFilter {
+	Logger = logger
+	ProfanityDetector = NewProfanityFilter(nil)
+	if err := ProfanityDetector.Reload(context.Background(), db); err != nil {
+		logger.Error("unable to load profanity list", zap.Error(err))
+	}
+	return ProfanityDetector
+}
+
+func GetProfanityList(ctx context.Context, db *sql.DB) ([]ProfanityWord, error) {
+	profanityList, err := dbmodels.ProfanityWordsLists(
+		dbmodels.ProfanityWordsListWhere.Enabled.EQ(true),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]ProfanityWord, len(profanityList))
+	for i, val := range profanityList {
+		list[i] = ProfanityWord{Word: val.Word, Severity: ProfanitySeverity(val.Severity)}
+	}
+	return list, nil
+}
+
+// CheckProfanity runs the text through the loaded word list
+func CheckProfanity(text string) ProfanityResult {
+	return ProfanityDetector.Check(text)
+}
+
+// CensorWord masks the mask words of the text
+func CensorWord(word string) (string, error) {
+	return ProfanityDetector.Check(word).Text, nil
+}
@@ -0,0 +1,67 @@
+package impart_test
+
+import (
+	"testing"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProfanityFilter() *impart.ProfanityFilter {
+	return impart.NewProfanityFilter([]impart.ProfanityWord{
+		{Word: "ass", Severity: impart.MaskProfanity},
+		{Word: "ass hole", Severity: impart.MaskProfanity},
+		{Word: "c-u-n-t", Severity: impart.MaskProfanity},
+		{Word: "scam", Severity: impart.HoldProfanity},
+		{Word: "slur", Severity: impart.RejectProfanity},
+		{Word: "legacy"},
+	})
+}
+
+func TestProfanityFilterMasksKeepingFirstLetter(t *testing.T) {
+	result := newTestProfanityFilter().Check("bad Ass and an ass hole")
+
+	require.Equal(t, "bad A** and an a*******", result.Text)
+	require.Equal(t, impart.MaskProfanity, result.Severity)
+}
+
+func TestProfanityFilterMatchesWholeWords(t *testing.T) {
+	result := newTestProfanityFilter().Check("a classic assessment")
+
+	require.Equal(t, "a classic assessment", result.Text)
+	require.Equal(t, impart.NoProfanity, result.Severity)
+	require.Empty(t, result.Words)
+}
+
+func TestProfanityFilterEscapesSpecialCharacters(t *testing.T) {
+	result := newTestProfanityFilter().Check("what a c-u-n-t, cxuxnxt")
+
+	require.Equal(t, "what a c******, cxuxnxt", result.Text)
+}
+
+func TestProfanityFilterReportsMostSevereWord(t *testing.T) {
+	f := newTestProfanityFilter()
+
+	held := f.Check("this ass is a scam")
+	require.Equal(t, impart.HoldProfanity, held.Severity)
+	require.Equal(t, "this a** is a scam", held.Text, "hold words stay readable for the reviewer")
+
+	rejected := f.Check("a scam and a SLUR")
+	require.Equal(t, impart.RejectProfanity, rejected.Severity)
+	require.ElementsMatch(t, []string{"scam", "slur"}, rejected.Words)
+}
+
+func TestProfanityFilterDefaultsToMask(t *testing.T) {
+	result := newTestProfanityFilter().Check("legacy")
+
+	require.Equal(t, "l*****", result.Text)
+	require.Equal(t, impart.MaskProfanity, result.Severity)
+}
+
+func TestProfanitySeverityValid(t *testing.T) {
+	for _, s := range impart.ProfanitySeverities {
+		require.True(t, s.Valid())
+	}
+	require.False(t, impart.ProfanitySeverity("ban").Valid())
+	require.False(t, impart.NoProfanity.Valid())
+}
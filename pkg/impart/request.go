@@ -0,0 +1,218 @@
+package impart
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader carries the id of a request, it is accepted from the caller and echoed in the response
+const RequestIDHeader = "X-Request-ID"
+
+const RequestIDContextKey = "RequestIDContextKey{}"
+const RouteContextKey = "RouteContextKey{}"
+
+// maxRequestIDLength bounds the ids accepted from callers, longer or malformed ids are replaced
+const maxRequestIDLength = 128
+
+// Log fields identifying the request, they are attached to the logs and to the Sentry events
+const (
+	RequestIDLogField = "requestId"
+	UserIDLogField    = "impartWealthId"
+	ClientIDLogField  = "clientId"
+	RouteLogField     = "route"
+)
+
+// RequestLogFields are the fields CtxLogger adds, Sentry reports them as tags
+var RequestLogFields = []string{RequestIDLogField, UserIDLogField, ClientIDLogField, RouteLogField}
+
+func GetCtxRequestID(ctx context.Context) string {
+	val := ctx.Value(RequestIDContextKey)
+	if val != nil {
+		return val.(string)
+	}
+	return ""
+}
+
+func GetCtxRoute(ctx context.Context) string {
+	val := ctx.Value(RouteContextKey)
+	if val != nil {
+		return val.(string)
+	}
+	return ""
+}
+
+// NewRequestID returns a new id for a request the caller sent without one
+func NewRequestID() string {
+	return ksuid.New().String()
+}
+
+// ValidRequestID reports whether a request id sent by a caller can be used as is
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// RequestIDHandler accepts the X-Request-ID of the caller or generates one, puts it and the route
+// in the context and echoes it in the response header and in the error bodies.
+func RequestIDHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(RequestIDHeader)
+		if !ValidRequestID(id) {
+			id = NewRequestID()
+		}
+		ctx.Set(RequestIDContextKey, id)
+		ctx.Set(RouteContextKey, ctx.FullPath())
+		ctx.Header(RequestIDHeader, id)
+
+		w := &errorBodyWriter{ResponseWriter: ctx.Writer, requestID: id}
+		ctx.Writer = w
+		ctx.Next()
+		w.flush()
+	}
+}
+
+// CtxLogger returns the logger with the request id, user, client and route of the request in ctx,
+// or the logger itself outside of a request.
+func CtxLogger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	if ctx == nil {
+		return logger
+	}
+	id := GetCtxRequestID(ctx)
+	if id == "" {
+		return logger
+	}
+	fields := []zap.Field{zap.String(RequestIDLogField, id)}
+	if user, ok := ctx.Value(UserRequestContextKey).(*dbmodels.User); ok && user != nil {
+		fields = append(fields, zap.String(UserIDLogField, user.ImpartWealthID))
+	}
+	if clientID := GetCtxClientID(ctx); clientID != "" {
+		fields = append(fields, zap.String(ClientIDLogField, clientID))
+	}
+	if route := GetCtxRoute(ctx); route != "" {
+		fields = append(fields, zap.String(RouteLogField, route))
+	}
+	return logger.With(fields...)
+}
+
+// AccessLogHandler logs every request once it is served, with the fields of CtxLogger
+func AccessLogHandler(logger *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		fields := []zap.Field{
+			zap.Int("status", ctx.Writer.Status()),
+			zap.String("method", ctx.Request.Method),
+			zap.String("path", ctx.Request.URL.Path),
+			zap.String("query", ctx.Request.URL.RawQuery),
+			zap.String("ip", ctx.ClientIP()),
+			zap.String("user-agent", ctx.Request.UserAgent()),
+			zap.Duration("latency", time.Since(start)),
+		}
+		log := CtxLogger(ctx, logger)
+		if len(ctx.Errors) > 0 {
+			for _, e := range ctx.Errors.Errors() {
+				log.Error(e, fields...)
+			}
+			return
+		}
+		log.Info(ctx.Request.URL.Path, fields...)
+	}
+}
+
+// errorBodyWriter holds the error responses back until the handlers are done so the request id
+// can be added to the impart.ErrorResponse bodies.
+type errorBodyWriter struct {
+	gin.ResponseWriter
+	requestID string
+	body      bytes.Buffer
+}
+
+func (w *errorBodyWriter) Write(b []byte) (int, error) {
+	if w.ResponseWriter.Status() < http.StatusBadRequest {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+func (w *errorBodyWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *errorBodyWriter) Written() bool {
+	return w.ResponseWriter.Written() || w.body.Len() > 0
+}
+
+func (w *errorBodyWriter) flush() {
+	if w.body.Len() == 0 {
+		return
+	}
+	_, _ = w.ResponseWriter.Write(withRequestID(w.body.Bytes(), w.requestID))
+	w.body.Reset()
+}
+
+// withRequestID adds the request id to an error response body, other bodies are left as they are
+func withRequestID(body []byte, requestID string) []byte {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(body, &m); err != nil {
+		return body
+	}
+	if _, ok := m["errors"]; !ok {
+		return body
+	}
+	if _, ok := m["requestId"]; ok {
+		return body
+	}
+	id, _ := json.Marshal(requestID)
+	m["requestId"] = id
+	b, err := json.Marshal(m)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// ParseLimitOffset reads the paging query parameters. On a bad value it has already answered the
+// request with a bad request and returns the error.
+func ParseLimitOffset(ctx *gin.Context) (limit int, offset int, err error) {
+	params := ctx.Request.URL.Query()
+
+	if limitParam := strings.TrimSpace(params.Get("limit")); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse(NewError(err, "invalid limit passed in")))
+			return
+		}
+	}
+
+	if offsetParam := strings.TrimSpace(params.Get("offset")); offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err == nil && offset < 0 {
+			err = ErrBadRequest
+		}
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse(NewError(err, "invalid offset passed in")))
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,136 @@
+package impart
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func bufferLogger(buf *bytes.Buffer) *zap.Logger {
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	return zap.New(zapcore.NewCore(enc, zapcore.AddSync(buf), zapcore.DebugLevel))
+}
+
+func requestRouter(logger *zap.Logger) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestIDHandler())
+	r.Use(func(ctx *gin.Context) {
+		ctx.Set(ClientIdentificationHeaderKey, "ios-client")
+		ctx.Set(UserRequestContextKey, &dbmodels.User{ImpartWealthID: "1xRvvB2ztPPVYpRLLgArq7KHQ8Q"})
+		ctx.Next()
+	})
+	r.GET("/hives/:hiveId", func(ctx *gin.Context) {
+		CtxLogger(ctx, logger).Info("fetching hive")
+		ctx.JSON(http.StatusOK, gin.H{"hiveId": ctx.Param("hiveId")})
+	})
+	r.GET("/missing", func(ctx *gin.Context) {
+		impartErr := NewError(ErrNotFound, "hive not found")
+		ctx.JSON(impartErr.HttpStatus(), ErrorResponse(impartErr))
+	})
+	return r
+}
+
+func TestRequestIDHandler(t *testing.T) {
+	r := requestRouter(zap.NewNop())
+
+	req := httptest.NewRequest(http.MethodGet, "/hives/1", nil)
+	req.Header.Set(RequestIDHeader, "client-request-1")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "client-request-1", w.Header().Get(RequestIDHeader))
+	assert.JSONEq(t, `{"hiveId":"1"}`, w.Body.String(), "only error bodies carry the id")
+
+	for _, invalid := range []string{"", "has spaces", "<script>", strings.Repeat("a", maxRequestIDLength+1)} {
+		req = httptest.NewRequest(http.MethodGet, "/hives/1", nil)
+		req.Header.Set(RequestIDHeader, invalid)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		id := w.Header().Get(RequestIDHeader)
+		assert.NotEqual(t, invalid, id)
+		assert.True(t, ValidRequestID(id), "a new id replaces %q", invalid)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/missing", nil)
+	req.Header.Set(RequestIDHeader, "client-request-2")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	var body struct {
+		Errors []struct {
+			Msg string `json:"msg"`
+		} `json:"errors"`
+		RequestID string `json:"requestId"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "client-request-2", body.RequestID)
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, "hive not found", body.Errors[0].Msg)
+}
+
+func TestCtxLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	r := requestRouter(bufferLogger(buf))
+
+	req := httptest.NewRequest(http.MethodGet, "/hives/1", nil)
+	req.Header.Set(RequestIDHeader, "client-request-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "fetching hive", entry["msg"])
+	assert.Equal(t, "client-request-1", entry[RequestIDLogField])
+	assert.Equal(t, "1xRvvB2ztPPVYpRLLgArq7KHQ8Q", entry[UserIDLogField])
+	assert.Equal(t, "ios-client", entry[ClientIDLogField])
+	assert.Equal(t, "/hives/:hiveId", entry[RouteLogField])
+
+	logger := zap.NewNop()
+	assert.Same(t, logger, CtxLogger(nil, logger))
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.Same(t, logger, CtxLogger(ctx, logger), "outside of a request the logger is unchanged")
+}
+
+func TestWithRequestID(t *testing.T) {
+	assert.Equal(t, `not json`, string(withRequestID([]byte(`not json`), "id")))
+	assert.Equal(t, `{"error":"x"}`, string(withRequestID([]byte(`{"error":"x"}`), "id")))
+	assert.JSONEq(t, `{"errors":[],"requestId":"id"}`, string(withRequestID([]byte(`{"errors":[]}`), "id")))
+}
+
+func TestParseLimitOffset(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for query, want := range map[string]struct {
+		limit, offset int
+		ok            bool
+	}{
+		"":                    {ok: true},
+		"limit=10&offset=20":  {limit: 10, offset: 20, ok: true},
+		"limit=ten":           {},
+		"limit=10&offset=-1":  {},
+		"limit=10&offset=two": {},
+	} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+		limit, offset, err := ParseLimitOffset(ctx)
+		if !want.ok {
+			assert.Error(t, err, query)
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			continue
+		}
+		require.NoError(t, err, query)
+		assert.Equal(t, want.limit, limit, query)
+		assert.Equal(t, want.offset, offset, query)
+	}
+}
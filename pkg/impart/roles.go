@@ -0,0 +1,55 @@
+package impart
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+// Role is the access level of a user, a higher role has every permission of the lower ones
+type Role int
+
+const (
+	MemberRole Role = iota
+	AdminRole
+	SuperAdminRole
+)
+
+func (r Role) String() string {
+	switch r {
+	case SuperAdminRole:
+		return "super admin"
+	case AdminRole:
+		return "admin"
+	default:
+		return "member"
+	}
+}
+
+// UserRole is the highest role of the user
+func UserRole(u *dbmodels.User) Role {
+	switch {
+	case u == nil:
+		return MemberRole
+	case u.SuperAdmin:
+		return SuperAdminRole
+	case u.Admin:
+		return AdminRole
+	default:
+		return MemberRole
+	}
+}
+
+// RequireRole aborts the requests of users below the role
+func RequireRole(role Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, _ := ctx.Value(UserRequestContextKey).(*dbmodels.User)
+		if UserRole(user) < role {
+			impartErr := NewError(ErrUnauthorized, fmt.Sprintf("only %ss can access this route", role))
+			ctx.AbortWithStatusJSON(impartErr.HttpStatus(), ErrorResponse(impartErr))
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,52 @@
+package impart
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRole(t *testing.T) {
+	assert.Equal(t, MemberRole, UserRole(nil))
+	assert.Equal(t, MemberRole, UserRole(&dbmodels.User{}))
+	assert.Equal(t, AdminRole, UserRole(&dbmodels.User{Admin: true}))
+	assert.Equal(t, SuperAdminRole, UserRole(&dbmodels.User{SuperAdmin: true}))
+	assert.Equal(t, SuperAdminRole, UserRole(&dbmodels.User{Admin: true, SuperAdmin: true}))
+}
+
+func TestRequireRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, tc := range []struct {
+		name string
+		role Role
+		user *dbmodels.User
+		code int
+		msg  string
+	}{
+		{"no user", AdminRole, nil, http.StatusUnauthorized, "only admins can access this route"},
+		{"member", AdminRole, &dbmodels.User{}, http.StatusUnauthorized, "only admins can access this route"},
+		{"admin", AdminRole, &dbmodels.User{Admin: true}, http.StatusOK, ""},
+		{"super admin", AdminRole, &dbmodels.User{SuperAdmin: true}, http.StatusOK, ""},
+		{"admin below super admin", SuperAdminRole, &dbmodels.User{Admin: true}, http.StatusUnauthorized, "only super admins can access this route"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(func(ctx *gin.Context) {
+				if tc.user != nil {
+					ctx.Set(UserRequestContextKey, tc.user)
+				}
+			})
+			r.GET("/admin", RequireRole(tc.role), func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin", nil))
+			assert.Equal(t, tc.code, w.Code)
+			if tc.msg != "" {
+				assert.Contains(t, w.Body.String(), tc.msg)
+			}
+		})
+	}
+}
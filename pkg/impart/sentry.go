@@ -32,6 +32,7 @@ func ModifyToSentryLogger(log *zap.Logger, DSN string, env string) *zap.Logger {
 		Tags: map[string]string{
 			"component": "system",
 		},
+		TagFields:         RequestLogFields,
 		DisableStacktrace: true,
 	}
 	core, err := sentryCore.NewCore(
@@ -0,0 +1,104 @@
+package impart
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"go.uber.org/zap"
+)
+
+// snsStub is a local stand-in for the SNS query api, covering the platform endpoint and publish actions
+type snsStub struct {
+	*httptest.Server
+	mu        sync.Mutex
+	endpoints map[string]map[string]string // endpoint arn -> attributes
+	published []map[string]string
+}
+
+func newSNSStub(t *testing.T) *snsStub {
+	s := &snsStub{endpoints: make(map[string]map[string]string)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// client returns an SNS client pointed at the stub
+func (s *snsStub) client(t *testing.T) *sns.SNS {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(s.URL),
+		Credentials: credentials.NewStaticCredentials("test", "test", ""),
+		MaxRetries:  aws.Int(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sns.New(sess)
+}
+
+func (s *snsStub) service(t *testing.T, platformApplicationARNs map[DevicePlatform]string) *snsNotificationService {
+	return newSNSNotificationService(nil, "test", s.client(t), platformApplicationARNs, zap.NewNop())
+}
+
+func (s *snsStub) lastPublished() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.published) == 0 {
+		return nil
+	}
+	return s.published[len(s.published)-1]
+}
+
+func (s *snsStub) handle(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	action := r.Form.Get("Action")
+	switch action {
+	case "CreatePlatformEndpoint":
+		arn := fmt.Sprintf("%s/%s", strings.Replace(r.Form.Get("PlatformApplicationArn"), ":app/", ":endpoint/", 1), r.Form.Get("Token"))
+		s.endpoints[arn] = map[string]string{"Token": r.Form.Get("Token"), "Enabled": "true"}
+		fmt.Fprintf(w, `<CreatePlatformEndpointResponse><CreatePlatformEndpointResult><EndpointArn>%s</EndpointArn></CreatePlatformEndpointResult><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></CreatePlatformEndpointResponse>`, arn)
+	case "GetEndpointAttributes":
+		attrs, ok := s.endpoints[r.Form.Get("EndpointArn")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>NotFound</Code><Message>Endpoint does not exist</Message></Error><RequestId>1</RequestId></ErrorResponse>`)
+			return
+		}
+		var entries strings.Builder
+		for k, v := range attrs {
+			fmt.Fprintf(&entries, "<entry><key>%s</key><value>%s</value></entry>", k, v)
+		}
+		fmt.Fprintf(w, `<GetEndpointAttributesResponse><GetEndpointAttributesResult><Attributes>%s</Attributes></GetEndpointAttributesResult><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></GetEndpointAttributesResponse>`, entries.String())
+	case "SetEndpointAttributes":
+		attrs := s.endpoints[r.Form.Get("EndpointArn")]
+		for i := 1; r.Form.Get(fmt.Sprintf("Attributes.entry.%d.key", i)) != ""; i++ {
+			attrs[r.Form.Get(fmt.Sprintf("Attributes.entry.%d.key", i))] = r.Form.Get(fmt.Sprintf("Attributes.entry.%d.value", i))
+		}
+		fmt.Fprint(w, `<SetEndpointAttributesResponse><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></SetEndpointAttributesResponse>`)
+	case "Publish":
+		s.published = append(s.published, map[string]string{
+			"TargetArn":        r.Form.Get("TargetArn"),
+			"TopicArn":         r.Form.Get("TopicArn"),
+			"Message":          r.Form.Get("Message"),
+			"MessageStructure": r.Form.Get("MessageStructure"),
+		})
+		fmt.Fprint(w, `<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></PublishResponse>`)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidAction</Code><Message>%s not supported</Message></Error><RequestId>1</RequestId></ErrorResponse>`, action)
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func UserDemographicsUpdate(ctx context.Context, db *sql.DB, ishivedemographics bool, isdemographics bool) {
+func UserDemographicsUpdate(ctx context.Context, db *sql.DB, ishivedemographics bool, isdemographics bool) error {
 	// defer wg.Done()
 	hivedemographics := ""
 	demographics := ""
@@ -70,4 +70,5 @@ func UserDemographicsUpdate(ctx context.Context, db *sql.DB, ishivedemographics
 	if err != nil {
 		Logger.Error("query failed", zap.Any("query", err), zap.Any("query", query))
 	}
+	return err
 }
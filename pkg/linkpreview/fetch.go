@@ -0,0 +1,166 @@
+package linkpreview
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/otiai10/opengraph/v2"
+)
+
+const userAgent = "HiveWealthLinkPreview/1.0 (+https://impartwealth.com)"
+
+// blockedNetworks are never fetched, covering loopback, private, link local (including the cloud
+// metadata address), carrier grade nat, multicast and reserved ranges.
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	out := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		out[i] = n
+	}
+	return out
+}
+
+// isBlockedIP reports whether the address is outside of the public internet
+func isBlockedIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	for _, n := range blockedNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetcher downloads a page and extracts its open graph details. The address check runs on
+// every dial against the resolved ip, so neither a redirect nor a dns answer can reach an
+// internal address.
+type fetcher struct {
+	client       *http.Client
+	maxBodyBytes int64
+}
+
+func newFetcher(timeout time.Duration, maxRedirects int, maxBodyBytes int64, blocked func(net.IP) bool) *fetcher {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || blocked(ip) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		// no proxy, a proxy would dial on our behalf and bypass the address check
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+	return &fetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) > maxRedirects {
+					return ErrTooManyRedirects
+				}
+				if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+					return ErrInvalidURL
+				}
+				return nil
+			},
+		},
+		maxBodyBytes: maxBodyBytes,
+	}
+}
+
+// fetch returns the open graph details of the page, relative image urls are made absolute
+// against the final url after redirects.
+func (f *fetcher) fetch(ctx context.Context, pageURL string) (*opengraph.OpenGraph, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("link preview: unexpected status %d", resp.StatusCode)
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("link preview: unsupported content type %q", mediaType)
+	}
+	if resp.ContentLength > f.maxBodyBytes {
+		return nil, ErrBodyTooLarge
+	}
+
+	og := opengraph.New(resp.Request.URL.String())
+	// the open graph tags live in the head, a page cut off at the cap still parses
+	if err := og.Parse(io.LimitReader(resp.Body, f.maxBodyBytes)); err != nil {
+		return nil, err
+	}
+	og.Intent.URL = resp.Request.URL.String()
+	og.URL = ""
+	if err := og.ToAbs(); err != nil {
+		return nil, err
+	}
+	return og, nil
+}
+
+func imageURL(og *opengraph.OpenGraph) string {
+	for _, img := range og.Image {
+		if strings.HasPrefix(img.URL, "http://") || strings.HasPrefix(img.URL, "https://") {
+			return img.URL
+		}
+	}
+	return ""
+}
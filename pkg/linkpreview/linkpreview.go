@@ -0,0 +1,151 @@
+package linkpreview
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidURL       = errors.New("link preview: invalid url")
+	ErrBlockedAddress   = errors.New("link preview: address not allowed")
+	ErrTooManyRedirects = errors.New("link preview: too many redirects")
+	ErrBodyTooLarge     = errors.New("link preview: response too large")
+	ErrUnavailable      = errors.New("link preview: unavailable")
+)
+
+const (
+	// failed fetches are retried sooner than successful ones are refreshed
+	maxFailureTTL = 15 * time.Minute
+	// the post_urls columns are NVARCHAR(250)
+	maxFieldLength = 250
+)
+
+// Service fetches the open graph details of links, results are cached by normalized url.
+type Service interface {
+	Preview(ctx context.Context, rawURL string) (models.PostUrl, error)
+}
+
+type service struct {
+	db      *sql.DB
+	logger  *zap.Logger
+	fetcher *fetcher
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+func New(cfg *config.Impart, db *sql.DB, logger *zap.Logger) Service {
+	lp := cfg.LinkPreview
+	return newService(db, logger, newFetcher(lp.Timeout, lp.MaxRedirects, lp.MaxBodyBytes, isBlockedIP), lp.CacheTTL)
+}
+
+func newService(db *sql.DB, logger *zap.Logger, f *fetcher, ttl time.Duration) *service {
+	return &service{
+		db:      db,
+		logger:  logger,
+		fetcher: f,
+		ttl:     ttl,
+		now:     impart.CurrentUTC,
+	}
+}
+
+// Preview returns the details for the link, ErrInvalidURL when it is not an http(s) url
+// and ErrUnavailable when the page could not be fetched now or recently.
+func (s *service) Preview(ctx context.Context, rawURL string) (models.PostUrl, error) {
+	normalized, err := Normalize(rawURL)
+	if err != nil {
+		return models.PostUrl{}, err
+	}
+	key := cacheKey(normalized)
+	now := s.now()
+
+	if cached := s.cached(ctx, key, now); cached != nil {
+		if cached.Failed {
+			return models.PostUrl{}, ErrUnavailable
+		}
+		return previewFromDB(cached), nil
+	}
+
+	row := &dbmodels.LinkPreview{
+		URLHash:   key,
+		URL:       normalized,
+		FetchedAt: now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+	og, err := s.fetcher.fetch(ctx, normalized)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Info("unable to fetch link preview", zap.String("url", normalized), zap.Error(err))
+		row.Failed = true
+		failureTTL := s.ttl
+		if failureTTL > maxFailureTTL {
+			failureTTL = maxFailureTTL
+		}
+		row.ExpiresAt = now.Add(failureTTL)
+	} else {
+		row.Title = truncate(og.Title)
+		row.Description = truncate(og.Description)
+		if image := imageURL(og); len([]rune(image)) <= maxFieldLength {
+			row.ImageURL = image
+		}
+	}
+	s.store(ctx, row)
+
+	if row.Failed {
+		if errors.Is(err, ErrBlockedAddress) {
+			return models.PostUrl{}, ErrBlockedAddress
+		}
+		return models.PostUrl{}, ErrUnavailable
+	}
+	return previewFromDB(row), nil
+}
+
+func (s *service) cached(ctx context.Context, key string, now time.Time) *dbmodels.LinkPreview {
+	if s.db == nil {
+		return nil
+	}
+	row, err := dbmodels.LinkPreviews(
+		dbmodels.LinkPreviewWhere.URLHash.EQ(key),
+		dbmodels.LinkPreviewWhere.ExpiresAt.GT(now),
+	).One(ctx, s.db)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			impart.CtxLogger(ctx, s.logger).Error("unable to read link preview cache", zap.Error(err))
+		}
+		return nil
+	}
+	return row
+}
+
+func (s *service) store(ctx context.Context, row *dbmodels.LinkPreview) {
+	if s.db == nil {
+		return
+	}
+	if err := row.Upsert(ctx, s.db, boil.Infer(), boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to write link preview cache", zap.String("url", row.URL), zap.Error(err))
+	}
+}
+
+func previewFromDB(row *dbmodels.LinkPreview) models.PostUrl {
+	return models.PostUrl{
+		Url:         row.URL,
+		ImageUrl:    row.ImageURL,
+		Title:       row.Title,
+		Description: row.Description,
+	}
+}
+
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) > maxFieldLength {
+		return string(r[:maxFieldLength])
+	}
+	return s
+}
@@ -0,0 +1,130 @@
+package linkpreview
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testPage = `<!doctype html><html><head>
+<title>fallback title</title>
+<meta property="og:title" content="Saving for retirement">
+<meta property="og:description" content="A guide to getting started">
+<meta property="og:image" content="/images/cover.png">
+</head><body>%s</body></html>`
+
+// onlyLocalhost lets the tests reach httptest servers on 127.0.0.1 while every other
+// address, including ::1, is treated as blocked
+func onlyLocalhost(ip net.IP) bool {
+	return !ip.Equal(net.IPv4(127, 0, 0, 1))
+}
+
+func testService(timeout time.Duration, maxBody int64) *service {
+	return newService(nil, zap.NewNop(), newFetcher(timeout, 3, maxBody, onlyLocalhost), time.Hour)
+}
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPreview(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, testPage, "")
+	})
+	preview, err := testService(time.Second, 1<<20).Preview(context.Background(), srv.URL+"/article#top")
+	require.NoError(t, err)
+	assert.Equal(t, "Saving for retirement", preview.Title)
+	assert.Equal(t, "A guide to getting started", preview.Description)
+	assert.Equal(t, srv.URL+"/images/cover.png", preview.ImageUrl)
+	assert.Equal(t, srv.URL+"/article", preview.Url)
+}
+
+func TestPreviewBlocksPrivateAddresses(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, testPage, "")
+	})
+	s := newService(nil, zap.NewNop(), newFetcher(time.Second, 3, 1<<20, isBlockedIP), time.Hour)
+	_, err := s.Preview(context.Background(), srv.URL)
+	assert.Equal(t, ErrBlockedAddress, err)
+}
+
+func TestPreviewRedirects(t *testing.T) {
+	var srv *httptest.Server
+	srv = newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/moved":
+			http.Redirect(w, r, "/article", http.StatusFound)
+		case r.URL.Path == "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		case r.URL.Path == "/internal":
+			_, port, _ := net.SplitHostPort(strings.TrimPrefix(srv.URL, "http://"))
+			http.Redirect(w, r, "http://[::1]:"+port+"/article", http.StatusFound)
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprintf(w, testPage, "")
+		}
+	})
+	s := testService(time.Second, 1<<20)
+
+	preview, err := s.Preview(context.Background(), srv.URL+"/moved")
+	require.NoError(t, err)
+	assert.Equal(t, "Saving for retirement", preview.Title)
+
+	_, err = s.Preview(context.Background(), srv.URL+"/loop")
+	assert.Equal(t, ErrUnavailable, err)
+
+	_, err = s.Preview(context.Background(), srv.URL+"/internal")
+	assert.Equal(t, ErrBlockedAddress, err)
+}
+
+func TestPreviewLimits(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(300 * time.Millisecond)
+		case "/large":
+			w.Header().Set("Content-Type", "text/html")
+			w.Header().Set("Content-Length", "4096")
+			fmt.Fprint(w, strings.Repeat("a", 4096))
+			return
+		case "/json":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, testPage, strings.Repeat("<p>filler</p>", 1000))
+	})
+	s := testService(100*time.Millisecond, 1024)
+	ctx := context.Background()
+
+	_, err := s.Preview(ctx, srv.URL+"/slow")
+	assert.Equal(t, ErrUnavailable, err)
+
+	_, err = s.Preview(ctx, srv.URL+"/large")
+	assert.Equal(t, ErrUnavailable, err)
+
+	_, err = s.Preview(ctx, srv.URL+"/json")
+	assert.Equal(t, ErrUnavailable, err)
+
+	// a chunked page larger than the cap is cut off, the head is still read
+	preview, err := s.Preview(ctx, srv.URL+"/chunked")
+	require.NoError(t, err)
+	assert.Equal(t, "Saving for retirement", preview.Title)
+
+	_, err = s.Preview(ctx, "ftp://example.com/file")
+	assert.Equal(t, ErrInvalidURL, err)
+}
@@ -0,0 +1,84 @@
+package linkpreview
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	urlPattern    = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'` + "`" + `]+`)
+	schemePattern = regexp.MustCompile(`(?i)^[a-z][a-z0-9+.-]*://`)
+)
+
+// FindURLs returns the distinct http(s) links in the content, in the order they appear
+func FindURLs(content string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, match := range urlPattern.FindAllString(content, -1) {
+		// trailing punctuation and a closing markdown bracket belong to the sentence, not the link
+		match = strings.TrimRight(match, ".,;:!?)]}*_")
+		normalized, err := Normalize(match)
+		if err != nil || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		out = append(out, match)
+	}
+	return out
+}
+
+// Normalize returns the canonical form of a link used as the cache key; the scheme defaults to http,
+// scheme and host are lower cased, default ports, credentials and the fragment are dropped and the
+// query parameters are sorted.
+func Normalize(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", ErrInvalidURL
+	}
+	if !schemePattern.MatchString(rawURL) {
+		rawURL = "http://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", ErrInvalidURL
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return "", ErrInvalidURL
+	}
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	switch {
+	case port != "":
+		u.Host = net.JoinHostPort(host, port)
+	case strings.Contains(host, ":"):
+		u.Host = "[" + host + "]"
+	default:
+		u.Host = host
+	}
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = u.Query().Encode()
+	}
+	return u.String(), nil
+}
+
+func cacheKey(normalizedURL string) string {
+	sum := sha256.Sum256([]byte(normalizedURL))
+	return hex.EncodeToString(sum[:])
+}
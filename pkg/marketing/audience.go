@@ -0,0 +1,83 @@
+package marketing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"go.uber.org/zap"
+)
+
+const (
+	StatusSubscribed   = "subscribed"
+	StatusUnsubscribed = "unsubscribed"
+)
+
+// Member is a member of the marketing audience, the merge fields are the ones built by
+// impart.SetMailChimpAnswer.
+type Member struct {
+	Email       string                 `json:"email"`
+	Status      string                 `json:"status"`
+	MergeFields map[string]interface{} `json:"mergeFields,omitempty"`
+	// SetStatus overwrites the status of a member already in the audience. Only explicit
+	// subscription changes set it, so an unsubscribe made in the audience is kept.
+	SetStatus bool `json:"-"`
+}
+
+// Audience is the marketing audience the users are synced to.
+type Audience interface {
+	// Upsert adds the member, or updates it when the email is already in the audience
+	Upsert(ctx context.Context, member Member) error
+	// Remove archives the member, removing an email that is not in the audience is not an error
+	Remove(ctx context.Context, email string) error
+	// Erase permanently deletes the member and its data from the audience
+	Erase(ctx context.Context, email string) error
+	// Members lists the members of the audience that are not archived
+	Members(ctx context.Context) ([]Member, error)
+}
+
+// NewAudience returns the Audience for the configured sink, mailchimp unless told otherwise.
+// Local environments use the file audience instead of mailchimp.
+func NewAudience(cfg *config.Impart, logger *zap.Logger) Audience {
+	switch {
+	case cfg.Marketing.Sink == config.FileMarketingSink,
+		cfg.Marketing.Sink == config.MailchimpMarketingSink && cfg.Env == config.Local:
+		return NewFileAudience(cfg.Marketing.FilePath, logger)
+	case cfg.Marketing.Sink == config.NoopMarketingSink:
+		return NewNoopAudience()
+	}
+	audience, err := NewMailchimpAudience(cfg.MailchimpApikey, cfg.MailchimpAudienceId, logger)
+	if err != nil {
+		logger.Error("unable to create the mailchimp audience, members will not be synced", zap.Error(err))
+		return NewNoopAudience()
+	}
+	return audience
+}
+
+// normalizeEmail is the key members are compared by, the audiences ignore the case of emails
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+type noopAudience struct {
+}
+
+func NewNoopAudience() Audience {
+	return &noopAudience{}
+}
+
+func (a *noopAudience) Upsert(ctx context.Context, member Member) error {
+	return nil
+}
+
+func (a *noopAudience) Remove(ctx context.Context, email string) error {
+	return nil
+}
+
+func (a *noopAudience) Erase(ctx context.Context, email string) error {
+	return nil
+}
+
+func (a *noopAudience) Members(ctx context.Context) ([]Member, error) {
+	return nil, nil
+}
@@ -0,0 +1,108 @@
+package marketing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// fileAudience keeps the audience in a json file keyed by email, for development and tests
+type fileAudience struct {
+	path   string
+	logger *zap.Logger
+	mu     sync.Mutex
+}
+
+func NewFileAudience(path string, logger *zap.Logger) Audience {
+	return &fileAudience{
+		path:   path,
+		logger: logger,
+	}
+}
+
+func (a *fileAudience) read() (map[string]Member, error) {
+	out := make(map[string]Member)
+	b, err := ioutil.ReadFile(a.path)
+	if os.IsNotExist(err) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return out, nil
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a *fileAudience) write(audience map[string]Member) error {
+	if err := os.MkdirAll(filepath.Dir(a.path), 0755); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(audience, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(a.path, b, 0644)
+}
+
+// update applies fn to the audience read from the file and writes it back
+func (a *fileAudience) update(fn func(audience map[string]Member)) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	audience, err := a.read()
+	if err != nil {
+		return err
+	}
+	fn(audience)
+	if err := a.write(audience); err != nil {
+		a.logger.Error("unable to write the audience file", zap.String("path", a.path), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (a *fileAudience) Upsert(ctx context.Context, member Member) error {
+	return a.update(func(audience map[string]Member) {
+		email := normalizeEmail(member.Email)
+		if existing, ok := audience[email]; ok && !member.SetStatus {
+			member.Status = existing.Status
+		}
+		member.SetStatus = false
+		audience[email] = member
+	})
+}
+
+func (a *fileAudience) Remove(ctx context.Context, email string) error {
+	return a.update(func(audience map[string]Member) {
+		delete(audience, normalizeEmail(email))
+	})
+}
+
+func (a *fileAudience) Erase(ctx context.Context, email string) error {
+	return a.Remove(ctx, email)
+}
+
+func (a *fileAudience) Members(ctx context.Context) ([]Member, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	audience, err := a.read()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Member, 0, len(audience))
+	for _, m := range audience {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Email < out[j].Email })
+	return out, nil
+}
@@ -0,0 +1,116 @@
+package marketing
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	mailchimp "github.com/beeker1121/mailchimp-go"
+	"github.com/beeker1121/mailchimp-go/lists/members"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// mailchimpPageSize is the number of members fetched per request when listing the audience
+	mailchimpPageSize = 1000
+	statusArchived    = "archived"
+)
+
+// mailchimpAudience syncs the members to a mailchimp audience (list). The client library keeps
+// the api key in a package variable, so it is set once here and nowhere else.
+type mailchimpAudience struct {
+	listID string
+	logger *zap.Logger
+}
+
+func NewMailchimpAudience(apiKey, listID string, logger *zap.Logger) (Audience, error) {
+	if listID == "" {
+		return nil, errors.New("no mailchimp audience id configured")
+	}
+	if err := mailchimp.SetKey(apiKey); err != nil {
+		return nil, err
+	}
+	mailchimp.SetClient(metrics.InstrumentClient(metrics.Mailchimp, impart.NewHttpClient(10*time.Second)))
+	return &mailchimpAudience{
+		listID: listID,
+		logger: logger,
+	}, nil
+}
+
+// subscriberHash is how mailchimp identifies a member, the md5 of the lowercase email
+func subscriberHash(email string) string {
+	hash := md5.Sum([]byte(normalizeEmail(email)))
+	return hex.EncodeToString(hash[:])
+}
+
+func isMailchimpNotFound(err error) bool {
+	apiErr, ok := err.(*mailchimp.APIError)
+	return ok && apiErr.Status == http.StatusNotFound
+}
+
+func (a *mailchimpAudience) Upsert(ctx context.Context, member Member) error {
+	params := &members.UpdateParams{
+		EmailAddress: member.Email,
+		StatusIfNew:  member.Status,
+		MergeFields:  member.MergeFields,
+	}
+	if member.SetStatus {
+		params.Status = members.Status(member.Status)
+	}
+	_, err := members.Update(a.listID, subscriberHash(member.Email), params)
+	return err
+}
+
+func (a *mailchimpAudience) Remove(ctx context.Context, email string) error {
+	err := members.Delete(a.listID, subscriberHash(email))
+	if isMailchimpNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// Erase deletes the member permanently, an archived member would keep their data in mailchimp.
+func (a *mailchimpAudience) Erase(ctx context.Context, email string) error {
+	path := fmt.Sprintf("lists/%s/members/%s/actions/delete-permanent", a.listID, subscriberHash(email))
+	err := mailchimp.Call(http.MethodPost, path, nil, nil, nil)
+	if isMailchimpNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (a *mailchimpAudience) Members(ctx context.Context) ([]Member, error) {
+	var out []Member
+	for offset := 0; ; offset += mailchimpPageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, err := members.Get(a.listID, &members.GetParams{
+			Fields: []string{"members.email_address", "members.status", "members.merge_fields", "total_items"},
+			Count:  mailchimpPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range page.Members {
+			if m.Status == statusArchived {
+				continue
+			}
+			out = append(out, Member{
+				Email:       m.EmailAddress,
+				Status:      string(m.Status),
+				MergeFields: m.MergeFields,
+			})
+		}
+		if len(page.Members) < mailchimpPageSize || offset+mailchimpPageSize >= page.TotalItems {
+			return out, nil
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package marketing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxAttempts is how many times a change is pushed before it is left as failed
+	maxAttempts = 10
+	// the delay before the first retry, doubled on every attempt up to maxBackoff
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+	queueBatch  = 100
+	// processed changes are kept this long for troubleshooting
+	doneRetention = 7 * 24 * time.Hour
+)
+
+type Service interface {
+	// ProcessQueue pushes the queued changes to the audience, failed changes are retried with a
+	// backoff. It is run by the scheduler.
+	ProcessQueue(ctx context.Context) error
+	// Reconcile compares the users with the members of the audience, with Fix the differences are
+	// queued to be fixed.
+	Reconcile(ctx context.Context, opts ReconcileOptions) (Reconciliation, error)
+}
+
+type service struct {
+	db       *sql.DB
+	audience Audience
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+func New(db *sql.DB, audience Audience, logger *zap.Logger) Service {
+	return &service{
+		db:       db,
+		audience: audience,
+		logger:   logger,
+		now:      impart.CurrentUTC,
+	}
+}
+
+// backoff is the delay before the next attempt of a change that failed attempts times
+func backoff(attempts uint) time.Duration {
+	d := baseBackoff
+	for i := uint(1); i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+func (s *service) ProcessQueue(ctx context.Context) error {
+	changes, err := dbmodels.MarketingSyncQueues(
+		dbmodels.MarketingSyncQueueWhere.Status.EQ(dbmodels.MarketingSyncQueueStatusPending),
+		dbmodels.MarketingSyncQueueWhere.NextAttemptAt.LTE(s.now()),
+		qm.OrderBy(dbmodels.MarketingSyncQueueColumns.SyncID),
+		qm.Limit(queueBatch),
+	).All(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.process(ctx, change)
+	}
+
+	_, err = dbmodels.MarketingSyncQueues(
+		dbmodels.MarketingSyncQueueWhere.Status.EQ(dbmodels.MarketingSyncQueueStatusDone),
+		dbmodels.MarketingSyncQueueWhere.UpdatedAt.LT(s.now().Add(-doneRetention)),
+	).DeleteAll(ctx, s.db)
+	return err
+}
+
+// process pushes a single change and records the outcome, a failure only affects the change
+func (s *service) process(ctx context.Context, change *dbmodels.MarketingSyncQueue) {
+	var err error
+	switch change.Action {
+	case dbmodels.MarketingSyncQueueActionRemove:
+		err = s.audience.Remove(ctx, change.Email.String)
+	case dbmodels.MarketingSyncQueueActionSubscription:
+		err = s.sync(ctx, change.ImpartWealthID.String, true)
+	default:
+		err = s.sync(ctx, change.ImpartWealthID.String, false)
+	}
+
+	now := s.now()
+	change.UpdatedAt = now
+	change.Attempts++
+	if err == nil {
+		change.Status = dbmodels.MarketingSyncQueueStatusDone
+		change.LastError = null.String{}
+	} else {
+		s.logger.Error("unable to sync the marketing audience", zap.Uint64("syncId", change.SyncID),
+			zap.String("action", change.Action), zap.Uint("attempts", change.Attempts), zap.Error(err))
+		msg := err.Error()
+		if len(msg) > 1000 {
+			msg = msg[:1000]
+		}
+		change.LastError = null.StringFrom(msg)
+		if change.Attempts >= maxAttempts {
+			change.Status = dbmodels.MarketingSyncQueueStatusFailed
+		} else {
+			change.NextAttemptAt = now.Add(backoff(change.Attempts))
+		}
+	}
+	if _, err := change.Update(ctx, s.db, boil.Infer()); err != nil {
+		s.logger.Error("unable to update the marketing sync", zap.Uint64("syncId", change.SyncID), zap.Error(err))
+	}
+}
+
+// sync pushes the current state of the user, the status only with setStatus. A deleted user is
+// removed by its own change.
+func (s *service) sync(ctx context.Context, impartWealthID string, setStatus bool) error {
+	user, err := dbmodels.Users(userMods(dbmodels.UserWhere.ImpartWealthID.EQ(impartWealthID))...).One(ctx, s.db)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Blocked {
+		return s.audience.Remove(ctx, user.Email)
+	}
+	member := memberFromUser(user)
+	member.SetStatus = setStatus
+	return s.audience.Upsert(ctx, member)
+}
+
+// userMods loads the users with everything memberFromUser needs
+func userMods(mods ...qm.QueryMod) []qm.QueryMod {
+	return append(mods,
+		qm.Load(dbmodels.UserRels.ImpartWealthProfile),
+		qm.Load(dbmodels.UserRels.MemberHiveHives),
+		qm.Load(qm.Rels(dbmodels.UserRels.ImpartWealthUserAnswers, dbmodels.UserAnswerRels.Answer)),
+	)
+}
+
+// memberFromUser builds the member of the audience from the user, the status merge field tells
+// whether the user is on the waitlist or in a hive.
+func memberFromUser(user *dbmodels.User) Member {
+	member := Member{
+		Email:  user.Email,
+		Status: StatusSubscribed,
+	}
+	if !user.EmailSubscribe {
+		member.Status = StatusUnsubscribed
+	}
+	status, zipCode := "", ""
+	userAnswer := impart.GetUserAnswerList()
+	if user.R != nil {
+		if len(user.R.MemberHiveHives) > 0 {
+			if user.R.MemberHiveHives[0].HiveID == impart.DefaultHiveID {
+				status = impart.WaitList
+			} else {
+				status = impart.Hive
+			}
+		}
+		for _, answer := range user.R.ImpartWealthUserAnswers {
+			if answer.R == nil || answer.R.Answer == nil {
+				continue
+			}
+			questionID := answer.R.Answer.QuestionID
+			userAnswer[questionID] = strings.Trim(fmt.Sprintf("%s,%s", userAnswer[questionID], answer.R.Answer.Text), ",")
+		}
+		if p := user.R.ImpartWealthProfile; p != nil {
+			attr := models.Attributes{}
+			if err := p.Attributes.Unmarshal(&attr); err == nil {
+				zipCode = attr.Address.Zip
+			}
+		}
+	}
+	member.MergeFields = impart.SetMailChimpAnswer(userAnswer, status, zipCode, user.FirstName, user.LastName)
+	return member
+}
@@ -0,0 +1,137 @@
+package marketing
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"go.uber.org/zap"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, backoff(1))
+	assert.Equal(t, 2*time.Minute, backoff(2))
+	assert.Equal(t, 16*time.Minute, backoff(5))
+	assert.Equal(t, maxBackoff, backoff(maxAttempts))
+}
+
+func testUser(email string, hiveID uint64) *dbmodels.User {
+	u := &dbmodels.User{
+		ImpartWealthID: "1xRvvB2ztPPVYpRLLgArq7KHQ8Q",
+		Email:          email,
+		FirstName:      "Jane",
+		EmailSubscribe: true,
+	}
+	u.R = u.R.NewStruct()
+	u.R.MemberHiveHives = dbmodels.HiveSlice{{HiveID: hiveID}}
+	u.R.ImpartWealthProfile = &dbmodels.Profile{Attributes: types.JSON(`{"address":{"zip":"60614"}}`)}
+	for _, a := range []*dbmodels.Answer{
+		{QuestionID: uint(impart.Household), Text: "Single"},
+		{QuestionID: uint(impart.FinancialGoals), Text: "Retirement"},
+		{QuestionID: uint(impart.FinancialGoals), Text: "Home"},
+	} {
+		ua := &dbmodels.UserAnswer{}
+		ua.R = ua.R.NewStruct()
+		ua.R.Answer = a
+		u.R.ImpartWealthUserAnswers = append(u.R.ImpartWealthUserAnswers, ua)
+	}
+	return u
+}
+
+func TestMemberFromUser(t *testing.T) {
+	m := memberFromUser(testUser("member@example.com", impart.DefaultHiveID))
+	assert.Equal(t, "member@example.com", m.Email)
+	assert.Equal(t, StatusSubscribed, m.Status)
+	assert.Equal(t, impart.WaitList, m.MergeFields["STATUS"])
+	assert.Equal(t, "Single", m.MergeFields["HOUSEHOLD"])
+	assert.Equal(t, "Retirement,Home", m.MergeFields["FINANCIALG"])
+	assert.Equal(t, "60614", m.MergeFields["ZIPCODE"])
+	assert.Equal(t, "Jane", m.MergeFields["FNAME"])
+
+	u := testUser("member@example.com", impart.DefaultHiveID+1)
+	u.EmailSubscribe = false
+	m = memberFromUser(u)
+	assert.Equal(t, StatusUnsubscribed, m.Status)
+	assert.Equal(t, impart.Hive, m.MergeFields["STATUS"])
+}
+
+func TestReconcile(t *testing.T) {
+	synced := testUser("synced@example.com", impart.DefaultHiveID)
+	missing := testUser("missing@example.com", impart.DefaultHiveID)
+	outdated := testUser("outdated@example.com", impart.DefaultHiveID+1)
+	unsubscribed := testUser("unsubscribed@example.com", impart.DefaultHiveID)
+	blocked := testUser("blocked@example.com", impart.DefaultHiveID)
+	blocked.Blocked = true
+
+	stale := memberFromUser(outdated)
+	stale.MergeFields = map[string]interface{}{"STATUS": impart.WaitList}
+	optedOut := memberFromUser(unsubscribed)
+	optedOut.Status = StatusUnsubscribed
+	inSync := memberFromUser(synced)
+	// the audience keeps the case the email was added with
+	inSync.Email = "Synced@Example.com"
+	audience := []Member{
+		inSync,
+		stale,
+		optedOut,
+		memberFromUser(blocked),
+		{Email: "newsletter@example.com", Status: StatusSubscribed},
+	}
+
+	diff := reconcile(dbmodels.UserSlice{synced, missing, outdated, unsubscribed, blocked}, audience)
+	assert.Equal(t, []string{"missing@example.com"}, diff.emails(diff.missing))
+	assert.Equal(t, []string{"outdated@example.com"}, diff.emails(diff.outdated))
+	assert.Equal(t, []string{"unsubscribed@example.com"}, diff.emails(diff.unsubscribed))
+	assert.Equal(t, []string{"blocked@example.com"}, diff.emails(diff.blocked))
+	assert.Equal(t, []string{"newsletter@example.com"}, diff.unknown)
+}
+
+func TestFileAudience(t *testing.T) {
+	ctx := context.Background()
+	a := NewFileAudience(filepath.Join(t.TempDir(), "marketing", "audience.json"), zap.NewNop())
+
+	members, err := a.Members(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+
+	require.NoError(t, a.Upsert(ctx, Member{Email: "b@example.com", Status: StatusSubscribed}))
+	require.NoError(t, a.Upsert(ctx, Member{Email: "a@example.com", Status: StatusSubscribed}))
+	require.NoError(t, a.Upsert(ctx, Member{Email: "A@example.com", Status: StatusUnsubscribed}))
+	members, err = a.Members(ctx)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "A@example.com", members[0].Email)
+	assert.Equal(t, StatusSubscribed, members[0].Status, "a routine sync keeps the status of the member")
+
+	require.NoError(t, a.Upsert(ctx, Member{Email: "A@example.com", Status: StatusUnsubscribed, SetStatus: true}))
+	members, err = a.Members(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StatusUnsubscribed, members[0].Status)
+
+	require.NoError(t, a.Remove(ctx, "a@example.com"))
+	require.NoError(t, a.Remove(ctx, "unknown@example.com"))
+	require.NoError(t, a.Erase(ctx, "b@example.com"))
+	members, err = a.Members(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestNewAudience(t *testing.T) {
+	cfg := &config.Impart{Env: config.Local}
+	cfg.Marketing.Sink = config.MailchimpMarketingSink
+	cfg.Marketing.FilePath = filepath.Join(t.TempDir(), "audience.json")
+	assert.IsType(t, &fileAudience{}, NewAudience(cfg, zap.NewNop()), "local environments don't sync to mailchimp")
+
+	cfg.Env = config.Development
+	cfg.Marketing.Sink = config.NoopMarketingSink
+	assert.IsType(t, &noopAudience{}, NewAudience(cfg, zap.NewNop()))
+	cfg.Marketing.Sink = config.FileMarketingSink
+	assert.IsType(t, &fileAudience{}, NewAudience(cfg, zap.NewNop()))
+}
@@ -0,0 +1,80 @@
+package marketing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// QueueSync queues a sync of the users to the audience. The worker pushes the state of the user
+// when the change is processed, so a user with a sync already pending is not queued again.
+// Blocked users are removed from the audience by their sync. The status of a member already in
+// the audience is left as it is.
+func QueueSync(ctx context.Context, exec boil.ContextExecutor, impartWealthIDs ...string) error {
+	return queueUsers(ctx, exec, dbmodels.MarketingSyncQueueActionSync, impartWealthIDs)
+}
+
+// QueueSubscription queues a sync that also overwrites the status of the members, for users who
+// subscribed or unsubscribed from the app.
+func QueueSubscription(ctx context.Context, exec boil.ContextExecutor, impartWealthIDs ...string) error {
+	return queueUsers(ctx, exec, dbmodels.MarketingSyncQueueActionSubscription, impartWealthIDs)
+}
+
+func queueUsers(ctx context.Context, exec boil.ContextExecutor, action string, impartWealthIDs []string) error {
+	now := impart.CurrentUTC()
+	for _, id := range impartWealthIDs {
+		if id == "" {
+			continue
+		}
+		pending, err := dbmodels.MarketingSyncQueues(
+			dbmodels.MarketingSyncQueueWhere.ImpartWealthID.EQ(null.StringFrom(id)),
+			dbmodels.MarketingSyncQueueWhere.Action.EQ(action),
+			dbmodels.MarketingSyncQueueWhere.Status.EQ(dbmodels.MarketingSyncQueueStatusPending),
+		).Exists(ctx, exec)
+		if err != nil {
+			return err
+		}
+		if pending {
+			continue
+		}
+		change := &dbmodels.MarketingSyncQueue{
+			Action:         action,
+			ImpartWealthID: null.StringFrom(id),
+			Status:         dbmodels.MarketingSyncQueueStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := change.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueueRemoval queues the removal of the emails from the audience, for users that are deleted
+// or whose email is about to change.
+func QueueRemoval(ctx context.Context, exec boil.ContextExecutor, emails ...string) error {
+	now := impart.CurrentUTC()
+	for _, email := range emails {
+		if email = strings.TrimSpace(email); email == "" {
+			continue
+		}
+		change := &dbmodels.MarketingSyncQueue{
+			Action:        dbmodels.MarketingSyncQueueActionRemove,
+			Email:         null.StringFrom(email),
+			Status:        dbmodels.MarketingSyncQueueStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := change.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package marketing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+)
+
+type ReconcileOptions struct {
+	// Fix queues the fixes of the differences, otherwise they are only reported
+	Fix bool
+	// Prune removes the members that are not users, they may have signed up elsewhere so it is
+	// only done when asked for
+	Prune bool
+}
+
+// Reconciliation lists the emails that differ between the users and the audience
+type Reconciliation struct {
+	Users   int `json:"users"`
+	Members int `json:"members"`
+	// users that are not in the audience
+	Missing []string `json:"missing"`
+	// users whose merge fields or status are out of date
+	Outdated []string `json:"outdated"`
+	// users that unsubscribed from an email of the audience, their subscription is turned off
+	Unsubscribed []string `json:"unsubscribed"`
+	// blocked users still in the audience
+	Blocked []string `json:"blocked"`
+	// members that are not users
+	Unknown []string `json:"unknown"`
+}
+
+func (s *service) Reconcile(ctx context.Context, opts ReconcileOptions) (Reconciliation, error) {
+	out := Reconciliation{}
+	users, err := dbmodels.Users(userMods()...).All(ctx, s.db)
+	if err != nil {
+		return out, err
+	}
+	audience, err := s.audience.Members(ctx)
+	if err != nil {
+		return out, err
+	}
+	out.Users, out.Members = len(users), len(audience)
+
+	diff := reconcile(users, audience)
+	out.Missing, out.Outdated, out.Unsubscribed, out.Blocked, out.Unknown =
+		diff.emails(diff.missing), diff.emails(diff.outdated), diff.emails(diff.unsubscribed), diff.emails(diff.blocked), diff.unknown
+	if !opts.Fix {
+		return out, nil
+	}
+
+	for _, u := range diff.unsubscribed {
+		u.EmailSubscribe = false
+		if _, err := u.Update(ctx, s.db, boil.Whitelist(dbmodels.UserColumns.EmailSubscribe)); err != nil {
+			return out, err
+		}
+	}
+	var ids []string
+	for _, group := range [][]*dbmodels.User{diff.missing, diff.unsubscribed, diff.blocked} {
+		for _, u := range group {
+			ids = append(ids, u.ImpartWealthID)
+		}
+	}
+	if err := QueueSync(ctx, s.db, ids...); err != nil {
+		return out, err
+	}
+	// an outdated status is one the app unsubscribed from, members who unsubscribed in the
+	// audience are in unsubscribed, so pushing the status of the user is safe
+	var outdated []string
+	for _, u := range diff.outdated {
+		outdated = append(outdated, u.ImpartWealthID)
+	}
+	if err := QueueSubscription(ctx, s.db, outdated...); err != nil {
+		return out, err
+	}
+	ids = append(ids, outdated...)
+	if opts.Prune {
+		if err := QueueRemoval(ctx, s.db, diff.unknown...); err != nil {
+			return out, err
+		}
+	}
+	s.logger.Info("marketing audience reconciled", zap.Int("synced", len(ids)),
+		zap.Int("unsubscribed", len(diff.unsubscribed)), zap.Bool("pruned", opts.Prune), zap.Int("unknown", len(diff.unknown)))
+	return out, nil
+}
+
+type audienceDiff struct {
+	missing, outdated, unsubscribed, blocked []*dbmodels.User
+	unknown                                  []string
+}
+
+func (d audienceDiff) emails(users []*dbmodels.User) []string {
+	out := make([]string, len(users))
+	for i, u := range users {
+		out[i] = u.Email
+	}
+	return out
+}
+
+// reconcile compares the users with the members of the audience. A member that unsubscribed
+// in the audience is not subscribed again, the user follows the audience instead.
+func reconcile(users dbmodels.UserSlice, audience []Member) audienceDiff {
+	diff := audienceDiff{}
+	members := make(map[string]Member, len(audience))
+	for _, m := range audience {
+		members[normalizeEmail(m.Email)] = m
+	}
+	known := make(map[string]bool, len(users))
+	for _, u := range users {
+		email := normalizeEmail(u.Email)
+		known[email] = true
+		m, inAudience := members[email]
+		switch {
+		case u.Blocked:
+			if inAudience {
+				diff.blocked = append(diff.blocked, u)
+			}
+		case !inAudience:
+			diff.missing = append(diff.missing, u)
+		case m.Status == StatusUnsubscribed && u.EmailSubscribe:
+			diff.unsubscribed = append(diff.unsubscribed, u)
+		case !sameMember(memberFromUser(u), m):
+			diff.outdated = append(diff.outdated, u)
+		}
+	}
+	for _, m := range audience {
+		if !known[normalizeEmail(m.Email)] {
+			diff.unknown = append(diff.unknown, m.Email)
+		}
+	}
+	return diff
+}
+
+// sameMember reports whether the member in the audience matches the expected one, merge fields
+// the audience has but we do not set are ignored. Statuses only the audience sets, such as
+// cleaned, are left alone.
+func sameMember(expected, actual Member) bool {
+	if (actual.Status == StatusSubscribed || actual.Status == StatusUnsubscribed) && expected.Status != actual.Status {
+		return false
+	}
+	for k, v := range expected.MergeFields {
+		if fmt.Sprint(v) != fmt.Sprint(actual.MergeFields[k]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlobStore keeps the media files by key. Missing keys are reported with ErrNotUploaded, except by
+// Delete which leaves them out so a delete can be retried.
+type BlobStore interface {
+	// Put stores the body at key, replacing the object already stored there
+	Put(ctx context.Context, key, contentType string, body io.Reader) error
+	// Get reads the object stored at key, at most limit bytes when limit is set
+	Get(ctx context.Context, key string, limit int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, keys ...string) error
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	// List returns the objects whose key starts with prefix
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// URL is where the object is read from, objects are private so it is only kept as a reference
+	URL(key string) string
+	// SignedURL is a url that reads (GET) or puts (PUT) the object stored at key until it expires, a
+	// put has to send the content type it was signed for.
+	SignedURL(ctx context.Context, method, key, contentType string, expiry time.Duration) (string, error)
+	// MakePrivate removes the public read access of objects stored before objects were private
+	MakePrivate(ctx context.Context, keys ...string) error
+}
+
+// NewBlobStore returns the store of the configured storage: local files, s3, an s3 compatible storage
+// like minio or memory for tests. An unknown storage returns a store that fails every call.
+func NewBlobStore(sc StorageConfigurations) BlobStore {
+	switch sc.Storage {
+	case "", "local":
+		return newLocalStore(sc)
+	case "s3", "minio":
+		return NewS3Store(sc)
+	case "memory":
+		return NewMemoryStore(sc)
+	default:
+		return unknownStore{storage: sc.Storage}
+	}
+}
+
+// localSigner signs the urls of the stores that are read and put through the local media routes
+type localSigner struct {
+	sc  StorageConfigurations
+	now func() time.Time
+}
+
+func (ls localSigner) SignedURL(_ context.Context, method, key, contentType string, expiry time.Duration) (string, error) {
+	base := ls.sc.Upload.LocalMediaURL
+	switch method {
+	case http.MethodGet:
+		contentType = ""
+	case http.MethodPut:
+		base = ls.sc.Upload.LocalURL
+	default:
+		return "", fmt.Errorf("unable to sign a %s url", method)
+	}
+	expires := strconv.FormatInt(ls.now().Add(expiry).Unix(), 10)
+	q := url.Values{}
+	q.Set("key", key)
+	q.Set("expires", expires)
+	q.Set("signature", ls.sc.LocalSignature(method, key, contentType, expires))
+	return base + "?" + q.Encode(), nil
+}
+
+// LocalSignature signs a local media url of the object stored at key until expires, a unix timestamp.
+// The method keeps a read url from being used to put, a put is signed with the content type it sends.
+func (sc StorageConfigurations) LocalSignature(method, key, contentType, expires string) string {
+	return sc.sign(strings.ToLower(method), key, contentType, expires)
+}
+
+// VerifyLocalSignature checks the signature of a local media url and that it hasn't expired
+func (sc StorageConfigurations) VerifyLocalSignature(method, key, contentType, expires, signature string, now time.Time) error {
+	return sc.verify(sc.LocalSignature(method, key, contentType, expires), expires, signature, now)
+}
+
+type unknownStore struct {
+	storage string
+}
+
+func (u unknownStore) err() error {
+	return fmt.Errorf("unable to identify media storage %q", u.storage)
+}
+
+func (u unknownStore) Put(context.Context, string, string, io.Reader) error { return u.err() }
+func (u unknownStore) Get(context.Context, string, int64) (io.ReadCloser, error) {
+	return nil, u.err()
+}
+func (u unknownStore) Delete(context.Context, ...string) error { return u.err() }
+func (u unknownStore) Stat(context.Context, string) (ObjectInfo, error) {
+	return ObjectInfo{}, u.err()
+}
+func (u unknownStore) List(context.Context, string) ([]ObjectInfo, error) { return nil, u.err() }
+func (u unknownStore) URL(key string) string                              { return key }
+func (u unknownStore) SignedURL(context.Context, string, string, string, time.Duration) (string, error) {
+	return "", u.err()
+}
+func (u unknownStore) MakePrivate(context.Context, ...string) error { return u.err() }
@@ -0,0 +1,191 @@
+package media
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// fileSystemStore keeps the objects as files under root. The keys are the paths of the files
+// relative to root, or start with prefix when the keys carry the media path like s3 keys do. It
+// is the local storage, its files are read and put through the signed local media urls.
+type fileSystemStore struct {
+	localSigner
+	root   string
+	prefix string
+}
+
+// NewFileSystemStore returns a store of the files under root, the keys are relative to root
+func NewFileSystemStore(root string, sc StorageConfigurations) BlobStore {
+	return &fileSystemStore{
+		localSigner: localSigner{sc: sc, now: func() time.Time { return time.Now().UTC() }},
+		root:        root,
+	}
+}
+
+// newLocalStore returns the local storage rooted at the media path, its keys start with the media path
+func newLocalStore(sc StorageConfigurations) BlobStore {
+	return &fileSystemStore{
+		localSigner: localSigner{sc: sc, now: func() time.Time { return time.Now().UTC() }},
+		root:        sc.MediaPath,
+		prefix:      sc.MediaPath,
+	}
+}
+
+// path is the file of the key under root, keys that are absolute or lead out of root are rejected
+func (fs *fileSystemStore) path(key string) (string, error) {
+	if !strings.HasPrefix(key, fs.prefix) {
+		return "", ErrInvalidKey
+	}
+	rel := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(key, fs.prefix)))
+	if filepath.IsAbs(rel) || filepath.VolumeName(rel) != "" {
+		return "", ErrInvalidKey
+	}
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		if part == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+	return filepath.Join(fs.root, rel), nil
+}
+
+func (fs *fileSystemStore) key(path string) string {
+	rel, err := filepath.Rel(fs.root, path)
+	if err != nil {
+		return fs.prefix + filepath.ToSlash(path)
+	}
+	return fs.prefix + filepath.ToSlash(rel)
+}
+
+// Put writes to a temporary file first so a failed put never leaves a partial object
+func (fs *fileSystemStore) Put(_ context.Context, key, _ string, body io.Reader) error {
+	path, err := fs.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".put-*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, body)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (fs *fileSystemStore) Get(_ context.Context, key string, limit int64) (io.ReadCloser, error) {
+	path, err := fs.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotUploaded
+	}
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		return limitReadCloser(f, limit), nil
+	}
+	return f, nil
+}
+
+func (fs *fileSystemStore) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		path, err := fs.path(key)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stat leaves the content type out, files don't keep it
+func (fs *fileSystemStore) Stat(_ context.Context, key string) (ObjectInfo, error) {
+	path, err := fs.path(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return ObjectInfo{}, ErrNotUploaded
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Key: key, Size: info.Size(), ModifiedAt: info.ModTime().UTC()}, nil
+}
+
+func (fs *fileSystemStore) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	dir, err := fs.path(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		dir = filepath.Dir(dir)
+	}
+	var out []ObjectInfo
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".put-") {
+			return nil
+		}
+		if key := fs.key(path); strings.HasPrefix(key, prefix) {
+			out = append(out, ObjectInfo{Key: key, Size: info.Size(), ModifiedAt: info.ModTime().UTC()})
+		}
+		return nil
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out, err
+}
+
+// URL is the path of the file, or the key itself when it is not a valid key
+func (fs *fileSystemStore) URL(key string) string {
+	path, err := fs.path(key)
+	if err != nil {
+		return key
+	}
+	return path
+}
+
+// MakePrivate does nothing, files are only read through the signed local media urls
+func (fs *fileSystemStore) MakePrivate(context.Context, ...string) error {
+	return nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func limitReadCloser(rc io.ReadCloser, limit int64) io.ReadCloser {
+	return readCloser{Reader: io.LimitReader(rc, limit), Closer: rc}
+}
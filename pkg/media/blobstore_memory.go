@@ -0,0 +1,103 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryStore keeps the objects in memory, it is meant for tests. Its urls are signed like the
+// urls of the local storage so the local media routes can be tested against it.
+type memoryStore struct {
+	localSigner
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+}
+
+type memoryObject struct {
+	body        []byte
+	contentType string
+	modifiedAt  time.Time
+}
+
+// NewMemoryStore returns an empty store kept in memory
+func NewMemoryStore(sc StorageConfigurations) BlobStore {
+	return &memoryStore{
+		localSigner: localSigner{sc: sc, now: func() time.Time { return time.Now().UTC() }},
+		objects:     map[string]memoryObject{},
+	}
+}
+
+func (m *memoryStore) Put(_ context.Context, key, contentType string, body io.Reader) error {
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = memoryObject{body: b, contentType: contentType, modifiedAt: m.now()}
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, key string, limit int64) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	o, ok := m.objects[key]
+	if !ok {
+		return nil, ErrNotUploaded
+	}
+	b := o.body
+	if limit > 0 && int64(len(b)) > limit {
+		b = b[:limit]
+	}
+	return ioutil.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.objects, key)
+	}
+	return nil
+}
+
+func (m *memoryStore) Stat(_ context.Context, key string) (ObjectInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	o, ok := m.objects[key]
+	if !ok {
+		return ObjectInfo{}, ErrNotUploaded
+	}
+	return o.info(key), nil
+}
+
+func (m *memoryStore) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []ObjectInfo
+	for key, o := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			out = append(out, o.info(key))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out, nil
+}
+
+func (m *memoryStore) URL(key string) string {
+	return "memory://" + key
+}
+
+func (m *memoryStore) MakePrivate(context.Context, ...string) error {
+	return nil
+}
+
+func (o memoryObject) info(key string) ObjectInfo {
+	return ObjectInfo{Key: key, Size: int64(len(o.body)), ContentType: o.contentType, ModifiedAt: o.modifiedAt}
+}
@@ -0,0 +1,240 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
+	"github.com/impartwealthapp/backend/pkg/metrics"
+)
+
+// deleteBatch is the most keys s3 deletes in one request
+const deleteBatch = 1000
+
+// s3Store keeps the objects in a bucket of s3, or of an s3 compatible storage like minio when
+// an endpoint is set. The credentials are read from the environment by the aws sdk.
+type s3Store struct {
+	sc      StorageConfigurations
+	once    sync.Once
+	svc     *s3.S3
+	initErr error
+}
+
+// NewS3Store returns the store of the configured bucket, the session is created on first use
+func NewS3Store(sc StorageConfigurations) BlobStore {
+	return &s3Store{sc: sc}
+}
+
+func (st *s3Store) service() (*s3.S3, error) {
+	st.once.Do(func() {
+		cfg := &aws.Config{Region: aws.String(st.sc.BucketRegion)}
+		if st.sc.Endpoint != "" {
+			cfg.Endpoint = aws.String(st.sc.Endpoint)
+			cfg.S3ForcePathStyle = aws.Bool(true)
+		}
+		s, err := session.NewSession(cfg)
+		if err != nil {
+			st.initErr = err
+			return
+		}
+		st.svc = s3.New(metrics.InstrumentAWS(s))
+	})
+	return st.svc, st.initErr
+}
+
+func (st *s3Store) Put(ctx context.Context, key, contentType string, body io.Reader) error {
+	svc, err := st.service()
+	if err != nil {
+		return err
+	}
+	rs, ok := body.(io.ReadSeeker)
+	if !ok {
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		rs = bytes.NewReader(b)
+	}
+	_, err = svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(st.sc.BucketName),
+		Key:         aws.String(key),
+		Body:        rs,
+		ContentType: aws.String(contentType),
+	})
+	return err
+}
+
+func (st *s3Store) Get(ctx context.Context, key string, limit int64) (io.ReadCloser, error) {
+	svc, err := st.service()
+	if err != nil {
+		return nil, err
+	}
+	in := &s3.GetObjectInput{
+		Bucket: aws.String(st.sc.BucketName),
+		Key:    aws.String(key),
+	}
+	if limit > 0 {
+		in.Range = aws.String(fmt.Sprintf("bytes=0-%d", limit-1))
+	}
+	out, err := svc.GetObjectWithContext(ctx, in)
+	if isNotFound(err) {
+		return nil, ErrNotUploaded
+	}
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		return limitReadCloser(out.Body, limit), nil
+	}
+	return out.Body, nil
+}
+
+func (st *s3Store) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	svc, err := st.service()
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(keys); start += deleteBatch {
+		end := start + deleteBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := svc.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(st.sc.BucketName),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.Errors) > 0 {
+			e := out.Errors[0]
+			return fmt.Errorf("unable to delete %d objects, %s: %s", len(out.Errors), aws.StringValue(e.Key), aws.StringValue(e.Message))
+		}
+	}
+	return nil
+}
+
+func (st *s3Store) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	svc, err := st.service()
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	head, err := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(st.sc.BucketName),
+		Key:    aws.String(key),
+	})
+	if isNotFound(err) {
+		return ObjectInfo{}, ErrNotUploaded
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{
+		Key:         key,
+		Size:        aws.Int64Value(head.ContentLength),
+		ContentType: aws.StringValue(head.ContentType),
+		ModifiedAt:  aws.TimeValue(head.LastModified),
+	}, nil
+}
+
+func (st *s3Store) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	svc, err := st.service()
+	if err != nil {
+		return nil, err
+	}
+	var out []ObjectInfo
+	err = svc.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(st.sc.BucketName),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			out = append(out, ObjectInfo{
+				Key:        aws.StringValue(o.Key),
+				Size:       aws.Int64Value(o.Size),
+				ModifiedAt: aws.TimeValue(o.LastModified),
+			})
+		}
+		return true
+	})
+	return out, err
+}
+
+func (st *s3Store) URL(key string) string {
+	if st.sc.Endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(st.sc.Endpoint, "/"), st.sc.BucketName, key)
+	}
+	cfg, _ := config.GetImpart()
+	if cfg.Env == config.Preproduction {
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com%s", st.sc.BucketName, st.sc.BucketRegion, key)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", st.sc.BucketName, st.sc.BucketRegion, key)
+}
+
+func (st *s3Store) SignedURL(_ context.Context, method, key, contentType string, expiry time.Duration) (string, error) {
+	svc, err := st.service()
+	if err != nil {
+		return "", err
+	}
+	var req *request.Request
+	switch method {
+	case http.MethodGet:
+		req, _ = svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(st.sc.BucketName),
+			Key:    aws.String(key),
+		})
+	case http.MethodPut:
+		req, _ = svc.PutObjectRequest(&s3.PutObjectInput{
+			Bucket:      aws.String(st.sc.BucketName),
+			Key:         aws.String(key),
+			ContentType: aws.String(contentType),
+		})
+	default:
+		return "", fmt.Errorf("unable to sign a %s url", method)
+	}
+	return req.Presign(expiry)
+}
+
+func (st *s3Store) MakePrivate(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	svc, err := st.service()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		_, err := svc.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
+			Bucket: aws.String(st.sc.BucketName),
+			Key:    aws.String(key),
+			ACL:    aws.String(s3.ObjectCannedACLPrivate),
+		})
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey)
+}
@@ -0,0 +1,84 @@
+package media
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStores(t *testing.T) {
+	sc := testStorage(t).StorageConfigurations
+	for name, store := range map[string]BlobStore{
+		"fs":     NewFileSystemStore(t.TempDir(), sc),
+		"memory": NewMemoryStore(sc),
+	} {
+		t.Run(name, func(t *testing.T) {
+			testBlobStore(t, store)
+		})
+	}
+}
+
+func testBlobStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+	_, err := store.Stat(ctx, "post/a.png")
+	assert.Equal(t, ErrNotUploaded, err)
+	_, err = store.Get(ctx, "post/a.png", 0)
+	assert.Equal(t, ErrNotUploaded, err)
+
+	require.NoError(t, store.Put(ctx, "post/a.png", "image/png", strings.NewReader("first")))
+	require.NoError(t, store.Put(ctx, "post/a.png", "image/png", strings.NewReader("12345")))
+	require.NoError(t, store.Put(ctx, "post/b/c.png", "image/png", strings.NewReader("123")))
+	require.NoError(t, store.Put(ctx, "postscript.txt", "text/plain", strings.NewReader("1")))
+
+	info, err := store.Stat(ctx, "post/a.png")
+	require.NoError(t, err)
+	assert.Equal(t, "post/a.png", info.Key)
+	assert.Equal(t, int64(5), info.Size)
+
+	body, err := store.Get(ctx, "post/a.png", 3)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	assert.Equal(t, "123", string(b))
+
+	list, err := store.List(ctx, "post/")
+	require.NoError(t, err)
+	var keys []string
+	for _, o := range list {
+		keys = append(keys, o.Key)
+	}
+	assert.Equal(t, []string{"post/a.png", "post/b/c.png"}, keys)
+	list, err = store.List(ctx, "post")
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	signed, err := store.SignedURL(ctx, http.MethodGet, "post/a.png", "", time.Minute)
+	require.NoError(t, err)
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, "post/a.png", u.Query().Get("key"))
+	_, err = store.SignedURL(ctx, http.MethodDelete, "post/a.png", "", time.Minute)
+	assert.Error(t, err)
+
+	// deleting a missing key is not an error so a delete can be retried
+	require.NoError(t, store.Delete(ctx, "post/a.png", "post/b/c.png", "post/missing.png"))
+	_, err = store.Stat(ctx, "post/a.png")
+	assert.Equal(t, ErrNotUploaded, err)
+	list, err = store.List(ctx, "post/")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestUnknownStore(t *testing.T) {
+	fp := New(StorageConfigurations{Storage: "ftp"})
+	_, err := fp.StatUpload(context.Background(), "post/a.png")
+	assert.EqualError(t, err, `unable to identify media storage "ftp"`)
+}
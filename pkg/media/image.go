@@ -0,0 +1,289 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	RenditionOriginal  = "original"
+	RenditionMedium    = "medium"
+	RenditionThumbnail = "thumbnail"
+
+	// longest side of the medium and thumbnail renditions, in pixels
+	MediumSize    = 1280
+	ThumbnailSize = 320
+
+	// maxPixels refuses images that would take too much memory once decoded
+	maxPixels = 50000000
+)
+
+var ErrUnsupportedImage = errors.New("image can't be processed")
+
+// Rendition is a processed copy of an uploaded image
+type Rendition struct {
+	Name        string
+	ContentType string
+	Body        []byte
+}
+
+// DetectContentType reads the content type of a file from its first bytes rather than trusting
+// the type the client declared.
+func DetectContentType(b []byte) string {
+	return http.DetectContentType(b)
+}
+
+// IsImage tells if the content type is processed into renditions
+func IsImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// ProcessImage re-encodes the image without its metadata, so EXIF and GPS data are dropped, and
+// returns the original, medium and thumbnail renditions. A jpeg is turned upright from its EXIF
+// orientation first since the orientation is dropped with the rest. The original of a gif keeps
+// every frame, the smaller renditions of a gif are a png of its first frame.
+func ProcessImage(b []byte) ([]Rendition, error) {
+	contentType := DetectContentType(b)
+	if !IsImage(contentType) {
+		return nil, ErrUnsupportedImage
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrUnsupportedImage
+	}
+
+	var original bytes.Buffer
+	var src *image.RGBA
+	switch contentType {
+	case "image/jpeg":
+		img, err := jpeg.Decode(bytes.NewReader(b))
+		if err != nil {
+			return nil, ErrUnsupportedImage
+		}
+		src = orient(toRGBA(img), jpegOrientation(b))
+		if err := jpeg.Encode(&original, src, &jpeg.Options{Quality: 90}); err != nil {
+			return nil, err
+		}
+	case "image/png":
+		img, err := png.Decode(bytes.NewReader(b))
+		if err != nil {
+			return nil, ErrUnsupportedImage
+		}
+		src = toRGBA(img)
+		if err := png.Encode(&original, img); err != nil {
+			return nil, err
+		}
+	case "image/gif":
+		g, err := gif.DecodeAll(bytes.NewReader(b))
+		if err != nil || len(g.Image) == 0 {
+			return nil, ErrUnsupportedImage
+		}
+		src = toRGBA(g.Image[0])
+		if err := gif.EncodeAll(&original, g); err != nil {
+			return nil, err
+		}
+	}
+
+	out := []Rendition{{Name: RenditionOriginal, ContentType: contentType, Body: original.Bytes()}}
+	for _, r := range []struct {
+		name string
+		size int
+	}{{RenditionMedium, MediumSize}, {RenditionThumbnail, ThumbnailSize}} {
+		body, ct, err := encodeRendition(resize(src, r.size), contentType)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Rendition{Name: r.name, ContentType: ct, Body: body})
+	}
+	return out, nil
+}
+
+// RenditionKey is where a rendition of the file stored at key is kept, the original replaces the upload
+func RenditionKey(key string, r Rendition) string {
+	if r.Name == RenditionOriginal {
+		return key
+	}
+	ext := ".jpg"
+	if r.ContentType == "image/png" {
+		ext = ".png"
+	}
+	return strings.TrimSuffix(key, filepath.Ext(key)) + "_" + r.Name + ext
+}
+
+// RenditionContentType is the content type of the medium and thumbnail renditions of an image
+func RenditionContentType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return contentType
+	}
+	return "image/png"
+}
+
+func encodeRendition(img image.Image, contentType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	contentType = RenditionContentType(contentType)
+	if contentType == "image/jpeg" {
+		err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+		return buf.Bytes(), contentType, err
+	}
+	err := png.Encode(&buf, img)
+	return buf.Bytes(), contentType, err
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	out := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(out, out.Bounds(), img, b.Min, draw.Src)
+	return out
+}
+
+// resize scales the image down so its longest side is at most size, averaging the pixels each
+// output pixel covers. Smaller images are returned as they are.
+func resize(src *image.RGBA, size int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w <= size && h <= size {
+		return src
+	}
+	dw, dh := size, h*size/w
+	if h > w {
+		dw, dh = w*size/h, size
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*h/dh, (y+1)*h/dh
+		if sy1 <= sy0 {
+			sy1 = sy0 + 1
+		}
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*w/dw, (x+1)*w/dw
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+			var sum [4]uint64
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					sum[0] += uint64(p[0])
+					sum[1] += uint64(p[1])
+					sum[2] += uint64(p[2])
+					sum[3] += uint64(p[3])
+				}
+			}
+			n := uint64((sy1 - sy0) * (sx1 - sx0))
+			d := dst.Pix[y*dst.Stride+x*4:]
+			d[0], d[1], d[2], d[3] = uint8(sum[0]/n), uint8(sum[1]/n), uint8(sum[2]/n), uint8(sum[3]/n)
+		}
+	}
+	return dst
+}
+
+// orient turns the image upright from its EXIF orientation, 1 to 8
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored
+				dx, dy = w-1-x, y
+			case 3: // upside down
+				dx, dy = w-1-x, h-1-y
+			case 4: // upside down and mirrored
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // needs a quarter turn clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // needs a quarter turn counter clockwise
+				dx, dy = y, w-1-x
+			}
+			copy(dst.Pix[dy*dst.Stride+dx*4:dy*dst.Stride+dx*4+4], src.Pix[y*src.Stride+x*4:y*src.Stride+x*4+4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the orientation tag of the EXIF segment of a jpeg, 1 (upright) when there is none
+func jpegOrientation(b []byte) int {
+	if len(b) < 4 || b[0] != 0xFF || b[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(b); {
+		if b[i] != 0xFF {
+			return 1
+		}
+		marker := b[i+1]
+		if marker == 0xDA || marker == 0xD9 { // image data starts, no more metadata
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(b[i+2:]))
+		if length < 2 || i+2+length > len(b) {
+			return 1
+		}
+		segment := b[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+func tiffOrientation(t []byte) int {
+	if len(t) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(t[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	ifd := int(order.Uint32(t[4:]))
+	if ifd+2 > len(t) {
+		return 1
+	}
+	entries := int(order.Uint16(t[ifd:]))
+	for e := 0; e < entries; e++ {
+		entry := ifd + 2 + e*12
+		if entry+12 > len(t) {
+			return 1
+		}
+		if order.Uint16(t[entry:]) == 0x0112 {
+			return int(order.Uint16(t[entry+8:]))
+		}
+	}
+	return 1
+}
@@ -0,0 +1,144 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage is red on its left half and blue on its right half
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// withExif inserts an APP1 segment holding the orientation and a GPS pointer right after the SOI marker
+func withExif(t *testing.T, jpg []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	binary.Write(&tiff, binary.BigEndian, uint16(42))
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(2))
+	// orientation, SHORT
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	// GPS IFD pointer, LONG
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x8825, 4})
+	binary.Write(&tiff, binary.BigEndian, []uint32{1, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	require.NoError(t, binary.Write(&out, binary.BigEndian, uint16(len(segment)+2)))
+	out.Write(segment)
+	out.Write(jpg[2:])
+	return out.Bytes()
+}
+
+func TestProcessJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, testImage(2000, 1000), nil))
+	b := withExif(t, buf.Bytes(), 6)
+	assert.Equal(t, 6, jpegOrientation(b))
+
+	renditions, err := ProcessImage(b)
+	require.NoError(t, err)
+	require.Len(t, renditions, 3)
+
+	sizes := map[string][2]int{
+		RenditionOriginal:  {1000, 2000},
+		RenditionMedium:    {640, 1280},
+		RenditionThumbnail: {160, 320},
+	}
+	for _, r := range renditions {
+		assert.Equal(t, "image/jpeg", r.ContentType, r.Name)
+		assert.False(t, bytes.Contains(r.Body, []byte("Exif\x00\x00")), "%s keeps the exif segment", r.Name)
+		assert.Equal(t, 1, jpegOrientation(r.Body), r.Name)
+		img, err := jpeg.Decode(bytes.NewReader(r.Body))
+		require.NoError(t, err, r.Name)
+		assert.Equal(t, sizes[r.Name][0], img.Bounds().Dx(), r.Name)
+		assert.Equal(t, sizes[r.Name][1], img.Bounds().Dy(), r.Name)
+	}
+
+	// a quarter turn clockwise puts the red left half on top
+	img, _ := jpeg.Decode(bytes.NewReader(renditions[0].Body))
+	r, _, bl, _ := img.At(500, 100).RGBA()
+	assert.True(t, r > bl, "top should be red")
+	r, _, bl, _ = img.At(500, 1900).RGBA()
+	assert.True(t, bl > r, "bottom should be blue")
+}
+
+func TestProcessPNGAndGIF(t *testing.T) {
+	var pngBuf bytes.Buffer
+	require.NoError(t, png.Encode(&pngBuf, testImage(400, 200)))
+	renditions, err := ProcessImage(pngBuf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, renditions, 3)
+	assert.Equal(t, "image/png", renditions[0].ContentType)
+	thumb, err := png.Decode(bytes.NewReader(renditions[2].Body))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 320, 160), thumb.Bounds())
+	// smaller than the medium size, it keeps its size
+	medium, err := png.Decode(bytes.NewReader(renditions[1].Body))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 400, 200), medium.Bounds())
+
+	var gifBuf bytes.Buffer
+	require.NoError(t, gif.Encode(&gifBuf, testImage(40, 20), nil))
+	renditions, err = ProcessImage(gifBuf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "image/gif", renditions[0].ContentType)
+	assert.Equal(t, "image/png", renditions[2].ContentType)
+}
+
+func TestProcessRejectsOtherContent(t *testing.T) {
+	_, err := ProcessImage([]byte("%PDF-1.4 not an image"))
+	assert.Equal(t, ErrUnsupportedImage, err)
+	_, err = ProcessImage([]byte{0xFF, 0xD8, 0xFF, 0xE0, 0, 0})
+	assert.Equal(t, ErrUnsupportedImage, err)
+	assert.Equal(t, "application/pdf", DetectContentType([]byte("%PDF-1.4 not an image")))
+}
+
+func TestRenditionKey(t *testing.T) {
+	key := "media/post/someone/2Aa_photo.jpeg"
+	assert.Equal(t, key, RenditionKey(key, Rendition{Name: RenditionOriginal, ContentType: "image/jpeg"}))
+	assert.Equal(t, "media/post/someone/2Aa_photo_thumbnail.jpg", RenditionKey(key, Rendition{Name: RenditionThumbnail, ContentType: "image/jpeg"}))
+	assert.Equal(t, "media/post/someone/2Aa_photo_medium.png", RenditionKey("media/post/someone/2Aa_photo.gif", Rendition{Name: RenditionMedium, ContentType: "image/png"}))
+}
+
+func TestOrient(t *testing.T) {
+	src := testImage(4, 2)
+	for orientation, bounds := range map[int]image.Rectangle{
+		1: image.Rect(0, 0, 4, 2),
+		3: image.Rect(0, 0, 4, 2),
+		6: image.Rect(0, 0, 2, 4),
+		8: image.Rect(0, 0, 2, 4),
+	} {
+		assert.Equal(t, bounds, orient(src, orientation).Bounds(), "orientation %d", orientation)
+	}
+	// upside down puts the red left half on the right
+	assert.Equal(t, uint8(255), orient(src, 3).RGBAAt(3, 0).R)
+	// a quarter turn counter clockwise puts the red left half at the bottom
+	assert.Equal(t, uint8(255), orient(src, 8).RGBAAt(0, 3).R)
+	assert.Equal(t, uint8(255), orient(src, 8).RGBAAt(0, 0).B)
+}
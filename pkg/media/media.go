@@ -2,15 +2,11 @@ package media
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
-	"io"
-	"os"
 	"sync"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/models"
 )
@@ -19,21 +15,25 @@ import (
 type S3Storage struct {
 	BucketName   string
 	BucketRegion string
+	Endpoint     string // of an s3 compatible storage like minio
 }
 type StorageConfigurations struct {
-	Storage   string // with local / s3
+	Storage   string // with local / s3 / minio / memory
 	MediaPath string
 	S3Storage
+	Upload Upload
 }
 
 //uploader
 type FileUpload struct {
 	StorageConfigurations
+	Store BlobStore
 }
 
 func New(opt StorageConfigurations) *FileUpload {
 	return &FileUpload{
 		StorageConfigurations: opt,
+		Store:                 NewBlobStore(opt),
 	}
 }
 
@@ -49,210 +49,61 @@ func LoadMediaConfig(cfg *config.Impart) StorageConfigurations {
 	if v, ok := cfg.Media["Bucket"]; ok {
 		sc.BucketName = v
 	}
-
-	sc.BucketRegion = cfg.Region
-	return sc
-}
-
-// s3 uploader
-type s3Uploader struct {
-	StorageConfigurations
-}
-
-// s3 uploader
-type localUploader struct {
-	StorageConfigurations
-}
-
-// media service
-type MediaUploadService interface {
-	UploadMultipleFile(files []models.File) ([]models.File, error)
-}
-
-// Upload multiple files
-func (fp *FileUpload) UploadMultipleFile(files []models.File) ([]models.File, error) {
-	var uploaderService MediaUploadService
-
-	switch fp.Storage {
-	case "", "local":
-		uploaderService = &localUploader{
-			StorageConfigurations: fp.StorageConfigurations,
-		}
-	case "s3":
-		uploaderService = &s3Uploader{
-			StorageConfigurations: fp.StorageConfigurations,
-		}
-	default:
-		return []models.File{}, fmt.Errorf("unable to identify media storage")
-	}
-
-	return uploaderService.UploadMultipleFile(files)
-}
-
-// Upload multiple files
-func (up *s3Uploader) UploadMultipleFile(files []models.File) ([]models.File, error) {
-	var uploadedFiles []models.File
-	errChan := make(chan error)
-	uploadedFilesChan := make(chan models.File)
-
-	s, err := up.NewSession()
-	if err != nil {
-		return []models.File{}, err
-	}
-
-	var wg sync.WaitGroup
-	wg.Add(len(files))
-
-	// upload the files
-	for _, f := range files {
-		fileObj := f
-
-		go func() {
-			defer wg.Done()
-			file, err := up.UploadSingleFile(s, fileObj)
-			if err != nil {
-				errChan <- err
-			}
-			uploadedFilesChan <- file
-		}()
-	}
-
-	// Start a goroutine to close out once all the output goroutines are
-	// done.  This must start after the wg.Add call.
-	go func() {
-		wg.Wait()
-		close(uploadedFilesChan)
-		close(errChan)
-	}()
-
-	select {
-	case file := <-uploadedFilesChan:
-		uploadedFiles = append(uploadedFiles, file)
-	case err := <-errChan:
-		return uploadedFiles, err
-	}
-	return uploadedFiles, nil
-}
-
-//
-// Upload single file to s3
-//
-func (up *s3Uploader) UploadSingleFile(s *session.Session, file models.File) (models.File, error) {
-
-	fileName := file.FileName
-	// append base path
-	fileName = fmt.Sprintf("%s%s%s", up.MediaPath, file.FilePath, fileName)
-
-	ipFile, err := base64.StdEncoding.DecodeString(file.Content)
-	if err != nil {
-		return models.File{}, err
+	if v, ok := cfg.Media["Endpoint"]; ok {
+		sc.Endpoint = v
 	}
 
-	srcFile := bytes.NewReader(ipFile)
-	_, err = s3.New(s).PutObject(&s3.PutObjectInput{
-		Bucket:      aws.String(up.BucketName),
-		Key:         aws.String(fileName),
-		Body:        srcFile,
-		ContentType: &file.FileType,
-		ACL:         aws.String("public-read"),
-	})
-	if err != nil {
-		return models.File{}, err
-	}
-	//append the url to the response
-	file.URL = up.ConstructS3FilePath(fileName)
-	return file, nil
-}
-
-// create new session for s3
-func (up *s3Uploader) NewSession() (*session.Session, error) {
-	s, err := session.NewSession(&aws.Config{Region: aws.String(up.BucketRegion)})
-	if err != nil {
-		return nil, err
-	}
-	return s, nil
-}
+	sc.BucketRegion = cfg.Region
+	sc.Upload = Upload{
+		MaxBytes:  cfg.Upload.MaxBytes,
+		URLExpiry: cfg.Upload.URLExpiry,
+		Secret:    cfg.Upload.Secret,
+		LocalURL:  cfg.Upload.LocalURL,
 
-// construct uploded file path
-func (up *s3Uploader) ConstructS3FilePath(filename string) string {
-	cfg, _ := config.GetImpart()
-	if cfg.Env == config.Preproduction {
-		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com%s", up.BucketName, up.BucketRegion, filename)
+		MediaURLExpiry: cfg.Upload.MediaURLExpiry,
+		LocalMediaURL:  cfg.Upload.LocalMediaURL,
 	}
-	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", up.BucketName, up.BucketRegion, filename)
+	return sc
 }
 
-// local
-func (lp *localUploader) UploadMultipleFile(files []models.File) ([]models.File, error) {
-	var uploadedFiles []models.File
-	errChan := make(chan error)
-	uploadedFilesChan := make(chan models.File)
+// Upload multiple files posted in the body, the files are stored at the same time
+func (fp *FileUpload) UploadMultipleFile(files []models.File) ([]models.File, error) {
+	uploadedFiles := make([]models.File, len(files))
+	errs := make([]error, len(files))
 
 	var wg sync.WaitGroup
 	wg.Add(len(files))
-
-	// upload the files
-	for _, f := range files {
-		fileObj := f
-
-		go func() {
+	for i := range files {
+		go func(i int) {
 			defer wg.Done()
-			file, err := lp.UploadSingleFile(fileObj)
-			if err != nil {
-				errChan <- err
-			}
-			uploadedFilesChan <- file
-		}()
+			uploadedFiles[i], errs[i] = fp.UploadSingleFile(context.Background(), files[i])
+		}(i)
 	}
+	wg.Wait()
 
-	// Start a goroutine to close out once all the output goroutines are
-	// done.  This must start after the wg.Add call.
-	go func() {
-		wg.Wait()
-		close(uploadedFilesChan)
-		close(errChan)
-	}()
-
-	select {
-	case file := <-uploadedFilesChan:
-		uploadedFiles = append(uploadedFiles, file)
-	case err := <-errChan:
-		return uploadedFiles, err
+	for _, err := range errs {
+		if err != nil {
+			return []models.File{}, err
+		}
 	}
 	return uploadedFiles, nil
 }
 
 //
-// Upload single file to local
+// Upload single file posted in the body
 //
-func (lp *localUploader) UploadSingleFile(file models.File) (models.File, error) {
-	fileName := file.FileName
-
+func (fp *FileUpload) UploadSingleFile(ctx context.Context, file models.File) (models.File, error) {
 	// append base path
-	fileName = fmt.Sprintf("%s%s%s", lp.MediaPath, file.FilePath, fileName)
+	key := fmt.Sprintf("%s%s%s", fp.MediaPath, file.FilePath, file.FileName)
 
 	ipFile, err := base64.StdEncoding.DecodeString(file.Content)
 	if err != nil {
 		return models.File{}, err
 	}
-
-	imgFile, err := os.Create(fileName)
-	if err != nil {
+	if err := fp.Store.Put(ctx, key, file.FileType, bytes.NewReader(ipFile)); err != nil {
 		return models.File{}, err
 	}
-	srcFile := bytes.NewReader(ipFile)
-	_, err = io.Copy(imgFile, srcFile)
-
-	if err != nil {
-		return models.File{}, err
-	}
-
 	//append the url to the response
-	file.URL = lp.ConstructLocalFilePath(fileName)
+	file.URL = fp.Store.URL(key)
 	return file, nil
 }
-
-// construct uploded file path
-func (lp *localUploader) ConstructLocalFilePath(filename string) string {
-	return filename
-}
@@ -0,0 +1,227 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// stuckAfter is how long a file can stay processing before another run picks it up again
+	stuckAfter = 10 * time.Minute
+	// processConcurrency bounds the images decoded at the same time by an instance
+	processConcurrency = 2
+	// processBatch is the most files a scheduled run processes
+	processBatch = 100
+	// orphanAfter is how long an upload no post references is kept after its upload url expired
+	orphanAfter = 24 * time.Hour
+)
+
+// Processor strips the metadata of attached files and stores their renditions in the background,
+// so creating a post doesn't wait for it. Files are claimed in the database before being processed,
+// every instance can run the processor.
+type Processor interface {
+	// Enqueue processes the pending files in the background
+	Enqueue(fileIDs ...uint64)
+	// ProcessPending processes the pending files and the files stuck processing, it is run by the scheduler
+	// to pick up the files an instance didn't finish.
+	ProcessPending(ctx context.Context) error
+	// CleanupUploads deletes the uploads no post referenced in time, with their stored files
+	CleanupUploads(ctx context.Context) error
+}
+
+type processor struct {
+	db      *sql.DB
+	storage *FileUpload
+	logger  *zap.Logger
+	sem     chan struct{}
+	now     func() time.Time
+}
+
+func NewProcessor(db *sql.DB, storage *FileUpload, logger *zap.Logger) Processor {
+	return &processor{
+		db:      db,
+		storage: storage,
+		logger:  logger,
+		sem:     make(chan struct{}, processConcurrency),
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (p *processor) Enqueue(fileIDs ...uint64) {
+	for _, id := range fileIDs {
+		go func(fileID uint64) {
+			p.sem <- struct{}{}
+			defer func() { <-p.sem }()
+			if err := p.process(context.Background(), fileID); err != nil {
+				p.logger.Error("unable to process file", zap.Uint64("fileId", fileID), zap.Error(err))
+			}
+		}(id)
+	}
+}
+
+func (p *processor) ProcessPending(ctx context.Context) error {
+	files, err := dbmodels.Files(
+		qm.Select(dbmodels.FileColumns.Fid),
+		dbmodels.FileWhere.Status.EQ(dbmodels.FilesStatusAttached),
+		qm.Expr(
+			dbmodels.FileWhere.ProcessingStatus.EQ(dbmodels.FilesProcessingStatusPending),
+			qm.Or2(qm.Expr(
+				dbmodels.FileWhere.ProcessingStatus.EQ(dbmodels.FilesProcessingStatusProcessing),
+				dbmodels.FileWhere.ProcessedAt.LT(null.TimeFrom(p.now().Add(-stuckAfter))),
+			)),
+		),
+		qm.OrderBy(dbmodels.FileColumns.Fid),
+		qm.Limit(processBatch),
+	).All(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	var failed int
+	for _, f := range files {
+		if err := p.process(ctx, f.Fid); err != nil {
+			p.logger.Error("unable to process file", zap.Uint64("fileId", f.Fid), zap.Error(err))
+			failed++
+		}
+	}
+	p.logger.Info("media-processing : done", zap.Int("files", len(files)), zap.Int("failed", failed))
+	if failed > 0 {
+		return fmt.Errorf("unable to process %d of %d files", failed, len(files))
+	}
+	return nil
+}
+
+func (p *processor) CleanupUploads(ctx context.Context) error {
+	files, err := dbmodels.Files(
+		dbmodels.FileWhere.Status.IN([]string{dbmodels.FilesStatusPending, dbmodels.FilesStatusUploaded}),
+		dbmodels.FileWhere.ExpiresAt.LT(null.TimeFrom(p.now().Add(-orphanAfter))),
+		qm.OrderBy(dbmodels.FileColumns.Fid),
+		qm.Limit(processBatch),
+	).All(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	var failed int
+	for _, f := range files {
+		if f.StorageKey.Valid {
+			if err := p.storage.Store.Delete(ctx, f.StorageKey.String); err != nil {
+				p.logger.Error("unable to delete orphaned upload", zap.Uint64("fileId", f.Fid), zap.Error(err))
+				failed++
+				continue
+			}
+		}
+		// the object goes first so a failed delete leaves the row to retry it
+		if _, err := f.Delete(ctx, p.db); err != nil {
+			p.logger.Error("unable to delete orphaned upload", zap.Uint64("fileId", f.Fid), zap.Error(err))
+			failed++
+		}
+	}
+	p.logger.Info("media-cleanup : done", zap.Int("files", len(files)), zap.Int("failed", failed))
+	if failed > 0 {
+		return fmt.Errorf("unable to delete %d of %d orphaned uploads", failed, len(files))
+	}
+	return nil
+}
+
+// process strips and renders one file. A file that isn't an allowed image or document is marked
+// failed, a storage or database error leaves it processing so a later run retries it.
+func (p *processor) process(ctx context.Context, fileID uint64) error {
+	now := p.now()
+	res, err := queries.Raw(`update files set processing_status = ?, processed_at = ?
+		where fid = ? and status = ? and (processing_status = ? or (processing_status = ? and processed_at < ?))`,
+		dbmodels.FilesProcessingStatusProcessing, now, fileID, dbmodels.FilesStatusAttached,
+		dbmodels.FilesProcessingStatusPending, dbmodels.FilesProcessingStatusProcessing, now.Add(-stuckAfter),
+	).ExecContext(ctx, p.db)
+	if err != nil {
+		return err
+	}
+	if claimed, err := res.RowsAffected(); err != nil || claimed == 0 {
+		// processed or claimed by another run
+		return err
+	}
+	f, err := dbmodels.FindFile(ctx, p.db, fileID)
+	if err != nil {
+		return err
+	}
+	if !f.StorageKey.Valid {
+		return p.fail(ctx, f, "file has no storage key")
+	}
+	b, err := p.storage.ReadObject(ctx, f.StorageKey.String, 0)
+	if err == ErrNotUploaded {
+		return p.fail(ctx, f, "file is missing from the storage")
+	}
+	if err != nil {
+		return err
+	}
+
+	contentType := DetectContentType(b)
+	if !UploadContentTypes[contentType] {
+		return p.fail(ctx, f, fmt.Sprintf("content type %s is not allowed", contentType))
+	}
+	f.FileType = contentType
+	f.Size = uint64(len(b))
+	if IsImage(contentType) {
+		renditions, err := ProcessImage(b)
+		if err != nil {
+			return p.fail(ctx, f, err.Error())
+		}
+		for _, r := range renditions {
+			key := RenditionKey(f.StorageKey.String, r)
+			if err := p.storage.WriteObject(ctx, key, r.ContentType, r.Body); err != nil {
+				return err
+			}
+			switch r.Name {
+			case RenditionOriginal:
+				f.Size = uint64(len(r.Body))
+			case RenditionMedium:
+				f.MediumURL = null.StringFrom(p.storage.FileURL(key))
+			case RenditionThumbnail:
+				f.ThumbnailURL = null.StringFrom(p.storage.FileURL(key))
+			}
+		}
+	}
+	f.ProcessingStatus = dbmodels.FilesProcessingStatusReady
+	f.ProcessedAt = null.TimeFrom(p.now())
+	_, err = f.Update(ctx, p.db, boil.Whitelist(
+		dbmodels.FileColumns.FileType,
+		dbmodels.FileColumns.Size,
+		dbmodels.FileColumns.ThumbnailURL,
+		dbmodels.FileColumns.MediumURL,
+		dbmodels.FileColumns.ProcessingStatus,
+		dbmodels.FileColumns.ProcessedAt,
+	))
+	return err
+}
+
+func (p *processor) fail(ctx context.Context, f *dbmodels.File, reason string) error {
+	p.logger.Warn("media-processing : file failed", zap.Uint64("fileId", f.Fid), zap.String("reason", reason))
+	f.ProcessingStatus = dbmodels.FilesProcessingStatusFailed
+	f.ProcessedAt = null.TimeFrom(p.now())
+	_, err := f.Update(ctx, p.db, boil.Whitelist(dbmodels.FileColumns.ProcessingStatus, dbmodels.FileColumns.ProcessedAt))
+	return err
+}
+
+// ReadObject reads the file stored at key, at most limit bytes when limit is set
+func (fp *FileUpload) ReadObject(ctx context.Context, key string, limit int64) ([]byte, error) {
+	body, err := fp.Store.Get(ctx, key, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// WriteObject stores the file at key, replacing the file already stored there
+func (fp *FileUpload) WriteObject(ctx context.Context, key, contentType string, body []byte) error {
+	return fp.Store.Put(ctx, key, contentType, bytes.NewReader(body))
+}
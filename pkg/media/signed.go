@@ -0,0 +1,41 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// SignedURL is a url that reads the file stored at key until it expires, a presigned s3 get or a
+// signed url of the local media route. Stored files are private so they are only read with these urls.
+func (fp *FileUpload) SignedURL(ctx context.Context, key string) (string, error) {
+	return fp.Store.SignedURL(ctx, http.MethodGet, key, "", fp.Upload.MediaURLExpiry)
+}
+
+// MakePrivate removes the public read access files stored before files were private had,
+// so the links already handed out stop working.
+func (fp *FileUpload) MakePrivate(ctx context.Context, keys ...string) error {
+	return fp.Store.MakePrivate(ctx, keys...)
+}
+
+// KeyFromURL is the key of a file stored before the key was kept with the file, read back from its url
+func (fp *FileUpload) KeyFromURL(fileURL string) string {
+	return strings.TrimPrefix(fileURL, fp.Store.URL(""))
+}
+
+// FileKeys are the keys of a file stored at key and of its renditions when it has some
+func FileKeys(key, contentType string, hasRenditions bool) []string {
+	if !hasRenditions {
+		return []string{key}
+	}
+	return []string{
+		key,
+		FileRenditionKey(key, contentType, RenditionMedium),
+		FileRenditionKey(key, contentType, RenditionThumbnail),
+	}
+}
+
+// FileRenditionKey is where the rendition of a file of the content type stored at key is kept
+func FileRenditionKey(key, contentType, rendition string) string {
+	return RenditionKey(key, Rendition{Name: rendition, ContentType: RenditionContentType(contentType)})
+}
@@ -0,0 +1,53 @@
+package media
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignedURL(t *testing.T) {
+	fp := testStorage(t)
+	now := time.Now().UTC()
+	key := fp.MediaPath + "post/a_thumbnail.png"
+	signed, err := fp.SignedURL(context.Background(), key)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/media", u.Path)
+	assert.Equal(t, key, u.Query().Get("key"))
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	assert.NoError(t, fp.VerifyLocalSignature("GET", key, "", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("GET", fp.MediaPath+"post/a.png", "", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("GET", key, "", expires, signature, now.Add(31*time.Minute)))
+
+	// a read url doesn't upload and an upload url doesn't read
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key, "", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("GET", key, "", expires, fp.LocalSignature("PUT", key, "", expires), now))
+}
+
+func TestKeyFromURL(t *testing.T) {
+	fp := testStorage(t)
+	assert.Equal(t, fp.MediaPath+"post/a.png", fp.KeyFromURL(fp.FileURL(fp.MediaPath+"post/a.png")))
+
+	minio := New(StorageConfigurations{Storage: "minio", S3Storage: S3Storage{BucketName: "bucket", Endpoint: "http://localhost:9000/"}})
+	assert.Equal(t, "http://localhost:9000/bucket/post/a.png", minio.FileURL("post/a.png"))
+	assert.Equal(t, "post/a.png", minio.KeyFromURL(minio.FileURL("post/a.png")))
+}
+
+func TestFileRenditionKey(t *testing.T) {
+	assert.Equal(t, "post/a.jpeg", FileRenditionKey("post/a.jpeg", "image/jpeg", RenditionOriginal))
+	assert.Equal(t, "post/a_medium.jpg", FileRenditionKey("post/a.jpeg", "image/jpeg", RenditionMedium))
+	assert.Equal(t, "post/a_thumbnail.png", FileRenditionKey("post/a.gif", "image/gif", RenditionThumbnail))
+}
+
+func TestFileKeys(t *testing.T) {
+	assert.Equal(t, []string{"post/a.pdf"}, FileKeys("post/a.pdf", "application/pdf", false))
+	assert.Equal(t, []string{"post/a.png", "post/a_medium.png", "post/a_thumbnail.png"}, FileKeys("post/a.png", "image/png", true))
+}
@@ -0,0 +1,165 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultMaxBytes bounds a local upload when no upload size limit is configured
+const defaultMaxBytes = 10 << 20
+
+var (
+	ErrContentType      = errors.New("content type is not allowed")
+	ErrFileSize         = errors.New("file size is not allowed")
+	ErrInvalidSignature = errors.New("url is invalid or expired")
+	ErrNotUploaded      = errors.New("file has not been uploaded")
+	ErrInvalidKey       = errors.New("invalid storage key")
+)
+
+// UploadContentTypes are the content types a client can upload directly to the storage, they
+// are the types the processor can read.
+var UploadContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"application/pdf": true,
+}
+
+// Upload limits the direct uploads, LocalURL is where a local storage receives them
+// and LocalMediaURL where it serves them. Secret signs the local urls.
+type Upload struct {
+	MaxBytes       int64
+	URLExpiry      time.Duration
+	Secret         string
+	LocalURL       string
+	MediaURLExpiry time.Duration
+	LocalMediaURL  string
+}
+
+// PresignedUpload is the request the client sends to put the file in the storage,
+// every header has to be sent as is since they are part of the signature.
+type PresignedUpload struct {
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// ObjectInfo is what the storage knows about a stored file, ContentType is empty
+// when the storage doesn't keep it.
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModifiedAt  time.Time
+}
+
+// ValidateUpload checks the declared content type and size of a file before an upload url is given out
+func (sc StorageConfigurations) ValidateUpload(contentType string, size int64) error {
+	if !UploadContentTypes[contentType] {
+		return ErrContentType
+	}
+	if size <= 0 || (sc.Upload.MaxBytes > 0 && size > sc.Upload.MaxBytes) {
+		return ErrFileSize
+	}
+	return nil
+}
+
+// PresignUpload returns the request that puts the file stored at key, a presigned s3 put
+// or a signed url of the local upload route.
+func (fp *FileUpload) PresignUpload(ctx context.Context, key, contentType string, now time.Time) (PresignedUpload, error) {
+	out := PresignedUpload{
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: now.Add(fp.Upload.URLExpiry),
+	}
+	var err error
+	out.URL, err = fp.Store.SignedURL(ctx, http.MethodPut, key, contentType, fp.Upload.URLExpiry)
+	return out, err
+}
+
+// StatUpload returns the size and content type of the file stored at key
+func (fp *FileUpload) StatUpload(ctx context.Context, key string) (ObjectInfo, error) {
+	return fp.Store.Stat(ctx, key)
+}
+
+// FileURL is the url a stored file is read from
+func (fp *FileUpload) FileURL(key string) string {
+	return fp.Store.URL(key)
+}
+
+// SignsLocally tells if the store is read and put through the signed local media routes rather
+// than urls of the storage itself.
+func (sc StorageConfigurations) SignsLocally() bool {
+	switch sc.Storage {
+	case "", "local", "memory":
+		return true
+	}
+	return false
+}
+
+// Validate checks a storage signing its own urls has a secret to sign them with, and that the
+// local storage has a media path to keep its files under.
+func (sc StorageConfigurations) Validate() error {
+	if !sc.SignsLocally() {
+		return nil
+	}
+	if sc.Upload.Secret == "" {
+		return errors.New("an upload secret is required to sign the local media urls")
+	}
+	if sc.Storage != "memory" && sc.MediaPath == "" {
+		return errors.New("a media base path is required by the local storage")
+	}
+	return nil
+}
+
+// PutLocalUpload stores the body put to a local upload url at key. The body has to be the size
+// declared when the upload url was given out, within the upload size limit, and is read no further
+// so a partial or oversized file is never kept.
+func (fp *FileUpload) PutLocalUpload(ctx context.Context, key, contentType string, size int64, body io.Reader) error {
+	limit := fp.Upload.MaxBytes
+	if limit <= 0 {
+		limit = defaultMaxBytes
+	}
+	if size <= 0 || size > limit {
+		return ErrFileSize
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(body, size+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(b)) != size {
+		return ErrFileSize
+	}
+	return fp.Store.Put(ctx, key, contentType, bytes.NewReader(b))
+}
+
+// sign is the signature of a local url, the purpose keeps a read url from being used to upload
+func (sc StorageConfigurations) sign(purpose string, parts ...string) string {
+	mac := hmac.New(sha256.New, []byte(sc.Upload.Secret))
+	mac.Write([]byte(purpose))
+	for _, p := range parts {
+		mac.Write([]byte(":" + p))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (sc StorageConfigurations) verify(expected, expires, signature string, now time.Time) error {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > unix {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package media
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStorage(t *testing.T) *FileUpload {
+	return New(StorageConfigurations{
+		Storage:   "local",
+		MediaPath: t.TempDir() + "/",
+		Upload: Upload{
+			MaxBytes:       1024,
+			URLExpiry:      15 * time.Minute,
+			Secret:         "unit-test-secret",
+			LocalURL:       "http://localhost:8080/v1/uploads",
+			MediaURLExpiry: 30 * time.Minute,
+			LocalMediaURL:  "http://localhost:8080/v1/media",
+		},
+	})
+}
+
+func TestValidateUpload(t *testing.T) {
+	fp := testStorage(t)
+	assert.NoError(t, fp.ValidateUpload("image/png", 1024))
+	assert.Equal(t, ErrContentType, fp.ValidateUpload("text/html", 10))
+	assert.Equal(t, ErrFileSize, fp.ValidateUpload("image/png", 0))
+	assert.Equal(t, ErrFileSize, fp.ValidateUpload("image/png", 1025))
+}
+
+func TestPresignLocalUpload(t *testing.T) {
+	fp := testStorage(t)
+	now := time.Now().UTC()
+	key := fp.MediaPath + "post/a.png"
+	presigned, err := fp.PresignUpload(context.Background(), key, "image/png", now)
+	require.NoError(t, err)
+	assert.Equal(t, "PUT", presigned.Method)
+	assert.Equal(t, "image/png", presigned.Headers["Content-Type"])
+	assert.Equal(t, now.Add(15*time.Minute), presigned.ExpiresAt)
+
+	u, err := url.Parse(presigned.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/uploads", u.Path)
+	assert.Equal(t, key, u.Query().Get("key"))
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	assert.NoError(t, fp.VerifyLocalSignature("PUT", key, "image/png", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key+"x", "image/png", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key, "text/html", expires, signature, now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key, "image/png", expires, "00"+signature[2:], now))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key, "image/png", expires, signature, now.Add(16*time.Minute)))
+	assert.Equal(t, ErrInvalidSignature, fp.VerifyLocalSignature("PUT", key, "image/png", "soon", signature, now))
+}
+
+func TestPutLocalUpload(t *testing.T) {
+	fp := testStorage(t)
+	ctx := context.Background()
+	key := filepath.Join(fp.MediaPath, "post", "someone", "a.png")
+
+	require.NoError(t, fp.PutLocalUpload(ctx, key, "image/png", 5, strings.NewReader("12345")))
+	b, err := ioutil.ReadFile(key)
+	require.NoError(t, err)
+	assert.Equal(t, "12345", string(b))
+	info, err := fp.StatUpload(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+
+	// a body other than the declared size, or a size over the limit, is not kept
+	other := filepath.Join(fp.MediaPath, "post", "someone", "b.png")
+	assert.Equal(t, ErrFileSize, fp.PutLocalUpload(ctx, other, "image/png", 1025, strings.NewReader(strings.Repeat("1", 1025))))
+	assert.Equal(t, ErrFileSize, fp.PutLocalUpload(ctx, other, "image/png", 5, strings.NewReader("123456")))
+	assert.Equal(t, ErrFileSize, fp.PutLocalUpload(ctx, other, "image/png", 5, strings.NewReader("1234")))
+	assert.Equal(t, ErrFileSize, fp.PutLocalUpload(ctx, other, "image/png", 0, strings.NewReader("")))
+	_, err = fp.StatUpload(ctx, other)
+	assert.Equal(t, ErrNotUploaded, err)
+}
+
+func TestLocalStoreKeys(t *testing.T) {
+	fp := testStorage(t)
+	ctx := context.Background()
+	outside := t.TempDir()
+	for _, key := range []string{
+		"/etc/passwd",
+		filepath.Join(outside, "a.png"),
+		fp.MediaPath + "../a.png",
+		fp.MediaPath + "post/../../a.png",
+		fp.MediaPath + "/etc/passwd",
+		"post/a.png",
+	} {
+		assert.Equal(t, ErrInvalidKey, fp.Store.Put(ctx, key, "image/png", strings.NewReader("1")), key)
+		_, err := fp.Store.Get(ctx, key, 0)
+		assert.Equal(t, ErrInvalidKey, err, key)
+		_, err = fp.Store.Stat(ctx, key)
+		assert.Equal(t, ErrInvalidKey, err, key)
+	}
+	files, err := ioutil.ReadDir(outside)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	// a path that stays under the media path is cleaned
+	require.NoError(t, fp.Store.Put(ctx, fp.MediaPath+"post/x/../a.png", "image/png", strings.NewReader("1")))
+	_, err = fp.Store.Stat(ctx, fp.MediaPath+"post/a.png")
+	assert.NoError(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	sc := testStorage(t).StorageConfigurations
+	assert.NoError(t, sc.Validate())
+
+	noSecret := sc
+	noSecret.Upload.Secret = ""
+	assert.Error(t, noSecret.Validate())
+	noPath := sc
+	noPath.MediaPath = ""
+	assert.Error(t, noPath.Validate())
+
+	s3 := sc
+	s3.Storage, s3.Upload.Secret = "s3", ""
+	assert.NoError(t, s3.Validate(), "s3 signs its own urls")
+}
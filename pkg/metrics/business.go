@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	postsCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "posts_created_total",
+		Help:      "Posts created, by hive.",
+	}, []string{"hive"})
+
+	commentsCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "comments_created_total",
+		Help:      "Comments created, by hive.",
+	}, []string{"hive"})
+
+	votes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "votes_total",
+		Help:      "Votes cast and taken back on posts and comments, by hive.",
+	}, []string{"hive", "content", "action"})
+
+	reports = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reports_total",
+		Help:      "Posts and comments reported, by hive.",
+	}, []string{"hive", "content"})
+
+	signups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "signups_total",
+		Help:      "Members placed in a hive after the onboarding questionnaire, the default hive is the waitlist.",
+	}, []string{"hive"})
+)
+
+func init() {
+	Registry.MustRegister(postsCreated, commentsCreated, votes, reports, signups)
+}
+
+func hiveLabel(hiveID uint64) string {
+	return strconv.FormatUint(hiveID, 10)
+}
+
+func PostCreated(hiveID uint64) {
+	postsCreated.WithLabelValues(hiveLabel(hiveID)).Inc()
+}
+
+func CommentCreated(hiveID uint64) {
+	commentsCreated.WithLabelValues(hiveLabel(hiveID)).Inc()
+}
+
+// Voted records a vote on a post or comment, action is the vote type, e.g. upVote or takeUpVote
+func Voted(hiveID uint64, content, action string) {
+	votes.WithLabelValues(hiveLabel(hiveID), content, action).Inc()
+}
+
+// Reported records a report of a post or comment
+func Reported(hiveID uint64, content string) {
+	reports.WithLabelValues(hiveLabel(hiveID), content).Inc()
+}
+
+func SignedUp(hiveID uint64) {
+	signups.WithLabelValues(hiveLabel(hiveID)).Inc()
+}
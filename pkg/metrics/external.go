@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The external services the api calls
+const (
+	SNS       = "sns"
+	SES       = "ses"
+	S3        = "s3"
+	Plaid     = "plaid"
+	Mailchimp = "mailchimp"
+	Auth0     = "auth0"
+)
+
+// errorCode labels the calls that got no response at all
+const errorCode = "error"
+
+var (
+	externalRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "requests_total",
+		Help:      "Calls to external services, by service, operation and status.",
+	}, []string{"service", "operation", "code"})
+
+	externalDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken by the calls to external services, by service and operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation"})
+)
+
+func init() {
+	Registry.MustRegister(externalRequests, externalDuration)
+}
+
+// ObserveExternal records a call to an external service, code is the http status or "error" when
+// the call got no response.
+func ObserveExternal(service, operation, code string, elapsed time.Duration) {
+	externalRequests.WithLabelValues(service, operation, code).Inc()
+	externalDuration.WithLabelValues(service, operation).Observe(elapsed.Seconds())
+}
+
+// InstrumentClient returns a copy of the client recording its calls to the service, the
+// operation is the http method as the paths carry ids.
+func InstrumentClient(service string, client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	instrumented := *client
+	instrumented.Transport = &transport{service: service, next: next}
+	return &instrumented
+}
+
+type transport struct {
+	service string
+	next    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	code := errorCode
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	ObserveExternal(t.service, strings.ToLower(req.Method), code, time.Since(start))
+	return resp, err
+}
+
+// InstrumentAWS records the calls made with the session by their aws service and operation,
+// e.g. sns Publish, retries included.
+func InstrumentAWS(sess *session.Session) *session.Session {
+	sess.Handlers.Complete.PushBack(func(r *request.Request) {
+		code := errorCode
+		if r.HTTPResponse != nil {
+			code = strconv.Itoa(r.HTTPResponse.StatusCode)
+		}
+		operation := ""
+		if r.Operation != nil {
+			operation = r.Operation.Name
+		}
+		ObserveExternal(strings.ToLower(r.ClientInfo.ServiceID), operation, code, time.Since(r.Time))
+	})
+	return sess
+}
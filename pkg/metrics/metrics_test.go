@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(HTTPHandler())
+	r.GET("/hives/:hiveId/posts", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+
+	for _, target := range []string{"/hives/1/posts", "/hives/2/posts", "/unknown/path"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+	}
+	assert.Equal(t, float64(2), testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, "/hives/:hiveId/posts", "200")),
+		"the requests are counted by route template")
+	assert.Equal(t, float64(1), testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, unmatchedRoute, "404")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(httpInFlight))
+}
+
+func TestInstrumentClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := InstrumentClient(Mailchimp, server.Client())
+	resp, err := client.Get(server.URL + "/lists/1/members/2")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, float64(1), testutil.ToFloat64(externalRequests.WithLabelValues(Mailchimp, "get", "404")))
+	assert.NotSame(t, server.Client(), client)
+
+	server.Close()
+	_, err = client.Get(server.URL)
+	assert.Error(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(externalRequests.WithLabelValues(Mailchimp, "get", errorCode)))
+}
+
+func TestBusinessCounters(t *testing.T) {
+	PostCreated(2)
+	PostCreated(2)
+	Voted(2, "post", "upVote")
+	Reported(3, "comment")
+	SignedUp(1)
+	assert.Equal(t, float64(2), testutil.ToFloat64(postsCreated.WithLabelValues("2")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(votes.WithLabelValues("2", "post", "upVote")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(reports.WithLabelValues("3", "comment")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(signups.WithLabelValues("1")))
+}
+
+func TestHandler(t *testing.T) {
+	// the pool stats are read without connecting
+	db, err := sql.Open("mysql", "user:pass@tcp(127.0.0.1:1)/impart")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(7)
+	require.NoError(t, RegisterDB("test", db))
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `impart_db_max_open_connections{db="test"} 7`)
+	assert.True(t, strings.Contains(body, "go_goroutines"))
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+// AccountDeletion is a request to delete an account, the account is purged after PurgeAfter
+// unless the request is cancelled. Steps is only set for admins.
+type AccountDeletion struct {
+	ImpartWealthID string                `json:"impartWealthId"`
+	Status         string                `json:"status"`
+	ByAdmin        bool                  `json:"byAdmin"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	PurgeAfter     time.Time             `json:"purgeAfter"`
+	CancelledAt    *time.Time            `json:"cancelledAt,omitempty"`
+	CompletedAt    *time.Time            `json:"completedAt,omitempty"`
+	Steps          []AccountDeletionStep `json:"steps,omitempty"`
+}
+
+// AccountDeletionStep is the progress of one step of the purge of an account
+type AccountDeletionStep struct {
+	Step        string     `json:"step"`
+	Status      string     `json:"status"`
+	Attempts    uint       `json:"attempts"`
+	LastError   string     `json:"lastError,omitempty"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func AccountDeletionFromDBModel(d *dbmodels.AccountDeletion, steps dbmodels.AccountDeletionStepSlice) AccountDeletion {
+	out := AccountDeletion{
+		ImpartWealthID: d.ImpartWealthID,
+		Status:         d.Status,
+		ByAdmin:        d.RequestedBy != d.ImpartWealthID,
+		CreatedAt:      d.CreatedAt,
+		PurgeAfter:     d.PurgeAfter,
+	}
+	if d.CancelledAt.Valid {
+		out.CancelledAt = &d.CancelledAt.Time
+	}
+	if d.CompletedAt.Valid {
+		out.CompletedAt = &d.CompletedAt.Time
+	}
+	for _, s := range steps {
+		step := AccountDeletionStep{
+			Step:      s.Step,
+			Status:    s.Status,
+			Attempts:  s.Attempts,
+			LastError: s.LastError.String,
+			UpdatedAt: s.UpdatedAt,
+		}
+		if s.CompletedAt.Valid {
+			step.CompletedAt = &s.CompletedAt.Time
+		}
+		out.Steps = append(out.Steps, step)
+	}
+	return out
+}
@@ -45,6 +45,9 @@ type UserDetail struct {
 	LastloginAt      string      `json:"lastlogin_at"`
 	AnswerIds        string      `json:"answer_ids"`
 	EmploymentStatus string      `json:"employment_status"`
+	Strikes          int         `json:"strikes"`
+	SuspendedUntil   null.Time   `json:"suspended_until"`
+	ReadOnly         bool        `json:"read_only"`
 	// List             null.Uint64 `json:"list"`
 	// Waitlist         bool        `json:"waitlist"`
 }
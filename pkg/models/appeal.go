@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type ModerationDecisions []ModerationDecision
+type ModerationDecision struct {
+	DecisionID  uint64    `json:"decisionId"`
+	PostID      uint64    `json:"postId"`
+	CommentID   uint64    `json:"commentId,omitempty"`
+	Action      string    `json:"action"`
+	Comment     string    `json:"comment"`
+	ModeratorID string    `json:"moderatorId,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type ContentAppeals []ContentAppeal
+type ContentAppeal struct {
+	AppealID          uint64     `json:"appealId"`
+	DecisionID        uint64     `json:"decisionId"`
+	PostID            uint64     `json:"postId"`
+	CommentID         uint64     `json:"commentId,omitempty"`
+	HiveID            uint64     `json:"hiveId"`
+	ImpartWealthID    string     `json:"impartWealthId"`
+	Message           string     `json:"message"`
+	Status            string     `json:"status"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	ResolvedAt        *time.Time `json:"resolvedAt,omitempty"`
+	ResolvedBy        string     `json:"resolvedBy,omitempty"`
+	ResolutionComment string     `json:"resolutionComment"`
+}
+
+// ModerationHistory is every decision on a post or comment with the appeals of the author,
+// AppealDeadline is set while the latest removal can still be appealed.
+type ModerationHistory struct {
+	PostID         uint64              `json:"postId"`
+	CommentID      uint64              `json:"commentId,omitempty"`
+	Decisions      ModerationDecisions `json:"decisions"`
+	Appeals        ContentAppeals      `json:"appeals"`
+	AppealDeadline *time.Time          `json:"appealDeadline,omitempty"`
+}
+
+type PagedAppealsResponse struct {
+	Appeals  ContentAppeals `json:"appeals"`
+	NextPage *NextPage      `json:"nextPage"`
+}
+
+type NewAppealInput struct {
+	Message string `json:"message" binding:"required"`
+}
+
+type ResolveAppealInput struct {
+	Grant   *bool  `json:"grant" binding:"required"`
+	Comment string `json:"comment"`
+}
+
+// RedactModerators hides which moderators made the decisions from the author
+func (h *ModerationHistory) RedactModerators() {
+	for i := range h.Decisions {
+		h.Decisions[i].ModeratorID = ""
+	}
+	for i := range h.Appeals {
+		h.Appeals[i].ResolvedBy = ""
+	}
+}
+
+func ModerationDecisionFromDBModel(d *dbmodels.ModerationDecision) ModerationDecision {
+	return ModerationDecision{
+		DecisionID:  d.DecisionID,
+		PostID:      d.PostID,
+		CommentID:   d.CommentID.Uint64,
+		Action:      d.Action,
+		Comment:     d.ReviewComment,
+		ModeratorID: d.ModeratorID.String,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func ModerationDecisionsFromDBModel(decisions dbmodels.ModerationDecisionSlice) ModerationDecisions {
+	out := make(ModerationDecisions, len(decisions))
+	for i, d := range decisions {
+		out[i] = ModerationDecisionFromDBModel(d)
+	}
+	return out
+}
+
+func ContentAppealFromDBModel(a *dbmodels.ContentAppeal) ContentAppeal {
+	return ContentAppeal{
+		AppealID:          a.AppealID,
+		DecisionID:        a.DecisionID,
+		PostID:            a.PostID,
+		CommentID:         a.CommentID.Uint64,
+		HiveID:            a.HiveID,
+		ImpartWealthID:    a.ImpartWealthID,
+		Message:           a.Message,
+		Status:            a.Status,
+		CreatedAt:         a.CreatedAt,
+		ResolvedAt:        a.ResolvedAt.Ptr(),
+		ResolvedBy:        a.ResolvedBy.String,
+		ResolutionComment: a.ResolutionComment,
+	}
+}
+
+func ContentAppealsFromDBModel(appeals dbmodels.ContentAppealSlice) ContentAppeals {
+	out := make(ContentAppeals, len(appeals))
+	for i, a := range appeals {
+		out[i] = ContentAppealFromDBModel(a)
+	}
+	return out
+}
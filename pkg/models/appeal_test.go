@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestModerationHistoryRedactModerators(t *testing.T) {
+	now := impart.CurrentUTC()
+	history := ModerationHistory{
+		PostID: 4,
+		Decisions: ModerationDecisionsFromDBModel(dbmodels.ModerationDecisionSlice{
+			{DecisionID: 1, PostID: 4, Action: dbmodels.ModerationDecisionsActionRemoved, ReviewComment: "spam", ModeratorID: null.StringFrom("first"), CreatedAt: now},
+			{DecisionID: 2, PostID: 4, Action: dbmodels.ModerationDecisionsActionAppealGranted, ModeratorID: null.StringFrom("second"), CreatedAt: now},
+		}),
+		Appeals: ContentAppealsFromDBModel(dbmodels.ContentAppealSlice{
+			{AppealID: 1, DecisionID: 1, PostID: 4, Message: "not spam", Status: dbmodels.ContentAppealsStatusGranted,
+				ResolvedAt: null.TimeFrom(now), ResolvedBy: null.StringFrom("second")},
+		}),
+	}
+	assert.Equal(t, "spam", history.Decisions[0].Comment)
+	assert.Equal(t, "second", history.Appeals[0].ResolvedBy)
+	assert.Equal(t, now, *history.Appeals[0].ResolvedAt)
+
+	history.RedactModerators()
+	for _, d := range history.Decisions {
+		assert.Empty(t, d.ModeratorID)
+	}
+	assert.Empty(t, history.Appeals[0].ResolvedBy)
+	assert.Equal(t, "not spam", history.Appeals[0].Message)
+}
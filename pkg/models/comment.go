@@ -39,6 +39,7 @@ type Comment struct {
 	PostCommentTrack    PostCommentTrack `json:"postCommentTrack,omitempty"`
 	ReportedCount       int              `json:"reportedCount"`
 	Obfuscated          bool             `json:"obfuscated"`
+	Held                bool             `json:"held"`
 	Reviewed            bool             `json:"reviewed"`
 	ReviewComment       string           `json:"reviewComment"`
 	ReviewedDatetime    time.Time        `json:"reviewedDatetime,omitempty"`
@@ -51,6 +52,7 @@ type Comment struct {
 	AvatarLetter        string           `json:"avatarLetter,omitempty"`
 	Admin               bool             `json:"admin"`
 	LoggedInUserDetails LoggedInUser     `json:"loggedInUserDetails"`
+	Mentions            Mentions         `json:"mentions,omitempty"`
 }
 
 func (comments Comments) Latest() time.Time {
@@ -142,6 +144,7 @@ func CommentFromDBModel(c *dbmodels.Comment, loggedInUser *dbmodels.User) Commen
 		PostCommentTrack: PostCommentTrack{},
 		ReportedCount:    c.ReportedCount,
 		Obfuscated:       c.Obfuscated,
+		Held:             c.Held,
 		Reviewed:         c.Reviewed,
 		ReviewComment:    c.ReviewComment.String,
 	}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type DataExports []DataExport
+
+// DataExport is a copy of the data of a member, DownloadURL is only set while a ready export
+// can be downloaded and expires long before the export does.
+type DataExport struct {
+	ExportID    uint64     `json:"exportId"`
+	Status      string     `json:"status"`
+	Size        uint64     `json:"size,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	DownloadURL string     `json:"downloadUrl,omitempty"`
+}
+
+func DataExportFromDBModel(e *dbmodels.DataExport) DataExport {
+	out := DataExport{
+		ExportID:  e.ExportID,
+		Status:    e.Status,
+		Size:      e.Size,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.CompletedAt.Valid {
+		out.CompletedAt = &e.CompletedAt.Time
+	}
+	if e.ExpiresAt.Valid {
+		out.ExpiresAt = &e.ExpiresAt.Time
+	}
+	return out
+}
+
+func DataExportsFromDBModel(exports dbmodels.DataExportSlice) DataExports {
+	out := make(DataExports, len(exports))
+	for i, e := range exports {
+		out[i] = DataExportFromDBModel(e)
+	}
+	return out
+}
@@ -0,0 +1,1276 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AccountDeletionStep is an object representing the database table.
+type AccountDeletionStep struct {
+	DeletionID  uint64      `boil:"deletion_id" json:"deletion_id" toml:"deletion_id" yaml:"deletion_id"`
+	Step        string      `boil:"step" json:"step" toml:"step" yaml:"step"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts    uint        `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError   null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CompletedAt null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+
+	R *accountDeletionStepR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accountDeletionStepL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccountDeletionStepColumns = struct {
+	DeletionID  string
+	Step        string
+	Status      string
+	Attempts    string
+	LastError   string
+	UpdatedAt   string
+	CompletedAt string
+}{
+	DeletionID:  "deletion_id",
+	Step:        "step",
+	Status:      "status",
+	Attempts:    "attempts",
+	LastError:   "last_error",
+	UpdatedAt:   "updated_at",
+	CompletedAt: "completed_at",
+}
+
+var AccountDeletionStepTableColumns = struct {
+	DeletionID  string
+	Step        string
+	Status      string
+	Attempts    string
+	LastError   string
+	UpdatedAt   string
+	CompletedAt string
+}{
+	DeletionID:  "account_deletion_steps.deletion_id",
+	Step:        "account_deletion_steps.step",
+	Status:      "account_deletion_steps.status",
+	Attempts:    "account_deletion_steps.attempts",
+	LastError:   "account_deletion_steps.last_error",
+	UpdatedAt:   "account_deletion_steps.updated_at",
+	CompletedAt: "account_deletion_steps.completed_at",
+}
+
+// Generated where
+
+type whereHelperuint64 struct{ field string }
+
+func (w whereHelperuint64) EQ(x uint64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperuint64) NEQ(x uint64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperuint64) LT(x uint64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperuint64) LTE(x uint64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperuint64) GT(x uint64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperuint64) GTE(x uint64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperuint64) IN(slice []uint64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperuint64) NIN(slice []uint64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperuint struct{ field string }
+
+func (w whereHelperuint) EQ(x uint) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperuint) NEQ(x uint) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperuint) LT(x uint) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperuint) LTE(x uint) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperuint) GT(x uint) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperuint) GTE(x uint) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperuint) IN(slice []uint) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperuint) NIN(slice []uint) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AccountDeletionStepWhere = struct {
+	DeletionID  whereHelperuint64
+	Step        whereHelperstring
+	Status      whereHelperstring
+	Attempts    whereHelperuint
+	LastError   whereHelpernull_String
+	UpdatedAt   whereHelpertime_Time
+	CompletedAt whereHelpernull_Time
+}{
+	DeletionID:  whereHelperuint64{field: "`account_deletion_steps`.`deletion_id`"},
+	Step:        whereHelperstring{field: "`account_deletion_steps`.`step`"},
+	Status:      whereHelperstring{field: "`account_deletion_steps`.`status`"},
+	Attempts:    whereHelperuint{field: "`account_deletion_steps`.`attempts`"},
+	LastError:   whereHelpernull_String{field: "`account_deletion_steps`.`last_error`"},
+	UpdatedAt:   whereHelpertime_Time{field: "`account_deletion_steps`.`updated_at`"},
+	CompletedAt: whereHelpernull_Time{field: "`account_deletion_steps`.`completed_at`"},
+}
+
+// AccountDeletionStepRels is where relationship names are stored.
+var AccountDeletionStepRels = struct {
+	Deletion string
+}{
+	Deletion: "Deletion",
+}
+
+// accountDeletionStepR is where relationships are stored.
+type accountDeletionStepR struct {
+	Deletion *AccountDeletion `boil:"Deletion" json:"Deletion" toml:"Deletion" yaml:"Deletion"`
+}
+
+// NewStruct creates a new relationship struct
+func (*accountDeletionStepR) NewStruct() *accountDeletionStepR {
+	return &accountDeletionStepR{}
+}
+
+// accountDeletionStepL is where Load methods for each relationship are stored.
+type accountDeletionStepL struct{}
+
+var (
+	accountDeletionStepAllColumns            = []string{"deletion_id", "step", "status", "attempts", "last_error", "updated_at", "completed_at"}
+	accountDeletionStepColumnsWithoutDefault = []string{"deletion_id", "step", "last_error", "updated_at", "completed_at"}
+	accountDeletionStepColumnsWithDefault    = []string{"status", "attempts"}
+	accountDeletionStepPrimaryKeyColumns     = []string{"deletion_id", "step"}
+)
+
+type (
+	// AccountDeletionStepSlice is an alias for a slice of pointers to AccountDeletionStep.
+	// This should almost always be used instead of []AccountDeletionStep.
+	AccountDeletionStepSlice []*AccountDeletionStep
+	// AccountDeletionStepHook is the signature for custom AccountDeletionStep hook methods
+	AccountDeletionStepHook func(context.Context, boil.ContextExecutor, *AccountDeletionStep) error
+
+	accountDeletionStepQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accountDeletionStepType                 = reflect.TypeOf(&AccountDeletionStep{})
+	accountDeletionStepMapping              = queries.MakeStructMapping(accountDeletionStepType)
+	accountDeletionStepPrimaryKeyMapping, _ = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, accountDeletionStepPrimaryKeyColumns)
+	accountDeletionStepInsertCacheMut       sync.RWMutex
+	accountDeletionStepInsertCache          = make(map[string]insertCache)
+	accountDeletionStepUpdateCacheMut       sync.RWMutex
+	accountDeletionStepUpdateCache          = make(map[string]updateCache)
+	accountDeletionStepUpsertCacheMut       sync.RWMutex
+	accountDeletionStepUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var accountDeletionStepBeforeInsertHooks []AccountDeletionStepHook
+var accountDeletionStepBeforeUpdateHooks []AccountDeletionStepHook
+var accountDeletionStepBeforeDeleteHooks []AccountDeletionStepHook
+var accountDeletionStepBeforeUpsertHooks []AccountDeletionStepHook
+
+var accountDeletionStepAfterInsertHooks []AccountDeletionStepHook
+var accountDeletionStepAfterSelectHooks []AccountDeletionStepHook
+var accountDeletionStepAfterUpdateHooks []AccountDeletionStepHook
+var accountDeletionStepAfterDeleteHooks []AccountDeletionStepHook
+var accountDeletionStepAfterUpsertHooks []AccountDeletionStepHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AccountDeletionStep) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AccountDeletionStep) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AccountDeletionStep) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AccountDeletionStep) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AccountDeletionStep) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AccountDeletionStep) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AccountDeletionStep) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AccountDeletionStep) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AccountDeletionStep) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionStepAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccountDeletionStepHook registers your hook function for all future operations.
+func AddAccountDeletionStepHook(hookPoint boil.HookPoint, accountDeletionStepHook AccountDeletionStepHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		accountDeletionStepBeforeInsertHooks = append(accountDeletionStepBeforeInsertHooks, accountDeletionStepHook)
+	case boil.BeforeUpdateHook:
+		accountDeletionStepBeforeUpdateHooks = append(accountDeletionStepBeforeUpdateHooks, accountDeletionStepHook)
+	case boil.BeforeDeleteHook:
+		accountDeletionStepBeforeDeleteHooks = append(accountDeletionStepBeforeDeleteHooks, accountDeletionStepHook)
+	case boil.BeforeUpsertHook:
+		accountDeletionStepBeforeUpsertHooks = append(accountDeletionStepBeforeUpsertHooks, accountDeletionStepHook)
+	case boil.AfterInsertHook:
+		accountDeletionStepAfterInsertHooks = append(accountDeletionStepAfterInsertHooks, accountDeletionStepHook)
+	case boil.AfterSelectHook:
+		accountDeletionStepAfterSelectHooks = append(accountDeletionStepAfterSelectHooks, accountDeletionStepHook)
+	case boil.AfterUpdateHook:
+		accountDeletionStepAfterUpdateHooks = append(accountDeletionStepAfterUpdateHooks, accountDeletionStepHook)
+	case boil.AfterDeleteHook:
+		accountDeletionStepAfterDeleteHooks = append(accountDeletionStepAfterDeleteHooks, accountDeletionStepHook)
+	case boil.AfterUpsertHook:
+		accountDeletionStepAfterUpsertHooks = append(accountDeletionStepAfterUpsertHooks, accountDeletionStepHook)
+	}
+}
+
+// One returns a single accountDeletionStep record from the query.
+func (q accountDeletionStepQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AccountDeletionStep, error) {
+	o := &AccountDeletionStep{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for account_deletion_steps")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AccountDeletionStep records from the query.
+func (q accountDeletionStepQuery) All(ctx context.Context, exec boil.ContextExecutor) (AccountDeletionStepSlice, error) {
+	var o []*AccountDeletionStep
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to AccountDeletionStep slice")
+	}
+
+	if len(accountDeletionStepAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AccountDeletionStep records in the query.
+func (q accountDeletionStepQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count account_deletion_steps rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q accountDeletionStepQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if account_deletion_steps exists")
+	}
+
+	return count > 0, nil
+}
+
+// Deletion pointed to by the foreign key.
+func (o *AccountDeletionStep) Deletion(mods ...qm.QueryMod) accountDeletionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`deletion_id` = ?", o.DeletionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AccountDeletions(queryMods...)
+	queries.SetFrom(query.Query, "`account_deletions`")
+
+	return query
+}
+
+// LoadDeletion allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountDeletionStepL) LoadDeletion(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccountDeletionStep interface{}, mods queries.Applicator) error {
+	var slice []*AccountDeletionStep
+	var object *AccountDeletionStep
+
+	if singular {
+		object = maybeAccountDeletionStep.(*AccountDeletionStep)
+	} else {
+		slice = *maybeAccountDeletionStep.(*[]*AccountDeletionStep)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountDeletionStepR{}
+		}
+		args = append(args, object.DeletionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountDeletionStepR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DeletionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DeletionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_deletions`),
+		qm.WhereIn(`account_deletions.deletion_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AccountDeletion")
+	}
+
+	var resultSlice []*AccountDeletion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AccountDeletion")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for account_deletions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_deletions")
+	}
+
+	if len(accountDeletionStepAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Deletion = foreign
+		if foreign.R == nil {
+			foreign.R = &accountDeletionR{}
+		}
+		foreign.R.DeletionAccountDeletionSteps = append(foreign.R.DeletionAccountDeletionSteps, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DeletionID == foreign.DeletionID {
+				local.R.Deletion = foreign
+				if foreign.R == nil {
+					foreign.R = &accountDeletionR{}
+				}
+				foreign.R.DeletionAccountDeletionSteps = append(foreign.R.DeletionAccountDeletionSteps, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDeletion of the accountDeletionStep to the related item.
+// Sets o.R.Deletion to related.
+// Adds o to related.R.DeletionAccountDeletionSteps.
+func (o *AccountDeletionStep) SetDeletion(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AccountDeletion) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `account_deletion_steps` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"deletion_id"}),
+		strmangle.WhereClause("`", "`", 0, accountDeletionStepPrimaryKeyColumns),
+	)
+	values := []interface{}{related.DeletionID, o.DeletionID, o.Step}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DeletionID = related.DeletionID
+	if o.R == nil {
+		o.R = &accountDeletionStepR{
+			Deletion: related,
+		}
+	} else {
+		o.R.Deletion = related
+	}
+
+	if related.R == nil {
+		related.R = &accountDeletionR{
+			DeletionAccountDeletionSteps: AccountDeletionStepSlice{o},
+		}
+	} else {
+		related.R.DeletionAccountDeletionSteps = append(related.R.DeletionAccountDeletionSteps, o)
+	}
+
+	return nil
+}
+
+// AccountDeletionSteps retrieves all the records using an executor.
+func AccountDeletionSteps(mods ...qm.QueryMod) accountDeletionStepQuery {
+	mods = append(mods, qm.From("`account_deletion_steps`"))
+	return accountDeletionStepQuery{NewQuery(mods...)}
+}
+
+// FindAccountDeletionStep retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccountDeletionStep(ctx context.Context, exec boil.ContextExecutor, deletionID uint64, step string, selectCols ...string) (*AccountDeletionStep, error) {
+	accountDeletionStepObj := &AccountDeletionStep{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `account_deletion_steps` where `deletion_id`=? AND `step`=?", sel,
+	)
+
+	q := queries.Raw(query, deletionID, step)
+
+	err := q.Bind(ctx, exec, accountDeletionStepObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from account_deletion_steps")
+	}
+
+	if err = accountDeletionStepObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return accountDeletionStepObj, err
+	}
+
+	return accountDeletionStepObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccountDeletionStep) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no account_deletion_steps provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountDeletionStepColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accountDeletionStepInsertCacheMut.RLock()
+	cache, cached := accountDeletionStepInsertCache[key]
+	accountDeletionStepInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accountDeletionStepAllColumns,
+			accountDeletionStepColumnsWithDefault,
+			accountDeletionStepColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `account_deletion_steps` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `account_deletion_steps` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `account_deletion_steps` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, accountDeletionStepPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into account_deletion_steps")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.DeletionID,
+		o.Step,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for account_deletion_steps")
+	}
+
+CacheNoHooks:
+	if !cached {
+		accountDeletionStepInsertCacheMut.Lock()
+		accountDeletionStepInsertCache[key] = cache
+		accountDeletionStepInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AccountDeletionStep.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccountDeletionStep) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	accountDeletionStepUpdateCacheMut.RLock()
+	cache, cached := accountDeletionStepUpdateCache[key]
+	accountDeletionStepUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accountDeletionStepAllColumns,
+			accountDeletionStepPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update account_deletion_steps, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `account_deletion_steps` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, accountDeletionStepPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, append(wl, accountDeletionStepPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update account_deletion_steps row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for account_deletion_steps")
+	}
+
+	if !cached {
+		accountDeletionStepUpdateCacheMut.Lock()
+		accountDeletionStepUpdateCache[key] = cache
+		accountDeletionStepUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accountDeletionStepQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for account_deletion_steps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for account_deletion_steps")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccountDeletionStepSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionStepPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `account_deletion_steps` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionStepPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in accountDeletionStep slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all accountDeletionStep")
+	}
+	return rowsAff, nil
+}
+
+var mySQLAccountDeletionStepUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccountDeletionStep) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no account_deletion_steps provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountDeletionStepColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAccountDeletionStepUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accountDeletionStepUpsertCacheMut.RLock()
+	cache, cached := accountDeletionStepUpsertCache[key]
+	accountDeletionStepUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			accountDeletionStepAllColumns,
+			accountDeletionStepColumnsWithDefault,
+			accountDeletionStepColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			accountDeletionStepAllColumns,
+			accountDeletionStepPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert account_deletion_steps, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`account_deletion_steps`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `account_deletion_steps` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for account_deletion_steps")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(accountDeletionStepType, accountDeletionStepMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for account_deletion_steps")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for account_deletion_steps")
+	}
+
+CacheNoHooks:
+	if !cached {
+		accountDeletionStepUpsertCacheMut.Lock()
+		accountDeletionStepUpsertCache[key] = cache
+		accountDeletionStepUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AccountDeletionStep record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccountDeletionStep) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no AccountDeletionStep provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accountDeletionStepPrimaryKeyMapping)
+	sql := "DELETE FROM `account_deletion_steps` WHERE `deletion_id`=? AND `step`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from account_deletion_steps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for account_deletion_steps")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q accountDeletionStepQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no accountDeletionStepQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from account_deletion_steps")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for account_deletion_steps")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccountDeletionStepSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(accountDeletionStepBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionStepPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `account_deletion_steps` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionStepPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from accountDeletionStep slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for account_deletion_steps")
+	}
+
+	if len(accountDeletionStepAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccountDeletionStep) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAccountDeletionStep(ctx, exec, o.DeletionID, o.Step)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccountDeletionStepSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccountDeletionStepSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionStepPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `account_deletion_steps`.* FROM `account_deletion_steps` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionStepPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in AccountDeletionStepSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccountDeletionStepExists checks if the AccountDeletionStep row exists.
+func AccountDeletionStepExists(ctx context.Context, exec boil.ContextExecutor, deletionID uint64, step string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `account_deletion_steps` where `deletion_id`=? AND `step`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, deletionID, step)
+	}
+	row := exec.QueryRowContext(ctx, sql, deletionID, step)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if account_deletion_steps exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1194 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AccountDeletion is an object representing the database table.
+type AccountDeletion struct {
+	DeletionID       uint64      `boil:"deletion_id" json:"deletion_id" toml:"deletion_id" yaml:"deletion_id"`
+	ImpartWealthID   string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	AuthenticationID string      `boil:"authentication_id" json:"authentication_id" toml:"authentication_id" yaml:"authentication_id"`
+	Email            string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Status           string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	RequestedBy      string      `boil:"requested_by" json:"requested_by" toml:"requested_by" yaml:"requested_by"`
+	Feedback         null.String `boil:"feedback" json:"feedback,omitempty" toml:"feedback" yaml:"feedback,omitempty"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	PurgeAfter       time.Time   `boil:"purge_after" json:"purge_after" toml:"purge_after" yaml:"purge_after"`
+	CancelledAt      null.Time   `boil:"cancelled_at" json:"cancelled_at,omitempty" toml:"cancelled_at" yaml:"cancelled_at,omitempty"`
+	CompletedAt      null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+
+	R *accountDeletionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accountDeletionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccountDeletionColumns = struct {
+	DeletionID       string
+	ImpartWealthID   string
+	AuthenticationID string
+	Email            string
+	Status           string
+	RequestedBy      string
+	Feedback         string
+	CreatedAt        string
+	PurgeAfter       string
+	CancelledAt      string
+	CompletedAt      string
+}{
+	DeletionID:       "deletion_id",
+	ImpartWealthID:   "impart_wealth_id",
+	AuthenticationID: "authentication_id",
+	Email:            "email",
+	Status:           "status",
+	RequestedBy:      "requested_by",
+	Feedback:         "feedback",
+	CreatedAt:        "created_at",
+	PurgeAfter:       "purge_after",
+	CancelledAt:      "cancelled_at",
+	CompletedAt:      "completed_at",
+}
+
+var AccountDeletionTableColumns = struct {
+	DeletionID       string
+	ImpartWealthID   string
+	AuthenticationID string
+	Email            string
+	Status           string
+	RequestedBy      string
+	Feedback         string
+	CreatedAt        string
+	PurgeAfter       string
+	CancelledAt      string
+	CompletedAt      string
+}{
+	DeletionID:       "account_deletions.deletion_id",
+	ImpartWealthID:   "account_deletions.impart_wealth_id",
+	AuthenticationID: "account_deletions.authentication_id",
+	Email:            "account_deletions.email",
+	Status:           "account_deletions.status",
+	RequestedBy:      "account_deletions.requested_by",
+	Feedback:         "account_deletions.feedback",
+	CreatedAt:        "account_deletions.created_at",
+	PurgeAfter:       "account_deletions.purge_after",
+	CancelledAt:      "account_deletions.cancelled_at",
+	CompletedAt:      "account_deletions.completed_at",
+}
+
+// Generated where
+
+var AccountDeletionWhere = struct {
+	DeletionID       whereHelperuint64
+	ImpartWealthID   whereHelperstring
+	AuthenticationID whereHelperstring
+	Email            whereHelperstring
+	Status           whereHelperstring
+	RequestedBy      whereHelperstring
+	Feedback         whereHelpernull_String
+	CreatedAt        whereHelpertime_Time
+	PurgeAfter       whereHelpertime_Time
+	CancelledAt      whereHelpernull_Time
+	CompletedAt      whereHelpernull_Time
+}{
+	DeletionID:       whereHelperuint64{field: "`account_deletions`.`deletion_id`"},
+	ImpartWealthID:   whereHelperstring{field: "`account_deletions`.`impart_wealth_id`"},
+	AuthenticationID: whereHelperstring{field: "`account_deletions`.`authentication_id`"},
+	Email:            whereHelperstring{field: "`account_deletions`.`email`"},
+	Status:           whereHelperstring{field: "`account_deletions`.`status`"},
+	RequestedBy:      whereHelperstring{field: "`account_deletions`.`requested_by`"},
+	Feedback:         whereHelpernull_String{field: "`account_deletions`.`feedback`"},
+	CreatedAt:        whereHelpertime_Time{field: "`account_deletions`.`created_at`"},
+	PurgeAfter:       whereHelpertime_Time{field: "`account_deletions`.`purge_after`"},
+	CancelledAt:      whereHelpernull_Time{field: "`account_deletions`.`cancelled_at`"},
+	CompletedAt:      whereHelpernull_Time{field: "`account_deletions`.`completed_at`"},
+}
+
+// AccountDeletionRels is where relationship names are stored.
+var AccountDeletionRels = struct {
+	DeletionAccountDeletionSteps string
+}{
+	DeletionAccountDeletionSteps: "DeletionAccountDeletionSteps",
+}
+
+// accountDeletionR is where relationships are stored.
+type accountDeletionR struct {
+	DeletionAccountDeletionSteps AccountDeletionStepSlice `boil:"DeletionAccountDeletionSteps" json:"DeletionAccountDeletionSteps" toml:"DeletionAccountDeletionSteps" yaml:"DeletionAccountDeletionSteps"`
+}
+
+// NewStruct creates a new relationship struct
+func (*accountDeletionR) NewStruct() *accountDeletionR {
+	return &accountDeletionR{}
+}
+
+// accountDeletionL is where Load methods for each relationship are stored.
+type accountDeletionL struct{}
+
+var (
+	accountDeletionAllColumns            = []string{"deletion_id", "impart_wealth_id", "authentication_id", "email", "status", "requested_by", "feedback", "created_at", "purge_after", "cancelled_at", "completed_at"}
+	accountDeletionColumnsWithoutDefault = []string{"impart_wealth_id", "authentication_id", "email", "requested_by", "feedback", "created_at", "purge_after", "cancelled_at", "completed_at"}
+	accountDeletionColumnsWithDefault    = []string{"deletion_id", "status"}
+	accountDeletionPrimaryKeyColumns     = []string{"deletion_id"}
+)
+
+type (
+	// AccountDeletionSlice is an alias for a slice of pointers to AccountDeletion.
+	// This should almost always be used instead of []AccountDeletion.
+	AccountDeletionSlice []*AccountDeletion
+	// AccountDeletionHook is the signature for custom AccountDeletion hook methods
+	AccountDeletionHook func(context.Context, boil.ContextExecutor, *AccountDeletion) error
+
+	accountDeletionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accountDeletionType                 = reflect.TypeOf(&AccountDeletion{})
+	accountDeletionMapping              = queries.MakeStructMapping(accountDeletionType)
+	accountDeletionPrimaryKeyMapping, _ = queries.BindMapping(accountDeletionType, accountDeletionMapping, accountDeletionPrimaryKeyColumns)
+	accountDeletionInsertCacheMut       sync.RWMutex
+	accountDeletionInsertCache          = make(map[string]insertCache)
+	accountDeletionUpdateCacheMut       sync.RWMutex
+	accountDeletionUpdateCache          = make(map[string]updateCache)
+	accountDeletionUpsertCacheMut       sync.RWMutex
+	accountDeletionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var accountDeletionBeforeInsertHooks []AccountDeletionHook
+var accountDeletionBeforeUpdateHooks []AccountDeletionHook
+var accountDeletionBeforeDeleteHooks []AccountDeletionHook
+var accountDeletionBeforeUpsertHooks []AccountDeletionHook
+
+var accountDeletionAfterInsertHooks []AccountDeletionHook
+var accountDeletionAfterSelectHooks []AccountDeletionHook
+var accountDeletionAfterUpdateHooks []AccountDeletionHook
+var accountDeletionAfterDeleteHooks []AccountDeletionHook
+var accountDeletionAfterUpsertHooks []AccountDeletionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AccountDeletion) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AccountDeletion) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AccountDeletion) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AccountDeletion) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AccountDeletion) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AccountDeletion) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AccountDeletion) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AccountDeletion) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AccountDeletion) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range accountDeletionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccountDeletionHook registers your hook function for all future operations.
+func AddAccountDeletionHook(hookPoint boil.HookPoint, accountDeletionHook AccountDeletionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		accountDeletionBeforeInsertHooks = append(accountDeletionBeforeInsertHooks, accountDeletionHook)
+	case boil.BeforeUpdateHook:
+		accountDeletionBeforeUpdateHooks = append(accountDeletionBeforeUpdateHooks, accountDeletionHook)
+	case boil.BeforeDeleteHook:
+		accountDeletionBeforeDeleteHooks = append(accountDeletionBeforeDeleteHooks, accountDeletionHook)
+	case boil.BeforeUpsertHook:
+		accountDeletionBeforeUpsertHooks = append(accountDeletionBeforeUpsertHooks, accountDeletionHook)
+	case boil.AfterInsertHook:
+		accountDeletionAfterInsertHooks = append(accountDeletionAfterInsertHooks, accountDeletionHook)
+	case boil.AfterSelectHook:
+		accountDeletionAfterSelectHooks = append(accountDeletionAfterSelectHooks, accountDeletionHook)
+	case boil.AfterUpdateHook:
+		accountDeletionAfterUpdateHooks = append(accountDeletionAfterUpdateHooks, accountDeletionHook)
+	case boil.AfterDeleteHook:
+		accountDeletionAfterDeleteHooks = append(accountDeletionAfterDeleteHooks, accountDeletionHook)
+	case boil.AfterUpsertHook:
+		accountDeletionAfterUpsertHooks = append(accountDeletionAfterUpsertHooks, accountDeletionHook)
+	}
+}
+
+// One returns a single accountDeletion record from the query.
+func (q accountDeletionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AccountDeletion, error) {
+	o := &AccountDeletion{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for account_deletions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AccountDeletion records from the query.
+func (q accountDeletionQuery) All(ctx context.Context, exec boil.ContextExecutor) (AccountDeletionSlice, error) {
+	var o []*AccountDeletion
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to AccountDeletion slice")
+	}
+
+	if len(accountDeletionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AccountDeletion records in the query.
+func (q accountDeletionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count account_deletions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q accountDeletionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if account_deletions exists")
+	}
+
+	return count > 0, nil
+}
+
+// DeletionAccountDeletionSteps retrieves all the account_deletion_step's AccountDeletionSteps with an executor via deletion_id column.
+func (o *AccountDeletion) DeletionAccountDeletionSteps(mods ...qm.QueryMod) accountDeletionStepQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`account_deletion_steps`.`deletion_id`=?", o.DeletionID),
+	)
+
+	query := AccountDeletionSteps(queryMods...)
+	queries.SetFrom(query.Query, "`account_deletion_steps`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`account_deletion_steps`.*"})
+	}
+
+	return query
+}
+
+// LoadDeletionAccountDeletionSteps allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountDeletionL) LoadDeletionAccountDeletionSteps(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccountDeletion interface{}, mods queries.Applicator) error {
+	var slice []*AccountDeletion
+	var object *AccountDeletion
+
+	if singular {
+		object = maybeAccountDeletion.(*AccountDeletion)
+	} else {
+		slice = *maybeAccountDeletion.(*[]*AccountDeletion)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountDeletionR{}
+		}
+		args = append(args, object.DeletionID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountDeletionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DeletionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DeletionID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`account_deletion_steps`),
+		qm.WhereIn(`account_deletion_steps.deletion_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load account_deletion_steps")
+	}
+
+	var resultSlice []*AccountDeletionStep
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice account_deletion_steps")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on account_deletion_steps")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_deletion_steps")
+	}
+
+	if len(accountDeletionStepAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeletionAccountDeletionSteps = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accountDeletionStepR{}
+			}
+			foreign.R.Deletion = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.DeletionID == foreign.DeletionID {
+				local.R.DeletionAccountDeletionSteps = append(local.R.DeletionAccountDeletionSteps, foreign)
+				if foreign.R == nil {
+					foreign.R = &accountDeletionStepR{}
+				}
+				foreign.R.Deletion = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddDeletionAccountDeletionSteps adds the given related objects to the existing relationships
+// of the account_deletion, optionally inserting them as new records.
+// Appends related to o.R.DeletionAccountDeletionSteps.
+// Sets related.R.Deletion appropriately.
+func (o *AccountDeletion) AddDeletionAccountDeletionSteps(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccountDeletionStep) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DeletionID = o.DeletionID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `account_deletion_steps` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"deletion_id"}),
+				strmangle.WhereClause("`", "`", 0, accountDeletionStepPrimaryKeyColumns),
+			)
+			values := []interface{}{o.DeletionID, rel.DeletionID, rel.Step}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DeletionID = o.DeletionID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountDeletionR{
+			DeletionAccountDeletionSteps: related,
+		}
+	} else {
+		o.R.DeletionAccountDeletionSteps = append(o.R.DeletionAccountDeletionSteps, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountDeletionStepR{
+				Deletion: o,
+			}
+		} else {
+			rel.R.Deletion = o
+		}
+	}
+	return nil
+}
+
+// AccountDeletions retrieves all the records using an executor.
+func AccountDeletions(mods ...qm.QueryMod) accountDeletionQuery {
+	mods = append(mods, qm.From("`account_deletions`"))
+	return accountDeletionQuery{NewQuery(mods...)}
+}
+
+// FindAccountDeletion retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccountDeletion(ctx context.Context, exec boil.ContextExecutor, deletionID uint64, selectCols ...string) (*AccountDeletion, error) {
+	accountDeletionObj := &AccountDeletion{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `account_deletions` where `deletion_id`=?", sel,
+	)
+
+	q := queries.Raw(query, deletionID)
+
+	err := q.Bind(ctx, exec, accountDeletionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from account_deletions")
+	}
+
+	if err = accountDeletionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return accountDeletionObj, err
+	}
+
+	return accountDeletionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccountDeletion) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no account_deletions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountDeletionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accountDeletionInsertCacheMut.RLock()
+	cache, cached := accountDeletionInsertCache[key]
+	accountDeletionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accountDeletionAllColumns,
+			accountDeletionColumnsWithDefault,
+			accountDeletionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `account_deletions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `account_deletions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `account_deletions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, accountDeletionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into account_deletions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DeletionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == accountDeletionMapping["deletion_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.DeletionID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for account_deletions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		accountDeletionInsertCacheMut.Lock()
+		accountDeletionInsertCache[key] = cache
+		accountDeletionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AccountDeletion.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccountDeletion) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	accountDeletionUpdateCacheMut.RLock()
+	cache, cached := accountDeletionUpdateCache[key]
+	accountDeletionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accountDeletionAllColumns,
+			accountDeletionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update account_deletions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `account_deletions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, accountDeletionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, append(wl, accountDeletionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update account_deletions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for account_deletions")
+	}
+
+	if !cached {
+		accountDeletionUpdateCacheMut.Lock()
+		accountDeletionUpdateCache[key] = cache
+		accountDeletionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accountDeletionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for account_deletions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for account_deletions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccountDeletionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `account_deletions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in accountDeletion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all accountDeletion")
+	}
+	return rowsAff, nil
+}
+
+var mySQLAccountDeletionUniqueColumns = []string{
+	"deletion_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccountDeletion) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no account_deletions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountDeletionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAccountDeletionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accountDeletionUpsertCacheMut.RLock()
+	cache, cached := accountDeletionUpsertCache[key]
+	accountDeletionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			accountDeletionAllColumns,
+			accountDeletionColumnsWithDefault,
+			accountDeletionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			accountDeletionAllColumns,
+			accountDeletionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert account_deletions, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`account_deletions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `account_deletions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for account_deletions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DeletionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == accountDeletionMapping["deletion_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(accountDeletionType, accountDeletionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for account_deletions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for account_deletions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		accountDeletionUpsertCacheMut.Lock()
+		accountDeletionUpsertCache[key] = cache
+		accountDeletionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AccountDeletion record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccountDeletion) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no AccountDeletion provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accountDeletionPrimaryKeyMapping)
+	sql := "DELETE FROM `account_deletions` WHERE `deletion_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from account_deletions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for account_deletions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q accountDeletionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no accountDeletionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from account_deletions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for account_deletions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccountDeletionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(accountDeletionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `account_deletions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from accountDeletion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for account_deletions")
+	}
+
+	if len(accountDeletionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccountDeletion) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAccountDeletion(ctx, exec, o.DeletionID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccountDeletionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccountDeletionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountDeletionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `account_deletions`.* FROM `account_deletions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, accountDeletionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in AccountDeletionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccountDeletionExists checks if the AccountDeletion row exists.
+func AccountDeletionExists(ctx context.Context, exec boil.ContextExecutor, deletionID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `account_deletions` where `deletion_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, deletionID)
+	}
+	row := exec.QueryRowContext(ctx, sql, deletionID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if account_deletions exists")
+	}
+
+	return exists, nil
+}
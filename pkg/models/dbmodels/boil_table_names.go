@@ -4,81 +4,113 @@
 package dbmodels
 
 var TableNames = struct {
-	Answer                    string
-	BankTypes                 string
-	Comment                   string
-	CommentEdits              string
-	CommentReactions          string
-	Files                     string
-	Hive                      string
-	HiveAdmins                string
-	HiveMembers               string
-	HiveRuleMap               string
-	HiveRules                 string
-	HiveRulesCriteria         string
-	HiveUserDemographic       string
-	Institutions              string
-	NotificationDeviceMapping string
-	NotificationSubscriptions string
-	NotificationTopic         string
-	Pings                     string
-	Post                      string
-	PostEdits                 string
-	PostFiles                 string
-	PostReactions             string
-	PostTag                   string
-	PostUrls                  string
-	PostVideos                string
-	ProfanityWordsList        string
-	Profile                   string
-	Question                  string
-	QuestionType              string
-	Questionnaire             string
-	Tag                       string
-	User                      string
-	UserAnswers               string
-	UserConfigurations        string
-	UserDemographic           string
-	UserDevices               string
-	UserInstitutions          string
-	UserPlaidAccountsLog      string
+	AccountDeletionSteps        string
+	AccountDeletions            string
+	Answer                      string
+	BankTypes                   string
+	Comment                     string
+	CommentEdits                string
+	CommentReactions            string
+	ContentAppeals              string
+	DataExports                 string
+	Drafts                      string
+	Files                       string
+	Hive                        string
+	HiveAdmins                  string
+	HiveMembers                 string
+	HiveRuleMap                 string
+	HiveRules                   string
+	HiveRulesCriteria           string
+	HiveUserDemographic         string
+	Institutions                string
+	LinkPreviews                string
+	MarketingSyncQueue          string
+	Mentions                    string
+	ModerationDecisions         string
+	NotificationDeviceMapping   string
+	NotificationSubscriptions   string
+	NotificationTopic           string
+	Pings                       string
+	Post                        string
+	PostEdits                   string
+	PostFiles                   string
+	PostFollows                 string
+	PostReactions               string
+	PostTag                     string
+	PostUrls                    string
+	PostVideos                  string
+	ProfanityWordsList          string
+	Profile                     string
+	Question                    string
+	QuestionType                string
+	Questionnaire               string
+	RateLimitCounters           string
+	ScheduledJobRuns            string
+	ScheduledJobs               string
+	Tag                         string
+	User                        string
+	UserAnswers                 string
+	UserConfigurations          string
+	UserDemographic             string
+	UserDevices                 string
+	UserInstitutions            string
+	UserNotificationPreferences string
+	UserNotifications           string
+	UserPlaidAccountsLog        string
+	UserSanctions               string
 }{
-	Answer:                    "answer",
-	BankTypes:                 "bank_types",
-	Comment:                   "comment",
-	CommentEdits:              "comment_edits",
-	CommentReactions:          "comment_reactions",
-	Files:                     "files",
-	Hive:                      "hive",
-	HiveAdmins:                "hive_admins",
-	HiveMembers:               "hive_members",
-	HiveRuleMap:               "hive_rule_map",
-	HiveRules:                 "hive_rules",
-	HiveRulesCriteria:         "hive_rules_criteria",
-	HiveUserDemographic:       "hive_user_demographic",
-	Institutions:              "institutions",
-	NotificationDeviceMapping: "notification_device_mapping",
-	NotificationSubscriptions: "notification_subscriptions",
-	NotificationTopic:         "notification_topic",
-	Pings:                     "pings",
-	Post:                      "post",
-	PostEdits:                 "post_edits",
-	PostFiles:                 "post_files",
-	PostReactions:             "post_reactions",
-	PostTag:                   "post_tag",
-	PostUrls:                  "post_urls",
-	PostVideos:                "post_videos",
-	ProfanityWordsList:        "profanity_words_list",
-	Profile:                   "profile",
-	Question:                  "question",
-	QuestionType:              "question_type",
-	Questionnaire:             "questionnaire",
-	Tag:                       "tag",
-	User:                      "user",
-	UserAnswers:               "user_answers",
-	UserConfigurations:        "user_configurations",
-	UserDemographic:           "user_demographic",
-	UserDevices:               "user_devices",
-	UserInstitutions:          "user_institutions",
-	UserPlaidAccountsLog:      "user_plaid_accounts_log",
+	AccountDeletionSteps:        "account_deletion_steps",
+	AccountDeletions:            "account_deletions",
+	Answer:                      "answer",
+	BankTypes:                   "bank_types",
+	Comment:                     "comment",
+	CommentEdits:                "comment_edits",
+	CommentReactions:            "comment_reactions",
+	ContentAppeals:              "content_appeals",
+	DataExports:                 "data_exports",
+	Drafts:                      "drafts",
+	Files:                       "files",
+	Hive:                        "hive",
+	HiveAdmins:                  "hive_admins",
+	HiveMembers:                 "hive_members",
+	HiveRuleMap:                 "hive_rule_map",
+	HiveRules:                   "hive_rules",
+	HiveRulesCriteria:           "hive_rules_criteria",
+	HiveUserDemographic:         "hive_user_demographic",
+	Institutions:                "institutions",
+	LinkPreviews:                "link_previews",
+	MarketingSyncQueue:          "marketing_sync_queue",
+	Mentions:                    "mentions",
+	ModerationDecisions:         "moderation_decisions",
+	NotificationDeviceMapping:   "notification_device_mapping",
+	NotificationSubscriptions:   "notification_subscriptions",
+	NotificationTopic:           "notification_topic",
+	Pings:                       "pings",
+	Post:                        "post",
+	PostEdits:                   "post_edits",
+	PostFiles:                   "post_files",
+	PostFollows:                 "post_follows",
+	PostReactions:               "post_reactions",
+	PostTag:                     "post_tag",
+	PostUrls:                    "post_urls",
+	PostVideos:                  "post_videos",
+	ProfanityWordsList:          "profanity_words_list",
+	Profile:                     "profile",
+	Question:                    "question",
+	QuestionType:                "question_type",
+	Questionnaire:               "questionnaire",
+	RateLimitCounters:           "rate_limit_counters",
+	ScheduledJobRuns:            "scheduled_job_runs",
+	ScheduledJobs:               "scheduled_jobs",
+	Tag:                         "tag",
+	User:                        "user",
+	UserAnswers:                 "user_answers",
+	UserConfigurations:          "user_configurations",
+	UserDemographic:             "user_demographic",
+	UserDevices:                 "user_devices",
+	UserInstitutions:            "user_institutions",
+	UserNotificationPreferences: "user_notification_preferences",
+	UserNotifications:           "user_notifications",
+	UserPlaidAccountsLog:        "user_plaid_accounts_log",
+	UserSanctions:               "user_sanctions",
 }
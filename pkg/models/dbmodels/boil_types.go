@@ -50,3 +50,95 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	strmangle.PutBuffer(buf)
 	return str
 }
+
+// Enum values for account_deletion_steps.step
+const (
+	AccountDeletionStepsStepSNS       = "sns"
+	AccountDeletionStepsStepPlaid     = "plaid"
+	AccountDeletionStepsStepMailchimp = "mailchimp"
+	AccountDeletionStepsStepMedia     = "media"
+	AccountDeletionStepsStepAuth0     = "auth0"
+	AccountDeletionStepsStepMysql     = "mysql"
+)
+
+// Enum values for account_deletion_steps.status
+const (
+	AccountDeletionStepsStatusPending = "pending"
+	AccountDeletionStepsStatusDone    = "done"
+	AccountDeletionStepsStatusFailed  = "failed"
+)
+
+// Enum values for account_deletions.status
+const (
+	AccountDeletionsStatusPending   = "pending"
+	AccountDeletionsStatusCancelled = "cancelled"
+	AccountDeletionsStatusPurging   = "purging"
+	AccountDeletionsStatusPurged    = "purged"
+	AccountDeletionsStatusFailed    = "failed"
+)
+
+// Enum values for content_appeals.status
+const (
+	ContentAppealsStatusPending = "pending"
+	ContentAppealsStatusGranted = "granted"
+	ContentAppealsStatusDenied  = "denied"
+)
+
+// Enum values for data_exports.status
+const (
+	DataExportsStatusPending    = "pending"
+	DataExportsStatusProcessing = "processing"
+	DataExportsStatusReady      = "ready"
+	DataExportsStatusFailed     = "failed"
+	DataExportsStatusExpired    = "expired"
+)
+
+// Enum values for drafts.kind
+const (
+	DraftsKindPost    = "post"
+	DraftsKindComment = "comment"
+)
+
+// Enum values for files.status
+const (
+	FilesStatusPending  = "pending"
+	FilesStatusUploaded = "uploaded"
+	FilesStatusAttached = "attached"
+)
+
+// Enum values for files.processing_status
+const (
+	FilesProcessingStatusPending    = "pending"
+	FilesProcessingStatusProcessing = "processing"
+	FilesProcessingStatusReady      = "ready"
+	FilesProcessingStatusFailed     = "failed"
+)
+
+// Enum values for marketing_sync_queue.action
+const (
+	MarketingSyncQueueActionSync         = "sync"
+	MarketingSyncQueueActionSubscription = "subscription"
+	MarketingSyncQueueActionRemove       = "remove"
+)
+
+// Enum values for marketing_sync_queue.status
+const (
+	MarketingSyncQueueStatusPending = "pending"
+	MarketingSyncQueueStatusDone    = "done"
+	MarketingSyncQueueStatusFailed  = "failed"
+)
+
+// Enum values for moderation_decisions.action
+const (
+	ModerationDecisionsActionApproved      = "approved"
+	ModerationDecisionsActionRemoved       = "removed"
+	ModerationDecisionsActionAppealGranted = "appeal_granted"
+	ModerationDecisionsActionAppealDenied  = "appeal_denied"
+)
+
+// Enum values for user_sanctions.kind
+const (
+	UserSanctionsKindWarning    = "warning"
+	UserSanctionsKindSuspension = "suspension"
+	UserSanctionsKindReadOnly   = "read_only"
+)
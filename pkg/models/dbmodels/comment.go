@@ -40,6 +40,7 @@ type Comment struct {
 	ReviewedAt      null.Time   `boil:"reviewed_at" json:"reviewed_at,omitempty" toml:"reviewed_at" yaml:"reviewed_at,omitempty"`
 	Reviewed        bool        `boil:"reviewed" json:"reviewed" toml:"reviewed" yaml:"reviewed"`
 	ReviewComment   null.String `boil:"review_comment" json:"review_comment,omitempty" toml:"review_comment" yaml:"review_comment,omitempty"`
+	Held            bool        `boil:"held" json:"held" toml:"held" yaml:"held"`
 
 	R *commentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L commentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -62,6 +63,7 @@ var CommentColumns = struct {
 	ReviewedAt      string
 	Reviewed        string
 	ReviewComment   string
+	Held            string
 }{
 	CommentID:       "comment_id",
 	PostID:          "post_id",
@@ -79,6 +81,7 @@ var CommentColumns = struct {
 	ReviewedAt:      "reviewed_at",
 	Reviewed:        "reviewed",
 	ReviewComment:   "review_comment",
+	Held:            "held",
 }
 
 var CommentTableColumns = struct {
@@ -98,6 +101,7 @@ var CommentTableColumns = struct {
 	ReviewedAt      string
 	Reviewed        string
 	ReviewComment   string
+	Held            string
 }{
 	CommentID:       "comment.comment_id",
 	PostID:          "comment.post_id",
@@ -115,54 +119,11 @@ var CommentTableColumns = struct {
 	ReviewedAt:      "comment.reviewed_at",
 	Reviewed:        "comment.reviewed",
 	ReviewComment:   "comment.review_comment",
+	Held:            "comment.held",
 }
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Uint64 struct{ field string }
 
 func (w whereHelpernull_Uint64) EQ(x null.Uint64) qm.QueryMod {
@@ -218,29 +179,6 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var CommentWhere = struct {
 	CommentID       whereHelperuint64
 	PostID          whereHelperuint64
@@ -258,6 +196,7 @@ var CommentWhere = struct {
 	ReviewedAt      whereHelpernull_Time
 	Reviewed        whereHelperbool
 	ReviewComment   whereHelpernull_String
+	Held            whereHelperbool
 }{
 	CommentID:       whereHelperuint64{field: "`comment`.`comment_id`"},
 	PostID:          whereHelperuint64{field: "`comment`.`post_id`"},
@@ -275,6 +214,7 @@ var CommentWhere = struct {
 	ReviewedAt:      whereHelpernull_Time{field: "`comment`.`reviewed_at`"},
 	Reviewed:        whereHelperbool{field: "`comment`.`reviewed`"},
 	ReviewComment:   whereHelpernull_String{field: "`comment`.`review_comment`"},
+	Held:            whereHelperbool{field: "`comment`.`held`"},
 }
 
 // CommentRels is where relationship names are stored.
@@ -285,6 +225,9 @@ var CommentRels = struct {
 	ParentCommentComments string
 	CommentEdits          string
 	CommentReactions      string
+	ContentAppeals        string
+	Mentions              string
+	ModerationDecisions   string
 }{
 	Post:                  "Post",
 	ParentComment:         "ParentComment",
@@ -292,16 +235,22 @@ var CommentRels = struct {
 	ParentCommentComments: "ParentCommentComments",
 	CommentEdits:          "CommentEdits",
 	CommentReactions:      "CommentReactions",
+	ContentAppeals:        "ContentAppeals",
+	Mentions:              "Mentions",
+	ModerationDecisions:   "ModerationDecisions",
 }
 
 // commentR is where relationships are stored.
 type commentR struct {
-	Post                  *Post                `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
-	ParentComment         *Comment             `boil:"ParentComment" json:"ParentComment" toml:"ParentComment" yaml:"ParentComment"`
-	ImpartWealth          *User                `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
-	ParentCommentComments CommentSlice         `boil:"ParentCommentComments" json:"ParentCommentComments" toml:"ParentCommentComments" yaml:"ParentCommentComments"`
-	CommentEdits          CommentEditSlice     `boil:"CommentEdits" json:"CommentEdits" toml:"CommentEdits" yaml:"CommentEdits"`
-	CommentReactions      CommentReactionSlice `boil:"CommentReactions" json:"CommentReactions" toml:"CommentReactions" yaml:"CommentReactions"`
+	Post                  *Post                   `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	ParentComment         *Comment                `boil:"ParentComment" json:"ParentComment" toml:"ParentComment" yaml:"ParentComment"`
+	ImpartWealth          *User                   `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+	ParentCommentComments CommentSlice            `boil:"ParentCommentComments" json:"ParentCommentComments" toml:"ParentCommentComments" yaml:"ParentCommentComments"`
+	CommentEdits          CommentEditSlice        `boil:"CommentEdits" json:"CommentEdits" toml:"CommentEdits" yaml:"CommentEdits"`
+	CommentReactions      CommentReactionSlice    `boil:"CommentReactions" json:"CommentReactions" toml:"CommentReactions" yaml:"CommentReactions"`
+	ContentAppeals        ContentAppealSlice      `boil:"ContentAppeals" json:"ContentAppeals" toml:"ContentAppeals" yaml:"ContentAppeals"`
+	Mentions              MentionSlice            `boil:"Mentions" json:"Mentions" toml:"Mentions" yaml:"Mentions"`
+	ModerationDecisions   ModerationDecisionSlice `boil:"ModerationDecisions" json:"ModerationDecisions" toml:"ModerationDecisions" yaml:"ModerationDecisions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -313,9 +262,9 @@ func (*commentR) NewStruct() *commentR {
 type commentL struct{}
 
 var (
-	commentAllColumns            = []string{"comment_id", "post_id", "impart_wealth_id", "created_at", "updated_at", "deleted_at", "content", "last_reply_ts", "parent_comment_id", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed_at", "reviewed", "review_comment"}
+	commentAllColumns            = []string{"comment_id", "post_id", "impart_wealth_id", "created_at", "updated_at", "deleted_at", "content", "last_reply_ts", "parent_comment_id", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed_at", "reviewed", "review_comment", "held"}
 	commentColumnsWithoutDefault = []string{"post_id", "impart_wealth_id", "created_at", "updated_at", "deleted_at", "content", "last_reply_ts", "parent_comment_id", "reviewed_at", "review_comment"}
-	commentColumnsWithDefault    = []string{"comment_id", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed"}
+	commentColumnsWithDefault    = []string{"comment_id", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed", "held"}
 	commentPrimaryKeyColumns     = []string{"comment_id"}
 )
 
@@ -705,6 +654,69 @@ func (o *Comment) CommentReactions(mods ...qm.QueryMod) commentReactionQuery {
 	return query
 }
 
+// ContentAppeals retrieves all the content_appeal's ContentAppeals with an executor.
+func (o *Comment) ContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`content_appeals`.`comment_id`=?", o.CommentID),
+	)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`content_appeals`.*"})
+	}
+
+	return query
+}
+
+// Mentions retrieves all the mention's Mentions with an executor.
+func (o *Comment) Mentions(mods ...qm.QueryMod) mentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`mentions`.`comment_id`=?", o.CommentID),
+	)
+
+	query := Mentions(queryMods...)
+	queries.SetFrom(query.Query, "`mentions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`mentions`.*"})
+	}
+
+	return query
+}
+
+// ModerationDecisions retrieves all the moderation_decision's ModerationDecisions with an executor.
+func (o *Comment) ModerationDecisions(mods ...qm.QueryMod) moderationDecisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`moderation_decisions`.`comment_id`=?", o.CommentID),
+	)
+
+	query := ModerationDecisions(queryMods...)
+	queries.SetFrom(query.Query, "`moderation_decisions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`moderation_decisions`.*"})
+	}
+
+	return query
+}
+
 // LoadPost allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (commentL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
@@ -1321,121 +1333,415 @@ func (commentL) LoadCommentReactions(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// SetPost of the comment to the related item.
-// Sets o.R.Post to related.
-// Adds o to related.R.Comments.
-func (o *Comment) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `comment` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
-		strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
-	)
-	values := []interface{}{related.PostID, o.CommentID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadContentAppeals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (commentL) LoadContentAppeals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
+	var slice []*Comment
+	var object *Comment
 
-	o.PostID = related.PostID
-	if o.R == nil {
-		o.R = &commentR{
-			Post: related,
-		}
+	if singular {
+		object = maybeComment.(*Comment)
 	} else {
-		o.R.Post = related
+		slice = *maybeComment.(*[]*Comment)
 	}
 
-	if related.R == nil {
-		related.R = &postR{
-			Comments: CommentSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &commentR{}
 		}
+		args = append(args, object.CommentID)
 	} else {
-		related.R.Comments = append(related.R.Comments, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &commentR{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
 
-// SetParentComment of the comment to the related item.
-// Sets o.R.ParentComment to related.
-// Adds o to related.R.ParentCommentComments.
-func (o *Comment) SetParentComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			args = append(args, obj.CommentID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `comment` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"parent_comment_id"}),
-		strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.comment_id in ?`, args...),
 	)
-	values := []interface{}{related.CommentID, o.CommentID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load content_appeals")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*ContentAppeal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice content_appeals")
 	}
 
-	queries.Assign(&o.ParentCommentID, related.CommentID)
-	if o.R == nil {
-		o.R = &commentR{
-			ParentComment: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on content_appeals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.ParentComment = related
+	}
+	if singular {
+		object.R.ContentAppeals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &contentAppealR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &commentR{
-			ParentCommentComments: CommentSlice{o},
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.ContentAppeals = append(local.R.ContentAppeals, foreign)
+				if foreign.R == nil {
+					foreign.R = &contentAppealR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
 		}
-	} else {
-		related.R.ParentCommentComments = append(related.R.ParentCommentComments, o)
 	}
 
 	return nil
 }
 
-// RemoveParentComment relationship.
-// Sets o.R.ParentComment to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *Comment) RemoveParentComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
-	var err error
+// LoadMentions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (commentL) LoadMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
+	var slice []*Comment
+	var object *Comment
 
-	queries.SetScanner(&o.ParentCommentID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_comment_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if singular {
+		object = maybeComment.(*Comment)
+	} else {
+		slice = *maybeComment.(*[]*Comment)
 	}
 
-	if o.R != nil {
-		o.R.ParentComment = nil
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &commentR{}
+		}
+		args = append(args, object.CommentID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &commentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CommentID)
+		}
 	}
-	if related == nil || related.R == nil {
+
+	if len(args) == 0 {
 		return nil
 	}
 
-	for i, ri := range related.R.ParentCommentComments {
-		if queries.Equal(o.ParentCommentID, ri.ParentCommentID) {
-			continue
+	query := NewQuery(
+		qm.From(`mentions`),
+		qm.WhereIn(`mentions.comment_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mentions")
+	}
+
+	var resultSlice []*Mention
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mentions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mentions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mentions")
+	}
+
+	if len(mentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Mentions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mentionR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.Mentions = append(local.R.Mentions, foreign)
+				if foreign.R == nil {
+					foreign.R = &mentionR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadModerationDecisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (commentL) LoadModerationDecisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComment interface{}, mods queries.Applicator) error {
+	var slice []*Comment
+	var object *Comment
+
+	if singular {
+		object = maybeComment.(*Comment)
+	} else {
+		slice = *maybeComment.(*[]*Comment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &commentR{}
+		}
+		args = append(args, object.CommentID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &commentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CommentID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`moderation_decisions`),
+		qm.WhereIn(`moderation_decisions.comment_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load moderation_decisions")
+	}
+
+	var resultSlice []*ModerationDecision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice moderation_decisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on moderation_decisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for moderation_decisions")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ModerationDecisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &moderationDecisionR{}
+			}
+			foreign.R.Comment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.ModerationDecisions = append(local.R.ModerationDecisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &moderationDecisionR{}
+				}
+				foreign.R.Comment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPost of the comment to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.Comments.
+func (o *Comment) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `comment` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+		strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PostID, o.CommentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.PostID
+	if o.R == nil {
+		o.R = &commentR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			Comments: CommentSlice{o},
+		}
+	} else {
+		related.R.Comments = append(related.R.Comments, o)
+	}
+
+	return nil
+}
+
+// SetParentComment of the comment to the related item.
+// Sets o.R.ParentComment to related.
+// Adds o to related.R.ParentCommentComments.
+func (o *Comment) SetParentComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `comment` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"parent_comment_id"}),
+		strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.CommentID, o.CommentID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentCommentID, related.CommentID)
+	if o.R == nil {
+		o.R = &commentR{
+			ParentComment: related,
+		}
+	} else {
+		o.R.ParentComment = related
+	}
+
+	if related.R == nil {
+		related.R = &commentR{
+			ParentCommentComments: CommentSlice{o},
+		}
+	} else {
+		related.R.ParentCommentComments = append(related.R.ParentCommentComments, o)
+	}
+
+	return nil
+}
+
+// RemoveParentComment relationship.
+// Sets o.R.ParentComment to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Comment) RemoveParentComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
+	var err error
+
+	queries.SetScanner(&o.ParentCommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ParentComment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentCommentComments {
+		if queries.Equal(o.ParentCommentID, ri.ParentCommentID) {
+			continue
 		}
 
 		ln := len(related.R.ParentCommentComments)
@@ -1728,6 +2034,387 @@ func (o *Comment) AddCommentReactions(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
+// AddContentAppeals adds the given related objects to the existing relationships
+// of the comment, optionally inserting them as new records.
+// Appends related to o.R.ContentAppeals.
+// Sets related.R.Comment appropriately.
+func (o *Comment) AddContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CommentID, o.CommentID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `content_appeals` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+				strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+			)
+			values := []interface{}{o.CommentID, rel.AppealID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CommentID, o.CommentID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &commentR{
+			ContentAppeals: related,
+		}
+	} else {
+		o.R.ContentAppeals = append(o.R.ContentAppeals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentAppealR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
+// SetContentAppeals removes all previously related items of the
+// comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's ContentAppeals accordingly.
+// Replaces o.R.ContentAppeals with related.
+// Sets related.R.Comment's ContentAppeals accordingly.
+func (o *Comment) SetContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	query := "update `content_appeals` set `comment_id` = null where `comment_id` = ?"
+	values := []interface{}{o.CommentID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ContentAppeals {
+			queries.SetScanner(&rel.CommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Comment = nil
+		}
+
+		o.R.ContentAppeals = nil
+	}
+	return o.AddContentAppeals(ctx, exec, insert, related...)
+}
+
+// RemoveContentAppeals relationships from objects passed in.
+// Removes related items from R.ContentAppeals (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+func (o *Comment) RemoveContentAppeals(ctx context.Context, exec boil.ContextExecutor, related ...*ContentAppeal) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CommentID, nil)
+		if rel.R != nil {
+			rel.R.Comment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ContentAppeals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ContentAppeals)
+			if ln > 1 && i < ln-1 {
+				o.R.ContentAppeals[i] = o.R.ContentAppeals[ln-1]
+			}
+			o.R.ContentAppeals = o.R.ContentAppeals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMentions adds the given related objects to the existing relationships
+// of the comment, optionally inserting them as new records.
+// Appends related to o.R.Mentions.
+// Sets related.R.Comment appropriately.
+func (o *Comment) AddMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mention) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CommentID, o.CommentID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `mentions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+				strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.CommentID, rel.MentionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CommentID, o.CommentID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &commentR{
+			Mentions: related,
+		}
+	} else {
+		o.R.Mentions = append(o.R.Mentions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mentionR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
+// SetMentions removes all previously related items of the
+// comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's Mentions accordingly.
+// Replaces o.R.Mentions with related.
+// Sets related.R.Comment's Mentions accordingly.
+func (o *Comment) SetMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mention) error {
+	query := "update `mentions` set `comment_id` = null where `comment_id` = ?"
+	values := []interface{}{o.CommentID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Mentions {
+			queries.SetScanner(&rel.CommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Comment = nil
+		}
+
+		o.R.Mentions = nil
+	}
+	return o.AddMentions(ctx, exec, insert, related...)
+}
+
+// RemoveMentions relationships from objects passed in.
+// Removes related items from R.Mentions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+func (o *Comment) RemoveMentions(ctx context.Context, exec boil.ContextExecutor, related ...*Mention) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CommentID, nil)
+		if rel.R != nil {
+			rel.R.Comment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Mentions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Mentions)
+			if ln > 1 && i < ln-1 {
+				o.R.Mentions[i] = o.R.Mentions[ln-1]
+			}
+			o.R.Mentions = o.R.Mentions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddModerationDecisions adds the given related objects to the existing relationships
+// of the comment, optionally inserting them as new records.
+// Appends related to o.R.ModerationDecisions.
+// Sets related.R.Comment appropriately.
+func (o *Comment) AddModerationDecisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ModerationDecision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CommentID, o.CommentID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `moderation_decisions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+				strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.CommentID, rel.DecisionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CommentID, o.CommentID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &commentR{
+			ModerationDecisions: related,
+		}
+	} else {
+		o.R.ModerationDecisions = append(o.R.ModerationDecisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &moderationDecisionR{
+				Comment: o,
+			}
+		} else {
+			rel.R.Comment = o
+		}
+	}
+	return nil
+}
+
+// SetModerationDecisions removes all previously related items of the
+// comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Comment's ModerationDecisions accordingly.
+// Replaces o.R.ModerationDecisions with related.
+// Sets related.R.Comment's ModerationDecisions accordingly.
+func (o *Comment) SetModerationDecisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ModerationDecision) error {
+	query := "update `moderation_decisions` set `comment_id` = null where `comment_id` = ?"
+	values := []interface{}{o.CommentID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ModerationDecisions {
+			queries.SetScanner(&rel.CommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Comment = nil
+		}
+
+		o.R.ModerationDecisions = nil
+	}
+	return o.AddModerationDecisions(ctx, exec, insert, related...)
+}
+
+// RemoveModerationDecisions relationships from objects passed in.
+// Removes related items from R.ModerationDecisions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Comment.
+func (o *Comment) RemoveModerationDecisions(ctx context.Context, exec boil.ContextExecutor, related ...*ModerationDecision) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CommentID, nil)
+		if rel.R != nil {
+			rel.R.Comment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ModerationDecisions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ModerationDecisions)
+			if ln > 1 && i < ln-1 {
+				o.R.ModerationDecisions[i] = o.R.ModerationDecisions[ln-1]
+			}
+			o.R.ModerationDecisions = o.R.ModerationDecisions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Comments retrieves all the records using an executor.
 func Comments(mods ...qm.QueryMod) commentQuery {
 	mods = append(mods, qm.From("`comment`"), qmhelper.WhereIsNull("`comment`.`deleted_at`"))
@@ -34,6 +34,7 @@ type CommentReaction struct {
 	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ReportWeight   uint16      `boil:"report_weight" json:"report_weight" toml:"report_weight" yaml:"report_weight"`
 
 	R *commentReactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L commentReactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var CommentReactionColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	ReportWeight   string
 }{
 	CommentID:      "comment_id",
 	PostID:         "post_id",
@@ -61,6 +63,7 @@ var CommentReactionColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	DeletedAt:      "deleted_at",
+	ReportWeight:   "report_weight",
 }
 
 var CommentReactionTableColumns = struct {
@@ -74,6 +77,7 @@ var CommentReactionTableColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	ReportWeight   string
 }{
 	CommentID:      "comment_reactions.comment_id",
 	PostID:         "comment_reactions.post_id",
@@ -85,10 +89,34 @@ var CommentReactionTableColumns = struct {
 	CreatedAt:      "comment_reactions.created_at",
 	UpdatedAt:      "comment_reactions.updated_at",
 	DeletedAt:      "comment_reactions.deleted_at",
+	ReportWeight:   "comment_reactions.report_weight",
 }
 
 // Generated where
 
+type whereHelperuint16 struct{ field string }
+
+func (w whereHelperuint16) EQ(x uint16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperuint16) NEQ(x uint16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperuint16) LT(x uint16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperuint16) LTE(x uint16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperuint16) GT(x uint16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperuint16) GTE(x uint16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperuint16) IN(slice []uint16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperuint16) NIN(slice []uint16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var CommentReactionWhere = struct {
 	CommentID      whereHelperuint64
 	PostID         whereHelperuint64
@@ -100,6 +128,7 @@ var CommentReactionWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	DeletedAt      whereHelpernull_Time
+	ReportWeight   whereHelperuint16
 }{
 	CommentID:      whereHelperuint64{field: "`comment_reactions`.`comment_id`"},
 	PostID:         whereHelperuint64{field: "`comment_reactions`.`post_id`"},
@@ -111,6 +140,7 @@ var CommentReactionWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "`comment_reactions`.`created_at`"},
 	UpdatedAt:      whereHelpertime_Time{field: "`comment_reactions`.`updated_at`"},
 	DeletedAt:      whereHelpernull_Time{field: "`comment_reactions`.`deleted_at`"},
+	ReportWeight:   whereHelperuint16{field: "`comment_reactions`.`report_weight`"},
 }
 
 // CommentReactionRels is where relationship names are stored.
@@ -137,9 +167,9 @@ func (*commentReactionR) NewStruct() *commentReactionR {
 type commentReactionL struct{}
 
 var (
-	commentReactionAllColumns            = []string{"comment_id", "post_id", "impart_wealth_id", "upvoted", "downvoted", "reported", "reported_reason", "created_at", "updated_at", "deleted_at"}
+	commentReactionAllColumns            = []string{"comment_id", "post_id", "impart_wealth_id", "upvoted", "downvoted", "reported", "reported_reason", "created_at", "updated_at", "deleted_at", "report_weight"}
 	commentReactionColumnsWithoutDefault = []string{"comment_id", "post_id", "impart_wealth_id", "reported_reason", "created_at", "updated_at", "deleted_at"}
-	commentReactionColumnsWithDefault    = []string{"upvoted", "downvoted", "reported"}
+	commentReactionColumnsWithDefault    = []string{"upvoted", "downvoted", "reported", "report_weight"}
 	commentReactionPrimaryKeyColumns     = []string{"comment_id", "impart_wealth_id"}
 )
 
@@ -0,0 +1,2119 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ContentAppeal is an object representing the database table.
+type ContentAppeal struct {
+	AppealID          uint64      `boil:"appeal_id" json:"appeal_id" toml:"appeal_id" yaml:"appeal_id"`
+	DecisionID        uint64      `boil:"decision_id" json:"decision_id" toml:"decision_id" yaml:"decision_id"`
+	PostID            uint64      `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	CommentID         null.Uint64 `boil:"comment_id" json:"comment_id,omitempty" toml:"comment_id" yaml:"comment_id,omitempty"`
+	HiveID            uint64      `boil:"hive_id" json:"hive_id" toml:"hive_id" yaml:"hive_id"`
+	ImpartWealthID    string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Message           string      `boil:"message" json:"message" toml:"message" yaml:"message"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ResolvedAt        null.Time   `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+	ResolvedBy        null.String `boil:"resolved_by" json:"resolved_by,omitempty" toml:"resolved_by" yaml:"resolved_by,omitempty"`
+	ResolutionComment string      `boil:"resolution_comment" json:"resolution_comment" toml:"resolution_comment" yaml:"resolution_comment"`
+
+	R *contentAppealR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contentAppealL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContentAppealColumns = struct {
+	AppealID          string
+	DecisionID        string
+	PostID            string
+	CommentID         string
+	HiveID            string
+	ImpartWealthID    string
+	Message           string
+	Status            string
+	CreatedAt         string
+	ResolvedAt        string
+	ResolvedBy        string
+	ResolutionComment string
+}{
+	AppealID:          "appeal_id",
+	DecisionID:        "decision_id",
+	PostID:            "post_id",
+	CommentID:         "comment_id",
+	HiveID:            "hive_id",
+	ImpartWealthID:    "impart_wealth_id",
+	Message:           "message",
+	Status:            "status",
+	CreatedAt:         "created_at",
+	ResolvedAt:        "resolved_at",
+	ResolvedBy:        "resolved_by",
+	ResolutionComment: "resolution_comment",
+}
+
+var ContentAppealTableColumns = struct {
+	AppealID          string
+	DecisionID        string
+	PostID            string
+	CommentID         string
+	HiveID            string
+	ImpartWealthID    string
+	Message           string
+	Status            string
+	CreatedAt         string
+	ResolvedAt        string
+	ResolvedBy        string
+	ResolutionComment string
+}{
+	AppealID:          "content_appeals.appeal_id",
+	DecisionID:        "content_appeals.decision_id",
+	PostID:            "content_appeals.post_id",
+	CommentID:         "content_appeals.comment_id",
+	HiveID:            "content_appeals.hive_id",
+	ImpartWealthID:    "content_appeals.impart_wealth_id",
+	Message:           "content_appeals.message",
+	Status:            "content_appeals.status",
+	CreatedAt:         "content_appeals.created_at",
+	ResolvedAt:        "content_appeals.resolved_at",
+	ResolvedBy:        "content_appeals.resolved_by",
+	ResolutionComment: "content_appeals.resolution_comment",
+}
+
+// Generated where
+
+var ContentAppealWhere = struct {
+	AppealID          whereHelperuint64
+	DecisionID        whereHelperuint64
+	PostID            whereHelperuint64
+	CommentID         whereHelpernull_Uint64
+	HiveID            whereHelperuint64
+	ImpartWealthID    whereHelperstring
+	Message           whereHelperstring
+	Status            whereHelperstring
+	CreatedAt         whereHelpertime_Time
+	ResolvedAt        whereHelpernull_Time
+	ResolvedBy        whereHelpernull_String
+	ResolutionComment whereHelperstring
+}{
+	AppealID:          whereHelperuint64{field: "`content_appeals`.`appeal_id`"},
+	DecisionID:        whereHelperuint64{field: "`content_appeals`.`decision_id`"},
+	PostID:            whereHelperuint64{field: "`content_appeals`.`post_id`"},
+	CommentID:         whereHelpernull_Uint64{field: "`content_appeals`.`comment_id`"},
+	HiveID:            whereHelperuint64{field: "`content_appeals`.`hive_id`"},
+	ImpartWealthID:    whereHelperstring{field: "`content_appeals`.`impart_wealth_id`"},
+	Message:           whereHelperstring{field: "`content_appeals`.`message`"},
+	Status:            whereHelperstring{field: "`content_appeals`.`status`"},
+	CreatedAt:         whereHelpertime_Time{field: "`content_appeals`.`created_at`"},
+	ResolvedAt:        whereHelpernull_Time{field: "`content_appeals`.`resolved_at`"},
+	ResolvedBy:        whereHelpernull_String{field: "`content_appeals`.`resolved_by`"},
+	ResolutionComment: whereHelperstring{field: "`content_appeals`.`resolution_comment`"},
+}
+
+// ContentAppealRels is where relationship names are stored.
+var ContentAppealRels = struct {
+	Decision       string
+	Post           string
+	Comment        string
+	Hive           string
+	ImpartWealth   string
+	ResolvedByUser string
+}{
+	Decision:       "Decision",
+	Post:           "Post",
+	Comment:        "Comment",
+	Hive:           "Hive",
+	ImpartWealth:   "ImpartWealth",
+	ResolvedByUser: "ResolvedByUser",
+}
+
+// contentAppealR is where relationships are stored.
+type contentAppealR struct {
+	Decision       *ModerationDecision `boil:"Decision" json:"Decision" toml:"Decision" yaml:"Decision"`
+	Post           *Post               `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Comment        *Comment            `boil:"Comment" json:"Comment" toml:"Comment" yaml:"Comment"`
+	Hive           *Hive               `boil:"Hive" json:"Hive" toml:"Hive" yaml:"Hive"`
+	ImpartWealth   *User               `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+	ResolvedByUser *User               `boil:"ResolvedByUser" json:"ResolvedByUser" toml:"ResolvedByUser" yaml:"ResolvedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*contentAppealR) NewStruct() *contentAppealR {
+	return &contentAppealR{}
+}
+
+// contentAppealL is where Load methods for each relationship are stored.
+type contentAppealL struct{}
+
+var (
+	contentAppealAllColumns            = []string{"appeal_id", "decision_id", "post_id", "comment_id", "hive_id", "impart_wealth_id", "message", "status", "created_at", "resolved_at", "resolved_by", "resolution_comment"}
+	contentAppealColumnsWithoutDefault = []string{"decision_id", "post_id", "comment_id", "hive_id", "impart_wealth_id", "message", "created_at", "resolved_at", "resolved_by", "resolution_comment"}
+	contentAppealColumnsWithDefault    = []string{"appeal_id", "status"}
+	contentAppealPrimaryKeyColumns     = []string{"appeal_id"}
+)
+
+type (
+	// ContentAppealSlice is an alias for a slice of pointers to ContentAppeal.
+	// This should almost always be used instead of []ContentAppeal.
+	ContentAppealSlice []*ContentAppeal
+	// ContentAppealHook is the signature for custom ContentAppeal hook methods
+	ContentAppealHook func(context.Context, boil.ContextExecutor, *ContentAppeal) error
+
+	contentAppealQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contentAppealType                 = reflect.TypeOf(&ContentAppeal{})
+	contentAppealMapping              = queries.MakeStructMapping(contentAppealType)
+	contentAppealPrimaryKeyMapping, _ = queries.BindMapping(contentAppealType, contentAppealMapping, contentAppealPrimaryKeyColumns)
+	contentAppealInsertCacheMut       sync.RWMutex
+	contentAppealInsertCache          = make(map[string]insertCache)
+	contentAppealUpdateCacheMut       sync.RWMutex
+	contentAppealUpdateCache          = make(map[string]updateCache)
+	contentAppealUpsertCacheMut       sync.RWMutex
+	contentAppealUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contentAppealBeforeInsertHooks []ContentAppealHook
+var contentAppealBeforeUpdateHooks []ContentAppealHook
+var contentAppealBeforeDeleteHooks []ContentAppealHook
+var contentAppealBeforeUpsertHooks []ContentAppealHook
+
+var contentAppealAfterInsertHooks []ContentAppealHook
+var contentAppealAfterSelectHooks []ContentAppealHook
+var contentAppealAfterUpdateHooks []ContentAppealHook
+var contentAppealAfterDeleteHooks []ContentAppealHook
+var contentAppealAfterUpsertHooks []ContentAppealHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ContentAppeal) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ContentAppeal) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ContentAppeal) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ContentAppeal) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ContentAppeal) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ContentAppeal) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ContentAppeal) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ContentAppeal) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ContentAppeal) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentAppealAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContentAppealHook registers your hook function for all future operations.
+func AddContentAppealHook(hookPoint boil.HookPoint, contentAppealHook ContentAppealHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		contentAppealBeforeInsertHooks = append(contentAppealBeforeInsertHooks, contentAppealHook)
+	case boil.BeforeUpdateHook:
+		contentAppealBeforeUpdateHooks = append(contentAppealBeforeUpdateHooks, contentAppealHook)
+	case boil.BeforeDeleteHook:
+		contentAppealBeforeDeleteHooks = append(contentAppealBeforeDeleteHooks, contentAppealHook)
+	case boil.BeforeUpsertHook:
+		contentAppealBeforeUpsertHooks = append(contentAppealBeforeUpsertHooks, contentAppealHook)
+	case boil.AfterInsertHook:
+		contentAppealAfterInsertHooks = append(contentAppealAfterInsertHooks, contentAppealHook)
+	case boil.AfterSelectHook:
+		contentAppealAfterSelectHooks = append(contentAppealAfterSelectHooks, contentAppealHook)
+	case boil.AfterUpdateHook:
+		contentAppealAfterUpdateHooks = append(contentAppealAfterUpdateHooks, contentAppealHook)
+	case boil.AfterDeleteHook:
+		contentAppealAfterDeleteHooks = append(contentAppealAfterDeleteHooks, contentAppealHook)
+	case boil.AfterUpsertHook:
+		contentAppealAfterUpsertHooks = append(contentAppealAfterUpsertHooks, contentAppealHook)
+	}
+}
+
+// One returns a single contentAppeal record from the query.
+func (q contentAppealQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ContentAppeal, error) {
+	o := &ContentAppeal{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for content_appeals")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ContentAppeal records from the query.
+func (q contentAppealQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContentAppealSlice, error) {
+	var o []*ContentAppeal
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ContentAppeal slice")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ContentAppeal records in the query.
+func (q contentAppealQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count content_appeals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contentAppealQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if content_appeals exists")
+	}
+
+	return count > 0, nil
+}
+
+// Decision pointed to by the foreign key.
+func (o *ContentAppeal) Decision(mods ...qm.QueryMod) moderationDecisionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`decision_id` = ?", o.DecisionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ModerationDecisions(queryMods...)
+	queries.SetFrom(query.Query, "`moderation_decisions`")
+
+	return query
+}
+
+// Post pointed to by the foreign key.
+func (o *ContentAppeal) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`post_id` = ?", o.PostID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Posts(queryMods...)
+	queries.SetFrom(query.Query, "`post`")
+
+	return query
+}
+
+// Comment pointed to by the foreign key.
+func (o *ContentAppeal) Comment(mods ...qm.QueryMod) commentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`comment_id` = ?", o.CommentID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Comments(queryMods...)
+	queries.SetFrom(query.Query, "`comment`")
+
+	return query
+}
+
+// Hive pointed to by the foreign key.
+func (o *ContentAppeal) Hive(mods ...qm.QueryMod) hiveQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hive_id` = ?", o.HiveID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Hives(queryMods...)
+	queries.SetFrom(query.Query, "`hive`")
+
+	return query
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *ContentAppeal) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// ResolvedByUser pointed to by the foreign key.
+func (o *ContentAppeal) ResolvedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ResolvedBy),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadDecision allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadDecision(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		args = append(args, object.DecisionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DecisionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DecisionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`moderation_decisions`),
+		qm.WhereIn(`moderation_decisions.decision_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ModerationDecision")
+	}
+
+	var resultSlice []*ModerationDecision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ModerationDecision")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for moderation_decisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for moderation_decisions")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Decision = foreign
+		if foreign.R == nil {
+			foreign.R = &moderationDecisionR{}
+		}
+		foreign.R.DecisionContentAppeal = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DecisionID == foreign.DecisionID {
+				local.R.Decision = foreign
+				if foreign.R == nil {
+					foreign.R = &moderationDecisionR{}
+				}
+				foreign.R.DecisionContentAppeal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		args = append(args, object.PostID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post`),
+		qm.WhereIn(`post.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for post")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.PostID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadComment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		if !queries.IsNil(object.CommentID) {
+			args = append(args, object.CommentID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CommentID) {
+				args = append(args, obj.CommentID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`comment`),
+		qm.WhereIn(`comment.comment_id in ?`, args...),
+		qmhelper.WhereIsNull(`comment.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Comment")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Comment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for comment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Comment = foreign
+		if foreign.R == nil {
+			foreign.R = &commentR{}
+		}
+		foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.Comment = foreign
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHive allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadHive(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		args = append(args, object.HiveID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if a == obj.HiveID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.HiveID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hive`),
+		qm.WhereIn(`hive.hive_id in ?`, args...),
+		qmhelper.WhereIsNull(`hive.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Hive")
+	}
+
+	var resultSlice []*Hive
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Hive")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for hive")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Hive = foreign
+		if foreign.R == nil {
+			foreign.R = &hiveR{}
+		}
+		foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.HiveID == foreign.HiveID {
+				local.R.Hive = foreign
+				if foreign.R == nil {
+					foreign.R = &hiveR{}
+				}
+				foreign.R.ContentAppeals = append(foreign.R.ContentAppeals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthContentAppeals = append(foreign.R.ImpartWealthContentAppeals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthContentAppeals = append(foreign.R.ImpartWealthContentAppeals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentAppealL) LoadResolvedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentAppeal interface{}, mods queries.Applicator) error {
+	var slice []*ContentAppeal
+	var object *ContentAppeal
+
+	if singular {
+		object = maybeContentAppeal.(*ContentAppeal)
+	} else {
+		slice = *maybeContentAppeal.(*[]*ContentAppeal)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentAppealR{}
+		}
+		if !queries.IsNil(object.ResolvedBy) {
+			args = append(args, object.ResolvedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentAppealR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ResolvedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ResolvedBy) {
+				args = append(args, obj.ResolvedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ResolvedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ResolvedByContentAppeals = append(foreign.R.ResolvedByContentAppeals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ResolvedBy, foreign.ImpartWealthID) {
+				local.R.ResolvedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ResolvedByContentAppeals = append(foreign.R.ResolvedByContentAppeals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDecision of the contentAppeal to the related item.
+// Sets o.R.Decision to related.
+// Adds o to related.R.DecisionContentAppeal.
+func (o *ContentAppeal) SetDecision(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ModerationDecision) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"decision_id"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.DecisionID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DecisionID = related.DecisionID
+	if o.R == nil {
+		o.R = &contentAppealR{
+			Decision: related,
+		}
+	} else {
+		o.R.Decision = related
+	}
+
+	if related.R == nil {
+		related.R = &moderationDecisionR{
+			DecisionContentAppeal: o,
+		}
+	} else {
+		related.R.DecisionContentAppeal = o
+	}
+
+	return nil
+}
+
+// SetPost of the contentAppeal to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.ContentAppeals.
+func (o *ContentAppeal) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PostID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.PostID
+	if o.R == nil {
+		o.R = &contentAppealR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			ContentAppeals: ContentAppealSlice{o},
+		}
+	} else {
+		related.R.ContentAppeals = append(related.R.ContentAppeals, o)
+	}
+
+	return nil
+}
+
+// SetComment of the contentAppeal to the related item.
+// Sets o.R.Comment to related.
+// Adds o to related.R.ContentAppeals.
+func (o *ContentAppeal) SetComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.CommentID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CommentID, related.CommentID)
+	if o.R == nil {
+		o.R = &contentAppealR{
+			Comment: related,
+		}
+	} else {
+		o.R.Comment = related
+	}
+
+	if related.R == nil {
+		related.R = &commentR{
+			ContentAppeals: ContentAppealSlice{o},
+		}
+	} else {
+		related.R.ContentAppeals = append(related.R.ContentAppeals, o)
+	}
+
+	return nil
+}
+
+// RemoveComment relationship.
+// Sets o.R.Comment to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ContentAppeal) RemoveComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
+	var err error
+
+	queries.SetScanner(&o.CommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Comment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ContentAppeals {
+		if queries.Equal(o.CommentID, ri.CommentID) {
+			continue
+		}
+
+		ln := len(related.R.ContentAppeals)
+		if ln > 1 && i < ln-1 {
+			related.R.ContentAppeals[i] = related.R.ContentAppeals[ln-1]
+		}
+		related.R.ContentAppeals = related.R.ContentAppeals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetHive of the contentAppeal to the related item.
+// Sets o.R.Hive to related.
+// Adds o to related.R.ContentAppeals.
+func (o *ContentAppeal) SetHive(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Hive) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.HiveID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.HiveID = related.HiveID
+	if o.R == nil {
+		o.R = &contentAppealR{
+			Hive: related,
+		}
+	} else {
+		o.R.Hive = related
+	}
+
+	if related.R == nil {
+		related.R = &hiveR{
+			ContentAppeals: ContentAppealSlice{o},
+		}
+	} else {
+		related.R.ContentAppeals = append(related.R.ContentAppeals, o)
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the contentAppeal to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthContentAppeals.
+func (o *ContentAppeal) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &contentAppealR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthContentAppeals: ContentAppealSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthContentAppeals = append(related.R.ImpartWealthContentAppeals, o)
+	}
+
+	return nil
+}
+
+// SetResolvedByUser of the contentAppeal to the related item.
+// Sets o.R.ResolvedByUser to related.
+// Adds o to related.R.ResolvedByContentAppeals.
+func (o *ContentAppeal) SetResolvedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"resolved_by"}),
+		strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.AppealID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ResolvedBy, related.ImpartWealthID)
+	if o.R == nil {
+		o.R = &contentAppealR{
+			ResolvedByUser: related,
+		}
+	} else {
+		o.R.ResolvedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ResolvedByContentAppeals: ContentAppealSlice{o},
+		}
+	} else {
+		related.R.ResolvedByContentAppeals = append(related.R.ResolvedByContentAppeals, o)
+	}
+
+	return nil
+}
+
+// RemoveResolvedByUser relationship.
+// Sets o.R.ResolvedByUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ContentAppeal) RemoveResolvedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ResolvedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ResolvedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ResolvedByContentAppeals {
+		if queries.Equal(o.ResolvedBy, ri.ResolvedBy) {
+			continue
+		}
+
+		ln := len(related.R.ResolvedByContentAppeals)
+		if ln > 1 && i < ln-1 {
+			related.R.ResolvedByContentAppeals[i] = related.R.ResolvedByContentAppeals[ln-1]
+		}
+		related.R.ResolvedByContentAppeals = related.R.ResolvedByContentAppeals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ContentAppeals retrieves all the records using an executor.
+func ContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	mods = append(mods, qm.From("`content_appeals`"))
+	return contentAppealQuery{NewQuery(mods...)}
+}
+
+// FindContentAppeal retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContentAppeal(ctx context.Context, exec boil.ContextExecutor, appealID uint64, selectCols ...string) (*ContentAppeal, error) {
+	contentAppealObj := &ContentAppeal{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `content_appeals` where `appeal_id`=?", sel,
+	)
+
+	q := queries.Raw(query, appealID)
+
+	err := q.Bind(ctx, exec, contentAppealObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from content_appeals")
+	}
+
+	if err = contentAppealObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contentAppealObj, err
+	}
+
+	return contentAppealObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ContentAppeal) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no content_appeals provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentAppealColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contentAppealInsertCacheMut.RLock()
+	cache, cached := contentAppealInsertCache[key]
+	contentAppealInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contentAppealAllColumns,
+			contentAppealColumnsWithDefault,
+			contentAppealColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contentAppealType, contentAppealMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contentAppealType, contentAppealMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `content_appeals` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `content_appeals` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `content_appeals` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into content_appeals")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.AppealID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == contentAppealMapping["appeal_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.AppealID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for content_appeals")
+	}
+
+CacheNoHooks:
+	if !cached {
+		contentAppealInsertCacheMut.Lock()
+		contentAppealInsertCache[key] = cache
+		contentAppealInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ContentAppeal.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ContentAppeal) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contentAppealUpdateCacheMut.RLock()
+	cache, cached := contentAppealUpdateCache[key]
+	contentAppealUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contentAppealAllColumns,
+			contentAppealPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update content_appeals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `content_appeals` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contentAppealType, contentAppealMapping, append(wl, contentAppealPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update content_appeals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for content_appeals")
+	}
+
+	if !cached {
+		contentAppealUpdateCacheMut.Lock()
+		contentAppealUpdateCache[key] = cache
+		contentAppealUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contentAppealQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for content_appeals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for content_appeals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContentAppealSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentAppealPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `content_appeals` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, contentAppealPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in contentAppeal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all contentAppeal")
+	}
+	return rowsAff, nil
+}
+
+var mySQLContentAppealUniqueColumns = []string{
+	"appeal_id",
+	"decision_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ContentAppeal) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no content_appeals provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentAppealColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLContentAppealUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contentAppealUpsertCacheMut.RLock()
+	cache, cached := contentAppealUpsertCache[key]
+	contentAppealUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			contentAppealAllColumns,
+			contentAppealColumnsWithDefault,
+			contentAppealColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			contentAppealAllColumns,
+			contentAppealPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert content_appeals, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`content_appeals`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `content_appeals` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contentAppealType, contentAppealMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contentAppealType, contentAppealMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for content_appeals")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.AppealID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == contentAppealMapping["appeal_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(contentAppealType, contentAppealMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for content_appeals")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for content_appeals")
+	}
+
+CacheNoHooks:
+	if !cached {
+		contentAppealUpsertCacheMut.Lock()
+		contentAppealUpsertCache[key] = cache
+		contentAppealUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ContentAppeal record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ContentAppeal) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ContentAppeal provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contentAppealPrimaryKeyMapping)
+	sql := "DELETE FROM `content_appeals` WHERE `appeal_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from content_appeals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for content_appeals")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contentAppealQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no contentAppealQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from content_appeals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for content_appeals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContentAppealSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contentAppealBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentAppealPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `content_appeals` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, contentAppealPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from contentAppeal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for content_appeals")
+	}
+
+	if len(contentAppealAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ContentAppeal) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContentAppeal(ctx, exec, o.AppealID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContentAppealSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContentAppealSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentAppealPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `content_appeals`.* FROM `content_appeals` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, contentAppealPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ContentAppealSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContentAppealExists checks if the ContentAppeal row exists.
+func ContentAppealExists(ctx context.Context, exec boil.ContextExecutor, appealID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `content_appeals` where `appeal_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, appealID)
+	}
+	row := exec.QueryRowContext(ctx, sql, appealID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if content_appeals exists")
+	}
+
+	return exists, nil
+}
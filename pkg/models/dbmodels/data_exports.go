@@ -0,0 +1,1175 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DataExport is an object representing the database table.
+type DataExport struct {
+	ExportID       uint64      `boil:"export_id" json:"export_id" toml:"export_id" yaml:"export_id"`
+	ImpartWealthID string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	StorageKey     null.String `boil:"storage_key" json:"storage_key,omitempty" toml:"storage_key" yaml:"storage_key,omitempty"`
+	Size           uint64      `boil:"size" json:"size" toml:"size" yaml:"size"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	StartedAt      null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	CompletedAt    null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	ExpiresAt      null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+
+	R *dataExportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataExportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataExportColumns = struct {
+	ExportID       string
+	ImpartWealthID string
+	Status         string
+	StorageKey     string
+	Size           string
+	CreatedAt      string
+	StartedAt      string
+	CompletedAt    string
+	ExpiresAt      string
+}{
+	ExportID:       "export_id",
+	ImpartWealthID: "impart_wealth_id",
+	Status:         "status",
+	StorageKey:     "storage_key",
+	Size:           "size",
+	CreatedAt:      "created_at",
+	StartedAt:      "started_at",
+	CompletedAt:    "completed_at",
+	ExpiresAt:      "expires_at",
+}
+
+var DataExportTableColumns = struct {
+	ExportID       string
+	ImpartWealthID string
+	Status         string
+	StorageKey     string
+	Size           string
+	CreatedAt      string
+	StartedAt      string
+	CompletedAt    string
+	ExpiresAt      string
+}{
+	ExportID:       "data_exports.export_id",
+	ImpartWealthID: "data_exports.impart_wealth_id",
+	Status:         "data_exports.status",
+	StorageKey:     "data_exports.storage_key",
+	Size:           "data_exports.size",
+	CreatedAt:      "data_exports.created_at",
+	StartedAt:      "data_exports.started_at",
+	CompletedAt:    "data_exports.completed_at",
+	ExpiresAt:      "data_exports.expires_at",
+}
+
+// Generated where
+
+var DataExportWhere = struct {
+	ExportID       whereHelperuint64
+	ImpartWealthID whereHelperstring
+	Status         whereHelperstring
+	StorageKey     whereHelpernull_String
+	Size           whereHelperuint64
+	CreatedAt      whereHelpertime_Time
+	StartedAt      whereHelpernull_Time
+	CompletedAt    whereHelpernull_Time
+	ExpiresAt      whereHelpernull_Time
+}{
+	ExportID:       whereHelperuint64{field: "`data_exports`.`export_id`"},
+	ImpartWealthID: whereHelperstring{field: "`data_exports`.`impart_wealth_id`"},
+	Status:         whereHelperstring{field: "`data_exports`.`status`"},
+	StorageKey:     whereHelpernull_String{field: "`data_exports`.`storage_key`"},
+	Size:           whereHelperuint64{field: "`data_exports`.`size`"},
+	CreatedAt:      whereHelpertime_Time{field: "`data_exports`.`created_at`"},
+	StartedAt:      whereHelpernull_Time{field: "`data_exports`.`started_at`"},
+	CompletedAt:    whereHelpernull_Time{field: "`data_exports`.`completed_at`"},
+	ExpiresAt:      whereHelpernull_Time{field: "`data_exports`.`expires_at`"},
+}
+
+// DataExportRels is where relationship names are stored.
+var DataExportRels = struct {
+	ImpartWealth string
+}{
+	ImpartWealth: "ImpartWealth",
+}
+
+// dataExportR is where relationships are stored.
+type dataExportR struct {
+	ImpartWealth *User `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataExportR) NewStruct() *dataExportR {
+	return &dataExportR{}
+}
+
+// dataExportL is where Load methods for each relationship are stored.
+type dataExportL struct{}
+
+var (
+	dataExportAllColumns            = []string{"export_id", "impart_wealth_id", "status", "storage_key", "size", "created_at", "started_at", "completed_at", "expires_at"}
+	dataExportColumnsWithoutDefault = []string{"impart_wealth_id", "storage_key", "created_at", "started_at", "completed_at", "expires_at"}
+	dataExportColumnsWithDefault    = []string{"export_id", "status", "size"}
+	dataExportPrimaryKeyColumns     = []string{"export_id"}
+)
+
+type (
+	// DataExportSlice is an alias for a slice of pointers to DataExport.
+	// This should almost always be used instead of []DataExport.
+	DataExportSlice []*DataExport
+	// DataExportHook is the signature for custom DataExport hook methods
+	DataExportHook func(context.Context, boil.ContextExecutor, *DataExport) error
+
+	dataExportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataExportType                 = reflect.TypeOf(&DataExport{})
+	dataExportMapping              = queries.MakeStructMapping(dataExportType)
+	dataExportPrimaryKeyMapping, _ = queries.BindMapping(dataExportType, dataExportMapping, dataExportPrimaryKeyColumns)
+	dataExportInsertCacheMut       sync.RWMutex
+	dataExportInsertCache          = make(map[string]insertCache)
+	dataExportUpdateCacheMut       sync.RWMutex
+	dataExportUpdateCache          = make(map[string]updateCache)
+	dataExportUpsertCacheMut       sync.RWMutex
+	dataExportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataExportBeforeInsertHooks []DataExportHook
+var dataExportBeforeUpdateHooks []DataExportHook
+var dataExportBeforeDeleteHooks []DataExportHook
+var dataExportBeforeUpsertHooks []DataExportHook
+
+var dataExportAfterInsertHooks []DataExportHook
+var dataExportAfterSelectHooks []DataExportHook
+var dataExportAfterUpdateHooks []DataExportHook
+var dataExportAfterDeleteHooks []DataExportHook
+var dataExportAfterUpsertHooks []DataExportHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataExport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataExport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataExport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataExport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataExport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataExport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataExport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataExport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataExport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataExportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataExportHook registers your hook function for all future operations.
+func AddDataExportHook(hookPoint boil.HookPoint, dataExportHook DataExportHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		dataExportBeforeInsertHooks = append(dataExportBeforeInsertHooks, dataExportHook)
+	case boil.BeforeUpdateHook:
+		dataExportBeforeUpdateHooks = append(dataExportBeforeUpdateHooks, dataExportHook)
+	case boil.BeforeDeleteHook:
+		dataExportBeforeDeleteHooks = append(dataExportBeforeDeleteHooks, dataExportHook)
+	case boil.BeforeUpsertHook:
+		dataExportBeforeUpsertHooks = append(dataExportBeforeUpsertHooks, dataExportHook)
+	case boil.AfterInsertHook:
+		dataExportAfterInsertHooks = append(dataExportAfterInsertHooks, dataExportHook)
+	case boil.AfterSelectHook:
+		dataExportAfterSelectHooks = append(dataExportAfterSelectHooks, dataExportHook)
+	case boil.AfterUpdateHook:
+		dataExportAfterUpdateHooks = append(dataExportAfterUpdateHooks, dataExportHook)
+	case boil.AfterDeleteHook:
+		dataExportAfterDeleteHooks = append(dataExportAfterDeleteHooks, dataExportHook)
+	case boil.AfterUpsertHook:
+		dataExportAfterUpsertHooks = append(dataExportAfterUpsertHooks, dataExportHook)
+	}
+}
+
+// One returns a single dataExport record from the query.
+func (q dataExportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataExport, error) {
+	o := &DataExport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for data_exports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataExport records from the query.
+func (q dataExportQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataExportSlice, error) {
+	var o []*DataExport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to DataExport slice")
+	}
+
+	if len(dataExportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataExport records in the query.
+func (q dataExportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count data_exports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataExportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if data_exports exists")
+	}
+
+	return count > 0, nil
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *DataExport) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataExportL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataExport interface{}, mods queries.Applicator) error {
+	var slice []*DataExport
+	var object *DataExport
+
+	if singular {
+		object = maybeDataExport.(*DataExport)
+	} else {
+		slice = *maybeDataExport.(*[]*DataExport)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dataExportR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataExportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(dataExportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthDataExports = append(foreign.R.ImpartWealthDataExports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthDataExports = append(foreign.R.ImpartWealthDataExports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the dataExport to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthDataExports.
+func (o *DataExport) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_exports` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.ExportID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &dataExportR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthDataExports: DataExportSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthDataExports = append(related.R.ImpartWealthDataExports, o)
+	}
+
+	return nil
+}
+
+// DataExports retrieves all the records using an executor.
+func DataExports(mods ...qm.QueryMod) dataExportQuery {
+	mods = append(mods, qm.From("`data_exports`"))
+	return dataExportQuery{NewQuery(mods...)}
+}
+
+// FindDataExport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataExport(ctx context.Context, exec boil.ContextExecutor, exportID uint64, selectCols ...string) (*DataExport, error) {
+	dataExportObj := &DataExport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_exports` where `export_id`=?", sel,
+	)
+
+	q := queries.Raw(query, exportID)
+
+	err := q.Bind(ctx, exec, dataExportObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from data_exports")
+	}
+
+	if err = dataExportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataExportObj, err
+	}
+
+	return dataExportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataExport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no data_exports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataExportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataExportInsertCacheMut.RLock()
+	cache, cached := dataExportInsertCache[key]
+	dataExportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataExportAllColumns,
+			dataExportColumnsWithDefault,
+			dataExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataExportType, dataExportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_exports` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_exports` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_exports` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into data_exports")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ExportID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataExportMapping["export_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ExportID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for data_exports")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataExportInsertCacheMut.Lock()
+		dataExportInsertCache[key] = cache
+		dataExportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataExport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataExport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataExportUpdateCacheMut.RLock()
+	cache, cached := dataExportUpdateCache[key]
+	dataExportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataExportAllColumns,
+			dataExportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update data_exports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_exports` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, append(wl, dataExportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update data_exports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for data_exports")
+	}
+
+	if !cached {
+		dataExportUpdateCacheMut.Lock()
+		dataExportUpdateCache[key] = cache
+		dataExportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataExportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for data_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataExportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_exports` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in dataExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all dataExport")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataExportUniqueColumns = []string{
+	"export_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataExport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no data_exports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataExportColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataExportUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataExportUpsertCacheMut.RLock()
+	cache, cached := dataExportUpsertCache[key]
+	dataExportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			dataExportAllColumns,
+			dataExportColumnsWithDefault,
+			dataExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			dataExportAllColumns,
+			dataExportPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert data_exports, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_exports`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_exports` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataExportType, dataExportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataExportType, dataExportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for data_exports")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ExportID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataExportMapping["export_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataExportType, dataExportMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for data_exports")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for data_exports")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataExportUpsertCacheMut.Lock()
+		dataExportUpsertCache[key] = cache
+		dataExportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataExport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataExport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no DataExport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataExportPrimaryKeyMapping)
+	sql := "DELETE FROM `data_exports` WHERE `export_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for data_exports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataExportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no dataExportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from data_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for data_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataExportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataExportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_exports` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from dataExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for data_exports")
+	}
+
+	if len(dataExportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataExport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataExport(ctx, exec, o.ExportID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataExportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataExportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_exports`.* FROM `data_exports` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataExportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in DataExportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataExportExists checks if the DataExport row exists.
+func DataExportExists(ctx context.Context, exec boil.ContextExecutor, exportID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_exports` where `export_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, exportID)
+	}
+	row := exec.QueryRowContext(ctx, sql, exportID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if data_exports exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1614 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Draft is an object representing the database table.
+type Draft struct {
+	DraftID        uint64      `boil:"draft_id" json:"draft_id" toml:"draft_id" yaml:"draft_id"`
+	ImpartWealthID string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Kind           string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	HiveID         null.Uint64 `boil:"hive_id" json:"hive_id,omitempty" toml:"hive_id" yaml:"hive_id,omitempty"`
+	PostID         null.Uint64 `boil:"post_id" json:"post_id,omitempty" toml:"post_id" yaml:"post_id,omitempty"`
+	Payload        types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *draftR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L draftL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DraftColumns = struct {
+	DraftID        string
+	ImpartWealthID string
+	Kind           string
+	HiveID         string
+	PostID         string
+	Payload        string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	DraftID:        "draft_id",
+	ImpartWealthID: "impart_wealth_id",
+	Kind:           "kind",
+	HiveID:         "hive_id",
+	PostID:         "post_id",
+	Payload:        "payload",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var DraftTableColumns = struct {
+	DraftID        string
+	ImpartWealthID string
+	Kind           string
+	HiveID         string
+	PostID         string
+	Payload        string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	DraftID:        "drafts.draft_id",
+	ImpartWealthID: "drafts.impart_wealth_id",
+	Kind:           "drafts.kind",
+	HiveID:         "drafts.hive_id",
+	PostID:         "drafts.post_id",
+	Payload:        "drafts.payload",
+	CreatedAt:      "drafts.created_at",
+	UpdatedAt:      "drafts.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var DraftWhere = struct {
+	DraftID        whereHelperuint64
+	ImpartWealthID whereHelperstring
+	Kind           whereHelperstring
+	HiveID         whereHelpernull_Uint64
+	PostID         whereHelpernull_Uint64
+	Payload        whereHelpertypes_JSON
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	DraftID:        whereHelperuint64{field: "`drafts`.`draft_id`"},
+	ImpartWealthID: whereHelperstring{field: "`drafts`.`impart_wealth_id`"},
+	Kind:           whereHelperstring{field: "`drafts`.`kind`"},
+	HiveID:         whereHelpernull_Uint64{field: "`drafts`.`hive_id`"},
+	PostID:         whereHelpernull_Uint64{field: "`drafts`.`post_id`"},
+	Payload:        whereHelpertypes_JSON{field: "`drafts`.`payload`"},
+	CreatedAt:      whereHelpertime_Time{field: "`drafts`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`drafts`.`updated_at`"},
+}
+
+// DraftRels is where relationship names are stored.
+var DraftRels = struct {
+	ImpartWealth string
+	Hive         string
+	Post         string
+}{
+	ImpartWealth: "ImpartWealth",
+	Hive:         "Hive",
+	Post:         "Post",
+}
+
+// draftR is where relationships are stored.
+type draftR struct {
+	ImpartWealth *User `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+	Hive         *Hive `boil:"Hive" json:"Hive" toml:"Hive" yaml:"Hive"`
+	Post         *Post `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+}
+
+// NewStruct creates a new relationship struct
+func (*draftR) NewStruct() *draftR {
+	return &draftR{}
+}
+
+// draftL is where Load methods for each relationship are stored.
+type draftL struct{}
+
+var (
+	draftAllColumns            = []string{"draft_id", "impart_wealth_id", "kind", "hive_id", "post_id", "payload", "created_at", "updated_at"}
+	draftColumnsWithoutDefault = []string{"impart_wealth_id", "kind", "hive_id", "post_id", "payload", "created_at", "updated_at"}
+	draftColumnsWithDefault    = []string{"draft_id"}
+	draftPrimaryKeyColumns     = []string{"draft_id"}
+)
+
+type (
+	// DraftSlice is an alias for a slice of pointers to Draft.
+	// This should almost always be used instead of []Draft.
+	DraftSlice []*Draft
+	// DraftHook is the signature for custom Draft hook methods
+	DraftHook func(context.Context, boil.ContextExecutor, *Draft) error
+
+	draftQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	draftType                 = reflect.TypeOf(&Draft{})
+	draftMapping              = queries.MakeStructMapping(draftType)
+	draftPrimaryKeyMapping, _ = queries.BindMapping(draftType, draftMapping, draftPrimaryKeyColumns)
+	draftInsertCacheMut       sync.RWMutex
+	draftInsertCache          = make(map[string]insertCache)
+	draftUpdateCacheMut       sync.RWMutex
+	draftUpdateCache          = make(map[string]updateCache)
+	draftUpsertCacheMut       sync.RWMutex
+	draftUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var draftBeforeInsertHooks []DraftHook
+var draftBeforeUpdateHooks []DraftHook
+var draftBeforeDeleteHooks []DraftHook
+var draftBeforeUpsertHooks []DraftHook
+
+var draftAfterInsertHooks []DraftHook
+var draftAfterSelectHooks []DraftHook
+var draftAfterUpdateHooks []DraftHook
+var draftAfterDeleteHooks []DraftHook
+var draftAfterUpsertHooks []DraftHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Draft) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Draft) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Draft) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Draft) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Draft) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Draft) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Draft) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Draft) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Draft) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range draftAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDraftHook registers your hook function for all future operations.
+func AddDraftHook(hookPoint boil.HookPoint, draftHook DraftHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		draftBeforeInsertHooks = append(draftBeforeInsertHooks, draftHook)
+	case boil.BeforeUpdateHook:
+		draftBeforeUpdateHooks = append(draftBeforeUpdateHooks, draftHook)
+	case boil.BeforeDeleteHook:
+		draftBeforeDeleteHooks = append(draftBeforeDeleteHooks, draftHook)
+	case boil.BeforeUpsertHook:
+		draftBeforeUpsertHooks = append(draftBeforeUpsertHooks, draftHook)
+	case boil.AfterInsertHook:
+		draftAfterInsertHooks = append(draftAfterInsertHooks, draftHook)
+	case boil.AfterSelectHook:
+		draftAfterSelectHooks = append(draftAfterSelectHooks, draftHook)
+	case boil.AfterUpdateHook:
+		draftAfterUpdateHooks = append(draftAfterUpdateHooks, draftHook)
+	case boil.AfterDeleteHook:
+		draftAfterDeleteHooks = append(draftAfterDeleteHooks, draftHook)
+	case boil.AfterUpsertHook:
+		draftAfterUpsertHooks = append(draftAfterUpsertHooks, draftHook)
+	}
+}
+
+// One returns a single draft record from the query.
+func (q draftQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Draft, error) {
+	o := &Draft{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for drafts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Draft records from the query.
+func (q draftQuery) All(ctx context.Context, exec boil.ContextExecutor) (DraftSlice, error) {
+	var o []*Draft
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to Draft slice")
+	}
+
+	if len(draftAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Draft records in the query.
+func (q draftQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count drafts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q draftQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if drafts exists")
+	}
+
+	return count > 0, nil
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *Draft) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// Hive pointed to by the foreign key.
+func (o *Draft) Hive(mods ...qm.QueryMod) hiveQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hive_id` = ?", o.HiveID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Hives(queryMods...)
+	queries.SetFrom(query.Query, "`hive`")
+
+	return query
+}
+
+// Post pointed to by the foreign key.
+func (o *Draft) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`post_id` = ?", o.PostID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Posts(queryMods...)
+	queries.SetFrom(query.Query, "`post`")
+
+	return query
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (draftL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDraft interface{}, mods queries.Applicator) error {
+	var slice []*Draft
+	var object *Draft
+
+	if singular {
+		object = maybeDraft.(*Draft)
+	} else {
+		slice = *maybeDraft.(*[]*Draft)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &draftR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &draftR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(draftAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthDrafts = append(foreign.R.ImpartWealthDrafts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthDrafts = append(foreign.R.ImpartWealthDrafts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHive allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (draftL) LoadHive(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDraft interface{}, mods queries.Applicator) error {
+	var slice []*Draft
+	var object *Draft
+
+	if singular {
+		object = maybeDraft.(*Draft)
+	} else {
+		slice = *maybeDraft.(*[]*Draft)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &draftR{}
+		}
+		if !queries.IsNil(object.HiveID) {
+			args = append(args, object.HiveID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &draftR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.HiveID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.HiveID) {
+				args = append(args, obj.HiveID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`hive`),
+		qm.WhereIn(`hive.hive_id in ?`, args...),
+		qmhelper.WhereIsNull(`hive.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Hive")
+	}
+
+	var resultSlice []*Hive
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Hive")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for hive")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
+	}
+
+	if len(draftAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Hive = foreign
+		if foreign.R == nil {
+			foreign.R = &hiveR{}
+		}
+		foreign.R.Drafts = append(foreign.R.Drafts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.HiveID, foreign.HiveID) {
+				local.R.Hive = foreign
+				if foreign.R == nil {
+					foreign.R = &hiveR{}
+				}
+				foreign.R.Drafts = append(foreign.R.Drafts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (draftL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDraft interface{}, mods queries.Applicator) error {
+	var slice []*Draft
+	var object *Draft
+
+	if singular {
+		object = maybeDraft.(*Draft)
+	} else {
+		slice = *maybeDraft.(*[]*Draft)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &draftR{}
+		}
+		if !queries.IsNil(object.PostID) {
+			args = append(args, object.PostID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &draftR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PostID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PostID) {
+				args = append(args, obj.PostID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post`),
+		qm.WhereIn(`post.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for post")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+	}
+
+	if len(draftAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.Drafts = append(foreign.R.Drafts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PostID, foreign.PostID) {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Drafts = append(foreign.R.Drafts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the draft to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthDrafts.
+func (o *Draft) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `drafts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.DraftID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &draftR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthDrafts: DraftSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthDrafts = append(related.R.ImpartWealthDrafts, o)
+	}
+
+	return nil
+}
+
+// SetHive of the draft to the related item.
+// Sets o.R.Hive to related.
+// Adds o to related.R.Drafts.
+func (o *Draft) SetHive(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Hive) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `drafts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
+		strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+	)
+	values := []interface{}{related.HiveID, o.DraftID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.HiveID, related.HiveID)
+	if o.R == nil {
+		o.R = &draftR{
+			Hive: related,
+		}
+	} else {
+		o.R.Hive = related
+	}
+
+	if related.R == nil {
+		related.R = &hiveR{
+			Drafts: DraftSlice{o},
+		}
+	} else {
+		related.R.Drafts = append(related.R.Drafts, o)
+	}
+
+	return nil
+}
+
+// RemoveHive relationship.
+// Sets o.R.Hive to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Draft) RemoveHive(ctx context.Context, exec boil.ContextExecutor, related *Hive) error {
+	var err error
+
+	queries.SetScanner(&o.HiveID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("hive_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Hive = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Drafts {
+		if queries.Equal(o.HiveID, ri.HiveID) {
+			continue
+		}
+
+		ln := len(related.R.Drafts)
+		if ln > 1 && i < ln-1 {
+			related.R.Drafts[i] = related.R.Drafts[ln-1]
+		}
+		related.R.Drafts = related.R.Drafts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPost of the draft to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.Drafts.
+func (o *Draft) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `drafts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+		strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PostID, o.DraftID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PostID, related.PostID)
+	if o.R == nil {
+		o.R = &draftR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			Drafts: DraftSlice{o},
+		}
+	} else {
+		related.R.Drafts = append(related.R.Drafts, o)
+	}
+
+	return nil
+}
+
+// RemovePost relationship.
+// Sets o.R.Post to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Draft) RemovePost(ctx context.Context, exec boil.ContextExecutor, related *Post) error {
+	var err error
+
+	queries.SetScanner(&o.PostID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Post = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Drafts {
+		if queries.Equal(o.PostID, ri.PostID) {
+			continue
+		}
+
+		ln := len(related.R.Drafts)
+		if ln > 1 && i < ln-1 {
+			related.R.Drafts[i] = related.R.Drafts[ln-1]
+		}
+		related.R.Drafts = related.R.Drafts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Drafts retrieves all the records using an executor.
+func Drafts(mods ...qm.QueryMod) draftQuery {
+	mods = append(mods, qm.From("`drafts`"))
+	return draftQuery{NewQuery(mods...)}
+}
+
+// FindDraft retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDraft(ctx context.Context, exec boil.ContextExecutor, draftID uint64, selectCols ...string) (*Draft, error) {
+	draftObj := &Draft{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `drafts` where `draft_id`=?", sel,
+	)
+
+	q := queries.Raw(query, draftID)
+
+	err := q.Bind(ctx, exec, draftObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from drafts")
+	}
+
+	if err = draftObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return draftObj, err
+	}
+
+	return draftObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Draft) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no drafts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(draftColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	draftInsertCacheMut.RLock()
+	cache, cached := draftInsertCache[key]
+	draftInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			draftAllColumns,
+			draftColumnsWithDefault,
+			draftColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(draftType, draftMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(draftType, draftMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `drafts` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `drafts` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `drafts` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into drafts")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DraftID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == draftMapping["draft_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.DraftID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for drafts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		draftInsertCacheMut.Lock()
+		draftInsertCache[key] = cache
+		draftInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Draft.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Draft) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	draftUpdateCacheMut.RLock()
+	cache, cached := draftUpdateCache[key]
+	draftUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			draftAllColumns,
+			draftPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update drafts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `drafts` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(draftType, draftMapping, append(wl, draftPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update drafts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for drafts")
+	}
+
+	if !cached {
+		draftUpdateCacheMut.Lock()
+		draftUpdateCache[key] = cache
+		draftUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q draftQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for drafts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for drafts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DraftSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), draftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `drafts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, draftPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in draft slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all draft")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDraftUniqueColumns = []string{
+	"draft_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Draft) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no drafts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(draftColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDraftUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	draftUpsertCacheMut.RLock()
+	cache, cached := draftUpsertCache[key]
+	draftUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			draftAllColumns,
+			draftColumnsWithDefault,
+			draftColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			draftAllColumns,
+			draftPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert drafts, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`drafts`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `drafts` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(draftType, draftMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(draftType, draftMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for drafts")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DraftID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == draftMapping["draft_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(draftType, draftMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for drafts")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for drafts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		draftUpsertCacheMut.Lock()
+		draftUpsertCache[key] = cache
+		draftUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Draft record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Draft) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no Draft provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), draftPrimaryKeyMapping)
+	sql := "DELETE FROM `drafts` WHERE `draft_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from drafts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for drafts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q draftQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no draftQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from drafts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for drafts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DraftSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(draftBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), draftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `drafts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, draftPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from draft slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for drafts")
+	}
+
+	if len(draftAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Draft) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDraft(ctx, exec, o.DraftID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DraftSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DraftSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), draftPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `drafts`.* FROM `drafts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, draftPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in DraftSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DraftExists checks if the Draft row exists.
+func DraftExists(ctx context.Context, exec boil.ContextExecutor, draftID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `drafts` where `draft_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, draftID)
+	}
+	row := exec.QueryRowContext(ctx, sql, draftID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if drafts exists")
+	}
+
+	return exists, nil
+}
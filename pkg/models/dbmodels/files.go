@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,62 +24,135 @@ import (
 
 // File is an object representing the database table.
 type File struct {
-	Fid      uint64 `boil:"fid" json:"fid" toml:"fid" yaml:"fid"`
-	FileName string `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
-	FileType string `boil:"file_type" json:"file_type" toml:"file_type" yaml:"file_type"`
-	URL      string `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Fid              uint64      `boil:"fid" json:"fid" toml:"fid" yaml:"fid"`
+	FileName         string      `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
+	FileType         string      `boil:"file_type" json:"file_type" toml:"file_type" yaml:"file_type"`
+	URL              string      `boil:"url" json:"url" toml:"url" yaml:"url"`
+	ImpartWealthID   null.String `boil:"impart_wealth_id" json:"impart_wealth_id,omitempty" toml:"impart_wealth_id" yaml:"impart_wealth_id,omitempty"`
+	StorageKey       null.String `boil:"storage_key" json:"storage_key,omitempty" toml:"storage_key" yaml:"storage_key,omitempty"`
+	Size             uint64      `boil:"size" json:"size" toml:"size" yaml:"size"`
+	Status           string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt        null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	ExpiresAt        null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	ThumbnailURL     null.String `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
+	MediumURL        null.String `boil:"medium_url" json:"medium_url,omitempty" toml:"medium_url" yaml:"medium_url,omitempty"`
+	ProcessingStatus string      `boil:"processing_status" json:"processing_status" toml:"processing_status" yaml:"processing_status"`
+	ProcessedAt      null.Time   `boil:"processed_at" json:"processed_at,omitempty" toml:"processed_at" yaml:"processed_at,omitempty"`
 
 	R *fileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L fileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var FileColumns = struct {
-	Fid      string
-	FileName string
-	FileType string
-	URL      string
+	Fid              string
+	FileName         string
+	FileType         string
+	URL              string
+	ImpartWealthID   string
+	StorageKey       string
+	Size             string
+	Status           string
+	CreatedAt        string
+	ExpiresAt        string
+	ThumbnailURL     string
+	MediumURL        string
+	ProcessingStatus string
+	ProcessedAt      string
 }{
-	Fid:      "fid",
-	FileName: "file_name",
-	FileType: "file_type",
-	URL:      "url",
+	Fid:              "fid",
+	FileName:         "file_name",
+	FileType:         "file_type",
+	URL:              "url",
+	ImpartWealthID:   "impart_wealth_id",
+	StorageKey:       "storage_key",
+	Size:             "size",
+	Status:           "status",
+	CreatedAt:        "created_at",
+	ExpiresAt:        "expires_at",
+	ThumbnailURL:     "thumbnail_url",
+	MediumURL:        "medium_url",
+	ProcessingStatus: "processing_status",
+	ProcessedAt:      "processed_at",
 }
 
 var FileTableColumns = struct {
-	Fid      string
-	FileName string
-	FileType string
-	URL      string
+	Fid              string
+	FileName         string
+	FileType         string
+	URL              string
+	ImpartWealthID   string
+	StorageKey       string
+	Size             string
+	Status           string
+	CreatedAt        string
+	ExpiresAt        string
+	ThumbnailURL     string
+	MediumURL        string
+	ProcessingStatus string
+	ProcessedAt      string
 }{
-	Fid:      "files.fid",
-	FileName: "files.file_name",
-	FileType: "files.file_type",
-	URL:      "files.url",
+	Fid:              "files.fid",
+	FileName:         "files.file_name",
+	FileType:         "files.file_type",
+	URL:              "files.url",
+	ImpartWealthID:   "files.impart_wealth_id",
+	StorageKey:       "files.storage_key",
+	Size:             "files.size",
+	Status:           "files.status",
+	CreatedAt:        "files.created_at",
+	ExpiresAt:        "files.expires_at",
+	ThumbnailURL:     "files.thumbnail_url",
+	MediumURL:        "files.medium_url",
+	ProcessingStatus: "files.processing_status",
+	ProcessedAt:      "files.processed_at",
 }
 
 // Generated where
 
 var FileWhere = struct {
-	Fid      whereHelperuint64
-	FileName whereHelperstring
-	FileType whereHelperstring
-	URL      whereHelperstring
+	Fid              whereHelperuint64
+	FileName         whereHelperstring
+	FileType         whereHelperstring
+	URL              whereHelperstring
+	ImpartWealthID   whereHelpernull_String
+	StorageKey       whereHelpernull_String
+	Size             whereHelperuint64
+	Status           whereHelperstring
+	CreatedAt        whereHelpernull_Time
+	ExpiresAt        whereHelpernull_Time
+	ThumbnailURL     whereHelpernull_String
+	MediumURL        whereHelpernull_String
+	ProcessingStatus whereHelperstring
+	ProcessedAt      whereHelpernull_Time
 }{
-	Fid:      whereHelperuint64{field: "`files`.`fid`"},
-	FileName: whereHelperstring{field: "`files`.`file_name`"},
-	FileType: whereHelperstring{field: "`files`.`file_type`"},
-	URL:      whereHelperstring{field: "`files`.`url`"},
+	Fid:              whereHelperuint64{field: "`files`.`fid`"},
+	FileName:         whereHelperstring{field: "`files`.`file_name`"},
+	FileType:         whereHelperstring{field: "`files`.`file_type`"},
+	URL:              whereHelperstring{field: "`files`.`url`"},
+	ImpartWealthID:   whereHelpernull_String{field: "`files`.`impart_wealth_id`"},
+	StorageKey:       whereHelpernull_String{field: "`files`.`storage_key`"},
+	Size:             whereHelperuint64{field: "`files`.`size`"},
+	Status:           whereHelperstring{field: "`files`.`status`"},
+	CreatedAt:        whereHelpernull_Time{field: "`files`.`created_at`"},
+	ExpiresAt:        whereHelpernull_Time{field: "`files`.`expires_at`"},
+	ThumbnailURL:     whereHelpernull_String{field: "`files`.`thumbnail_url`"},
+	MediumURL:        whereHelpernull_String{field: "`files`.`medium_url`"},
+	ProcessingStatus: whereHelperstring{field: "`files`.`processing_status`"},
+	ProcessedAt:      whereHelpernull_Time{field: "`files`.`processed_at`"},
 }
 
 // FileRels is where relationship names are stored.
 var FileRels = struct {
+	ImpartWealth string
 	FidPostFiles string
 }{
+	ImpartWealth: "ImpartWealth",
 	FidPostFiles: "FidPostFiles",
 }
 
 // fileR is where relationships are stored.
 type fileR struct {
+	ImpartWealth *User         `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
 	FidPostFiles PostFileSlice `boil:"FidPostFiles" json:"FidPostFiles" toml:"FidPostFiles" yaml:"FidPostFiles"`
 }
 
@@ -91,9 +165,9 @@ func (*fileR) NewStruct() *fileR {
 type fileL struct{}
 
 var (
-	fileAllColumns            = []string{"fid", "file_name", "file_type", "url"}
-	fileColumnsWithoutDefault = []string{"file_name", "file_type", "url"}
-	fileColumnsWithDefault    = []string{"fid"}
+	fileAllColumns            = []string{"fid", "file_name", "file_type", "url", "impart_wealth_id", "storage_key", "size", "status", "created_at", "expires_at", "thumbnail_url", "medium_url", "processing_status", "processed_at"}
+	fileColumnsWithoutDefault = []string{"file_name", "file_type", "url", "impart_wealth_id", "storage_key", "created_at", "expires_at", "thumbnail_url", "medium_url", "processed_at"}
+	fileColumnsWithDefault    = []string{"fid", "size", "status", "processing_status"}
 	filePrimaryKeyColumns     = []string{"fid"}
 )
 
@@ -372,6 +446,21 @@ func (q fileQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ImpartWealth pointed to by the foreign key.
+func (o *File) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
 // FidPostFiles retrieves all the post_file's PostFiles with an executor via fid column.
 func (o *File) FidPostFiles(mods ...qm.QueryMod) postFileQuery {
 	var queryMods []qm.QueryMod
@@ -393,6 +482,115 @@ func (o *File) FidPostFiles(mods ...qm.QueryMod) postFileQuery {
 	return query
 }
 
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (fileL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
+
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
+		}
+		if !queries.IsNil(object.ImpartWealthID) {
+			args = append(args, object.ImpartWealthID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ImpartWealthID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ImpartWealthID) {
+				args = append(args, obj.ImpartWealthID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(fileAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthFiles = append(foreign.R.ImpartWealthFiles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ImpartWealthID, foreign.ImpartWealthID) {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthFiles = append(foreign.R.ImpartWealthFiles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadFidPostFiles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (fileL) LoadFidPostFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
@@ -491,6 +689,86 @@ func (fileL) LoadFidPostFiles(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// SetImpartWealth of the file to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthFiles.
+func (o *File) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `files` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.Fid}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ImpartWealthID, related.ImpartWealthID)
+	if o.R == nil {
+		o.R = &fileR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthFiles: FileSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthFiles = append(related.R.ImpartWealthFiles, o)
+	}
+
+	return nil
+}
+
+// RemoveImpartWealth relationship.
+// Sets o.R.ImpartWealth to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *File) RemoveImpartWealth(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ImpartWealthID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("impart_wealth_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ImpartWealth = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ImpartWealthFiles {
+		if queries.Equal(o.ImpartWealthID, ri.ImpartWealthID) {
+			continue
+		}
+
+		ln := len(related.R.ImpartWealthFiles)
+		if ln > 1 && i < ln-1 {
+			related.R.ImpartWealthFiles[i] = related.R.ImpartWealthFiles[ln-1]
+		}
+		related.R.ImpartWealthFiles = related.R.ImpartWealthFiles[:ln-1]
+		break
+	}
+	return nil
+}
+
 // AddFidPostFiles adds the given related objects to the existing relationships
 // of the file, optionally inserting them as new records.
 // Appends related to o.R.FidPostFiles.
@@ -588,6 +866,13 @@ func (o *File) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 	}
 
 	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
 		return err
@@ -824,6 +1109,13 @@ func (o *File) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColu
 	if o == nil {
 		return errors.New("dbmodels: no files provided for upsert")
 	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
 		return err
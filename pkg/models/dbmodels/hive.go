@@ -33,6 +33,7 @@ type Hive struct {
 	HiveDistributions    null.JSON   `boil:"hive_distributions" json:"hive_distributions,omitempty" toml:"hive_distributions" yaml:"hive_distributions,omitempty"`
 	CreatedAt            null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	DeletedAt            null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ReportHideThreshold  uint16      `boil:"report_hide_threshold" json:"report_hide_threshold" toml:"report_hide_threshold" yaml:"report_hide_threshold"`
 
 	R *hiveR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L hiveL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +49,7 @@ var HiveColumns = struct {
 	HiveDistributions    string
 	CreatedAt            string
 	DeletedAt            string
+	ReportHideThreshold  string
 }{
 	HiveID:               "hive_id",
 	Name:                 "name",
@@ -58,6 +60,7 @@ var HiveColumns = struct {
 	HiveDistributions:    "hive_distributions",
 	CreatedAt:            "created_at",
 	DeletedAt:            "deleted_at",
+	ReportHideThreshold:  "report_hide_threshold",
 }
 
 var HiveTableColumns = struct {
@@ -70,6 +73,7 @@ var HiveTableColumns = struct {
 	HiveDistributions    string
 	CreatedAt            string
 	DeletedAt            string
+	ReportHideThreshold  string
 }{
 	HiveID:               "hive.hive_id",
 	Name:                 "hive.name",
@@ -80,6 +84,7 @@ var HiveTableColumns = struct {
 	HiveDistributions:    "hive.hive_distributions",
 	CreatedAt:            "hive.created_at",
 	DeletedAt:            "hive.deleted_at",
+	ReportHideThreshold:  "hive.report_hide_threshold",
 }
 
 // Generated where
@@ -117,6 +122,7 @@ var HiveWhere = struct {
 	HiveDistributions    whereHelpernull_JSON
 	CreatedAt            whereHelpernull_Time
 	DeletedAt            whereHelpernull_Time
+	ReportHideThreshold  whereHelperuint16
 }{
 	HiveID:               whereHelperuint64{field: "`hive`.`hive_id`"},
 	Name:                 whereHelperstring{field: "`hive`.`name`"},
@@ -127,10 +133,13 @@ var HiveWhere = struct {
 	HiveDistributions:    whereHelpernull_JSON{field: "`hive`.`hive_distributions`"},
 	CreatedAt:            whereHelpernull_Time{field: "`hive`.`created_at`"},
 	DeletedAt:            whereHelpernull_Time{field: "`hive`.`deleted_at`"},
+	ReportHideThreshold:  whereHelperuint16{field: "`hive`.`report_hide_threshold`"},
 }
 
 // HiveRels is where relationship names are stored.
 var HiveRels = struct {
+	ContentAppeals          string
+	Drafts                  string
 	AdminImpartWealthUsers  string
 	MemberImpartWealthUsers string
 	RuleHiveRules           string
@@ -138,6 +147,8 @@ var HiveRels = struct {
 	HiveUserDemographics    string
 	Posts                   string
 }{
+	ContentAppeals:          "ContentAppeals",
+	Drafts:                  "Drafts",
 	AdminImpartWealthUsers:  "AdminImpartWealthUsers",
 	MemberImpartWealthUsers: "MemberImpartWealthUsers",
 	RuleHiveRules:           "RuleHiveRules",
@@ -148,6 +159,8 @@ var HiveRels = struct {
 
 // hiveR is where relationships are stored.
 type hiveR struct {
+	ContentAppeals          ContentAppealSlice       `boil:"ContentAppeals" json:"ContentAppeals" toml:"ContentAppeals" yaml:"ContentAppeals"`
+	Drafts                  DraftSlice               `boil:"Drafts" json:"Drafts" toml:"Drafts" yaml:"Drafts"`
 	AdminImpartWealthUsers  UserSlice                `boil:"AdminImpartWealthUsers" json:"AdminImpartWealthUsers" toml:"AdminImpartWealthUsers" yaml:"AdminImpartWealthUsers"`
 	MemberImpartWealthUsers UserSlice                `boil:"MemberImpartWealthUsers" json:"MemberImpartWealthUsers" toml:"MemberImpartWealthUsers" yaml:"MemberImpartWealthUsers"`
 	RuleHiveRules           HiveRuleSlice            `boil:"RuleHiveRules" json:"RuleHiveRules" toml:"RuleHiveRules" yaml:"RuleHiveRules"`
@@ -165,9 +178,9 @@ func (*hiveR) NewStruct() *hiveR {
 type hiveL struct{}
 
 var (
-	hiveAllColumns            = []string{"hive_id", "name", "description", "pinned_post_id", "tag_comparisons", "notification_topic_arn", "hive_distributions", "created_at", "deleted_at"}
+	hiveAllColumns            = []string{"hive_id", "name", "description", "pinned_post_id", "tag_comparisons", "notification_topic_arn", "hive_distributions", "created_at", "deleted_at", "report_hide_threshold"}
 	hiveColumnsWithoutDefault = []string{"name", "description", "pinned_post_id", "tag_comparisons", "notification_topic_arn", "hive_distributions", "created_at", "deleted_at"}
-	hiveColumnsWithDefault    = []string{"hive_id"}
+	hiveColumnsWithDefault    = []string{"hive_id", "report_hide_threshold"}
 	hivePrimaryKeyColumns     = []string{"hive_id"}
 )
 
@@ -446,6 +459,48 @@ func (q hiveQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ContentAppeals retrieves all the content_appeal's ContentAppeals with an executor.
+func (o *Hive) ContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`content_appeals`.`hive_id`=?", o.HiveID),
+	)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`content_appeals`.*"})
+	}
+
+	return query
+}
+
+// Drafts retrieves all the draft's Drafts with an executor.
+func (o *Hive) Drafts(mods ...qm.QueryMod) draftQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`drafts`.`hive_id`=?", o.HiveID),
+	)
+
+	query := Drafts(queryMods...)
+	queries.SetFrom(query.Query, "`drafts`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`drafts`.*"})
+	}
+
+	return query
+}
+
 // AdminImpartWealthUsers retrieves all the user's Users with an executor via impart_wealth_id column.
 func (o *Hive) AdminImpartWealthUsers(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -576,6 +631,202 @@ func (o *Hive) Posts(mods ...qm.QueryMod) postQuery {
 	return query
 }
 
+// LoadContentAppeals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (hiveL) LoadContentAppeals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHive interface{}, mods queries.Applicator) error {
+	var slice []*Hive
+	var object *Hive
+
+	if singular {
+		object = maybeHive.(*Hive)
+	} else {
+		slice = *maybeHive.(*[]*Hive)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &hiveR{}
+		}
+		args = append(args, object.HiveID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &hiveR{}
+			}
+
+			for _, a := range args {
+				if a == obj.HiveID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.HiveID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.hive_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load content_appeals")
+	}
+
+	var resultSlice []*ContentAppeal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice content_appeals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on content_appeals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
+	}
+
+	if len(contentAppealAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContentAppeals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &contentAppealR{}
+			}
+			foreign.R.Hive = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.HiveID == foreign.HiveID {
+				local.R.ContentAppeals = append(local.R.ContentAppeals, foreign)
+				if foreign.R == nil {
+					foreign.R = &contentAppealR{}
+				}
+				foreign.R.Hive = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDrafts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (hiveL) LoadDrafts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHive interface{}, mods queries.Applicator) error {
+	var slice []*Hive
+	var object *Hive
+
+	if singular {
+		object = maybeHive.(*Hive)
+	} else {
+		slice = *maybeHive.(*[]*Hive)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &hiveR{}
+		}
+		args = append(args, object.HiveID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &hiveR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.HiveID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.HiveID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`drafts`),
+		qm.WhereIn(`drafts.hive_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load drafts")
+	}
+
+	var resultSlice []*Draft
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice drafts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on drafts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for drafts")
+	}
+
+	if len(draftAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Drafts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &draftR{}
+			}
+			foreign.R.Hive = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.HiveID, foreign.HiveID) {
+				local.R.Drafts = append(local.R.Drafts, foreign)
+				if foreign.R == nil {
+					foreign.R = &draftR{}
+				}
+				foreign.R.Hive = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadAdminImpartWealthUsers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (hiveL) LoadAdminImpartWealthUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeHive interface{}, mods queries.Applicator) error {
@@ -1218,6 +1469,186 @@ func (hiveL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
+// AddContentAppeals adds the given related objects to the existing relationships
+// of the hive, optionally inserting them as new records.
+// Appends related to o.R.ContentAppeals.
+// Sets related.R.Hive appropriately.
+func (o *Hive) AddContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.HiveID = o.HiveID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `content_appeals` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
+				strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+			)
+			values := []interface{}{o.HiveID, rel.AppealID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.HiveID = o.HiveID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &hiveR{
+			ContentAppeals: related,
+		}
+	} else {
+		o.R.ContentAppeals = append(o.R.ContentAppeals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentAppealR{
+				Hive: o,
+			}
+		} else {
+			rel.R.Hive = o
+		}
+	}
+	return nil
+}
+
+// AddDrafts adds the given related objects to the existing relationships
+// of the hive, optionally inserting them as new records.
+// Appends related to o.R.Drafts.
+// Sets related.R.Hive appropriately.
+func (o *Hive) AddDrafts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Draft) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.HiveID, o.HiveID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `drafts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
+				strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+			)
+			values := []interface{}{o.HiveID, rel.DraftID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.HiveID, o.HiveID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &hiveR{
+			Drafts: related,
+		}
+	} else {
+		o.R.Drafts = append(o.R.Drafts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &draftR{
+				Hive: o,
+			}
+		} else {
+			rel.R.Hive = o
+		}
+	}
+	return nil
+}
+
+// SetDrafts removes all previously related items of the
+// hive replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Hive's Drafts accordingly.
+// Replaces o.R.Drafts with related.
+// Sets related.R.Hive's Drafts accordingly.
+func (o *Hive) SetDrafts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Draft) error {
+	query := "update `drafts` set `hive_id` = null where `hive_id` = ?"
+	values := []interface{}{o.HiveID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Drafts {
+			queries.SetScanner(&rel.HiveID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Hive = nil
+		}
+
+		o.R.Drafts = nil
+	}
+	return o.AddDrafts(ctx, exec, insert, related...)
+}
+
+// RemoveDrafts relationships from objects passed in.
+// Removes related items from R.Drafts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Hive.
+func (o *Hive) RemoveDrafts(ctx context.Context, exec boil.ContextExecutor, related ...*Draft) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.HiveID, nil)
+		if rel.R != nil {
+			rel.R.Hive = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("hive_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Drafts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Drafts)
+			if ln > 1 && i < ln-1 {
+				o.R.Drafts[i] = o.R.Drafts[ln-1]
+			}
+			o.R.Drafts = o.R.Drafts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddAdminImpartWealthUsers adds the given related objects to the existing relationships
 // of the hive, optionally inserting them as new records.
 // Appends related to o.R.AdminImpartWealthUsers.
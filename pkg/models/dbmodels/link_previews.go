@@ -0,0 +1,960 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LinkPreview is an object representing the database table.
+type LinkPreview struct {
+	URLHash     string    `boil:"url_hash" json:"url_hash" toml:"url_hash" yaml:"url_hash"`
+	URL         string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Title       string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
+	ImageURL    string    `boil:"image_url" json:"image_url" toml:"image_url" yaml:"image_url"`
+	Failed      bool      `boil:"failed" json:"failed" toml:"failed" yaml:"failed"`
+	FetchedAt   time.Time `boil:"fetched_at" json:"fetched_at" toml:"fetched_at" yaml:"fetched_at"`
+	ExpiresAt   time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *linkPreviewR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L linkPreviewL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LinkPreviewColumns = struct {
+	URLHash     string
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	Failed      string
+	FetchedAt   string
+	ExpiresAt   string
+}{
+	URLHash:     "url_hash",
+	URL:         "url",
+	Title:       "title",
+	Description: "description",
+	ImageURL:    "image_url",
+	Failed:      "failed",
+	FetchedAt:   "fetched_at",
+	ExpiresAt:   "expires_at",
+}
+
+var LinkPreviewTableColumns = struct {
+	URLHash     string
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	Failed      string
+	FetchedAt   string
+	ExpiresAt   string
+}{
+	URLHash:     "link_previews.url_hash",
+	URL:         "link_previews.url",
+	Title:       "link_previews.title",
+	Description: "link_previews.description",
+	ImageURL:    "link_previews.image_url",
+	Failed:      "link_previews.failed",
+	FetchedAt:   "link_previews.fetched_at",
+	ExpiresAt:   "link_previews.expires_at",
+}
+
+// Generated where
+
+var LinkPreviewWhere = struct {
+	URLHash     whereHelperstring
+	URL         whereHelperstring
+	Title       whereHelperstring
+	Description whereHelperstring
+	ImageURL    whereHelperstring
+	Failed      whereHelperbool
+	FetchedAt   whereHelpertime_Time
+	ExpiresAt   whereHelpertime_Time
+}{
+	URLHash:     whereHelperstring{field: "`link_previews`.`url_hash`"},
+	URL:         whereHelperstring{field: "`link_previews`.`url`"},
+	Title:       whereHelperstring{field: "`link_previews`.`title`"},
+	Description: whereHelperstring{field: "`link_previews`.`description`"},
+	ImageURL:    whereHelperstring{field: "`link_previews`.`image_url`"},
+	Failed:      whereHelperbool{field: "`link_previews`.`failed`"},
+	FetchedAt:   whereHelpertime_Time{field: "`link_previews`.`fetched_at`"},
+	ExpiresAt:   whereHelpertime_Time{field: "`link_previews`.`expires_at`"},
+}
+
+// LinkPreviewRels is where relationship names are stored.
+var LinkPreviewRels = struct {
+}{}
+
+// linkPreviewR is where relationships are stored.
+type linkPreviewR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*linkPreviewR) NewStruct() *linkPreviewR {
+	return &linkPreviewR{}
+}
+
+// linkPreviewL is where Load methods for each relationship are stored.
+type linkPreviewL struct{}
+
+var (
+	linkPreviewAllColumns            = []string{"url_hash", "url", "title", "description", "image_url", "failed", "fetched_at", "expires_at"}
+	linkPreviewColumnsWithoutDefault = []string{"url_hash", "url", "title", "description", "image_url", "fetched_at", "expires_at"}
+	linkPreviewColumnsWithDefault    = []string{"failed"}
+	linkPreviewPrimaryKeyColumns     = []string{"url_hash"}
+)
+
+type (
+	// LinkPreviewSlice is an alias for a slice of pointers to LinkPreview.
+	// This should almost always be used instead of []LinkPreview.
+	LinkPreviewSlice []*LinkPreview
+	// LinkPreviewHook is the signature for custom LinkPreview hook methods
+	LinkPreviewHook func(context.Context, boil.ContextExecutor, *LinkPreview) error
+
+	linkPreviewQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	linkPreviewType                 = reflect.TypeOf(&LinkPreview{})
+	linkPreviewMapping              = queries.MakeStructMapping(linkPreviewType)
+	linkPreviewPrimaryKeyMapping, _ = queries.BindMapping(linkPreviewType, linkPreviewMapping, linkPreviewPrimaryKeyColumns)
+	linkPreviewInsertCacheMut       sync.RWMutex
+	linkPreviewInsertCache          = make(map[string]insertCache)
+	linkPreviewUpdateCacheMut       sync.RWMutex
+	linkPreviewUpdateCache          = make(map[string]updateCache)
+	linkPreviewUpsertCacheMut       sync.RWMutex
+	linkPreviewUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var linkPreviewBeforeInsertHooks []LinkPreviewHook
+var linkPreviewBeforeUpdateHooks []LinkPreviewHook
+var linkPreviewBeforeDeleteHooks []LinkPreviewHook
+var linkPreviewBeforeUpsertHooks []LinkPreviewHook
+
+var linkPreviewAfterInsertHooks []LinkPreviewHook
+var linkPreviewAfterSelectHooks []LinkPreviewHook
+var linkPreviewAfterUpdateHooks []LinkPreviewHook
+var linkPreviewAfterDeleteHooks []LinkPreviewHook
+var linkPreviewAfterUpsertHooks []LinkPreviewHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LinkPreview) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LinkPreview) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LinkPreview) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LinkPreview) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LinkPreview) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LinkPreview) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LinkPreview) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LinkPreview) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LinkPreview) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range linkPreviewAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLinkPreviewHook registers your hook function for all future operations.
+func AddLinkPreviewHook(hookPoint boil.HookPoint, linkPreviewHook LinkPreviewHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		linkPreviewBeforeInsertHooks = append(linkPreviewBeforeInsertHooks, linkPreviewHook)
+	case boil.BeforeUpdateHook:
+		linkPreviewBeforeUpdateHooks = append(linkPreviewBeforeUpdateHooks, linkPreviewHook)
+	case boil.BeforeDeleteHook:
+		linkPreviewBeforeDeleteHooks = append(linkPreviewBeforeDeleteHooks, linkPreviewHook)
+	case boil.BeforeUpsertHook:
+		linkPreviewBeforeUpsertHooks = append(linkPreviewBeforeUpsertHooks, linkPreviewHook)
+	case boil.AfterInsertHook:
+		linkPreviewAfterInsertHooks = append(linkPreviewAfterInsertHooks, linkPreviewHook)
+	case boil.AfterSelectHook:
+		linkPreviewAfterSelectHooks = append(linkPreviewAfterSelectHooks, linkPreviewHook)
+	case boil.AfterUpdateHook:
+		linkPreviewAfterUpdateHooks = append(linkPreviewAfterUpdateHooks, linkPreviewHook)
+	case boil.AfterDeleteHook:
+		linkPreviewAfterDeleteHooks = append(linkPreviewAfterDeleteHooks, linkPreviewHook)
+	case boil.AfterUpsertHook:
+		linkPreviewAfterUpsertHooks = append(linkPreviewAfterUpsertHooks, linkPreviewHook)
+	}
+}
+
+// One returns a single linkPreview record from the query.
+func (q linkPreviewQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LinkPreview, error) {
+	o := &LinkPreview{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for link_previews")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LinkPreview records from the query.
+func (q linkPreviewQuery) All(ctx context.Context, exec boil.ContextExecutor) (LinkPreviewSlice, error) {
+	var o []*LinkPreview
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to LinkPreview slice")
+	}
+
+	if len(linkPreviewAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LinkPreview records in the query.
+func (q linkPreviewQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count link_previews rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q linkPreviewQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if link_previews exists")
+	}
+
+	return count > 0, nil
+}
+
+// LinkPreviews retrieves all the records using an executor.
+func LinkPreviews(mods ...qm.QueryMod) linkPreviewQuery {
+	mods = append(mods, qm.From("`link_previews`"))
+	return linkPreviewQuery{NewQuery(mods...)}
+}
+
+// FindLinkPreview retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLinkPreview(ctx context.Context, exec boil.ContextExecutor, uRLHash string, selectCols ...string) (*LinkPreview, error) {
+	linkPreviewObj := &LinkPreview{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `link_previews` where `url_hash`=?", sel,
+	)
+
+	q := queries.Raw(query, uRLHash)
+
+	err := q.Bind(ctx, exec, linkPreviewObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from link_previews")
+	}
+
+	if err = linkPreviewObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return linkPreviewObj, err
+	}
+
+	return linkPreviewObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LinkPreview) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no link_previews provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(linkPreviewColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	linkPreviewInsertCacheMut.RLock()
+	cache, cached := linkPreviewInsertCache[key]
+	linkPreviewInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			linkPreviewAllColumns,
+			linkPreviewColumnsWithDefault,
+			linkPreviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `link_previews` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `link_previews` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `link_previews` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, linkPreviewPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into link_previews")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.URLHash,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for link_previews")
+	}
+
+CacheNoHooks:
+	if !cached {
+		linkPreviewInsertCacheMut.Lock()
+		linkPreviewInsertCache[key] = cache
+		linkPreviewInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LinkPreview.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LinkPreview) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	linkPreviewUpdateCacheMut.RLock()
+	cache, cached := linkPreviewUpdateCache[key]
+	linkPreviewUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			linkPreviewAllColumns,
+			linkPreviewPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update link_previews, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `link_previews` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, linkPreviewPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, append(wl, linkPreviewPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update link_previews row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for link_previews")
+	}
+
+	if !cached {
+		linkPreviewUpdateCacheMut.Lock()
+		linkPreviewUpdateCache[key] = cache
+		linkPreviewUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q linkPreviewQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for link_previews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for link_previews")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LinkPreviewSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), linkPreviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `link_previews` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, linkPreviewPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in linkPreview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all linkPreview")
+	}
+	return rowsAff, nil
+}
+
+var mySQLLinkPreviewUniqueColumns = []string{
+	"url_hash",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LinkPreview) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no link_previews provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(linkPreviewColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLLinkPreviewUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	linkPreviewUpsertCacheMut.RLock()
+	cache, cached := linkPreviewUpsertCache[key]
+	linkPreviewUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			linkPreviewAllColumns,
+			linkPreviewColumnsWithDefault,
+			linkPreviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			linkPreviewAllColumns,
+			linkPreviewPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert link_previews, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`link_previews`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `link_previews` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for link_previews")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(linkPreviewType, linkPreviewMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for link_previews")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for link_previews")
+	}
+
+CacheNoHooks:
+	if !cached {
+		linkPreviewUpsertCacheMut.Lock()
+		linkPreviewUpsertCache[key] = cache
+		linkPreviewUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LinkPreview record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LinkPreview) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no LinkPreview provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), linkPreviewPrimaryKeyMapping)
+	sql := "DELETE FROM `link_previews` WHERE `url_hash`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from link_previews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for link_previews")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q linkPreviewQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no linkPreviewQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from link_previews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for link_previews")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LinkPreviewSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(linkPreviewBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), linkPreviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `link_previews` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, linkPreviewPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from linkPreview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for link_previews")
+	}
+
+	if len(linkPreviewAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LinkPreview) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLinkPreview(ctx, exec, o.URLHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LinkPreviewSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LinkPreviewSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), linkPreviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `link_previews`.* FROM `link_previews` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, linkPreviewPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in LinkPreviewSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LinkPreviewExists checks if the LinkPreview row exists.
+func LinkPreviewExists(ctx context.Context, exec boil.ContextExecutor, uRLHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `link_previews` where `url_hash`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, uRLHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, uRLHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if link_previews exists")
+	}
+
+	return exists, nil
+}
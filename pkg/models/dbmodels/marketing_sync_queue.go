@@ -0,0 +1,1021 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MarketingSyncQueue is an object representing the database table.
+type MarketingSyncQueue struct {
+	SyncID         uint64      `boil:"sync_id" json:"sync_id" toml:"sync_id" yaml:"sync_id"`
+	Action         string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ImpartWealthID null.String `boil:"impart_wealth_id" json:"impart_wealth_id,omitempty" toml:"impart_wealth_id" yaml:"impart_wealth_id,omitempty"`
+	Email          null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts       uint        `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError      null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	NextAttemptAt  time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *marketingSyncQueueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L marketingSyncQueueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarketingSyncQueueColumns = struct {
+	SyncID         string
+	Action         string
+	ImpartWealthID string
+	Email          string
+	Status         string
+	Attempts       string
+	LastError      string
+	NextAttemptAt  string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	SyncID:         "sync_id",
+	Action:         "action",
+	ImpartWealthID: "impart_wealth_id",
+	Email:          "email",
+	Status:         "status",
+	Attempts:       "attempts",
+	LastError:      "last_error",
+	NextAttemptAt:  "next_attempt_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var MarketingSyncQueueTableColumns = struct {
+	SyncID         string
+	Action         string
+	ImpartWealthID string
+	Email          string
+	Status         string
+	Attempts       string
+	LastError      string
+	NextAttemptAt  string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	SyncID:         "marketing_sync_queue.sync_id",
+	Action:         "marketing_sync_queue.action",
+	ImpartWealthID: "marketing_sync_queue.impart_wealth_id",
+	Email:          "marketing_sync_queue.email",
+	Status:         "marketing_sync_queue.status",
+	Attempts:       "marketing_sync_queue.attempts",
+	LastError:      "marketing_sync_queue.last_error",
+	NextAttemptAt:  "marketing_sync_queue.next_attempt_at",
+	CreatedAt:      "marketing_sync_queue.created_at",
+	UpdatedAt:      "marketing_sync_queue.updated_at",
+}
+
+// Generated where
+
+var MarketingSyncQueueWhere = struct {
+	SyncID         whereHelperuint64
+	Action         whereHelperstring
+	ImpartWealthID whereHelpernull_String
+	Email          whereHelpernull_String
+	Status         whereHelperstring
+	Attempts       whereHelperuint
+	LastError      whereHelpernull_String
+	NextAttemptAt  whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	SyncID:         whereHelperuint64{field: "`marketing_sync_queue`.`sync_id`"},
+	Action:         whereHelperstring{field: "`marketing_sync_queue`.`action`"},
+	ImpartWealthID: whereHelpernull_String{field: "`marketing_sync_queue`.`impart_wealth_id`"},
+	Email:          whereHelpernull_String{field: "`marketing_sync_queue`.`email`"},
+	Status:         whereHelperstring{field: "`marketing_sync_queue`.`status`"},
+	Attempts:       whereHelperuint{field: "`marketing_sync_queue`.`attempts`"},
+	LastError:      whereHelpernull_String{field: "`marketing_sync_queue`.`last_error`"},
+	NextAttemptAt:  whereHelpertime_Time{field: "`marketing_sync_queue`.`next_attempt_at`"},
+	CreatedAt:      whereHelpertime_Time{field: "`marketing_sync_queue`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`marketing_sync_queue`.`updated_at`"},
+}
+
+// MarketingSyncQueueRels is where relationship names are stored.
+var MarketingSyncQueueRels = struct {
+}{}
+
+// marketingSyncQueueR is where relationships are stored.
+type marketingSyncQueueR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*marketingSyncQueueR) NewStruct() *marketingSyncQueueR {
+	return &marketingSyncQueueR{}
+}
+
+// marketingSyncQueueL is where Load methods for each relationship are stored.
+type marketingSyncQueueL struct{}
+
+var (
+	marketingSyncQueueAllColumns            = []string{"sync_id", "action", "impart_wealth_id", "email", "status", "attempts", "last_error", "next_attempt_at", "created_at", "updated_at"}
+	marketingSyncQueueColumnsWithoutDefault = []string{"action", "impart_wealth_id", "email", "last_error", "next_attempt_at", "created_at", "updated_at"}
+	marketingSyncQueueColumnsWithDefault    = []string{"sync_id", "status", "attempts"}
+	marketingSyncQueuePrimaryKeyColumns     = []string{"sync_id"}
+)
+
+type (
+	// MarketingSyncQueueSlice is an alias for a slice of pointers to MarketingSyncQueue.
+	// This should almost always be used instead of []MarketingSyncQueue.
+	MarketingSyncQueueSlice []*MarketingSyncQueue
+	// MarketingSyncQueueHook is the signature for custom MarketingSyncQueue hook methods
+	MarketingSyncQueueHook func(context.Context, boil.ContextExecutor, *MarketingSyncQueue) error
+
+	marketingSyncQueueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	marketingSyncQueueType                 = reflect.TypeOf(&MarketingSyncQueue{})
+	marketingSyncQueueMapping              = queries.MakeStructMapping(marketingSyncQueueType)
+	marketingSyncQueuePrimaryKeyMapping, _ = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, marketingSyncQueuePrimaryKeyColumns)
+	marketingSyncQueueInsertCacheMut       sync.RWMutex
+	marketingSyncQueueInsertCache          = make(map[string]insertCache)
+	marketingSyncQueueUpdateCacheMut       sync.RWMutex
+	marketingSyncQueueUpdateCache          = make(map[string]updateCache)
+	marketingSyncQueueUpsertCacheMut       sync.RWMutex
+	marketingSyncQueueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var marketingSyncQueueBeforeInsertHooks []MarketingSyncQueueHook
+var marketingSyncQueueBeforeUpdateHooks []MarketingSyncQueueHook
+var marketingSyncQueueBeforeDeleteHooks []MarketingSyncQueueHook
+var marketingSyncQueueBeforeUpsertHooks []MarketingSyncQueueHook
+
+var marketingSyncQueueAfterInsertHooks []MarketingSyncQueueHook
+var marketingSyncQueueAfterSelectHooks []MarketingSyncQueueHook
+var marketingSyncQueueAfterUpdateHooks []MarketingSyncQueueHook
+var marketingSyncQueueAfterDeleteHooks []MarketingSyncQueueHook
+var marketingSyncQueueAfterUpsertHooks []MarketingSyncQueueHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MarketingSyncQueue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MarketingSyncQueue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MarketingSyncQueue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MarketingSyncQueue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MarketingSyncQueue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MarketingSyncQueue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MarketingSyncQueue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MarketingSyncQueue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MarketingSyncQueue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range marketingSyncQueueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMarketingSyncQueueHook registers your hook function for all future operations.
+func AddMarketingSyncQueueHook(hookPoint boil.HookPoint, marketingSyncQueueHook MarketingSyncQueueHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		marketingSyncQueueBeforeInsertHooks = append(marketingSyncQueueBeforeInsertHooks, marketingSyncQueueHook)
+	case boil.BeforeUpdateHook:
+		marketingSyncQueueBeforeUpdateHooks = append(marketingSyncQueueBeforeUpdateHooks, marketingSyncQueueHook)
+	case boil.BeforeDeleteHook:
+		marketingSyncQueueBeforeDeleteHooks = append(marketingSyncQueueBeforeDeleteHooks, marketingSyncQueueHook)
+	case boil.BeforeUpsertHook:
+		marketingSyncQueueBeforeUpsertHooks = append(marketingSyncQueueBeforeUpsertHooks, marketingSyncQueueHook)
+	case boil.AfterInsertHook:
+		marketingSyncQueueAfterInsertHooks = append(marketingSyncQueueAfterInsertHooks, marketingSyncQueueHook)
+	case boil.AfterSelectHook:
+		marketingSyncQueueAfterSelectHooks = append(marketingSyncQueueAfterSelectHooks, marketingSyncQueueHook)
+	case boil.AfterUpdateHook:
+		marketingSyncQueueAfterUpdateHooks = append(marketingSyncQueueAfterUpdateHooks, marketingSyncQueueHook)
+	case boil.AfterDeleteHook:
+		marketingSyncQueueAfterDeleteHooks = append(marketingSyncQueueAfterDeleteHooks, marketingSyncQueueHook)
+	case boil.AfterUpsertHook:
+		marketingSyncQueueAfterUpsertHooks = append(marketingSyncQueueAfterUpsertHooks, marketingSyncQueueHook)
+	}
+}
+
+// One returns a single marketingSyncQueue record from the query.
+func (q marketingSyncQueueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MarketingSyncQueue, error) {
+	o := &MarketingSyncQueue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for marketing_sync_queue")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MarketingSyncQueue records from the query.
+func (q marketingSyncQueueQuery) All(ctx context.Context, exec boil.ContextExecutor) (MarketingSyncQueueSlice, error) {
+	var o []*MarketingSyncQueue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to MarketingSyncQueue slice")
+	}
+
+	if len(marketingSyncQueueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MarketingSyncQueue records in the query.
+func (q marketingSyncQueueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count marketing_sync_queue rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q marketingSyncQueueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if marketing_sync_queue exists")
+	}
+
+	return count > 0, nil
+}
+
+// MarketingSyncQueues retrieves all the records using an executor.
+func MarketingSyncQueues(mods ...qm.QueryMod) marketingSyncQueueQuery {
+	mods = append(mods, qm.From("`marketing_sync_queue`"))
+	return marketingSyncQueueQuery{NewQuery(mods...)}
+}
+
+// FindMarketingSyncQueue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMarketingSyncQueue(ctx context.Context, exec boil.ContextExecutor, syncID uint64, selectCols ...string) (*MarketingSyncQueue, error) {
+	marketingSyncQueueObj := &MarketingSyncQueue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `marketing_sync_queue` where `sync_id`=?", sel,
+	)
+
+	q := queries.Raw(query, syncID)
+
+	err := q.Bind(ctx, exec, marketingSyncQueueObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from marketing_sync_queue")
+	}
+
+	if err = marketingSyncQueueObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return marketingSyncQueueObj, err
+	}
+
+	return marketingSyncQueueObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MarketingSyncQueue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no marketing_sync_queue provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketingSyncQueueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	marketingSyncQueueInsertCacheMut.RLock()
+	cache, cached := marketingSyncQueueInsertCache[key]
+	marketingSyncQueueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			marketingSyncQueueAllColumns,
+			marketingSyncQueueColumnsWithDefault,
+			marketingSyncQueueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `marketing_sync_queue` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `marketing_sync_queue` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `marketing_sync_queue` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, marketingSyncQueuePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into marketing_sync_queue")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.SyncID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == marketingSyncQueueMapping["sync_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.SyncID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for marketing_sync_queue")
+	}
+
+CacheNoHooks:
+	if !cached {
+		marketingSyncQueueInsertCacheMut.Lock()
+		marketingSyncQueueInsertCache[key] = cache
+		marketingSyncQueueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MarketingSyncQueue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MarketingSyncQueue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	marketingSyncQueueUpdateCacheMut.RLock()
+	cache, cached := marketingSyncQueueUpdateCache[key]
+	marketingSyncQueueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			marketingSyncQueueAllColumns,
+			marketingSyncQueuePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update marketing_sync_queue, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `marketing_sync_queue` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, marketingSyncQueuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, append(wl, marketingSyncQueuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update marketing_sync_queue row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for marketing_sync_queue")
+	}
+
+	if !cached {
+		marketingSyncQueueUpdateCacheMut.Lock()
+		marketingSyncQueueUpdateCache[key] = cache
+		marketingSyncQueueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q marketingSyncQueueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for marketing_sync_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for marketing_sync_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarketingSyncQueueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketingSyncQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `marketing_sync_queue` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, marketingSyncQueuePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in marketingSyncQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all marketingSyncQueue")
+	}
+	return rowsAff, nil
+}
+
+var mySQLMarketingSyncQueueUniqueColumns = []string{
+	"sync_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MarketingSyncQueue) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no marketing_sync_queue provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketingSyncQueueColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLMarketingSyncQueueUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	marketingSyncQueueUpsertCacheMut.RLock()
+	cache, cached := marketingSyncQueueUpsertCache[key]
+	marketingSyncQueueUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			marketingSyncQueueAllColumns,
+			marketingSyncQueueColumnsWithDefault,
+			marketingSyncQueueColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			marketingSyncQueueAllColumns,
+			marketingSyncQueuePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert marketing_sync_queue, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`marketing_sync_queue`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `marketing_sync_queue` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for marketing_sync_queue")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.SyncID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == marketingSyncQueueMapping["sync_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(marketingSyncQueueType, marketingSyncQueueMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for marketing_sync_queue")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for marketing_sync_queue")
+	}
+
+CacheNoHooks:
+	if !cached {
+		marketingSyncQueueUpsertCacheMut.Lock()
+		marketingSyncQueueUpsertCache[key] = cache
+		marketingSyncQueueUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MarketingSyncQueue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MarketingSyncQueue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no MarketingSyncQueue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), marketingSyncQueuePrimaryKeyMapping)
+	sql := "DELETE FROM `marketing_sync_queue` WHERE `sync_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from marketing_sync_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for marketing_sync_queue")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q marketingSyncQueueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no marketingSyncQueueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from marketing_sync_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for marketing_sync_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarketingSyncQueueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(marketingSyncQueueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketingSyncQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `marketing_sync_queue` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, marketingSyncQueuePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from marketingSyncQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for marketing_sync_queue")
+	}
+
+	if len(marketingSyncQueueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MarketingSyncQueue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMarketingSyncQueue(ctx, exec, o.SyncID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarketingSyncQueueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarketingSyncQueueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketingSyncQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `marketing_sync_queue`.* FROM `marketing_sync_queue` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, marketingSyncQueuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in MarketingSyncQueueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarketingSyncQueueExists checks if the MarketingSyncQueue row exists.
+func MarketingSyncQueueExists(ctx context.Context, exec boil.ContextExecutor, syncID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `marketing_sync_queue` where `sync_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, syncID)
+	}
+	row := exec.QueryRowContext(ctx, sql, syncID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if marketing_sync_queue exists")
+	}
+
+	return exists, nil
+}
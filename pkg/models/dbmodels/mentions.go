@@ -0,0 +1,1531 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Mention is an object representing the database table.
+type Mention struct {
+	MentionID      uint64      `boil:"mention_id" json:"mention_id" toml:"mention_id" yaml:"mention_id"`
+	PostID         uint64      `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	CommentID      null.Uint64 `boil:"comment_id" json:"comment_id,omitempty" toml:"comment_id" yaml:"comment_id,omitempty"`
+	ImpartWealthID string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	NotifiedAt     null.Time   `boil:"notified_at" json:"notified_at,omitempty" toml:"notified_at" yaml:"notified_at,omitempty"`
+
+	R *mentionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mentionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MentionColumns = struct {
+	MentionID      string
+	PostID         string
+	CommentID      string
+	ImpartWealthID string
+	CreatedAt      string
+	NotifiedAt     string
+}{
+	MentionID:      "mention_id",
+	PostID:         "post_id",
+	CommentID:      "comment_id",
+	ImpartWealthID: "impart_wealth_id",
+	CreatedAt:      "created_at",
+	NotifiedAt:     "notified_at",
+}
+
+var MentionTableColumns = struct {
+	MentionID      string
+	PostID         string
+	CommentID      string
+	ImpartWealthID string
+	CreatedAt      string
+	NotifiedAt     string
+}{
+	MentionID:      "mentions.mention_id",
+	PostID:         "mentions.post_id",
+	CommentID:      "mentions.comment_id",
+	ImpartWealthID: "mentions.impart_wealth_id",
+	CreatedAt:      "mentions.created_at",
+	NotifiedAt:     "mentions.notified_at",
+}
+
+// Generated where
+
+var MentionWhere = struct {
+	MentionID      whereHelperuint64
+	PostID         whereHelperuint64
+	CommentID      whereHelpernull_Uint64
+	ImpartWealthID whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	NotifiedAt     whereHelpernull_Time
+}{
+	MentionID:      whereHelperuint64{field: "`mentions`.`mention_id`"},
+	PostID:         whereHelperuint64{field: "`mentions`.`post_id`"},
+	CommentID:      whereHelpernull_Uint64{field: "`mentions`.`comment_id`"},
+	ImpartWealthID: whereHelperstring{field: "`mentions`.`impart_wealth_id`"},
+	CreatedAt:      whereHelpertime_Time{field: "`mentions`.`created_at`"},
+	NotifiedAt:     whereHelpernull_Time{field: "`mentions`.`notified_at`"},
+}
+
+// MentionRels is where relationship names are stored.
+var MentionRels = struct {
+	Post         string
+	Comment      string
+	ImpartWealth string
+}{
+	Post:         "Post",
+	Comment:      "Comment",
+	ImpartWealth: "ImpartWealth",
+}
+
+// mentionR is where relationships are stored.
+type mentionR struct {
+	Post         *Post    `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Comment      *Comment `boil:"Comment" json:"Comment" toml:"Comment" yaml:"Comment"`
+	ImpartWealth *User    `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+}
+
+// NewStruct creates a new relationship struct
+func (*mentionR) NewStruct() *mentionR {
+	return &mentionR{}
+}
+
+// mentionL is where Load methods for each relationship are stored.
+type mentionL struct{}
+
+var (
+	mentionAllColumns            = []string{"mention_id", "post_id", "comment_id", "impart_wealth_id", "created_at", "notified_at"}
+	mentionColumnsWithoutDefault = []string{"post_id", "comment_id", "impart_wealth_id", "created_at", "notified_at"}
+	mentionColumnsWithDefault    = []string{"mention_id"}
+	mentionPrimaryKeyColumns     = []string{"mention_id"}
+)
+
+type (
+	// MentionSlice is an alias for a slice of pointers to Mention.
+	// This should almost always be used instead of []Mention.
+	MentionSlice []*Mention
+	// MentionHook is the signature for custom Mention hook methods
+	MentionHook func(context.Context, boil.ContextExecutor, *Mention) error
+
+	mentionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mentionType                 = reflect.TypeOf(&Mention{})
+	mentionMapping              = queries.MakeStructMapping(mentionType)
+	mentionPrimaryKeyMapping, _ = queries.BindMapping(mentionType, mentionMapping, mentionPrimaryKeyColumns)
+	mentionInsertCacheMut       sync.RWMutex
+	mentionInsertCache          = make(map[string]insertCache)
+	mentionUpdateCacheMut       sync.RWMutex
+	mentionUpdateCache          = make(map[string]updateCache)
+	mentionUpsertCacheMut       sync.RWMutex
+	mentionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mentionBeforeInsertHooks []MentionHook
+var mentionBeforeUpdateHooks []MentionHook
+var mentionBeforeDeleteHooks []MentionHook
+var mentionBeforeUpsertHooks []MentionHook
+
+var mentionAfterInsertHooks []MentionHook
+var mentionAfterSelectHooks []MentionHook
+var mentionAfterUpdateHooks []MentionHook
+var mentionAfterDeleteHooks []MentionHook
+var mentionAfterUpsertHooks []MentionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Mention) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Mention) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Mention) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Mention) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Mention) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Mention) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Mention) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Mention) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Mention) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mentionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMentionHook registers your hook function for all future operations.
+func AddMentionHook(hookPoint boil.HookPoint, mentionHook MentionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		mentionBeforeInsertHooks = append(mentionBeforeInsertHooks, mentionHook)
+	case boil.BeforeUpdateHook:
+		mentionBeforeUpdateHooks = append(mentionBeforeUpdateHooks, mentionHook)
+	case boil.BeforeDeleteHook:
+		mentionBeforeDeleteHooks = append(mentionBeforeDeleteHooks, mentionHook)
+	case boil.BeforeUpsertHook:
+		mentionBeforeUpsertHooks = append(mentionBeforeUpsertHooks, mentionHook)
+	case boil.AfterInsertHook:
+		mentionAfterInsertHooks = append(mentionAfterInsertHooks, mentionHook)
+	case boil.AfterSelectHook:
+		mentionAfterSelectHooks = append(mentionAfterSelectHooks, mentionHook)
+	case boil.AfterUpdateHook:
+		mentionAfterUpdateHooks = append(mentionAfterUpdateHooks, mentionHook)
+	case boil.AfterDeleteHook:
+		mentionAfterDeleteHooks = append(mentionAfterDeleteHooks, mentionHook)
+	case boil.AfterUpsertHook:
+		mentionAfterUpsertHooks = append(mentionAfterUpsertHooks, mentionHook)
+	}
+}
+
+// One returns a single mention record from the query.
+func (q mentionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Mention, error) {
+	o := &Mention{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for mentions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Mention records from the query.
+func (q mentionQuery) All(ctx context.Context, exec boil.ContextExecutor) (MentionSlice, error) {
+	var o []*Mention
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to Mention slice")
+	}
+
+	if len(mentionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Mention records in the query.
+func (q mentionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count mentions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mentionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if mentions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Post pointed to by the foreign key.
+func (o *Mention) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`post_id` = ?", o.PostID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Posts(queryMods...)
+	queries.SetFrom(query.Query, "`post`")
+
+	return query
+}
+
+// Comment pointed to by the foreign key.
+func (o *Mention) Comment(mods ...qm.QueryMod) commentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`comment_id` = ?", o.CommentID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Comments(queryMods...)
+	queries.SetFrom(query.Query, "`comment`")
+
+	return query
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *Mention) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mentionL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMention interface{}, mods queries.Applicator) error {
+	var slice []*Mention
+	var object *Mention
+
+	if singular {
+		object = maybeMention.(*Mention)
+	} else {
+		slice = *maybeMention.(*[]*Mention)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mentionR{}
+		}
+		args = append(args, object.PostID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mentionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post`),
+		qm.WhereIn(`post.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for post")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+	}
+
+	if len(mentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.Mentions = append(foreign.R.Mentions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.PostID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Mentions = append(foreign.R.Mentions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mentionL) LoadComment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMention interface{}, mods queries.Applicator) error {
+	var slice []*Mention
+	var object *Mention
+
+	if singular {
+		object = maybeMention.(*Mention)
+	} else {
+		slice = *maybeMention.(*[]*Mention)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mentionR{}
+		}
+		if !queries.IsNil(object.CommentID) {
+			args = append(args, object.CommentID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mentionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CommentID) {
+				args = append(args, obj.CommentID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`comment`),
+		qm.WhereIn(`comment.comment_id in ?`, args...),
+		qmhelper.WhereIsNull(`comment.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Comment")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Comment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for comment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment")
+	}
+
+	if len(mentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Comment = foreign
+		if foreign.R == nil {
+			foreign.R = &commentR{}
+		}
+		foreign.R.Mentions = append(foreign.R.Mentions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.Comment = foreign
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.Mentions = append(foreign.R.Mentions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mentionL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMention interface{}, mods queries.Applicator) error {
+	var slice []*Mention
+	var object *Mention
+
+	if singular {
+		object = maybeMention.(*Mention)
+	} else {
+		slice = *maybeMention.(*[]*Mention)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mentionR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mentionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(mentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthMentions = append(foreign.R.ImpartWealthMentions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthMentions = append(foreign.R.ImpartWealthMentions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPost of the mention to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.Mentions.
+func (o *Mention) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+		strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PostID, o.MentionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.PostID
+	if o.R == nil {
+		o.R = &mentionR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			Mentions: MentionSlice{o},
+		}
+	} else {
+		related.R.Mentions = append(related.R.Mentions, o)
+	}
+
+	return nil
+}
+
+// SetComment of the mention to the related item.
+// Sets o.R.Comment to related.
+// Adds o to related.R.Mentions.
+func (o *Mention) SetComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+		strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.CommentID, o.MentionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CommentID, related.CommentID)
+	if o.R == nil {
+		o.R = &mentionR{
+			Comment: related,
+		}
+	} else {
+		o.R.Comment = related
+	}
+
+	if related.R == nil {
+		related.R = &commentR{
+			Mentions: MentionSlice{o},
+		}
+	} else {
+		related.R.Mentions = append(related.R.Mentions, o)
+	}
+
+	return nil
+}
+
+// RemoveComment relationship.
+// Sets o.R.Comment to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Mention) RemoveComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
+	var err error
+
+	queries.SetScanner(&o.CommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Comment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Mentions {
+		if queries.Equal(o.CommentID, ri.CommentID) {
+			continue
+		}
+
+		ln := len(related.R.Mentions)
+		if ln > 1 && i < ln-1 {
+			related.R.Mentions[i] = related.R.Mentions[ln-1]
+		}
+		related.R.Mentions = related.R.Mentions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetImpartWealth of the mention to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthMentions.
+func (o *Mention) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.MentionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &mentionR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthMentions: MentionSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthMentions = append(related.R.ImpartWealthMentions, o)
+	}
+
+	return nil
+}
+
+// Mentions retrieves all the records using an executor.
+func Mentions(mods ...qm.QueryMod) mentionQuery {
+	mods = append(mods, qm.From("`mentions`"))
+	return mentionQuery{NewQuery(mods...)}
+}
+
+// FindMention retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMention(ctx context.Context, exec boil.ContextExecutor, mentionID uint64, selectCols ...string) (*Mention, error) {
+	mentionObj := &Mention{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `mentions` where `mention_id`=?", sel,
+	)
+
+	q := queries.Raw(query, mentionID)
+
+	err := q.Bind(ctx, exec, mentionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from mentions")
+	}
+
+	if err = mentionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return mentionObj, err
+	}
+
+	return mentionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Mention) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no mentions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mentionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mentionInsertCacheMut.RLock()
+	cache, cached := mentionInsertCache[key]
+	mentionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mentionAllColumns,
+			mentionColumnsWithDefault,
+			mentionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mentionType, mentionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mentionType, mentionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `mentions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `mentions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `mentions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into mentions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.MentionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mentionMapping["mention_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.MentionID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for mentions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mentionInsertCacheMut.Lock()
+		mentionInsertCache[key] = cache
+		mentionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Mention.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Mention) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mentionUpdateCacheMut.RLock()
+	cache, cached := mentionUpdateCache[key]
+	mentionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mentionAllColumns,
+			mentionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update mentions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `mentions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mentionType, mentionMapping, append(wl, mentionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update mentions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for mentions")
+	}
+
+	if !cached {
+		mentionUpdateCacheMut.Lock()
+		mentionUpdateCache[key] = cache
+		mentionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mentionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for mentions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MentionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `mentions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mentionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in mention slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all mention")
+	}
+	return rowsAff, nil
+}
+
+var mySQLMentionUniqueColumns = []string{
+	"mention_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Mention) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no mentions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mentionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLMentionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mentionUpsertCacheMut.RLock()
+	cache, cached := mentionUpsertCache[key]
+	mentionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mentionAllColumns,
+			mentionColumnsWithDefault,
+			mentionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			mentionAllColumns,
+			mentionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert mentions, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`mentions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `mentions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mentionType, mentionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mentionType, mentionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for mentions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.MentionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mentionMapping["mention_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(mentionType, mentionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for mentions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for mentions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mentionUpsertCacheMut.Lock()
+		mentionUpsertCache[key] = cache
+		mentionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Mention record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Mention) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no Mention provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mentionPrimaryKeyMapping)
+	sql := "DELETE FROM `mentions` WHERE `mention_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for mentions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mentionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no mentionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from mentions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for mentions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MentionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mentionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `mentions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mentionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from mention slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for mentions")
+	}
+
+	if len(mentionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Mention) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMention(ctx, exec, o.MentionID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MentionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MentionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mentionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `mentions`.* FROM `mentions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mentionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in MentionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MentionExists checks if the Mention row exists.
+func MentionExists(ctx context.Context, exec boil.ContextExecutor, mentionID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `mentions` where `mention_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, mentionID)
+	}
+	row := exec.QueryRowContext(ctx, sql, mentionID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if mentions exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1744 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ModerationDecision is an object representing the database table.
+type ModerationDecision struct {
+	DecisionID    uint64      `boil:"decision_id" json:"decision_id" toml:"decision_id" yaml:"decision_id"`
+	PostID        uint64      `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	CommentID     null.Uint64 `boil:"comment_id" json:"comment_id,omitempty" toml:"comment_id" yaml:"comment_id,omitempty"`
+	Action        string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ReviewComment string      `boil:"review_comment" json:"review_comment" toml:"review_comment" yaml:"review_comment"`
+	ModeratorID   null.String `boil:"moderator_id" json:"moderator_id,omitempty" toml:"moderator_id" yaml:"moderator_id,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *moderationDecisionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L moderationDecisionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ModerationDecisionColumns = struct {
+	DecisionID    string
+	PostID        string
+	CommentID     string
+	Action        string
+	ReviewComment string
+	ModeratorID   string
+	CreatedAt     string
+}{
+	DecisionID:    "decision_id",
+	PostID:        "post_id",
+	CommentID:     "comment_id",
+	Action:        "action",
+	ReviewComment: "review_comment",
+	ModeratorID:   "moderator_id",
+	CreatedAt:     "created_at",
+}
+
+var ModerationDecisionTableColumns = struct {
+	DecisionID    string
+	PostID        string
+	CommentID     string
+	Action        string
+	ReviewComment string
+	ModeratorID   string
+	CreatedAt     string
+}{
+	DecisionID:    "moderation_decisions.decision_id",
+	PostID:        "moderation_decisions.post_id",
+	CommentID:     "moderation_decisions.comment_id",
+	Action:        "moderation_decisions.action",
+	ReviewComment: "moderation_decisions.review_comment",
+	ModeratorID:   "moderation_decisions.moderator_id",
+	CreatedAt:     "moderation_decisions.created_at",
+}
+
+// Generated where
+
+var ModerationDecisionWhere = struct {
+	DecisionID    whereHelperuint64
+	PostID        whereHelperuint64
+	CommentID     whereHelpernull_Uint64
+	Action        whereHelperstring
+	ReviewComment whereHelperstring
+	ModeratorID   whereHelpernull_String
+	CreatedAt     whereHelpertime_Time
+}{
+	DecisionID:    whereHelperuint64{field: "`moderation_decisions`.`decision_id`"},
+	PostID:        whereHelperuint64{field: "`moderation_decisions`.`post_id`"},
+	CommentID:     whereHelpernull_Uint64{field: "`moderation_decisions`.`comment_id`"},
+	Action:        whereHelperstring{field: "`moderation_decisions`.`action`"},
+	ReviewComment: whereHelperstring{field: "`moderation_decisions`.`review_comment`"},
+	ModeratorID:   whereHelpernull_String{field: "`moderation_decisions`.`moderator_id`"},
+	CreatedAt:     whereHelpertime_Time{field: "`moderation_decisions`.`created_at`"},
+}
+
+// ModerationDecisionRels is where relationship names are stored.
+var ModerationDecisionRels = struct {
+	Post                  string
+	Comment               string
+	Moderator             string
+	DecisionContentAppeal string
+}{
+	Post:                  "Post",
+	Comment:               "Comment",
+	Moderator:             "Moderator",
+	DecisionContentAppeal: "DecisionContentAppeal",
+}
+
+// moderationDecisionR is where relationships are stored.
+type moderationDecisionR struct {
+	Post                  *Post          `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	Comment               *Comment       `boil:"Comment" json:"Comment" toml:"Comment" yaml:"Comment"`
+	Moderator             *User          `boil:"Moderator" json:"Moderator" toml:"Moderator" yaml:"Moderator"`
+	DecisionContentAppeal *ContentAppeal `boil:"DecisionContentAppeal" json:"DecisionContentAppeal" toml:"DecisionContentAppeal" yaml:"DecisionContentAppeal"`
+}
+
+// NewStruct creates a new relationship struct
+func (*moderationDecisionR) NewStruct() *moderationDecisionR {
+	return &moderationDecisionR{}
+}
+
+// moderationDecisionL is where Load methods for each relationship are stored.
+type moderationDecisionL struct{}
+
+var (
+	moderationDecisionAllColumns            = []string{"decision_id", "post_id", "comment_id", "action", "review_comment", "moderator_id", "created_at"}
+	moderationDecisionColumnsWithoutDefault = []string{"post_id", "comment_id", "action", "review_comment", "moderator_id", "created_at"}
+	moderationDecisionColumnsWithDefault    = []string{"decision_id"}
+	moderationDecisionPrimaryKeyColumns     = []string{"decision_id"}
+)
+
+type (
+	// ModerationDecisionSlice is an alias for a slice of pointers to ModerationDecision.
+	// This should almost always be used instead of []ModerationDecision.
+	ModerationDecisionSlice []*ModerationDecision
+	// ModerationDecisionHook is the signature for custom ModerationDecision hook methods
+	ModerationDecisionHook func(context.Context, boil.ContextExecutor, *ModerationDecision) error
+
+	moderationDecisionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	moderationDecisionType                 = reflect.TypeOf(&ModerationDecision{})
+	moderationDecisionMapping              = queries.MakeStructMapping(moderationDecisionType)
+	moderationDecisionPrimaryKeyMapping, _ = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, moderationDecisionPrimaryKeyColumns)
+	moderationDecisionInsertCacheMut       sync.RWMutex
+	moderationDecisionInsertCache          = make(map[string]insertCache)
+	moderationDecisionUpdateCacheMut       sync.RWMutex
+	moderationDecisionUpdateCache          = make(map[string]updateCache)
+	moderationDecisionUpsertCacheMut       sync.RWMutex
+	moderationDecisionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var moderationDecisionBeforeInsertHooks []ModerationDecisionHook
+var moderationDecisionBeforeUpdateHooks []ModerationDecisionHook
+var moderationDecisionBeforeDeleteHooks []ModerationDecisionHook
+var moderationDecisionBeforeUpsertHooks []ModerationDecisionHook
+
+var moderationDecisionAfterInsertHooks []ModerationDecisionHook
+var moderationDecisionAfterSelectHooks []ModerationDecisionHook
+var moderationDecisionAfterUpdateHooks []ModerationDecisionHook
+var moderationDecisionAfterDeleteHooks []ModerationDecisionHook
+var moderationDecisionAfterUpsertHooks []ModerationDecisionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ModerationDecision) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ModerationDecision) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ModerationDecision) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ModerationDecision) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ModerationDecision) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ModerationDecision) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ModerationDecision) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ModerationDecision) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ModerationDecision) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range moderationDecisionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddModerationDecisionHook registers your hook function for all future operations.
+func AddModerationDecisionHook(hookPoint boil.HookPoint, moderationDecisionHook ModerationDecisionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		moderationDecisionBeforeInsertHooks = append(moderationDecisionBeforeInsertHooks, moderationDecisionHook)
+	case boil.BeforeUpdateHook:
+		moderationDecisionBeforeUpdateHooks = append(moderationDecisionBeforeUpdateHooks, moderationDecisionHook)
+	case boil.BeforeDeleteHook:
+		moderationDecisionBeforeDeleteHooks = append(moderationDecisionBeforeDeleteHooks, moderationDecisionHook)
+	case boil.BeforeUpsertHook:
+		moderationDecisionBeforeUpsertHooks = append(moderationDecisionBeforeUpsertHooks, moderationDecisionHook)
+	case boil.AfterInsertHook:
+		moderationDecisionAfterInsertHooks = append(moderationDecisionAfterInsertHooks, moderationDecisionHook)
+	case boil.AfterSelectHook:
+		moderationDecisionAfterSelectHooks = append(moderationDecisionAfterSelectHooks, moderationDecisionHook)
+	case boil.AfterUpdateHook:
+		moderationDecisionAfterUpdateHooks = append(moderationDecisionAfterUpdateHooks, moderationDecisionHook)
+	case boil.AfterDeleteHook:
+		moderationDecisionAfterDeleteHooks = append(moderationDecisionAfterDeleteHooks, moderationDecisionHook)
+	case boil.AfterUpsertHook:
+		moderationDecisionAfterUpsertHooks = append(moderationDecisionAfterUpsertHooks, moderationDecisionHook)
+	}
+}
+
+// One returns a single moderationDecision record from the query.
+func (q moderationDecisionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ModerationDecision, error) {
+	o := &ModerationDecision{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for moderation_decisions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ModerationDecision records from the query.
+func (q moderationDecisionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ModerationDecisionSlice, error) {
+	var o []*ModerationDecision
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ModerationDecision slice")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ModerationDecision records in the query.
+func (q moderationDecisionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count moderation_decisions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q moderationDecisionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if moderation_decisions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Post pointed to by the foreign key.
+func (o *ModerationDecision) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`post_id` = ?", o.PostID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Posts(queryMods...)
+	queries.SetFrom(query.Query, "`post`")
+
+	return query
+}
+
+// Comment pointed to by the foreign key.
+func (o *ModerationDecision) Comment(mods ...qm.QueryMod) commentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`comment_id` = ?", o.CommentID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Comments(queryMods...)
+	queries.SetFrom(query.Query, "`comment`")
+
+	return query
+}
+
+// Moderator pointed to by the foreign key.
+func (o *ModerationDecision) Moderator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ModeratorID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// DecisionContentAppeal pointed to by the foreign key.
+func (o *ModerationDecision) DecisionContentAppeal(mods ...qm.QueryMod) contentAppealQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`decision_id` = ?", o.DecisionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	return query
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (moderationDecisionL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeModerationDecision interface{}, mods queries.Applicator) error {
+	var slice []*ModerationDecision
+	var object *ModerationDecision
+
+	if singular {
+		object = maybeModerationDecision.(*ModerationDecision)
+	} else {
+		slice = *maybeModerationDecision.(*[]*ModerationDecision)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &moderationDecisionR{}
+		}
+		args = append(args, object.PostID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &moderationDecisionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post`),
+		qm.WhereIn(`post.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for post")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.ModerationDecisions = append(foreign.R.ModerationDecisions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.PostID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.ModerationDecisions = append(foreign.R.ModerationDecisions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (moderationDecisionL) LoadComment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeModerationDecision interface{}, mods queries.Applicator) error {
+	var slice []*ModerationDecision
+	var object *ModerationDecision
+
+	if singular {
+		object = maybeModerationDecision.(*ModerationDecision)
+	} else {
+		slice = *maybeModerationDecision.(*[]*ModerationDecision)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &moderationDecisionR{}
+		}
+		if !queries.IsNil(object.CommentID) {
+			args = append(args, object.CommentID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &moderationDecisionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CommentID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CommentID) {
+				args = append(args, obj.CommentID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`comment`),
+		qm.WhereIn(`comment.comment_id in ?`, args...),
+		qmhelper.WhereIsNull(`comment.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Comment")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Comment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for comment")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Comment = foreign
+		if foreign.R == nil {
+			foreign.R = &commentR{}
+		}
+		foreign.R.ModerationDecisions = append(foreign.R.ModerationDecisions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CommentID, foreign.CommentID) {
+				local.R.Comment = foreign
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.ModerationDecisions = append(foreign.R.ModerationDecisions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadModerator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (moderationDecisionL) LoadModerator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeModerationDecision interface{}, mods queries.Applicator) error {
+	var slice []*ModerationDecision
+	var object *ModerationDecision
+
+	if singular {
+		object = maybeModerationDecision.(*ModerationDecision)
+	} else {
+		slice = *maybeModerationDecision.(*[]*ModerationDecision)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &moderationDecisionR{}
+		}
+		if !queries.IsNil(object.ModeratorID) {
+			args = append(args, object.ModeratorID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &moderationDecisionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ModeratorID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ModeratorID) {
+				args = append(args, obj.ModeratorID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Moderator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ModeratorModerationDecisions = append(foreign.R.ModeratorModerationDecisions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ModeratorID, foreign.ImpartWealthID) {
+				local.R.Moderator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ModeratorModerationDecisions = append(foreign.R.ModeratorModerationDecisions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDecisionContentAppeal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (moderationDecisionL) LoadDecisionContentAppeal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeModerationDecision interface{}, mods queries.Applicator) error {
+	var slice []*ModerationDecision
+	var object *ModerationDecision
+
+	if singular {
+		object = maybeModerationDecision.(*ModerationDecision)
+	} else {
+		slice = *maybeModerationDecision.(*[]*ModerationDecision)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &moderationDecisionR{}
+		}
+		args = append(args, object.DecisionID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &moderationDecisionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DecisionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DecisionID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.decision_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ContentAppeal")
+	}
+
+	var resultSlice []*ContentAppeal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ContentAppeal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for content_appeals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
+	}
+
+	if len(moderationDecisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DecisionContentAppeal = foreign
+		if foreign.R == nil {
+			foreign.R = &contentAppealR{}
+		}
+		foreign.R.Decision = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DecisionID == foreign.DecisionID {
+				local.R.DecisionContentAppeal = foreign
+				if foreign.R == nil {
+					foreign.R = &contentAppealR{}
+				}
+				foreign.R.Decision = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPost of the moderationDecision to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.ModerationDecisions.
+func (o *ModerationDecision) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `moderation_decisions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+		strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PostID, o.DecisionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.PostID
+	if o.R == nil {
+		o.R = &moderationDecisionR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			ModerationDecisions: ModerationDecisionSlice{o},
+		}
+	} else {
+		related.R.ModerationDecisions = append(related.R.ModerationDecisions, o)
+	}
+
+	return nil
+}
+
+// SetComment of the moderationDecision to the related item.
+// Sets o.R.Comment to related.
+// Adds o to related.R.ModerationDecisions.
+func (o *ModerationDecision) SetComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Comment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `moderation_decisions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"comment_id"}),
+		strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.CommentID, o.DecisionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CommentID, related.CommentID)
+	if o.R == nil {
+		o.R = &moderationDecisionR{
+			Comment: related,
+		}
+	} else {
+		o.R.Comment = related
+	}
+
+	if related.R == nil {
+		related.R = &commentR{
+			ModerationDecisions: ModerationDecisionSlice{o},
+		}
+	} else {
+		related.R.ModerationDecisions = append(related.R.ModerationDecisions, o)
+	}
+
+	return nil
+}
+
+// RemoveComment relationship.
+// Sets o.R.Comment to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ModerationDecision) RemoveComment(ctx context.Context, exec boil.ContextExecutor, related *Comment) error {
+	var err error
+
+	queries.SetScanner(&o.CommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Comment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ModerationDecisions {
+		if queries.Equal(o.CommentID, ri.CommentID) {
+			continue
+		}
+
+		ln := len(related.R.ModerationDecisions)
+		if ln > 1 && i < ln-1 {
+			related.R.ModerationDecisions[i] = related.R.ModerationDecisions[ln-1]
+		}
+		related.R.ModerationDecisions = related.R.ModerationDecisions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetModerator of the moderationDecision to the related item.
+// Sets o.R.Moderator to related.
+// Adds o to related.R.ModeratorModerationDecisions.
+func (o *ModerationDecision) SetModerator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `moderation_decisions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"moderator_id"}),
+		strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.DecisionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ModeratorID, related.ImpartWealthID)
+	if o.R == nil {
+		o.R = &moderationDecisionR{
+			Moderator: related,
+		}
+	} else {
+		o.R.Moderator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ModeratorModerationDecisions: ModerationDecisionSlice{o},
+		}
+	} else {
+		related.R.ModeratorModerationDecisions = append(related.R.ModeratorModerationDecisions, o)
+	}
+
+	return nil
+}
+
+// RemoveModerator relationship.
+// Sets o.R.Moderator to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ModerationDecision) RemoveModerator(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ModeratorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("moderator_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Moderator = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ModeratorModerationDecisions {
+		if queries.Equal(o.ModeratorID, ri.ModeratorID) {
+			continue
+		}
+
+		ln := len(related.R.ModeratorModerationDecisions)
+		if ln > 1 && i < ln-1 {
+			related.R.ModeratorModerationDecisions[i] = related.R.ModeratorModerationDecisions[ln-1]
+		}
+		related.R.ModeratorModerationDecisions = related.R.ModeratorModerationDecisions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetDecisionContentAppeal of the moderationDecision to the related item.
+// Sets o.R.DecisionContentAppeal to related.
+// Adds o to related.R.Decision.
+func (o *ModerationDecision) SetDecisionContentAppeal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ContentAppeal) error {
+	var err error
+
+	if insert {
+		related.DecisionID = o.DecisionID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `content_appeals` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"decision_id"}),
+			strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+		)
+		values := []interface{}{o.DecisionID, related.AppealID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.DecisionID = o.DecisionID
+
+	}
+
+	if o.R == nil {
+		o.R = &moderationDecisionR{
+			DecisionContentAppeal: related,
+		}
+	} else {
+		o.R.DecisionContentAppeal = related
+	}
+
+	if related.R == nil {
+		related.R = &contentAppealR{
+			Decision: o,
+		}
+	} else {
+		related.R.Decision = o
+	}
+	return nil
+}
+
+// ModerationDecisions retrieves all the records using an executor.
+func ModerationDecisions(mods ...qm.QueryMod) moderationDecisionQuery {
+	mods = append(mods, qm.From("`moderation_decisions`"))
+	return moderationDecisionQuery{NewQuery(mods...)}
+}
+
+// FindModerationDecision retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindModerationDecision(ctx context.Context, exec boil.ContextExecutor, decisionID uint64, selectCols ...string) (*ModerationDecision, error) {
+	moderationDecisionObj := &ModerationDecision{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `moderation_decisions` where `decision_id`=?", sel,
+	)
+
+	q := queries.Raw(query, decisionID)
+
+	err := q.Bind(ctx, exec, moderationDecisionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from moderation_decisions")
+	}
+
+	if err = moderationDecisionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return moderationDecisionObj, err
+	}
+
+	return moderationDecisionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ModerationDecision) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no moderation_decisions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(moderationDecisionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	moderationDecisionInsertCacheMut.RLock()
+	cache, cached := moderationDecisionInsertCache[key]
+	moderationDecisionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			moderationDecisionAllColumns,
+			moderationDecisionColumnsWithDefault,
+			moderationDecisionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `moderation_decisions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `moderation_decisions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `moderation_decisions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into moderation_decisions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DecisionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == moderationDecisionMapping["decision_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.DecisionID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for moderation_decisions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		moderationDecisionInsertCacheMut.Lock()
+		moderationDecisionInsertCache[key] = cache
+		moderationDecisionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ModerationDecision.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ModerationDecision) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	moderationDecisionUpdateCacheMut.RLock()
+	cache, cached := moderationDecisionUpdateCache[key]
+	moderationDecisionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			moderationDecisionAllColumns,
+			moderationDecisionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update moderation_decisions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `moderation_decisions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, append(wl, moderationDecisionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update moderation_decisions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for moderation_decisions")
+	}
+
+	if !cached {
+		moderationDecisionUpdateCacheMut.Lock()
+		moderationDecisionUpdateCache[key] = cache
+		moderationDecisionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q moderationDecisionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for moderation_decisions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for moderation_decisions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ModerationDecisionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), moderationDecisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `moderation_decisions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, moderationDecisionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in moderationDecision slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all moderationDecision")
+	}
+	return rowsAff, nil
+}
+
+var mySQLModerationDecisionUniqueColumns = []string{
+	"decision_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ModerationDecision) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no moderation_decisions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(moderationDecisionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLModerationDecisionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	moderationDecisionUpsertCacheMut.RLock()
+	cache, cached := moderationDecisionUpsertCache[key]
+	moderationDecisionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			moderationDecisionAllColumns,
+			moderationDecisionColumnsWithDefault,
+			moderationDecisionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			moderationDecisionAllColumns,
+			moderationDecisionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert moderation_decisions, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`moderation_decisions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `moderation_decisions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for moderation_decisions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.DecisionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == moderationDecisionMapping["decision_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(moderationDecisionType, moderationDecisionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for moderation_decisions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for moderation_decisions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		moderationDecisionUpsertCacheMut.Lock()
+		moderationDecisionUpsertCache[key] = cache
+		moderationDecisionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ModerationDecision record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ModerationDecision) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ModerationDecision provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), moderationDecisionPrimaryKeyMapping)
+	sql := "DELETE FROM `moderation_decisions` WHERE `decision_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from moderation_decisions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for moderation_decisions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q moderationDecisionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no moderationDecisionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from moderation_decisions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for moderation_decisions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ModerationDecisionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(moderationDecisionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), moderationDecisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `moderation_decisions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, moderationDecisionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from moderationDecision slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for moderation_decisions")
+	}
+
+	if len(moderationDecisionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ModerationDecision) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindModerationDecision(ctx, exec, o.DecisionID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ModerationDecisionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ModerationDecisionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), moderationDecisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `moderation_decisions`.* FROM `moderation_decisions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, moderationDecisionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ModerationDecisionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ModerationDecisionExists checks if the ModerationDecision row exists.
+func ModerationDecisionExists(ctx context.Context, exec boil.ContextExecutor, decisionID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `moderation_decisions` where `decision_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, decisionID)
+	}
+	row := exec.QueryRowContext(ctx, sql, decisionID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if moderation_decisions exists")
+	}
+
+	return exists, nil
+}
@@ -42,6 +42,9 @@ type Post struct {
 	ReviewedAt     null.Time   `boil:"reviewed_at" json:"reviewed_at,omitempty" toml:"reviewed_at" yaml:"reviewed_at,omitempty"`
 	Reviewed       bool        `boil:"reviewed" json:"reviewed" toml:"reviewed" yaml:"reviewed"`
 	ReviewComment  null.String `boil:"review_comment" json:"review_comment,omitempty" toml:"review_comment" yaml:"review_comment,omitempty"`
+	Held           bool        `boil:"held" json:"held" toml:"held" yaml:"held"`
+	Popularity     int         `boil:"popularity" json:"popularity" toml:"popularity" yaml:"popularity"`
+	HotScore       float64     `boil:"hot_score" json:"hot_score" toml:"hot_score" yaml:"hot_score"`
 
 	R *postR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -66,6 +69,9 @@ var PostColumns = struct {
 	ReviewedAt     string
 	Reviewed       string
 	ReviewComment  string
+	Held           string
+	Popularity     string
+	HotScore       string
 }{
 	PostID:         "post_id",
 	HiveID:         "hive_id",
@@ -85,6 +91,9 @@ var PostColumns = struct {
 	ReviewedAt:     "reviewed_at",
 	Reviewed:       "reviewed",
 	ReviewComment:  "review_comment",
+	Held:           "held",
+	Popularity:     "popularity",
+	HotScore:       "hot_score",
 }
 
 var PostTableColumns = struct {
@@ -106,6 +115,9 @@ var PostTableColumns = struct {
 	ReviewedAt     string
 	Reviewed       string
 	ReviewComment  string
+	Held           string
+	Popularity     string
+	HotScore       string
 }{
 	PostID:         "post.post_id",
 	HiveID:         "post.hive_id",
@@ -125,10 +137,42 @@ var PostTableColumns = struct {
 	ReviewedAt:     "post.reviewed_at",
 	Reviewed:       "post.reviewed",
 	ReviewComment:  "post.review_comment",
+	Held:           "post.held",
+	Popularity:     "post.popularity",
+	HotScore:       "post.hot_score",
 }
 
 // Generated where
 
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var PostWhere = struct {
 	PostID         whereHelperuint64
 	HiveID         whereHelperuint64
@@ -148,6 +192,9 @@ var PostWhere = struct {
 	ReviewedAt     whereHelpernull_Time
 	Reviewed       whereHelperbool
 	ReviewComment  whereHelpernull_String
+	Held           whereHelperbool
+	Popularity     whereHelperint
+	HotScore       whereHelperfloat64
 }{
 	PostID:         whereHelperuint64{field: "`post`.`post_id`"},
 	HiveID:         whereHelperuint64{field: "`post`.`hive_id`"},
@@ -167,42 +214,60 @@ var PostWhere = struct {
 	ReviewedAt:     whereHelpernull_Time{field: "`post`.`reviewed_at`"},
 	Reviewed:       whereHelperbool{field: "`post`.`reviewed`"},
 	ReviewComment:  whereHelpernull_String{field: "`post`.`review_comment`"},
+	Held:           whereHelperbool{field: "`post`.`held`"},
+	Popularity:     whereHelperint{field: "`post`.`popularity`"},
+	HotScore:       whereHelperfloat64{field: "`post`.`hot_score`"},
 }
 
 // PostRels is where relationship names are stored.
 var PostRels = struct {
-	ImpartWealth  string
-	Hive          string
-	Comments      string
-	PostEdits     string
-	PostFiles     string
-	PostReactions string
-	Tags          string
-	PostUrls      string
-	PostVideos    string
+	ImpartWealth        string
+	Hive                string
+	Comments            string
+	ContentAppeals      string
+	Drafts              string
+	Mentions            string
+	ModerationDecisions string
+	PostEdits           string
+	PostFiles           string
+	PostFollows         string
+	PostReactions       string
+	Tags                string
+	PostUrls            string
+	PostVideos          string
 }{
-	ImpartWealth:  "ImpartWealth",
-	Hive:          "Hive",
-	Comments:      "Comments",
-	PostEdits:     "PostEdits",
-	PostFiles:     "PostFiles",
-	PostReactions: "PostReactions",
-	Tags:          "Tags",
-	PostUrls:      "PostUrls",
-	PostVideos:    "PostVideos",
+	ImpartWealth:        "ImpartWealth",
+	Hive:                "Hive",
+	Comments:            "Comments",
+	ContentAppeals:      "ContentAppeals",
+	Drafts:              "Drafts",
+	Mentions:            "Mentions",
+	ModerationDecisions: "ModerationDecisions",
+	PostEdits:           "PostEdits",
+	PostFiles:           "PostFiles",
+	PostFollows:         "PostFollows",
+	PostReactions:       "PostReactions",
+	Tags:                "Tags",
+	PostUrls:            "PostUrls",
+	PostVideos:          "PostVideos",
 }
 
 // postR is where relationships are stored.
 type postR struct {
-	ImpartWealth  *User             `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
-	Hive          *Hive             `boil:"Hive" json:"Hive" toml:"Hive" yaml:"Hive"`
-	Comments      CommentSlice      `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
-	PostEdits     PostEditSlice     `boil:"PostEdits" json:"PostEdits" toml:"PostEdits" yaml:"PostEdits"`
-	PostFiles     PostFileSlice     `boil:"PostFiles" json:"PostFiles" toml:"PostFiles" yaml:"PostFiles"`
-	PostReactions PostReactionSlice `boil:"PostReactions" json:"PostReactions" toml:"PostReactions" yaml:"PostReactions"`
-	Tags          TagSlice          `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
-	PostUrls      PostURLSlice      `boil:"PostUrls" json:"PostUrls" toml:"PostUrls" yaml:"PostUrls"`
-	PostVideos    PostVideoSlice    `boil:"PostVideos" json:"PostVideos" toml:"PostVideos" yaml:"PostVideos"`
+	ImpartWealth        *User                   `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+	Hive                *Hive                   `boil:"Hive" json:"Hive" toml:"Hive" yaml:"Hive"`
+	Comments            CommentSlice            `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	ContentAppeals      ContentAppealSlice      `boil:"ContentAppeals" json:"ContentAppeals" toml:"ContentAppeals" yaml:"ContentAppeals"`
+	Drafts              DraftSlice              `boil:"Drafts" json:"Drafts" toml:"Drafts" yaml:"Drafts"`
+	Mentions            MentionSlice            `boil:"Mentions" json:"Mentions" toml:"Mentions" yaml:"Mentions"`
+	ModerationDecisions ModerationDecisionSlice `boil:"ModerationDecisions" json:"ModerationDecisions" toml:"ModerationDecisions" yaml:"ModerationDecisions"`
+	PostEdits           PostEditSlice           `boil:"PostEdits" json:"PostEdits" toml:"PostEdits" yaml:"PostEdits"`
+	PostFiles           PostFileSlice           `boil:"PostFiles" json:"PostFiles" toml:"PostFiles" yaml:"PostFiles"`
+	PostFollows         PostFollowSlice         `boil:"PostFollows" json:"PostFollows" toml:"PostFollows" yaml:"PostFollows"`
+	PostReactions       PostReactionSlice       `boil:"PostReactions" json:"PostReactions" toml:"PostReactions" yaml:"PostReactions"`
+	Tags                TagSlice                `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	PostUrls            PostURLSlice            `boil:"PostUrls" json:"PostUrls" toml:"PostUrls" yaml:"PostUrls"`
+	PostVideos          PostVideoSlice          `boil:"PostVideos" json:"PostVideos" toml:"PostVideos" yaml:"PostVideos"`
 }
 
 // NewStruct creates a new relationship struct
@@ -214,9 +279,9 @@ func (*postR) NewStruct() *postR {
 type postL struct{}
 
 var (
-	postAllColumns            = []string{"post_id", "hive_id", "impart_wealth_id", "pinned", "created_at", "updated_at", "deleted_at", "subject", "content", "last_comment_ts", "comment_count", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed_at", "reviewed", "review_comment"}
+	postAllColumns            = []string{"post_id", "hive_id", "impart_wealth_id", "pinned", "created_at", "updated_at", "deleted_at", "subject", "content", "last_comment_ts", "comment_count", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed_at", "reviewed", "review_comment", "held", "popularity", "hot_score"}
 	postColumnsWithoutDefault = []string{"hive_id", "impart_wealth_id", "pinned", "created_at", "updated_at", "deleted_at", "subject", "content", "last_comment_ts", "reviewed_at", "review_comment"}
-	postColumnsWithDefault    = []string{"post_id", "comment_count", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed"}
+	postColumnsWithDefault    = []string{"post_id", "comment_count", "up_vote_count", "down_vote_count", "reported_count", "obfuscated", "reviewed", "held", "popularity", "hot_score"}
 	postPrimaryKeyColumns     = []string{"post_id"}
 )
 
@@ -547,6 +612,90 @@ func (o *Post) Comments(mods ...qm.QueryMod) commentQuery {
 	return query
 }
 
+// ContentAppeals retrieves all the content_appeal's ContentAppeals with an executor.
+func (o *Post) ContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`content_appeals`.`post_id`=?", o.PostID),
+	)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`content_appeals`.*"})
+	}
+
+	return query
+}
+
+// Drafts retrieves all the draft's Drafts with an executor.
+func (o *Post) Drafts(mods ...qm.QueryMod) draftQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`drafts`.`post_id`=?", o.PostID),
+	)
+
+	query := Drafts(queryMods...)
+	queries.SetFrom(query.Query, "`drafts`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`drafts`.*"})
+	}
+
+	return query
+}
+
+// Mentions retrieves all the mention's Mentions with an executor.
+func (o *Post) Mentions(mods ...qm.QueryMod) mentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`mentions`.`post_id`=?", o.PostID),
+	)
+
+	query := Mentions(queryMods...)
+	queries.SetFrom(query.Query, "`mentions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`mentions`.*"})
+	}
+
+	return query
+}
+
+// ModerationDecisions retrieves all the moderation_decision's ModerationDecisions with an executor.
+func (o *Post) ModerationDecisions(mods ...qm.QueryMod) moderationDecisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`moderation_decisions`.`post_id`=?", o.PostID),
+	)
+
+	query := ModerationDecisions(queryMods...)
+	queries.SetFrom(query.Query, "`moderation_decisions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`moderation_decisions`.*"})
+	}
+
+	return query
+}
+
 // PostEdits retrieves all the post_edit's PostEdits with an executor.
 func (o *Post) PostEdits(mods ...qm.QueryMod) postEditQuery {
 	var queryMods []qm.QueryMod
@@ -590,6 +739,27 @@ func (o *Post) PostFiles(mods ...qm.QueryMod) postFileQuery {
 	return query
 }
 
+// PostFollows retrieves all the post_follow's PostFollows with an executor.
+func (o *Post) PostFollows(mods ...qm.QueryMod) postFollowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`post_follows`.`post_id`=?", o.PostID),
+	)
+
+	query := PostFollows(queryMods...)
+	queries.SetFrom(query.Query, "`post_follows`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`post_follows`.*"})
+	}
+
+	return query
+}
+
 // PostReactions retrieves all the post_reaction's PostReactions with an executor.
 func (o *Post) PostReactions(mods ...qm.QueryMod) postReactionQuery {
 	var queryMods []qm.QueryMod
@@ -985,9 +1155,9 @@ func (postL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadPostEdits allows an eager lookup of values, cached into the
+// LoadContentAppeals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadContentAppeals(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1025,9 +1195,8 @@ func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`post_edits`),
-		qm.WhereIn(`post_edits.post_id in ?`, args...),
-		qmhelper.WhereIsNull(`post_edits.deleted_at`),
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.post_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1035,22 +1204,22 @@ func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_edits")
+		return errors.Wrap(err, "failed to eager load content_appeals")
 	}
 
-	var resultSlice []*PostEdit
+	var resultSlice []*ContentAppeal
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_edits")
+		return errors.Wrap(err, "failed to bind eager loaded slice content_appeals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_edits")
+		return errors.Wrap(err, "failed to close results in eager load on content_appeals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_edits")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
 	}
 
-	if len(postEditAfterSelectHooks) != 0 {
+	if len(contentAppealAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1058,10 +1227,10 @@ func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.PostEdits = resultSlice
+		object.R.ContentAppeals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postEditR{}
+				foreign.R = &contentAppealR{}
 			}
 			foreign.R.Post = object
 		}
@@ -1071,9 +1240,9 @@ func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.PostID == foreign.PostID {
-				local.R.PostEdits = append(local.R.PostEdits, foreign)
+				local.R.ContentAppeals = append(local.R.ContentAppeals, foreign)
 				if foreign.R == nil {
-					foreign.R = &postEditR{}
+					foreign.R = &contentAppealR{}
 				}
 				foreign.R.Post = local
 				break
@@ -1084,9 +1253,9 @@ func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadPostFiles allows an eager lookup of values, cached into the
+// LoadDrafts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadDrafts(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1110,7 +1279,7 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 			}
 
 			for _, a := range args {
-				if a == obj.PostID {
+				if queries.Equal(a, obj.PostID) {
 					continue Outer
 				}
 			}
@@ -1124,8 +1293,8 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`post_files`),
-		qm.WhereIn(`post_files.post_id in ?`, args...),
+		qm.From(`drafts`),
+		qm.WhereIn(`drafts.post_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1133,22 +1302,22 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_files")
+		return errors.Wrap(err, "failed to eager load drafts")
 	}
 
-	var resultSlice []*PostFile
+	var resultSlice []*Draft
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_files")
+		return errors.Wrap(err, "failed to bind eager loaded slice drafts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_files")
+		return errors.Wrap(err, "failed to close results in eager load on drafts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_files")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for drafts")
 	}
 
-	if len(postFileAfterSelectHooks) != 0 {
+	if len(draftAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1156,10 +1325,10 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.PostFiles = resultSlice
+		object.R.Drafts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postFileR{}
+				foreign.R = &draftR{}
 			}
 			foreign.R.Post = object
 		}
@@ -1168,10 +1337,10 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.PostID == foreign.PostID {
-				local.R.PostFiles = append(local.R.PostFiles, foreign)
+			if queries.Equal(local.PostID, foreign.PostID) {
+				local.R.Drafts = append(local.R.Drafts, foreign)
 				if foreign.R == nil {
-					foreign.R = &postFileR{}
+					foreign.R = &draftR{}
 				}
 				foreign.R.Post = local
 				break
@@ -1182,9 +1351,9 @@ func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadPostReactions allows an eager lookup of values, cached into the
+// LoadMentions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1222,9 +1391,8 @@ func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`post_reactions`),
-		qm.WhereIn(`post_reactions.post_id in ?`, args...),
-		qmhelper.WhereIsNull(`post_reactions.deleted_at`),
+		qm.From(`mentions`),
+		qm.WhereIn(`mentions.post_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1232,22 +1400,22 @@ func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_reactions")
+		return errors.Wrap(err, "failed to eager load mentions")
 	}
 
-	var resultSlice []*PostReaction
+	var resultSlice []*Mention
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_reactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice mentions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_reactions")
+		return errors.Wrap(err, "failed to close results in eager load on mentions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_reactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mentions")
 	}
 
-	if len(postReactionAfterSelectHooks) != 0 {
+	if len(mentionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1255,10 +1423,10 @@ func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.PostReactions = resultSlice
+		object.R.Mentions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postReactionR{}
+				foreign.R = &mentionR{}
 			}
 			foreign.R.Post = object
 		}
@@ -1268,9 +1436,9 @@ func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.PostID == foreign.PostID {
-				local.R.PostReactions = append(local.R.PostReactions, foreign)
+				local.R.Mentions = append(local.R.Mentions, foreign)
 				if foreign.R == nil {
-					foreign.R = &postReactionR{}
+					foreign.R = &mentionR{}
 				}
 				foreign.R.Post = local
 				break
@@ -1281,9 +1449,9 @@ func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadTags allows an eager lookup of values, cached into the
+// LoadModerationDecisions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadModerationDecisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1321,10 +1489,8 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	}
 
 	query := NewQuery(
-		qm.Select("`tag`.tag_id, `tag`.name, `tag`.long_name, `tag`.description, `tag`.sort_order, `a`.`post_id`"),
-		qm.From("`tag`"),
-		qm.InnerJoin("`post_tag` as `a` on `tag`.`tag_id` = `a`.`tag_id`"),
-		qm.WhereIn("`a`.`post_id` in ?", args...),
+		qm.From(`moderation_decisions`),
+		qm.WhereIn(`moderation_decisions.post_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1332,36 +1498,22 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tag")
+		return errors.Wrap(err, "failed to eager load moderation_decisions")
 	}
 
-	var resultSlice []*Tag
-
-	var localJoinCols []uint64
-	for results.Next() {
-		one := new(Tag)
-		var localJoinCol uint64
-
-		err = results.Scan(&one.TagID, &one.Name, &one.LongName, &one.Description, &one.SortOrder, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for tag")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tag")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*ModerationDecision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice moderation_decisions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tag")
+		return errors.Wrap(err, "failed to close results in eager load on moderation_decisions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tag")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for moderation_decisions")
 	}
 
-	if len(tagAfterSelectHooks) != 0 {
+	if len(moderationDecisionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1369,25 +1521,24 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 		}
 	}
 	if singular {
-		object.R.Tags = resultSlice
+		object.R.ModerationDecisions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tagR{}
+				foreign.R = &moderationDecisionR{}
 			}
-			foreign.R.Posts = append(foreign.R.Posts, object)
+			foreign.R.Post = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.PostID == localJoinCol {
-				local.R.Tags = append(local.R.Tags, foreign)
+			if local.PostID == foreign.PostID {
+				local.R.ModerationDecisions = append(local.R.ModerationDecisions, foreign)
 				if foreign.R == nil {
-					foreign.R = &tagR{}
+					foreign.R = &moderationDecisionR{}
 				}
-				foreign.R.Posts = append(foreign.R.Posts, local)
+				foreign.R.Post = local
 				break
 			}
 		}
@@ -1396,9 +1547,9 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
-// LoadPostUrls allows an eager lookup of values, cached into the
+// LoadPostEdits allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadPostEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1436,8 +1587,9 @@ func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`post_urls`),
-		qm.WhereIn(`post_urls.post_id in ?`, args...),
+		qm.From(`post_edits`),
+		qm.WhereIn(`post_edits.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post_edits.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1445,22 +1597,22 @@ func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_urls")
+		return errors.Wrap(err, "failed to eager load post_edits")
 	}
 
-	var resultSlice []*PostURL
+	var resultSlice []*PostEdit
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_urls")
+		return errors.Wrap(err, "failed to bind eager loaded slice post_edits")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_urls")
+		return errors.Wrap(err, "failed to close results in eager load on post_edits")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_urls")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_edits")
 	}
 
-	if len(postURLAfterSelectHooks) != 0 {
+	if len(postEditAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1468,10 +1620,10 @@ func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.PostUrls = resultSlice
+		object.R.PostEdits = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postURLR{}
+				foreign.R = &postEditR{}
 			}
 			foreign.R.Post = object
 		}
@@ -1481,9 +1633,9 @@ func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.PostID == foreign.PostID {
-				local.R.PostUrls = append(local.R.PostUrls, foreign)
+				local.R.PostEdits = append(local.R.PostEdits, foreign)
 				if foreign.R == nil {
-					foreign.R = &postURLR{}
+					foreign.R = &postEditR{}
 				}
 				foreign.R.Post = local
 				break
@@ -1494,9 +1646,9 @@ func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadPostVideos allows an eager lookup of values, cached into the
+// LoadPostFiles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+func (postL) LoadPostFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -1534,8 +1686,8 @@ func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`post_videos`),
-		qm.WhereIn(`post_videos.post_id in ?`, args...),
+		qm.From(`post_files`),
+		qm.WhereIn(`post_files.post_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1543,22 +1695,22 @@ func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_videos")
+		return errors.Wrap(err, "failed to eager load post_files")
 	}
 
-	var resultSlice []*PostVideo
+	var resultSlice []*PostFile
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_videos")
+		return errors.Wrap(err, "failed to bind eager loaded slice post_files")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_videos")
+		return errors.Wrap(err, "failed to close results in eager load on post_files")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_videos")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_files")
 	}
 
-	if len(postVideoAfterSelectHooks) != 0 {
+	if len(postFileAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1566,10 +1718,10 @@ func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.PostVideos = resultSlice
+		object.R.PostFiles = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postVideoR{}
+				foreign.R = &postFileR{}
 			}
 			foreign.R.Post = object
 		}
@@ -1579,9 +1731,9 @@ func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.PostID == foreign.PostID {
-				local.R.PostVideos = append(local.R.PostVideos, foreign)
+				local.R.PostFiles = append(local.R.PostFiles, foreign)
 				if foreign.R == nil {
-					foreign.R = &postVideoR{}
+					foreign.R = &postFileR{}
 				}
 				foreign.R.Post = local
 				break
@@ -1592,105 +1744,899 @@ func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// SetImpartWealth of the post to the related item.
-// Sets o.R.ImpartWealth to related.
-// Adds o to related.R.ImpartWealthPosts.
-func (o *Post) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `post` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-		strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ImpartWealthID, o.PostID}
+// LoadPostFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.PostID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_follows`),
+		qm.WhereIn(`post_follows.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_follows")
+	}
+
+	var resultSlice []*PostFollow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_follows")
+	}
+
+	if len(postFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postFollowR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.PostID == foreign.PostID {
+				local.R.PostFollows = append(local.R.PostFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &postFollowR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.PostID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_reactions`),
+		qm.WhereIn(`post_reactions.post_id in ?`, args...),
+		qmhelper.WhereIsNull(`post_reactions.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_reactions")
+	}
+
+	var resultSlice []*PostReaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_reactions")
+	}
+
+	if len(postReactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostReactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postReactionR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.PostID == foreign.PostID {
+				local.R.PostReactions = append(local.R.PostReactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &postReactionR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.PostID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("`tag`.tag_id, `tag`.name, `tag`.long_name, `tag`.description, `tag`.sort_order, `a`.`post_id`"),
+		qm.From("`tag`"),
+		qm.InnerJoin("`post_tag` as `a` on `tag`.`tag_id` = `a`.`tag_id`"),
+		qm.WhereIn("`a`.`post_id` in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tag")
+	}
+
+	var resultSlice []*Tag
+
+	var localJoinCols []uint64
+	for results.Next() {
+		one := new(Tag)
+		var localJoinCol uint64
+
+		err = results.Scan(&one.TagID, &one.Name, &one.LongName, &one.Description, &one.SortOrder, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for tag")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tag")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tag")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tag")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Posts = append(foreign.R.Posts, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.PostID == localJoinCol {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostUrls allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostUrls(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.PostID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_urls`),
+		qm.WhereIn(`post_urls.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_urls")
+	}
+
+	var resultSlice []*PostURL
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_urls")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_urls")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_urls")
+	}
+
+	if len(postURLAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostUrls = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postURLR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.PostID == foreign.PostID {
+				local.R.PostUrls = append(local.R.PostUrls, foreign)
+				if foreign.R == nil {
+					foreign.R = &postURLR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostVideos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostVideos(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.PostID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PostID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PostID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_videos`),
+		qm.WhereIn(`post_videos.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_videos")
+	}
+
+	var resultSlice []*PostVideo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_videos")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_videos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_videos")
+	}
+
+	if len(postVideoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostVideos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postVideoR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.PostID == foreign.PostID {
+				local.R.PostVideos = append(local.R.PostVideos, foreign)
+				if foreign.R == nil {
+					foreign.R = &postVideoR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the post to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthPosts.
+func (o *Post) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `post` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.PostID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &postR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthPosts: PostSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthPosts = append(related.R.ImpartWealthPosts, o)
+	}
+
+	return nil
+}
+
+// SetHive of the post to the related item.
+// Sets o.R.Hive to related.
+// Adds o to related.R.Posts.
+func (o *Post) SetHive(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Hive) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `post` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
+		strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.HiveID, o.PostID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
 	}
 	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.ImpartWealthID = related.ImpartWealthID
+	o.HiveID = related.HiveID
+	if o.R == nil {
+		o.R = &postR{
+			Hive: related,
+		}
+	} else {
+		o.R.Hive = related
+	}
+
+	if related.R == nil {
+		related.R = &hiveR{
+			Posts: PostSlice{o},
+		}
+	} else {
+		related.R.Posts = append(related.R.Posts, o)
+	}
+
+	return nil
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Post appropriately.
+func (o *Post) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.PostID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+				strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PostID, rel.CommentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.PostID
+		}
+	}
+
 	if o.R == nil {
 		o.R = &postR{
-			ImpartWealth: related,
+			Comments: related,
 		}
 	} else {
-		o.R.ImpartWealth = related
+		o.R.Comments = append(o.R.Comments, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			ImpartWealthPosts: PostSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddContentAppeals adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.ContentAppeals.
+// Sets related.R.Post appropriately.
+func (o *Post) AddContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.PostID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `content_appeals` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+				strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PostID, rel.AppealID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.PostID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			ContentAppeals: related,
 		}
 	} else {
-		related.R.ImpartWealthPosts = append(related.R.ImpartWealthPosts, o)
+		o.R.ContentAppeals = append(o.R.ContentAppeals, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentAppealR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// SetHive of the post to the related item.
-// Sets o.R.Hive to related.
-// Adds o to related.R.Posts.
-func (o *Post) SetHive(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Hive) error {
+// AddDrafts adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Drafts.
+// Sets related.R.Post appropriately.
+func (o *Post) AddDrafts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Draft) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PostID, o.PostID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `drafts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+				strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PostID, rel.DraftID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PostID, o.PostID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `post` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"hive_id"}),
-		strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
-	)
-	values := []interface{}{related.HiveID, o.PostID}
+	if o.R == nil {
+		o.R = &postR{
+			Drafts: related,
+		}
+	} else {
+		o.R.Drafts = append(o.R.Drafts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &draftR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
 
+// SetDrafts removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Post's Drafts accordingly.
+// Replaces o.R.Drafts with related.
+// Sets related.R.Post's Drafts accordingly.
+func (o *Post) SetDrafts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Draft) error {
+	query := "update `drafts` set `post_id` = null where `post_id` = ?"
+	values := []interface{}{o.PostID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	o.HiveID = related.HiveID
+	if o.R != nil {
+		for _, rel := range o.R.Drafts {
+			queries.SetScanner(&rel.PostID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Post = nil
+		}
+
+		o.R.Drafts = nil
+	}
+	return o.AddDrafts(ctx, exec, insert, related...)
+}
+
+// RemoveDrafts relationships from objects passed in.
+// Removes related items from R.Drafts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Post.
+func (o *Post) RemoveDrafts(ctx context.Context, exec boil.ContextExecutor, related ...*Draft) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PostID, nil)
+		if rel.R != nil {
+			rel.R.Post = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+			return err
+		}
+	}
 	if o.R == nil {
-		o.R = &postR{
-			Hive: related,
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Drafts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Drafts)
+			if ln > 1 && i < ln-1 {
+				o.R.Drafts[i] = o.R.Drafts[ln-1]
+			}
+			o.R.Drafts = o.R.Drafts[:ln-1]
+			break
 		}
-	} else {
-		o.R.Hive = related
 	}
 
-	if related.R == nil {
-		related.R = &hiveR{
-			Posts: PostSlice{o},
+	return nil
+}
+
+// AddMentions adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Mentions.
+// Sets related.R.Post appropriately.
+func (o *Post) AddMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mention) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.PostID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `mentions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+				strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PostID, rel.MentionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.PostID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Mentions: related,
 		}
 	} else {
-		related.R.Posts = append(related.R.Posts, o)
+		o.R.Mentions = append(o.R.Mentions, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mentionR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// AddComments adds the given related objects to the existing relationships
+// AddModerationDecisions adds the given related objects to the existing relationships
 // of the post, optionally inserting them as new records.
-// Appends related to o.R.Comments.
+// Appends related to o.R.ModerationDecisions.
 // Sets related.R.Post appropriately.
-func (o *Post) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+func (o *Post) AddModerationDecisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ModerationDecision) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1700,11 +2646,11 @@ func (o *Post) AddComments(ctx context.Context, exec boil.ContextExecutor, inser
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `comment` SET %s WHERE %s",
+				"UPDATE `moderation_decisions` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
-				strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.PostID, rel.CommentID}
+			values := []interface{}{o.PostID, rel.DecisionID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1721,15 +2667,15 @@ func (o *Post) AddComments(ctx context.Context, exec boil.ContextExecutor, inser
 
 	if o.R == nil {
 		o.R = &postR{
-			Comments: related,
+			ModerationDecisions: related,
 		}
 	} else {
-		o.R.Comments = append(o.R.Comments, related...)
+		o.R.ModerationDecisions = append(o.R.ModerationDecisions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
+			rel.R = &moderationDecisionR{
 				Post: o,
 			}
 		} else {
@@ -1845,6 +2791,59 @@ func (o *Post) AddPostFiles(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddPostFollows adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostFollows.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostFollow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.PostID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `post_follows` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"post_id"}),
+				strmangle.WhereClause("`", "`", 0, postFollowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PostID, rel.PostID, rel.ImpartWealthID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.PostID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostFollows: related,
+		}
+	} else {
+		o.R.PostFollows = append(o.R.PostFollows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postFollowR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
 // AddPostReactions adds the given related objects to the existing relationships
 // of the post, optionally inserting them as new records.
 // Appends related to o.R.PostReactions.
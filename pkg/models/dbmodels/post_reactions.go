@@ -33,6 +33,7 @@ type PostReaction struct {
 	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ReportWeight   uint16      `boil:"report_weight" json:"report_weight" toml:"report_weight" yaml:"report_weight"`
 
 	R *postReactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postReactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +49,7 @@ var PostReactionColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	ReportWeight   string
 }{
 	PostID:         "post_id",
 	ImpartWealthID: "impart_wealth_id",
@@ -58,6 +60,7 @@ var PostReactionColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	DeletedAt:      "deleted_at",
+	ReportWeight:   "report_weight",
 }
 
 var PostReactionTableColumns = struct {
@@ -70,6 +73,7 @@ var PostReactionTableColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	ReportWeight   string
 }{
 	PostID:         "post_reactions.post_id",
 	ImpartWealthID: "post_reactions.impart_wealth_id",
@@ -80,6 +84,7 @@ var PostReactionTableColumns = struct {
 	CreatedAt:      "post_reactions.created_at",
 	UpdatedAt:      "post_reactions.updated_at",
 	DeletedAt:      "post_reactions.deleted_at",
+	ReportWeight:   "post_reactions.report_weight",
 }
 
 // Generated where
@@ -94,6 +99,7 @@ var PostReactionWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	DeletedAt      whereHelpernull_Time
+	ReportWeight   whereHelperuint16
 }{
 	PostID:         whereHelperuint64{field: "`post_reactions`.`post_id`"},
 	ImpartWealthID: whereHelperstring{field: "`post_reactions`.`impart_wealth_id`"},
@@ -104,6 +110,7 @@ var PostReactionWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "`post_reactions`.`created_at`"},
 	UpdatedAt:      whereHelpertime_Time{field: "`post_reactions`.`updated_at`"},
 	DeletedAt:      whereHelpernull_Time{field: "`post_reactions`.`deleted_at`"},
+	ReportWeight:   whereHelperuint16{field: "`post_reactions`.`report_weight`"},
 }
 
 // PostReactionRels is where relationship names are stored.
@@ -130,9 +137,9 @@ func (*postReactionR) NewStruct() *postReactionR {
 type postReactionL struct{}
 
 var (
-	postReactionAllColumns            = []string{"post_id", "impart_wealth_id", "upvoted", "downvoted", "reported", "reported_reason", "created_at", "updated_at", "deleted_at"}
+	postReactionAllColumns            = []string{"post_id", "impart_wealth_id", "upvoted", "downvoted", "reported", "reported_reason", "created_at", "updated_at", "deleted_at", "report_weight"}
 	postReactionColumnsWithoutDefault = []string{"post_id", "impart_wealth_id", "reported_reason", "created_at", "updated_at", "deleted_at"}
-	postReactionColumnsWithDefault    = []string{"upvoted", "downvoted", "reported"}
+	postReactionColumnsWithDefault    = []string{"upvoted", "downvoted", "reported", "report_weight"}
 	postReactionPrimaryKeyColumns     = []string{"post_id", "impart_wealth_id"}
 )
 
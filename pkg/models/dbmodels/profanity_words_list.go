@@ -23,44 +23,65 @@ import (
 
 // ProfanityWordsList is an object representing the database table.
 type ProfanityWordsList struct {
-	WordID  uint64 `boil:"word_id" json:"word_id" toml:"word_id" yaml:"word_id"`
-	Word    string `boil:"word" json:"word" toml:"word" yaml:"word"`
-	Enabled bool   `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	WordID    uint64    `boil:"word_id" json:"word_id" toml:"word_id" yaml:"word_id"`
+	Word      string    `boil:"word" json:"word" toml:"word" yaml:"word"`
+	Enabled   bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	Severity  string    `boil:"severity" json:"severity" toml:"severity" yaml:"severity"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *profanityWordsListR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L profanityWordsListL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ProfanityWordsListColumns = struct {
-	WordID  string
-	Word    string
-	Enabled string
+	WordID    string
+	Word      string
+	Enabled   string
+	Severity  string
+	CreatedAt string
+	UpdatedAt string
 }{
-	WordID:  "word_id",
-	Word:    "word",
-	Enabled: "enabled",
+	WordID:    "word_id",
+	Word:      "word",
+	Enabled:   "enabled",
+	Severity:  "severity",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
 }
 
 var ProfanityWordsListTableColumns = struct {
-	WordID  string
-	Word    string
-	Enabled string
+	WordID    string
+	Word      string
+	Enabled   string
+	Severity  string
+	CreatedAt string
+	UpdatedAt string
 }{
-	WordID:  "profanity_words_list.word_id",
-	Word:    "profanity_words_list.word",
-	Enabled: "profanity_words_list.enabled",
+	WordID:    "profanity_words_list.word_id",
+	Word:      "profanity_words_list.word",
+	Enabled:   "profanity_words_list.enabled",
+	Severity:  "profanity_words_list.severity",
+	CreatedAt: "profanity_words_list.created_at",
+	UpdatedAt: "profanity_words_list.updated_at",
 }
 
 // Generated where
 
 var ProfanityWordsListWhere = struct {
-	WordID  whereHelperuint64
-	Word    whereHelperstring
-	Enabled whereHelperbool
+	WordID    whereHelperuint64
+	Word      whereHelperstring
+	Enabled   whereHelperbool
+	Severity  whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
 }{
-	WordID:  whereHelperuint64{field: "`profanity_words_list`.`word_id`"},
-	Word:    whereHelperstring{field: "`profanity_words_list`.`word`"},
-	Enabled: whereHelperbool{field: "`profanity_words_list`.`enabled`"},
+	WordID:    whereHelperuint64{field: "`profanity_words_list`.`word_id`"},
+	Word:      whereHelperstring{field: "`profanity_words_list`.`word`"},
+	Enabled:   whereHelperbool{field: "`profanity_words_list`.`enabled`"},
+	Severity:  whereHelperstring{field: "`profanity_words_list`.`severity`"},
+	CreatedAt: whereHelpertime_Time{field: "`profanity_words_list`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`profanity_words_list`.`updated_at`"},
 }
 
 // ProfanityWordsListRels is where relationship names are stored.
@@ -80,9 +101,9 @@ func (*profanityWordsListR) NewStruct() *profanityWordsListR {
 type profanityWordsListL struct{}
 
 var (
-	profanityWordsListAllColumns            = []string{"word_id", "word", "enabled"}
+	profanityWordsListAllColumns            = []string{"word_id", "word", "enabled", "severity", "created_at", "updated_at"}
 	profanityWordsListColumnsWithoutDefault = []string{"word"}
-	profanityWordsListColumnsWithDefault    = []string{"word_id", "enabled"}
+	profanityWordsListColumnsWithDefault    = []string{"word_id", "enabled", "severity", "created_at", "updated_at"}
 	profanityWordsListPrimaryKeyColumns     = []string{"word_id"}
 )
 
@@ -405,6 +426,16 @@ func (o *ProfanityWordsList) Insert(ctx context.Context, exec boil.ContextExecut
 	}
 
 	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
 		return err
@@ -507,6 +538,12 @@ CacheNoHooks:
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *ProfanityWordsList) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
@@ -641,6 +678,14 @@ func (o *ProfanityWordsList) Upsert(ctx context.Context, exec boil.ContextExecut
 	if o == nil {
 		return errors.New("dbmodels: no profanity_words_list provided for upsert")
 	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
 		return err
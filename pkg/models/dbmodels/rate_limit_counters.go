@@ -0,0 +1,931 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RateLimitCounter is an object representing the database table.
+type RateLimitCounter struct {
+	BucketKey   string    `boil:"bucket_key" json:"bucket_key" toml:"bucket_key" yaml:"bucket_key"`
+	WindowStart time.Time `boil:"window_start" json:"window_start" toml:"window_start" yaml:"window_start"`
+	Hits        uint      `boil:"hits" json:"hits" toml:"hits" yaml:"hits"`
+	ExpiresAt   time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *rateLimitCounterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rateLimitCounterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RateLimitCounterColumns = struct {
+	BucketKey   string
+	WindowStart string
+	Hits        string
+	ExpiresAt   string
+}{
+	BucketKey:   "bucket_key",
+	WindowStart: "window_start",
+	Hits:        "hits",
+	ExpiresAt:   "expires_at",
+}
+
+var RateLimitCounterTableColumns = struct {
+	BucketKey   string
+	WindowStart string
+	Hits        string
+	ExpiresAt   string
+}{
+	BucketKey:   "rate_limit_counters.bucket_key",
+	WindowStart: "rate_limit_counters.window_start",
+	Hits:        "rate_limit_counters.hits",
+	ExpiresAt:   "rate_limit_counters.expires_at",
+}
+
+// Generated where
+
+var RateLimitCounterWhere = struct {
+	BucketKey   whereHelperstring
+	WindowStart whereHelpertime_Time
+	Hits        whereHelperuint
+	ExpiresAt   whereHelpertime_Time
+}{
+	BucketKey:   whereHelperstring{field: "`rate_limit_counters`.`bucket_key`"},
+	WindowStart: whereHelpertime_Time{field: "`rate_limit_counters`.`window_start`"},
+	Hits:        whereHelperuint{field: "`rate_limit_counters`.`hits`"},
+	ExpiresAt:   whereHelpertime_Time{field: "`rate_limit_counters`.`expires_at`"},
+}
+
+// RateLimitCounterRels is where relationship names are stored.
+var RateLimitCounterRels = struct {
+}{}
+
+// rateLimitCounterR is where relationships are stored.
+type rateLimitCounterR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rateLimitCounterR) NewStruct() *rateLimitCounterR {
+	return &rateLimitCounterR{}
+}
+
+// rateLimitCounterL is where Load methods for each relationship are stored.
+type rateLimitCounterL struct{}
+
+var (
+	rateLimitCounterAllColumns            = []string{"bucket_key", "window_start", "hits", "expires_at"}
+	rateLimitCounterColumnsWithoutDefault = []string{"bucket_key", "window_start", "expires_at"}
+	rateLimitCounterColumnsWithDefault    = []string{"hits"}
+	rateLimitCounterPrimaryKeyColumns     = []string{"bucket_key", "window_start"}
+)
+
+type (
+	// RateLimitCounterSlice is an alias for a slice of pointers to RateLimitCounter.
+	// This should almost always be used instead of []RateLimitCounter.
+	RateLimitCounterSlice []*RateLimitCounter
+	// RateLimitCounterHook is the signature for custom RateLimitCounter hook methods
+	RateLimitCounterHook func(context.Context, boil.ContextExecutor, *RateLimitCounter) error
+
+	rateLimitCounterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rateLimitCounterType                 = reflect.TypeOf(&RateLimitCounter{})
+	rateLimitCounterMapping              = queries.MakeStructMapping(rateLimitCounterType)
+	rateLimitCounterPrimaryKeyMapping, _ = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, rateLimitCounterPrimaryKeyColumns)
+	rateLimitCounterInsertCacheMut       sync.RWMutex
+	rateLimitCounterInsertCache          = make(map[string]insertCache)
+	rateLimitCounterUpdateCacheMut       sync.RWMutex
+	rateLimitCounterUpdateCache          = make(map[string]updateCache)
+	rateLimitCounterUpsertCacheMut       sync.RWMutex
+	rateLimitCounterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rateLimitCounterBeforeInsertHooks []RateLimitCounterHook
+var rateLimitCounterBeforeUpdateHooks []RateLimitCounterHook
+var rateLimitCounterBeforeDeleteHooks []RateLimitCounterHook
+var rateLimitCounterBeforeUpsertHooks []RateLimitCounterHook
+
+var rateLimitCounterAfterInsertHooks []RateLimitCounterHook
+var rateLimitCounterAfterSelectHooks []RateLimitCounterHook
+var rateLimitCounterAfterUpdateHooks []RateLimitCounterHook
+var rateLimitCounterAfterDeleteHooks []RateLimitCounterHook
+var rateLimitCounterAfterUpsertHooks []RateLimitCounterHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RateLimitCounter) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RateLimitCounter) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RateLimitCounter) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RateLimitCounter) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RateLimitCounter) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RateLimitCounter) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RateLimitCounter) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RateLimitCounter) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RateLimitCounter) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitCounterAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRateLimitCounterHook registers your hook function for all future operations.
+func AddRateLimitCounterHook(hookPoint boil.HookPoint, rateLimitCounterHook RateLimitCounterHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		rateLimitCounterBeforeInsertHooks = append(rateLimitCounterBeforeInsertHooks, rateLimitCounterHook)
+	case boil.BeforeUpdateHook:
+		rateLimitCounterBeforeUpdateHooks = append(rateLimitCounterBeforeUpdateHooks, rateLimitCounterHook)
+	case boil.BeforeDeleteHook:
+		rateLimitCounterBeforeDeleteHooks = append(rateLimitCounterBeforeDeleteHooks, rateLimitCounterHook)
+	case boil.BeforeUpsertHook:
+		rateLimitCounterBeforeUpsertHooks = append(rateLimitCounterBeforeUpsertHooks, rateLimitCounterHook)
+	case boil.AfterInsertHook:
+		rateLimitCounterAfterInsertHooks = append(rateLimitCounterAfterInsertHooks, rateLimitCounterHook)
+	case boil.AfterSelectHook:
+		rateLimitCounterAfterSelectHooks = append(rateLimitCounterAfterSelectHooks, rateLimitCounterHook)
+	case boil.AfterUpdateHook:
+		rateLimitCounterAfterUpdateHooks = append(rateLimitCounterAfterUpdateHooks, rateLimitCounterHook)
+	case boil.AfterDeleteHook:
+		rateLimitCounterAfterDeleteHooks = append(rateLimitCounterAfterDeleteHooks, rateLimitCounterHook)
+	case boil.AfterUpsertHook:
+		rateLimitCounterAfterUpsertHooks = append(rateLimitCounterAfterUpsertHooks, rateLimitCounterHook)
+	}
+}
+
+// One returns a single rateLimitCounter record from the query.
+func (q rateLimitCounterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RateLimitCounter, error) {
+	o := &RateLimitCounter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for rate_limit_counters")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RateLimitCounter records from the query.
+func (q rateLimitCounterQuery) All(ctx context.Context, exec boil.ContextExecutor) (RateLimitCounterSlice, error) {
+	var o []*RateLimitCounter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to RateLimitCounter slice")
+	}
+
+	if len(rateLimitCounterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RateLimitCounter records in the query.
+func (q rateLimitCounterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count rate_limit_counters rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rateLimitCounterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if rate_limit_counters exists")
+	}
+
+	return count > 0, nil
+}
+
+// RateLimitCounters retrieves all the records using an executor.
+func RateLimitCounters(mods ...qm.QueryMod) rateLimitCounterQuery {
+	mods = append(mods, qm.From("`rate_limit_counters`"))
+	return rateLimitCounterQuery{NewQuery(mods...)}
+}
+
+// FindRateLimitCounter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRateLimitCounter(ctx context.Context, exec boil.ContextExecutor, bucketKey string, windowStart time.Time, selectCols ...string) (*RateLimitCounter, error) {
+	rateLimitCounterObj := &RateLimitCounter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `rate_limit_counters` where `bucket_key`=? AND `window_start`=?", sel,
+	)
+
+	q := queries.Raw(query, bucketKey, windowStart)
+
+	err := q.Bind(ctx, exec, rateLimitCounterObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from rate_limit_counters")
+	}
+
+	if err = rateLimitCounterObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return rateLimitCounterObj, err
+	}
+
+	return rateLimitCounterObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RateLimitCounter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no rate_limit_counters provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitCounterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rateLimitCounterInsertCacheMut.RLock()
+	cache, cached := rateLimitCounterInsertCache[key]
+	rateLimitCounterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterColumnsWithDefault,
+			rateLimitCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `rate_limit_counters` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `rate_limit_counters` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `rate_limit_counters` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, rateLimitCounterPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into rate_limit_counters")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.BucketKey,
+		o.WindowStart,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for rate_limit_counters")
+	}
+
+CacheNoHooks:
+	if !cached {
+		rateLimitCounterInsertCacheMut.Lock()
+		rateLimitCounterInsertCache[key] = cache
+		rateLimitCounterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RateLimitCounter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RateLimitCounter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rateLimitCounterUpdateCacheMut.RLock()
+	cache, cached := rateLimitCounterUpdateCache[key]
+	rateLimitCounterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update rate_limit_counters, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `rate_limit_counters` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, rateLimitCounterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, append(wl, rateLimitCounterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update rate_limit_counters row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for rate_limit_counters")
+	}
+
+	if !cached {
+		rateLimitCounterUpdateCacheMut.Lock()
+		rateLimitCounterUpdateCache[key] = cache
+		rateLimitCounterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rateLimitCounterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RateLimitCounterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `rate_limit_counters` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rateLimitCounterPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in rateLimitCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all rateLimitCounter")
+	}
+	return rowsAff, nil
+}
+
+var mySQLRateLimitCounterUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RateLimitCounter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no rate_limit_counters provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitCounterColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLRateLimitCounterUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rateLimitCounterUpsertCacheMut.RLock()
+	cache, cached := rateLimitCounterUpsertCache[key]
+	rateLimitCounterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterColumnsWithDefault,
+			rateLimitCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert rate_limit_counters, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`rate_limit_counters`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `rate_limit_counters` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for rate_limit_counters")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for rate_limit_counters")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for rate_limit_counters")
+	}
+
+CacheNoHooks:
+	if !cached {
+		rateLimitCounterUpsertCacheMut.Lock()
+		rateLimitCounterUpsertCache[key] = cache
+		rateLimitCounterUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RateLimitCounter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RateLimitCounter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no RateLimitCounter provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rateLimitCounterPrimaryKeyMapping)
+	sql := "DELETE FROM `rate_limit_counters` WHERE `bucket_key`=? AND `window_start`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for rate_limit_counters")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rateLimitCounterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no rateLimitCounterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RateLimitCounterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rateLimitCounterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `rate_limit_counters` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rateLimitCounterPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from rateLimitCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for rate_limit_counters")
+	}
+
+	if len(rateLimitCounterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RateLimitCounter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRateLimitCounter(ctx, exec, o.BucketKey, o.WindowStart)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RateLimitCounterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RateLimitCounterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `rate_limit_counters`.* FROM `rate_limit_counters` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, rateLimitCounterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in RateLimitCounterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RateLimitCounterExists checks if the RateLimitCounter row exists.
+func RateLimitCounterExists(ctx context.Context, exec boil.ContextExecutor, bucketKey string, windowStart time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `rate_limit_counters` where `bucket_key`=? AND `window_start`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, bucketKey, windowStart)
+	}
+	row := exec.QueryRowContext(ctx, sql, bucketKey, windowStart)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if rate_limit_counters exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1152 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ScheduledJobRun is an object representing the database table.
+type ScheduledJobRun struct {
+	RunID       uint64      `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	JobName     string      `boil:"job_name" json:"job_name" toml:"job_name" yaml:"job_name"`
+	InstanceID  string      `boil:"instance_id" json:"instance_id" toml:"instance_id" yaml:"instance_id"`
+	ScheduledAt time.Time   `boil:"scheduled_at" json:"scheduled_at" toml:"scheduled_at" yaml:"scheduled_at"`
+	StartedAt   time.Time   `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	FinishedAt  null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Error       null.String `boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+
+	R *scheduledJobRunR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scheduledJobRunL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScheduledJobRunColumns = struct {
+	RunID       string
+	JobName     string
+	InstanceID  string
+	ScheduledAt string
+	StartedAt   string
+	FinishedAt  string
+	Status      string
+	Error       string
+}{
+	RunID:       "run_id",
+	JobName:     "job_name",
+	InstanceID:  "instance_id",
+	ScheduledAt: "scheduled_at",
+	StartedAt:   "started_at",
+	FinishedAt:  "finished_at",
+	Status:      "status",
+	Error:       "error",
+}
+
+var ScheduledJobRunTableColumns = struct {
+	RunID       string
+	JobName     string
+	InstanceID  string
+	ScheduledAt string
+	StartedAt   string
+	FinishedAt  string
+	Status      string
+	Error       string
+}{
+	RunID:       "scheduled_job_runs.run_id",
+	JobName:     "scheduled_job_runs.job_name",
+	InstanceID:  "scheduled_job_runs.instance_id",
+	ScheduledAt: "scheduled_job_runs.scheduled_at",
+	StartedAt:   "scheduled_job_runs.started_at",
+	FinishedAt:  "scheduled_job_runs.finished_at",
+	Status:      "scheduled_job_runs.status",
+	Error:       "scheduled_job_runs.error",
+}
+
+// Generated where
+
+var ScheduledJobRunWhere = struct {
+	RunID       whereHelperuint64
+	JobName     whereHelperstring
+	InstanceID  whereHelperstring
+	ScheduledAt whereHelpertime_Time
+	StartedAt   whereHelpertime_Time
+	FinishedAt  whereHelpernull_Time
+	Status      whereHelperstring
+	Error       whereHelpernull_String
+}{
+	RunID:       whereHelperuint64{field: "`scheduled_job_runs`.`run_id`"},
+	JobName:     whereHelperstring{field: "`scheduled_job_runs`.`job_name`"},
+	InstanceID:  whereHelperstring{field: "`scheduled_job_runs`.`instance_id`"},
+	ScheduledAt: whereHelpertime_Time{field: "`scheduled_job_runs`.`scheduled_at`"},
+	StartedAt:   whereHelpertime_Time{field: "`scheduled_job_runs`.`started_at`"},
+	FinishedAt:  whereHelpernull_Time{field: "`scheduled_job_runs`.`finished_at`"},
+	Status:      whereHelperstring{field: "`scheduled_job_runs`.`status`"},
+	Error:       whereHelpernull_String{field: "`scheduled_job_runs`.`error`"},
+}
+
+// ScheduledJobRunRels is where relationship names are stored.
+var ScheduledJobRunRels = struct {
+	JobNameScheduledJob string
+}{
+	JobNameScheduledJob: "JobNameScheduledJob",
+}
+
+// scheduledJobRunR is where relationships are stored.
+type scheduledJobRunR struct {
+	JobNameScheduledJob *ScheduledJob `boil:"JobNameScheduledJob" json:"JobNameScheduledJob" toml:"JobNameScheduledJob" yaml:"JobNameScheduledJob"`
+}
+
+// NewStruct creates a new relationship struct
+func (*scheduledJobRunR) NewStruct() *scheduledJobRunR {
+	return &scheduledJobRunR{}
+}
+
+// scheduledJobRunL is where Load methods for each relationship are stored.
+type scheduledJobRunL struct{}
+
+var (
+	scheduledJobRunAllColumns            = []string{"run_id", "job_name", "instance_id", "scheduled_at", "started_at", "finished_at", "status", "error"}
+	scheduledJobRunColumnsWithoutDefault = []string{"job_name", "instance_id", "scheduled_at", "started_at", "finished_at", "status", "error"}
+	scheduledJobRunColumnsWithDefault    = []string{"run_id"}
+	scheduledJobRunPrimaryKeyColumns     = []string{"run_id"}
+)
+
+type (
+	// ScheduledJobRunSlice is an alias for a slice of pointers to ScheduledJobRun.
+	// This should almost always be used instead of []ScheduledJobRun.
+	ScheduledJobRunSlice []*ScheduledJobRun
+	// ScheduledJobRunHook is the signature for custom ScheduledJobRun hook methods
+	ScheduledJobRunHook func(context.Context, boil.ContextExecutor, *ScheduledJobRun) error
+
+	scheduledJobRunQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scheduledJobRunType                 = reflect.TypeOf(&ScheduledJobRun{})
+	scheduledJobRunMapping              = queries.MakeStructMapping(scheduledJobRunType)
+	scheduledJobRunPrimaryKeyMapping, _ = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, scheduledJobRunPrimaryKeyColumns)
+	scheduledJobRunInsertCacheMut       sync.RWMutex
+	scheduledJobRunInsertCache          = make(map[string]insertCache)
+	scheduledJobRunUpdateCacheMut       sync.RWMutex
+	scheduledJobRunUpdateCache          = make(map[string]updateCache)
+	scheduledJobRunUpsertCacheMut       sync.RWMutex
+	scheduledJobRunUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scheduledJobRunBeforeInsertHooks []ScheduledJobRunHook
+var scheduledJobRunBeforeUpdateHooks []ScheduledJobRunHook
+var scheduledJobRunBeforeDeleteHooks []ScheduledJobRunHook
+var scheduledJobRunBeforeUpsertHooks []ScheduledJobRunHook
+
+var scheduledJobRunAfterInsertHooks []ScheduledJobRunHook
+var scheduledJobRunAfterSelectHooks []ScheduledJobRunHook
+var scheduledJobRunAfterUpdateHooks []ScheduledJobRunHook
+var scheduledJobRunAfterDeleteHooks []ScheduledJobRunHook
+var scheduledJobRunAfterUpsertHooks []ScheduledJobRunHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ScheduledJobRun) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ScheduledJobRun) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ScheduledJobRun) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ScheduledJobRun) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ScheduledJobRun) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ScheduledJobRun) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ScheduledJobRun) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ScheduledJobRun) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ScheduledJobRun) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobRunAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScheduledJobRunHook registers your hook function for all future operations.
+func AddScheduledJobRunHook(hookPoint boil.HookPoint, scheduledJobRunHook ScheduledJobRunHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		scheduledJobRunBeforeInsertHooks = append(scheduledJobRunBeforeInsertHooks, scheduledJobRunHook)
+	case boil.BeforeUpdateHook:
+		scheduledJobRunBeforeUpdateHooks = append(scheduledJobRunBeforeUpdateHooks, scheduledJobRunHook)
+	case boil.BeforeDeleteHook:
+		scheduledJobRunBeforeDeleteHooks = append(scheduledJobRunBeforeDeleteHooks, scheduledJobRunHook)
+	case boil.BeforeUpsertHook:
+		scheduledJobRunBeforeUpsertHooks = append(scheduledJobRunBeforeUpsertHooks, scheduledJobRunHook)
+	case boil.AfterInsertHook:
+		scheduledJobRunAfterInsertHooks = append(scheduledJobRunAfterInsertHooks, scheduledJobRunHook)
+	case boil.AfterSelectHook:
+		scheduledJobRunAfterSelectHooks = append(scheduledJobRunAfterSelectHooks, scheduledJobRunHook)
+	case boil.AfterUpdateHook:
+		scheduledJobRunAfterUpdateHooks = append(scheduledJobRunAfterUpdateHooks, scheduledJobRunHook)
+	case boil.AfterDeleteHook:
+		scheduledJobRunAfterDeleteHooks = append(scheduledJobRunAfterDeleteHooks, scheduledJobRunHook)
+	case boil.AfterUpsertHook:
+		scheduledJobRunAfterUpsertHooks = append(scheduledJobRunAfterUpsertHooks, scheduledJobRunHook)
+	}
+}
+
+// One returns a single scheduledJobRun record from the query.
+func (q scheduledJobRunQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ScheduledJobRun, error) {
+	o := &ScheduledJobRun{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for scheduled_job_runs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ScheduledJobRun records from the query.
+func (q scheduledJobRunQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScheduledJobRunSlice, error) {
+	var o []*ScheduledJobRun
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ScheduledJobRun slice")
+	}
+
+	if len(scheduledJobRunAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ScheduledJobRun records in the query.
+func (q scheduledJobRunQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count scheduled_job_runs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scheduledJobRunQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if scheduled_job_runs exists")
+	}
+
+	return count > 0, nil
+}
+
+// JobNameScheduledJob pointed to by the foreign key.
+func (o *ScheduledJobRun) JobNameScheduledJob(mods ...qm.QueryMod) scheduledJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`job_name` = ?", o.JobName),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ScheduledJobs(queryMods...)
+	queries.SetFrom(query.Query, "`scheduled_jobs`")
+
+	return query
+}
+
+// LoadJobNameScheduledJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scheduledJobRunL) LoadJobNameScheduledJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScheduledJobRun interface{}, mods queries.Applicator) error {
+	var slice []*ScheduledJobRun
+	var object *ScheduledJobRun
+
+	if singular {
+		object = maybeScheduledJobRun.(*ScheduledJobRun)
+	} else {
+		slice = *maybeScheduledJobRun.(*[]*ScheduledJobRun)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduledJobRunR{}
+		}
+		args = append(args, object.JobName)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduledJobRunR{}
+			}
+
+			for _, a := range args {
+				if a == obj.JobName {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.JobName)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`scheduled_jobs`),
+		qm.WhereIn(`scheduled_jobs.job_name in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ScheduledJob")
+	}
+
+	var resultSlice []*ScheduledJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ScheduledJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for scheduled_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scheduled_jobs")
+	}
+
+	if len(scheduledJobRunAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.JobNameScheduledJob = foreign
+		if foreign.R == nil {
+			foreign.R = &scheduledJobR{}
+		}
+		foreign.R.JobNameScheduledJobRuns = append(foreign.R.JobNameScheduledJobRuns, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.JobName == foreign.JobName {
+				local.R.JobNameScheduledJob = foreign
+				if foreign.R == nil {
+					foreign.R = &scheduledJobR{}
+				}
+				foreign.R.JobNameScheduledJobRuns = append(foreign.R.JobNameScheduledJobRuns, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetJobNameScheduledJob of the scheduledJobRun to the related item.
+// Sets o.R.JobNameScheduledJob to related.
+// Adds o to related.R.JobNameScheduledJobRuns.
+func (o *ScheduledJobRun) SetJobNameScheduledJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ScheduledJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `scheduled_job_runs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"job_name"}),
+		strmangle.WhereClause("`", "`", 0, scheduledJobRunPrimaryKeyColumns),
+	)
+	values := []interface{}{related.JobName, o.RunID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.JobName = related.JobName
+	if o.R == nil {
+		o.R = &scheduledJobRunR{
+			JobNameScheduledJob: related,
+		}
+	} else {
+		o.R.JobNameScheduledJob = related
+	}
+
+	if related.R == nil {
+		related.R = &scheduledJobR{
+			JobNameScheduledJobRuns: ScheduledJobRunSlice{o},
+		}
+	} else {
+		related.R.JobNameScheduledJobRuns = append(related.R.JobNameScheduledJobRuns, o)
+	}
+
+	return nil
+}
+
+// ScheduledJobRuns retrieves all the records using an executor.
+func ScheduledJobRuns(mods ...qm.QueryMod) scheduledJobRunQuery {
+	mods = append(mods, qm.From("`scheduled_job_runs`"))
+	return scheduledJobRunQuery{NewQuery(mods...)}
+}
+
+// FindScheduledJobRun retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindScheduledJobRun(ctx context.Context, exec boil.ContextExecutor, runID uint64, selectCols ...string) (*ScheduledJobRun, error) {
+	scheduledJobRunObj := &ScheduledJobRun{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `scheduled_job_runs` where `run_id`=?", sel,
+	)
+
+	q := queries.Raw(query, runID)
+
+	err := q.Bind(ctx, exec, scheduledJobRunObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from scheduled_job_runs")
+	}
+
+	if err = scheduledJobRunObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return scheduledJobRunObj, err
+	}
+
+	return scheduledJobRunObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ScheduledJobRun) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no scheduled_job_runs provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduledJobRunColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scheduledJobRunInsertCacheMut.RLock()
+	cache, cached := scheduledJobRunInsertCache[key]
+	scheduledJobRunInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scheduledJobRunAllColumns,
+			scheduledJobRunColumnsWithDefault,
+			scheduledJobRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `scheduled_job_runs` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `scheduled_job_runs` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `scheduled_job_runs` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, scheduledJobRunPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into scheduled_job_runs")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.RunID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == scheduledJobRunMapping["run_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.RunID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for scheduled_job_runs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scheduledJobRunInsertCacheMut.Lock()
+		scheduledJobRunInsertCache[key] = cache
+		scheduledJobRunInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ScheduledJobRun.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ScheduledJobRun) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scheduledJobRunUpdateCacheMut.RLock()
+	cache, cached := scheduledJobRunUpdateCache[key]
+	scheduledJobRunUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scheduledJobRunAllColumns,
+			scheduledJobRunPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update scheduled_job_runs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `scheduled_job_runs` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, scheduledJobRunPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, append(wl, scheduledJobRunPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update scheduled_job_runs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for scheduled_job_runs")
+	}
+
+	if !cached {
+		scheduledJobRunUpdateCacheMut.Lock()
+		scheduledJobRunUpdateCache[key] = cache
+		scheduledJobRunUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scheduledJobRunQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for scheduled_job_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for scheduled_job_runs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScheduledJobRunSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `scheduled_job_runs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobRunPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in scheduledJobRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all scheduledJobRun")
+	}
+	return rowsAff, nil
+}
+
+var mySQLScheduledJobRunUniqueColumns = []string{
+	"run_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ScheduledJobRun) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no scheduled_job_runs provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduledJobRunColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLScheduledJobRunUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	scheduledJobRunUpsertCacheMut.RLock()
+	cache, cached := scheduledJobRunUpsertCache[key]
+	scheduledJobRunUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			scheduledJobRunAllColumns,
+			scheduledJobRunColumnsWithDefault,
+			scheduledJobRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			scheduledJobRunAllColumns,
+			scheduledJobRunPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert scheduled_job_runs, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`scheduled_job_runs`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `scheduled_job_runs` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for scheduled_job_runs")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.RunID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == scheduledJobRunMapping["run_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(scheduledJobRunType, scheduledJobRunMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for scheduled_job_runs")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for scheduled_job_runs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scheduledJobRunUpsertCacheMut.Lock()
+		scheduledJobRunUpsertCache[key] = cache
+		scheduledJobRunUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ScheduledJobRun record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ScheduledJobRun) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ScheduledJobRun provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), scheduledJobRunPrimaryKeyMapping)
+	sql := "DELETE FROM `scheduled_job_runs` WHERE `run_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from scheduled_job_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for scheduled_job_runs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scheduledJobRunQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no scheduledJobRunQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from scheduled_job_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for scheduled_job_runs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScheduledJobRunSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scheduledJobRunBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `scheduled_job_runs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobRunPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from scheduledJobRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for scheduled_job_runs")
+	}
+
+	if len(scheduledJobRunAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ScheduledJobRun) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindScheduledJobRun(ctx, exec, o.RunID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScheduledJobRunSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScheduledJobRunSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `scheduled_job_runs`.* FROM `scheduled_job_runs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobRunPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ScheduledJobRunSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScheduledJobRunExists checks if the ScheduledJobRun row exists.
+func ScheduledJobRunExists(ctx context.Context, exec boil.ContextExecutor, runID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `scheduled_job_runs` where `run_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runID)
+	}
+	row := exec.QueryRowContext(ctx, sql, runID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if scheduled_job_runs exists")
+	}
+
+	return exists, nil
+}
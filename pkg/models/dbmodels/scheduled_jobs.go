@@ -0,0 +1,1175 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ScheduledJob is an object representing the database table.
+type ScheduledJob struct {
+	JobName         string      `boil:"job_name" json:"job_name" toml:"job_name" yaml:"job_name"`
+	Schedule        string      `boil:"schedule" json:"schedule" toml:"schedule" yaml:"schedule"`
+	LastScheduledAt null.Time   `boil:"last_scheduled_at" json:"last_scheduled_at,omitempty" toml:"last_scheduled_at" yaml:"last_scheduled_at,omitempty"`
+	LastStartedAt   null.Time   `boil:"last_started_at" json:"last_started_at,omitempty" toml:"last_started_at" yaml:"last_started_at,omitempty"`
+	LastFinishedAt  null.Time   `boil:"last_finished_at" json:"last_finished_at,omitempty" toml:"last_finished_at" yaml:"last_finished_at,omitempty"`
+	LastStatus      string      `boil:"last_status" json:"last_status" toml:"last_status" yaml:"last_status"`
+	LastError       null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	LastErrorAt     null.Time   `boil:"last_error_at" json:"last_error_at,omitempty" toml:"last_error_at" yaml:"last_error_at,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *scheduledJobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scheduledJobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScheduledJobColumns = struct {
+	JobName         string
+	Schedule        string
+	LastScheduledAt string
+	LastStartedAt   string
+	LastFinishedAt  string
+	LastStatus      string
+	LastError       string
+	LastErrorAt     string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	JobName:         "job_name",
+	Schedule:        "schedule",
+	LastScheduledAt: "last_scheduled_at",
+	LastStartedAt:   "last_started_at",
+	LastFinishedAt:  "last_finished_at",
+	LastStatus:      "last_status",
+	LastError:       "last_error",
+	LastErrorAt:     "last_error_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var ScheduledJobTableColumns = struct {
+	JobName         string
+	Schedule        string
+	LastScheduledAt string
+	LastStartedAt   string
+	LastFinishedAt  string
+	LastStatus      string
+	LastError       string
+	LastErrorAt     string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	JobName:         "scheduled_jobs.job_name",
+	Schedule:        "scheduled_jobs.schedule",
+	LastScheduledAt: "scheduled_jobs.last_scheduled_at",
+	LastStartedAt:   "scheduled_jobs.last_started_at",
+	LastFinishedAt:  "scheduled_jobs.last_finished_at",
+	LastStatus:      "scheduled_jobs.last_status",
+	LastError:       "scheduled_jobs.last_error",
+	LastErrorAt:     "scheduled_jobs.last_error_at",
+	CreatedAt:       "scheduled_jobs.created_at",
+	UpdatedAt:       "scheduled_jobs.updated_at",
+}
+
+// Generated where
+
+var ScheduledJobWhere = struct {
+	JobName         whereHelperstring
+	Schedule        whereHelperstring
+	LastScheduledAt whereHelpernull_Time
+	LastStartedAt   whereHelpernull_Time
+	LastFinishedAt  whereHelpernull_Time
+	LastStatus      whereHelperstring
+	LastError       whereHelpernull_String
+	LastErrorAt     whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	JobName:         whereHelperstring{field: "`scheduled_jobs`.`job_name`"},
+	Schedule:        whereHelperstring{field: "`scheduled_jobs`.`schedule`"},
+	LastScheduledAt: whereHelpernull_Time{field: "`scheduled_jobs`.`last_scheduled_at`"},
+	LastStartedAt:   whereHelpernull_Time{field: "`scheduled_jobs`.`last_started_at`"},
+	LastFinishedAt:  whereHelpernull_Time{field: "`scheduled_jobs`.`last_finished_at`"},
+	LastStatus:      whereHelperstring{field: "`scheduled_jobs`.`last_status`"},
+	LastError:       whereHelpernull_String{field: "`scheduled_jobs`.`last_error`"},
+	LastErrorAt:     whereHelpernull_Time{field: "`scheduled_jobs`.`last_error_at`"},
+	CreatedAt:       whereHelpertime_Time{field: "`scheduled_jobs`.`created_at`"},
+	UpdatedAt:       whereHelpertime_Time{field: "`scheduled_jobs`.`updated_at`"},
+}
+
+// ScheduledJobRels is where relationship names are stored.
+var ScheduledJobRels = struct {
+	JobNameScheduledJobRuns string
+}{
+	JobNameScheduledJobRuns: "JobNameScheduledJobRuns",
+}
+
+// scheduledJobR is where relationships are stored.
+type scheduledJobR struct {
+	JobNameScheduledJobRuns ScheduledJobRunSlice `boil:"JobNameScheduledJobRuns" json:"JobNameScheduledJobRuns" toml:"JobNameScheduledJobRuns" yaml:"JobNameScheduledJobRuns"`
+}
+
+// NewStruct creates a new relationship struct
+func (*scheduledJobR) NewStruct() *scheduledJobR {
+	return &scheduledJobR{}
+}
+
+// scheduledJobL is where Load methods for each relationship are stored.
+type scheduledJobL struct{}
+
+var (
+	scheduledJobAllColumns            = []string{"job_name", "schedule", "last_scheduled_at", "last_started_at", "last_finished_at", "last_status", "last_error", "last_error_at", "created_at", "updated_at"}
+	scheduledJobColumnsWithoutDefault = []string{"job_name", "schedule", "last_scheduled_at", "last_started_at", "last_finished_at", "last_status", "last_error", "last_error_at", "created_at", "updated_at"}
+	scheduledJobColumnsWithDefault    = []string{}
+	scheduledJobPrimaryKeyColumns     = []string{"job_name"}
+)
+
+type (
+	// ScheduledJobSlice is an alias for a slice of pointers to ScheduledJob.
+	// This should almost always be used instead of []ScheduledJob.
+	ScheduledJobSlice []*ScheduledJob
+	// ScheduledJobHook is the signature for custom ScheduledJob hook methods
+	ScheduledJobHook func(context.Context, boil.ContextExecutor, *ScheduledJob) error
+
+	scheduledJobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scheduledJobType                 = reflect.TypeOf(&ScheduledJob{})
+	scheduledJobMapping              = queries.MakeStructMapping(scheduledJobType)
+	scheduledJobPrimaryKeyMapping, _ = queries.BindMapping(scheduledJobType, scheduledJobMapping, scheduledJobPrimaryKeyColumns)
+	scheduledJobInsertCacheMut       sync.RWMutex
+	scheduledJobInsertCache          = make(map[string]insertCache)
+	scheduledJobUpdateCacheMut       sync.RWMutex
+	scheduledJobUpdateCache          = make(map[string]updateCache)
+	scheduledJobUpsertCacheMut       sync.RWMutex
+	scheduledJobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scheduledJobBeforeInsertHooks []ScheduledJobHook
+var scheduledJobBeforeUpdateHooks []ScheduledJobHook
+var scheduledJobBeforeDeleteHooks []ScheduledJobHook
+var scheduledJobBeforeUpsertHooks []ScheduledJobHook
+
+var scheduledJobAfterInsertHooks []ScheduledJobHook
+var scheduledJobAfterSelectHooks []ScheduledJobHook
+var scheduledJobAfterUpdateHooks []ScheduledJobHook
+var scheduledJobAfterDeleteHooks []ScheduledJobHook
+var scheduledJobAfterUpsertHooks []ScheduledJobHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ScheduledJob) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ScheduledJob) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ScheduledJob) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ScheduledJob) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ScheduledJob) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ScheduledJob) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ScheduledJob) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ScheduledJob) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ScheduledJob) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduledJobAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScheduledJobHook registers your hook function for all future operations.
+func AddScheduledJobHook(hookPoint boil.HookPoint, scheduledJobHook ScheduledJobHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		scheduledJobBeforeInsertHooks = append(scheduledJobBeforeInsertHooks, scheduledJobHook)
+	case boil.BeforeUpdateHook:
+		scheduledJobBeforeUpdateHooks = append(scheduledJobBeforeUpdateHooks, scheduledJobHook)
+	case boil.BeforeDeleteHook:
+		scheduledJobBeforeDeleteHooks = append(scheduledJobBeforeDeleteHooks, scheduledJobHook)
+	case boil.BeforeUpsertHook:
+		scheduledJobBeforeUpsertHooks = append(scheduledJobBeforeUpsertHooks, scheduledJobHook)
+	case boil.AfterInsertHook:
+		scheduledJobAfterInsertHooks = append(scheduledJobAfterInsertHooks, scheduledJobHook)
+	case boil.AfterSelectHook:
+		scheduledJobAfterSelectHooks = append(scheduledJobAfterSelectHooks, scheduledJobHook)
+	case boil.AfterUpdateHook:
+		scheduledJobAfterUpdateHooks = append(scheduledJobAfterUpdateHooks, scheduledJobHook)
+	case boil.AfterDeleteHook:
+		scheduledJobAfterDeleteHooks = append(scheduledJobAfterDeleteHooks, scheduledJobHook)
+	case boil.AfterUpsertHook:
+		scheduledJobAfterUpsertHooks = append(scheduledJobAfterUpsertHooks, scheduledJobHook)
+	}
+}
+
+// One returns a single scheduledJob record from the query.
+func (q scheduledJobQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ScheduledJob, error) {
+	o := &ScheduledJob{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for scheduled_jobs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ScheduledJob records from the query.
+func (q scheduledJobQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScheduledJobSlice, error) {
+	var o []*ScheduledJob
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ScheduledJob slice")
+	}
+
+	if len(scheduledJobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ScheduledJob records in the query.
+func (q scheduledJobQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count scheduled_jobs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scheduledJobQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if scheduled_jobs exists")
+	}
+
+	return count > 0, nil
+}
+
+// JobNameScheduledJobRuns retrieves all the scheduled_job_run's ScheduledJobRuns with an executor via job_name column.
+func (o *ScheduledJob) JobNameScheduledJobRuns(mods ...qm.QueryMod) scheduledJobRunQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`scheduled_job_runs`.`job_name`=?", o.JobName),
+	)
+
+	query := ScheduledJobRuns(queryMods...)
+	queries.SetFrom(query.Query, "`scheduled_job_runs`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`scheduled_job_runs`.*"})
+	}
+
+	return query
+}
+
+// LoadJobNameScheduledJobRuns allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (scheduledJobL) LoadJobNameScheduledJobRuns(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScheduledJob interface{}, mods queries.Applicator) error {
+	var slice []*ScheduledJob
+	var object *ScheduledJob
+
+	if singular {
+		object = maybeScheduledJob.(*ScheduledJob)
+	} else {
+		slice = *maybeScheduledJob.(*[]*ScheduledJob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &scheduledJobR{}
+		}
+		args = append(args, object.JobName)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduledJobR{}
+			}
+
+			for _, a := range args {
+				if a == obj.JobName {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.JobName)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`scheduled_job_runs`),
+		qm.WhereIn(`scheduled_job_runs.job_name in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scheduled_job_runs")
+	}
+
+	var resultSlice []*ScheduledJobRun
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scheduled_job_runs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scheduled_job_runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scheduled_job_runs")
+	}
+
+	if len(scheduledJobRunAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.JobNameScheduledJobRuns = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scheduledJobRunR{}
+			}
+			foreign.R.JobNameScheduledJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.JobName == foreign.JobName {
+				local.R.JobNameScheduledJobRuns = append(local.R.JobNameScheduledJobRuns, foreign)
+				if foreign.R == nil {
+					foreign.R = &scheduledJobRunR{}
+				}
+				foreign.R.JobNameScheduledJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddJobNameScheduledJobRuns adds the given related objects to the existing relationships
+// of the scheduled_job, optionally inserting them as new records.
+// Appends related to o.R.JobNameScheduledJobRuns.
+// Sets related.R.JobNameScheduledJob appropriately.
+func (o *ScheduledJob) AddJobNameScheduledJobRuns(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ScheduledJobRun) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.JobName = o.JobName
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `scheduled_job_runs` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"job_name"}),
+				strmangle.WhereClause("`", "`", 0, scheduledJobRunPrimaryKeyColumns),
+			)
+			values := []interface{}{o.JobName, rel.RunID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.JobName = o.JobName
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scheduledJobR{
+			JobNameScheduledJobRuns: related,
+		}
+	} else {
+		o.R.JobNameScheduledJobRuns = append(o.R.JobNameScheduledJobRuns, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scheduledJobRunR{
+				JobNameScheduledJob: o,
+			}
+		} else {
+			rel.R.JobNameScheduledJob = o
+		}
+	}
+	return nil
+}
+
+// ScheduledJobs retrieves all the records using an executor.
+func ScheduledJobs(mods ...qm.QueryMod) scheduledJobQuery {
+	mods = append(mods, qm.From("`scheduled_jobs`"))
+	return scheduledJobQuery{NewQuery(mods...)}
+}
+
+// FindScheduledJob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindScheduledJob(ctx context.Context, exec boil.ContextExecutor, jobName string, selectCols ...string) (*ScheduledJob, error) {
+	scheduledJobObj := &ScheduledJob{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `scheduled_jobs` where `job_name`=?", sel,
+	)
+
+	q := queries.Raw(query, jobName)
+
+	err := q.Bind(ctx, exec, scheduledJobObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from scheduled_jobs")
+	}
+
+	if err = scheduledJobObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return scheduledJobObj, err
+	}
+
+	return scheduledJobObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ScheduledJob) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no scheduled_jobs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduledJobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scheduledJobInsertCacheMut.RLock()
+	cache, cached := scheduledJobInsertCache[key]
+	scheduledJobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scheduledJobAllColumns,
+			scheduledJobColumnsWithDefault,
+			scheduledJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `scheduled_jobs` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `scheduled_jobs` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `scheduled_jobs` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, scheduledJobPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into scheduled_jobs")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.JobName,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for scheduled_jobs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scheduledJobInsertCacheMut.Lock()
+		scheduledJobInsertCache[key] = cache
+		scheduledJobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ScheduledJob.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ScheduledJob) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scheduledJobUpdateCacheMut.RLock()
+	cache, cached := scheduledJobUpdateCache[key]
+	scheduledJobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scheduledJobAllColumns,
+			scheduledJobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update scheduled_jobs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `scheduled_jobs` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, scheduledJobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, append(wl, scheduledJobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update scheduled_jobs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for scheduled_jobs")
+	}
+
+	if !cached {
+		scheduledJobUpdateCacheMut.Lock()
+		scheduledJobUpdateCache[key] = cache
+		scheduledJobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scheduledJobQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for scheduled_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for scheduled_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScheduledJobSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `scheduled_jobs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in scheduledJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all scheduledJob")
+	}
+	return rowsAff, nil
+}
+
+var mySQLScheduledJobUniqueColumns = []string{
+	"job_name",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ScheduledJob) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no scheduled_jobs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduledJobColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLScheduledJobUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	scheduledJobUpsertCacheMut.RLock()
+	cache, cached := scheduledJobUpsertCache[key]
+	scheduledJobUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			scheduledJobAllColumns,
+			scheduledJobColumnsWithDefault,
+			scheduledJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			scheduledJobAllColumns,
+			scheduledJobPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert scheduled_jobs, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`scheduled_jobs`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `scheduled_jobs` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for scheduled_jobs")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(scheduledJobType, scheduledJobMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for scheduled_jobs")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for scheduled_jobs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scheduledJobUpsertCacheMut.Lock()
+		scheduledJobUpsertCache[key] = cache
+		scheduledJobUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ScheduledJob record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ScheduledJob) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ScheduledJob provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), scheduledJobPrimaryKeyMapping)
+	sql := "DELETE FROM `scheduled_jobs` WHERE `job_name`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from scheduled_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for scheduled_jobs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scheduledJobQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no scheduledJobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from scheduled_jobs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for scheduled_jobs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScheduledJobSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scheduledJobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `scheduled_jobs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from scheduledJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for scheduled_jobs")
+	}
+
+	if len(scheduledJobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ScheduledJob) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindScheduledJob(ctx, exec, o.JobName)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScheduledJobSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScheduledJobSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scheduledJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `scheduled_jobs`.* FROM `scheduled_jobs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scheduledJobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ScheduledJobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScheduledJobExists checks if the ScheduledJob row exists.
+func ScheduledJobExists(ctx context.Context, exec boil.ContextExecutor, jobName string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `scheduled_jobs` where `job_name`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, jobName)
+	}
+	row := exec.QueryRowContext(ctx, sql, jobName)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if scheduled_jobs exists")
+	}
+
+	return exists, nil
+}
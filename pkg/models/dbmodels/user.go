@@ -206,53 +206,92 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	ImpartWealthProfile                    string
-	ImpartWealthComments                   string
-	ImpartWealthCommentEdits               string
-	ImpartWealthCommentReactions           string
-	AdminHiveHives                         string
-	MemberHiveHives                        string
-	ImpartWealthNotificationDeviceMappings string
-	ImpartWealthPosts                      string
-	ImpartWealthPostEdits                  string
-	ImpartWealthPostReactions              string
-	ImpartWealthUserAnswers                string
-	ImpartWealthUserConfigurations         string
-	ImpartWealthUserDevices                string
-	ImpartWealthUserInstitutions           string
+	ImpartWealthProfile                     string
+	ImpartWealthComments                    string
+	ImpartWealthCommentEdits                string
+	ImpartWealthCommentReactions            string
+	ImpartWealthContentAppeals              string
+	ResolvedByContentAppeals                string
+	ImpartWealthDataExports                 string
+	ImpartWealthDrafts                      string
+	ImpartWealthFiles                       string
+	AdminHiveHives                          string
+	MemberHiveHives                         string
+	ImpartWealthMentions                    string
+	ModeratorModerationDecisions            string
+	ImpartWealthNotificationDeviceMappings  string
+	ImpartWealthPosts                       string
+	ImpartWealthPostEdits                   string
+	ImpartWealthPostFollows                 string
+	ImpartWealthPostReactions               string
+	ImpartWealthUserAnswers                 string
+	ImpartWealthUserConfigurations          string
+	ImpartWealthUserDevices                 string
+	ImpartWealthUserInstitutions            string
+	ImpartWealthUserNotificationPreferences string
+	ImpartWealthUserNotifications           string
+	ImpartWealthUserSanctions               string
+	IssuedByUserSanctions                   string
+	RevokedByUserSanctions                  string
 }{
-	ImpartWealthProfile:                    "ImpartWealthProfile",
-	ImpartWealthComments:                   "ImpartWealthComments",
-	ImpartWealthCommentEdits:               "ImpartWealthCommentEdits",
-	ImpartWealthCommentReactions:           "ImpartWealthCommentReactions",
-	AdminHiveHives:                         "AdminHiveHives",
-	MemberHiveHives:                        "MemberHiveHives",
-	ImpartWealthNotificationDeviceMappings: "ImpartWealthNotificationDeviceMappings",
-	ImpartWealthPosts:                      "ImpartWealthPosts",
-	ImpartWealthPostEdits:                  "ImpartWealthPostEdits",
-	ImpartWealthPostReactions:              "ImpartWealthPostReactions",
-	ImpartWealthUserAnswers:                "ImpartWealthUserAnswers",
-	ImpartWealthUserConfigurations:         "ImpartWealthUserConfigurations",
-	ImpartWealthUserDevices:                "ImpartWealthUserDevices",
-	ImpartWealthUserInstitutions:           "ImpartWealthUserInstitutions",
+	ImpartWealthProfile:                     "ImpartWealthProfile",
+	ImpartWealthComments:                    "ImpartWealthComments",
+	ImpartWealthCommentEdits:                "ImpartWealthCommentEdits",
+	ImpartWealthCommentReactions:            "ImpartWealthCommentReactions",
+	ImpartWealthContentAppeals:              "ImpartWealthContentAppeals",
+	ResolvedByContentAppeals:                "ResolvedByContentAppeals",
+	ImpartWealthDataExports:                 "ImpartWealthDataExports",
+	ImpartWealthDrafts:                      "ImpartWealthDrafts",
+	ImpartWealthFiles:                       "ImpartWealthFiles",
+	AdminHiveHives:                          "AdminHiveHives",
+	MemberHiveHives:                         "MemberHiveHives",
+	ImpartWealthMentions:                    "ImpartWealthMentions",
+	ModeratorModerationDecisions:            "ModeratorModerationDecisions",
+	ImpartWealthNotificationDeviceMappings:  "ImpartWealthNotificationDeviceMappings",
+	ImpartWealthPosts:                       "ImpartWealthPosts",
+	ImpartWealthPostEdits:                   "ImpartWealthPostEdits",
+	ImpartWealthPostFollows:                 "ImpartWealthPostFollows",
+	ImpartWealthPostReactions:               "ImpartWealthPostReactions",
+	ImpartWealthUserAnswers:                 "ImpartWealthUserAnswers",
+	ImpartWealthUserConfigurations:          "ImpartWealthUserConfigurations",
+	ImpartWealthUserDevices:                 "ImpartWealthUserDevices",
+	ImpartWealthUserInstitutions:            "ImpartWealthUserInstitutions",
+	ImpartWealthUserNotificationPreferences: "ImpartWealthUserNotificationPreferences",
+	ImpartWealthUserNotifications:           "ImpartWealthUserNotifications",
+	ImpartWealthUserSanctions:               "ImpartWealthUserSanctions",
+	IssuedByUserSanctions:                   "IssuedByUserSanctions",
+	RevokedByUserSanctions:                  "RevokedByUserSanctions",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	ImpartWealthProfile                    *Profile                       `boil:"ImpartWealthProfile" json:"ImpartWealthProfile" toml:"ImpartWealthProfile" yaml:"ImpartWealthProfile"`
-	ImpartWealthComments                   CommentSlice                   `boil:"ImpartWealthComments" json:"ImpartWealthComments" toml:"ImpartWealthComments" yaml:"ImpartWealthComments"`
-	ImpartWealthCommentEdits               CommentEditSlice               `boil:"ImpartWealthCommentEdits" json:"ImpartWealthCommentEdits" toml:"ImpartWealthCommentEdits" yaml:"ImpartWealthCommentEdits"`
-	ImpartWealthCommentReactions           CommentReactionSlice           `boil:"ImpartWealthCommentReactions" json:"ImpartWealthCommentReactions" toml:"ImpartWealthCommentReactions" yaml:"ImpartWealthCommentReactions"`
-	AdminHiveHives                         HiveSlice                      `boil:"AdminHiveHives" json:"AdminHiveHives" toml:"AdminHiveHives" yaml:"AdminHiveHives"`
-	MemberHiveHives                        HiveSlice                      `boil:"MemberHiveHives" json:"MemberHiveHives" toml:"MemberHiveHives" yaml:"MemberHiveHives"`
-	ImpartWealthNotificationDeviceMappings NotificationDeviceMappingSlice `boil:"ImpartWealthNotificationDeviceMappings" json:"ImpartWealthNotificationDeviceMappings" toml:"ImpartWealthNotificationDeviceMappings" yaml:"ImpartWealthNotificationDeviceMappings"`
-	ImpartWealthPosts                      PostSlice                      `boil:"ImpartWealthPosts" json:"ImpartWealthPosts" toml:"ImpartWealthPosts" yaml:"ImpartWealthPosts"`
-	ImpartWealthPostEdits                  PostEditSlice                  `boil:"ImpartWealthPostEdits" json:"ImpartWealthPostEdits" toml:"ImpartWealthPostEdits" yaml:"ImpartWealthPostEdits"`
-	ImpartWealthPostReactions              PostReactionSlice              `boil:"ImpartWealthPostReactions" json:"ImpartWealthPostReactions" toml:"ImpartWealthPostReactions" yaml:"ImpartWealthPostReactions"`
-	ImpartWealthUserAnswers                UserAnswerSlice                `boil:"ImpartWealthUserAnswers" json:"ImpartWealthUserAnswers" toml:"ImpartWealthUserAnswers" yaml:"ImpartWealthUserAnswers"`
-	ImpartWealthUserConfigurations         UserConfigurationSlice         `boil:"ImpartWealthUserConfigurations" json:"ImpartWealthUserConfigurations" toml:"ImpartWealthUserConfigurations" yaml:"ImpartWealthUserConfigurations"`
-	ImpartWealthUserDevices                UserDeviceSlice                `boil:"ImpartWealthUserDevices" json:"ImpartWealthUserDevices" toml:"ImpartWealthUserDevices" yaml:"ImpartWealthUserDevices"`
-	ImpartWealthUserInstitutions           UserInstitutionSlice           `boil:"ImpartWealthUserInstitutions" json:"ImpartWealthUserInstitutions" toml:"ImpartWealthUserInstitutions" yaml:"ImpartWealthUserInstitutions"`
+	ImpartWealthProfile                     *Profile                        `boil:"ImpartWealthProfile" json:"ImpartWealthProfile" toml:"ImpartWealthProfile" yaml:"ImpartWealthProfile"`
+	ImpartWealthComments                    CommentSlice                    `boil:"ImpartWealthComments" json:"ImpartWealthComments" toml:"ImpartWealthComments" yaml:"ImpartWealthComments"`
+	ImpartWealthCommentEdits                CommentEditSlice                `boil:"ImpartWealthCommentEdits" json:"ImpartWealthCommentEdits" toml:"ImpartWealthCommentEdits" yaml:"ImpartWealthCommentEdits"`
+	ImpartWealthCommentReactions            CommentReactionSlice            `boil:"ImpartWealthCommentReactions" json:"ImpartWealthCommentReactions" toml:"ImpartWealthCommentReactions" yaml:"ImpartWealthCommentReactions"`
+	ImpartWealthContentAppeals              ContentAppealSlice              `boil:"ImpartWealthContentAppeals" json:"ImpartWealthContentAppeals" toml:"ImpartWealthContentAppeals" yaml:"ImpartWealthContentAppeals"`
+	ResolvedByContentAppeals                ContentAppealSlice              `boil:"ResolvedByContentAppeals" json:"ResolvedByContentAppeals" toml:"ResolvedByContentAppeals" yaml:"ResolvedByContentAppeals"`
+	ImpartWealthDataExports                 DataExportSlice                 `boil:"ImpartWealthDataExports" json:"ImpartWealthDataExports" toml:"ImpartWealthDataExports" yaml:"ImpartWealthDataExports"`
+	ImpartWealthDrafts                      DraftSlice                      `boil:"ImpartWealthDrafts" json:"ImpartWealthDrafts" toml:"ImpartWealthDrafts" yaml:"ImpartWealthDrafts"`
+	ImpartWealthFiles                       FileSlice                       `boil:"ImpartWealthFiles" json:"ImpartWealthFiles" toml:"ImpartWealthFiles" yaml:"ImpartWealthFiles"`
+	AdminHiveHives                          HiveSlice                       `boil:"AdminHiveHives" json:"AdminHiveHives" toml:"AdminHiveHives" yaml:"AdminHiveHives"`
+	MemberHiveHives                         HiveSlice                       `boil:"MemberHiveHives" json:"MemberHiveHives" toml:"MemberHiveHives" yaml:"MemberHiveHives"`
+	ImpartWealthMentions                    MentionSlice                    `boil:"ImpartWealthMentions" json:"ImpartWealthMentions" toml:"ImpartWealthMentions" yaml:"ImpartWealthMentions"`
+	ModeratorModerationDecisions            ModerationDecisionSlice         `boil:"ModeratorModerationDecisions" json:"ModeratorModerationDecisions" toml:"ModeratorModerationDecisions" yaml:"ModeratorModerationDecisions"`
+	ImpartWealthNotificationDeviceMappings  NotificationDeviceMappingSlice  `boil:"ImpartWealthNotificationDeviceMappings" json:"ImpartWealthNotificationDeviceMappings" toml:"ImpartWealthNotificationDeviceMappings" yaml:"ImpartWealthNotificationDeviceMappings"`
+	ImpartWealthPosts                       PostSlice                       `boil:"ImpartWealthPosts" json:"ImpartWealthPosts" toml:"ImpartWealthPosts" yaml:"ImpartWealthPosts"`
+	ImpartWealthPostEdits                   PostEditSlice                   `boil:"ImpartWealthPostEdits" json:"ImpartWealthPostEdits" toml:"ImpartWealthPostEdits" yaml:"ImpartWealthPostEdits"`
+	ImpartWealthPostFollows                 PostFollowSlice                 `boil:"ImpartWealthPostFollows" json:"ImpartWealthPostFollows" toml:"ImpartWealthPostFollows" yaml:"ImpartWealthPostFollows"`
+	ImpartWealthPostReactions               PostReactionSlice               `boil:"ImpartWealthPostReactions" json:"ImpartWealthPostReactions" toml:"ImpartWealthPostReactions" yaml:"ImpartWealthPostReactions"`
+	ImpartWealthUserAnswers                 UserAnswerSlice                 `boil:"ImpartWealthUserAnswers" json:"ImpartWealthUserAnswers" toml:"ImpartWealthUserAnswers" yaml:"ImpartWealthUserAnswers"`
+	ImpartWealthUserConfigurations          UserConfigurationSlice          `boil:"ImpartWealthUserConfigurations" json:"ImpartWealthUserConfigurations" toml:"ImpartWealthUserConfigurations" yaml:"ImpartWealthUserConfigurations"`
+	ImpartWealthUserDevices                 UserDeviceSlice                 `boil:"ImpartWealthUserDevices" json:"ImpartWealthUserDevices" toml:"ImpartWealthUserDevices" yaml:"ImpartWealthUserDevices"`
+	ImpartWealthUserInstitutions            UserInstitutionSlice            `boil:"ImpartWealthUserInstitutions" json:"ImpartWealthUserInstitutions" toml:"ImpartWealthUserInstitutions" yaml:"ImpartWealthUserInstitutions"`
+	ImpartWealthUserNotificationPreferences UserNotificationPreferenceSlice `boil:"ImpartWealthUserNotificationPreferences" json:"ImpartWealthUserNotificationPreferences" toml:"ImpartWealthUserNotificationPreferences" yaml:"ImpartWealthUserNotificationPreferences"`
+	ImpartWealthUserNotifications           UserNotificationSlice           `boil:"ImpartWealthUserNotifications" json:"ImpartWealthUserNotifications" toml:"ImpartWealthUserNotifications" yaml:"ImpartWealthUserNotifications"`
+	ImpartWealthUserSanctions               UserSanctionSlice               `boil:"ImpartWealthUserSanctions" json:"ImpartWealthUserSanctions" toml:"ImpartWealthUserSanctions" yaml:"ImpartWealthUserSanctions"`
+	IssuedByUserSanctions                   UserSanctionSlice               `boil:"IssuedByUserSanctions" json:"IssuedByUserSanctions" toml:"IssuedByUserSanctions" yaml:"IssuedByUserSanctions"`
+	RevokedByUserSanctions                  UserSanctionSlice               `boil:"RevokedByUserSanctions" json:"RevokedByUserSanctions" toml:"RevokedByUserSanctions" yaml:"RevokedByUserSanctions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -625,6 +664,111 @@ func (o *User) ImpartWealthCommentReactions(mods ...qm.QueryMod) commentReaction
 	return query
 }
 
+// ImpartWealthContentAppeals retrieves all the content_appeal's ContentAppeals with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`content_appeals`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`content_appeals`.*"})
+	}
+
+	return query
+}
+
+// ResolvedByContentAppeals retrieves all the content_appeal's ContentAppeals with an executor via resolved_by column.
+func (o *User) ResolvedByContentAppeals(mods ...qm.QueryMod) contentAppealQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`content_appeals`.`resolved_by`=?", o.ImpartWealthID),
+	)
+
+	query := ContentAppeals(queryMods...)
+	queries.SetFrom(query.Query, "`content_appeals`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`content_appeals`.*"})
+	}
+
+	return query
+}
+
+// ImpartWealthDataExports retrieves all the data_export's DataExports with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthDataExports(mods ...qm.QueryMod) dataExportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_exports`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := DataExports(queryMods...)
+	queries.SetFrom(query.Query, "`data_exports`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`data_exports`.*"})
+	}
+
+	return query
+}
+
+// ImpartWealthDrafts retrieves all the draft's Drafts with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthDrafts(mods ...qm.QueryMod) draftQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`drafts`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := Drafts(queryMods...)
+	queries.SetFrom(query.Query, "`drafts`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`drafts`.*"})
+	}
+
+	return query
+}
+
+// ImpartWealthFiles retrieves all the file's Files with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthFiles(mods ...qm.QueryMod) fileQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`files`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := Files(queryMods...)
+	queries.SetFrom(query.Query, "`files`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`files`.*"})
+	}
+
+	return query
+}
+
 // AdminHiveHives retrieves all the hive's Hives with an executor via hive_id column.
 func (o *User) AdminHiveHives(mods ...qm.QueryMod) hiveQuery {
 	var queryMods []qm.QueryMod
@@ -669,6 +813,48 @@ func (o *User) MemberHiveHives(mods ...qm.QueryMod) hiveQuery {
 	return query
 }
 
+// ImpartWealthMentions retrieves all the mention's Mentions with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthMentions(mods ...qm.QueryMod) mentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`mentions`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := Mentions(queryMods...)
+	queries.SetFrom(query.Query, "`mentions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`mentions`.*"})
+	}
+
+	return query
+}
+
+// ModeratorModerationDecisions retrieves all the moderation_decision's ModerationDecisions with an executor via moderator_id column.
+func (o *User) ModeratorModerationDecisions(mods ...qm.QueryMod) moderationDecisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`moderation_decisions`.`moderator_id`=?", o.ImpartWealthID),
+	)
+
+	query := ModerationDecisions(queryMods...)
+	queries.SetFrom(query.Query, "`moderation_decisions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`moderation_decisions`.*"})
+	}
+
+	return query
+}
+
 // ImpartWealthNotificationDeviceMappings retrieves all the notification_device_mapping's NotificationDeviceMappings with an executor via impart_wealth_id column.
 func (o *User) ImpartWealthNotificationDeviceMappings(mods ...qm.QueryMod) notificationDeviceMappingQuery {
 	var queryMods []qm.QueryMod
@@ -734,6 +920,27 @@ func (o *User) ImpartWealthPostEdits(mods ...qm.QueryMod) postEditQuery {
 	return query
 }
 
+// ImpartWealthPostFollows retrieves all the post_follow's PostFollows with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthPostFollows(mods ...qm.QueryMod) postFollowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`post_follows`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := PostFollows(queryMods...)
+	queries.SetFrom(query.Query, "`post_follows`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`post_follows`.*"})
+	}
+
+	return query
+}
+
 // ImpartWealthPostReactions retrieves all the post_reaction's PostReactions with an executor via impart_wealth_id column.
 func (o *User) ImpartWealthPostReactions(mods ...qm.QueryMod) postReactionQuery {
 	var queryMods []qm.QueryMod
@@ -843,6 +1050,111 @@ func (o *User) ImpartWealthUserInstitutions(mods ...qm.QueryMod) userInstitution
 	return query
 }
 
+// ImpartWealthUserNotificationPreferences retrieves all the user_notification_preference's UserNotificationPreferences with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthUserNotificationPreferences(mods ...qm.QueryMod) userNotificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_notification_preferences`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := UserNotificationPreferences(queryMods...)
+	queries.SetFrom(query.Query, "`user_notification_preferences`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_notification_preferences`.*"})
+	}
+
+	return query
+}
+
+// ImpartWealthUserNotifications retrieves all the user_notification's UserNotifications with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthUserNotifications(mods ...qm.QueryMod) userNotificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_notifications`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := UserNotifications(queryMods...)
+	queries.SetFrom(query.Query, "`user_notifications`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_notifications`.*"})
+	}
+
+	return query
+}
+
+// ImpartWealthUserSanctions retrieves all the user_sanction's UserSanctions with an executor via impart_wealth_id column.
+func (o *User) ImpartWealthUserSanctions(mods ...qm.QueryMod) userSanctionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_sanctions`.`impart_wealth_id`=?", o.ImpartWealthID),
+	)
+
+	query := UserSanctions(queryMods...)
+	queries.SetFrom(query.Query, "`user_sanctions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_sanctions`.*"})
+	}
+
+	return query
+}
+
+// IssuedByUserSanctions retrieves all the user_sanction's UserSanctions with an executor via issued_by column.
+func (o *User) IssuedByUserSanctions(mods ...qm.QueryMod) userSanctionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_sanctions`.`issued_by`=?", o.ImpartWealthID),
+	)
+
+	query := UserSanctions(queryMods...)
+	queries.SetFrom(query.Query, "`user_sanctions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_sanctions`.*"})
+	}
+
+	return query
+}
+
+// RevokedByUserSanctions retrieves all the user_sanction's UserSanctions with an executor via revoked_by column.
+func (o *User) RevokedByUserSanctions(mods ...qm.QueryMod) userSanctionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_sanctions`.`revoked_by`=?", o.ImpartWealthID),
+	)
+
+	query := UserSanctions(queryMods...)
+	queries.SetFrom(query.Query, "`user_sanctions`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_sanctions`.*"})
+	}
+
+	return query
+}
+
 // LoadImpartWealthProfile allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadImpartWealthProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -1241,9 +1553,9 @@ func (userL) LoadImpartWealthCommentReactions(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadAdminHiveHives allows an eager lookup of values, cached into the
+// LoadImpartWealthContentAppeals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthContentAppeals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1281,11 +1593,8 @@ func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.Select("`hive`.hive_id, `hive`.name, `hive`.description, `hive`.pinned_post_id, `hive`.tag_comparisons, `hive`.notification_topic_arn, `hive`.hive_distributions, `hive`.created_at, `hive`.deleted_at, `a`.`admin_impart_wealth_id`"),
-		qm.From("`hive`"),
-		qm.InnerJoin("`hive_admins` as `a` on `hive`.`hive_id` = `a`.`admin_hive_id`"),
-		qm.WhereIn("`a`.`admin_impart_wealth_id` in ?", args...),
-		qmhelper.WhereIsNull("`hive`.`deleted_at`"),
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1293,36 +1602,22 @@ func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hive")
+		return errors.Wrap(err, "failed to eager load content_appeals")
 	}
 
-	var resultSlice []*Hive
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Hive)
-		var localJoinCol string
-
-		err = results.Scan(&one.HiveID, &one.Name, &one.Description, &one.PinnedPostID, &one.TagComparisons, &one.NotificationTopicArn, &one.HiveDistributions, &one.CreatedAt, &one.DeletedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for hive")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice hive")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*ContentAppeal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice content_appeals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hive")
+		return errors.Wrap(err, "failed to close results in eager load on content_appeals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
 	}
 
-	if len(hiveAfterSelectHooks) != 0 {
+	if len(contentAppealAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1330,25 +1625,24 @@ func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.AdminHiveHives = resultSlice
+		object.R.ImpartWealthContentAppeals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &hiveR{}
+				foreign.R = &contentAppealR{}
 			}
-			foreign.R.AdminImpartWealthUsers = append(foreign.R.AdminImpartWealthUsers, object)
+			foreign.R.ImpartWealth = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ImpartWealthID == localJoinCol {
-				local.R.AdminHiveHives = append(local.R.AdminHiveHives, foreign)
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthContentAppeals = append(local.R.ImpartWealthContentAppeals, foreign)
 				if foreign.R == nil {
-					foreign.R = &hiveR{}
+					foreign.R = &contentAppealR{}
 				}
-				foreign.R.AdminImpartWealthUsers = append(foreign.R.AdminImpartWealthUsers, local)
+				foreign.R.ImpartWealth = local
 				break
 			}
 		}
@@ -1357,9 +1651,9 @@ func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadMemberHiveHives allows an eager lookup of values, cached into the
+// LoadResolvedByContentAppeals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadResolvedByContentAppeals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1383,7 +1677,7 @@ func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, si
 			}
 
 			for _, a := range args {
-				if a == obj.ImpartWealthID {
+				if queries.Equal(a, obj.ImpartWealthID) {
 					continue Outer
 				}
 			}
@@ -1397,11 +1691,8 @@ func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.Select("`hive`.hive_id, `hive`.name, `hive`.description, `hive`.pinned_post_id, `hive`.tag_comparisons, `hive`.notification_topic_arn, `hive`.hive_distributions, `hive`.created_at, `hive`.deleted_at, `a`.`member_impart_wealth_id`"),
-		qm.From("`hive`"),
-		qm.InnerJoin("`hive_members` as `a` on `hive`.`hive_id` = `a`.`member_hive_id`"),
-		qm.WhereIn("`a`.`member_impart_wealth_id` in ?", args...),
-		qmhelper.WhereIsNull("`hive`.`deleted_at`"),
+		qm.From(`content_appeals`),
+		qm.WhereIn(`content_appeals.resolved_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1409,36 +1700,22 @@ func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load hive")
+		return errors.Wrap(err, "failed to eager load content_appeals")
 	}
 
-	var resultSlice []*Hive
+	var resultSlice []*ContentAppeal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice content_appeals")
+	}
 
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Hive)
-		var localJoinCol string
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on content_appeals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_appeals")
+	}
 
-		err = results.Scan(&one.HiveID, &one.Name, &one.Description, &one.PinnedPostID, &one.TagComparisons, &one.NotificationTopicArn, &one.HiveDistributions, &one.CreatedAt, &one.DeletedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for hive")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice hive")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on hive")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
-	}
-
-	if len(hiveAfterSelectHooks) != 0 {
+	if len(contentAppealAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1446,25 +1723,24 @@ func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 	if singular {
-		object.R.MemberHiveHives = resultSlice
+		object.R.ResolvedByContentAppeals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &hiveR{}
+				foreign.R = &contentAppealR{}
 			}
-			foreign.R.MemberImpartWealthUsers = append(foreign.R.MemberImpartWealthUsers, object)
+			foreign.R.ResolvedByUser = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ImpartWealthID == localJoinCol {
-				local.R.MemberHiveHives = append(local.R.MemberHiveHives, foreign)
+			if queries.Equal(local.ImpartWealthID, foreign.ResolvedBy) {
+				local.R.ResolvedByContentAppeals = append(local.R.ResolvedByContentAppeals, foreign)
 				if foreign.R == nil {
-					foreign.R = &hiveR{}
+					foreign.R = &contentAppealR{}
 				}
-				foreign.R.MemberImpartWealthUsers = append(foreign.R.MemberImpartWealthUsers, local)
+				foreign.R.ResolvedByUser = local
 				break
 			}
 		}
@@ -1473,9 +1749,9 @@ func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadImpartWealthNotificationDeviceMappings allows an eager lookup of values, cached into the
+// LoadImpartWealthDataExports allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthDataExports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1513,8 +1789,8 @@ func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e b
 	}
 
 	query := NewQuery(
-		qm.From(`notification_device_mapping`),
-		qm.WhereIn(`notification_device_mapping.impart_wealth_id in ?`, args...),
+		qm.From(`data_exports`),
+		qm.WhereIn(`data_exports.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1522,22 +1798,22 @@ func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load notification_device_mapping")
+		return errors.Wrap(err, "failed to eager load data_exports")
 	}
 
-	var resultSlice []*NotificationDeviceMapping
+	var resultSlice []*DataExport
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice notification_device_mapping")
+		return errors.Wrap(err, "failed to bind eager loaded slice data_exports")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on notification_device_mapping")
+		return errors.Wrap(err, "failed to close results in eager load on data_exports")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_device_mapping")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_exports")
 	}
 
-	if len(notificationDeviceMappingAfterSelectHooks) != 0 {
+	if len(dataExportAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1545,10 +1821,10 @@ func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e b
 		}
 	}
 	if singular {
-		object.R.ImpartWealthNotificationDeviceMappings = resultSlice
+		object.R.ImpartWealthDataExports = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &notificationDeviceMappingR{}
+				foreign.R = &dataExportR{}
 			}
 			foreign.R.ImpartWealth = object
 		}
@@ -1558,9 +1834,9 @@ func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e b
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthNotificationDeviceMappings = append(local.R.ImpartWealthNotificationDeviceMappings, foreign)
+				local.R.ImpartWealthDataExports = append(local.R.ImpartWealthDataExports, foreign)
 				if foreign.R == nil {
-					foreign.R = &notificationDeviceMappingR{}
+					foreign.R = &dataExportR{}
 				}
 				foreign.R.ImpartWealth = local
 				break
@@ -1571,9 +1847,9 @@ func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e b
 	return nil
 }
 
-// LoadImpartWealthPosts allows an eager lookup of values, cached into the
+// LoadImpartWealthDrafts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthDrafts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1611,9 +1887,8 @@ func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`post`),
-		qm.WhereIn(`post.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`post.deleted_at`),
+		qm.From(`drafts`),
+		qm.WhereIn(`drafts.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1621,22 +1896,22 @@ func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post")
+		return errors.Wrap(err, "failed to eager load drafts")
 	}
 
-	var resultSlice []*Post
+	var resultSlice []*Draft
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post")
+		return errors.Wrap(err, "failed to bind eager loaded slice drafts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post")
+		return errors.Wrap(err, "failed to close results in eager load on drafts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for drafts")
 	}
 
-	if len(postAfterSelectHooks) != 0 {
+	if len(draftAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1644,10 +1919,10 @@ func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.ImpartWealthPosts = resultSlice
+		object.R.ImpartWealthDrafts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postR{}
+				foreign.R = &draftR{}
 			}
 			foreign.R.ImpartWealth = object
 		}
@@ -1657,9 +1932,9 @@ func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor,
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthPosts = append(local.R.ImpartWealthPosts, foreign)
+				local.R.ImpartWealthDrafts = append(local.R.ImpartWealthDrafts, foreign)
 				if foreign.R == nil {
-					foreign.R = &postR{}
+					foreign.R = &draftR{}
 				}
 				foreign.R.ImpartWealth = local
 				break
@@ -1670,9 +1945,9 @@ func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadImpartWealthPostEdits allows an eager lookup of values, cached into the
+// LoadImpartWealthFiles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1696,7 +1971,7 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 			}
 
 			for _, a := range args {
-				if a == obj.ImpartWealthID {
+				if queries.Equal(a, obj.ImpartWealthID) {
 					continue Outer
 				}
 			}
@@ -1710,9 +1985,8 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`post_edits`),
-		qm.WhereIn(`post_edits.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`post_edits.deleted_at`),
+		qm.From(`files`),
+		qm.WhereIn(`files.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1720,22 +1994,22 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_edits")
+		return errors.Wrap(err, "failed to eager load files")
 	}
 
-	var resultSlice []*PostEdit
+	var resultSlice []*File
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_edits")
+		return errors.Wrap(err, "failed to bind eager loaded slice files")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_edits")
+		return errors.Wrap(err, "failed to close results in eager load on files")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_edits")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for files")
 	}
 
-	if len(postEditAfterSelectHooks) != 0 {
+	if len(fileAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1743,10 +2017,10 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 		}
 	}
 	if singular {
-		object.R.ImpartWealthPostEdits = resultSlice
+		object.R.ImpartWealthFiles = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postEditR{}
+				foreign.R = &fileR{}
 			}
 			foreign.R.ImpartWealth = object
 		}
@@ -1755,10 +2029,10 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthPostEdits = append(local.R.ImpartWealthPostEdits, foreign)
+			if queries.Equal(local.ImpartWealthID, foreign.ImpartWealthID) {
+				local.R.ImpartWealthFiles = append(local.R.ImpartWealthFiles, foreign)
 				if foreign.R == nil {
-					foreign.R = &postEditR{}
+					foreign.R = &fileR{}
 				}
 				foreign.R.ImpartWealth = local
 				break
@@ -1769,9 +2043,9 @@ func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadImpartWealthPostReactions allows an eager lookup of values, cached into the
+// LoadAdminHiveHives allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAdminHiveHives(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1809,9 +2083,11 @@ func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`post_reactions`),
-		qm.WhereIn(`post_reactions.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`post_reactions.deleted_at`),
+		qm.Select("`hive`.hive_id, `hive`.name, `hive`.description, `hive`.pinned_post_id, `hive`.tag_comparisons, `hive`.notification_topic_arn, `hive`.hive_distributions, `hive`.created_at, `hive`.deleted_at, `hive`.report_hide_threshold, `a`.`admin_impart_wealth_id`"),
+		qm.From("`hive`"),
+		qm.InnerJoin("`hive_admins` as `a` on `hive`.`hive_id` = `a`.`admin_hive_id`"),
+		qm.WhereIn("`a`.`admin_impart_wealth_id` in ?", args...),
+		qmhelper.WhereIsNull("`hive`.`deleted_at`"),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1819,22 +2095,36 @@ func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load post_reactions")
+		return errors.Wrap(err, "failed to eager load hive")
 	}
 
-	var resultSlice []*PostReaction
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice post_reactions")
+	var resultSlice []*Hive
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Hive)
+		var localJoinCol string
+
+		err = results.Scan(&one.HiveID, &one.Name, &one.Description, &one.PinnedPostID, &one.TagComparisons, &one.NotificationTopicArn, &one.HiveDistributions, &one.CreatedAt, &one.DeletedAt, &one.ReportHideThreshold, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for hive")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice hive")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on post_reactions")
+		return errors.Wrap(err, "failed to close results in eager load on hive")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_reactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
 	}
 
-	if len(postReactionAfterSelectHooks) != 0 {
+	if len(hiveAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1842,24 +2132,25 @@ func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextEx
 		}
 	}
 	if singular {
-		object.R.ImpartWealthPostReactions = resultSlice
+		object.R.AdminHiveHives = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &postReactionR{}
+				foreign.R = &hiveR{}
 			}
-			foreign.R.ImpartWealth = object
+			foreign.R.AdminImpartWealthUsers = append(foreign.R.AdminImpartWealthUsers, object)
 		}
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
 		for _, local := range slice {
-			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthPostReactions = append(local.R.ImpartWealthPostReactions, foreign)
+			if local.ImpartWealthID == localJoinCol {
+				local.R.AdminHiveHives = append(local.R.AdminHiveHives, foreign)
 				if foreign.R == nil {
-					foreign.R = &postReactionR{}
+					foreign.R = &hiveR{}
 				}
-				foreign.R.ImpartWealth = local
+				foreign.R.AdminImpartWealthUsers = append(foreign.R.AdminImpartWealthUsers, local)
 				break
 			}
 		}
@@ -1868,9 +2159,9 @@ func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// LoadImpartWealthUserAnswers allows an eager lookup of values, cached into the
+// LoadMemberHiveHives allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadMemberHiveHives(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1908,9 +2199,11 @@ func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`user_answers`),
-		qm.WhereIn(`user_answers.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_answers.deleted_at`),
+		qm.Select("`hive`.hive_id, `hive`.name, `hive`.description, `hive`.pinned_post_id, `hive`.tag_comparisons, `hive`.notification_topic_arn, `hive`.hive_distributions, `hive`.created_at, `hive`.deleted_at, `hive`.report_hide_threshold, `a`.`member_impart_wealth_id`"),
+		qm.From("`hive`"),
+		qm.InnerJoin("`hive_members` as `a` on `hive`.`hive_id` = `a`.`member_hive_id`"),
+		qm.WhereIn("`a`.`member_impart_wealth_id` in ?", args...),
+		qmhelper.WhereIsNull("`hive`.`deleted_at`"),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1918,22 +2211,36 @@ func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_answers")
+		return errors.Wrap(err, "failed to eager load hive")
 	}
 
-	var resultSlice []*UserAnswer
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_answers")
+	var resultSlice []*Hive
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Hive)
+		var localJoinCol string
+
+		err = results.Scan(&one.HiveID, &one.Name, &one.Description, &one.PinnedPostID, &one.TagComparisons, &one.NotificationTopicArn, &one.HiveDistributions, &one.CreatedAt, &one.DeletedAt, &one.ReportHideThreshold, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for hive")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice hive")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_answers")
+		return errors.Wrap(err, "failed to close results in eager load on hive")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_answers")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for hive")
 	}
 
-	if len(userAnswerAfterSelectHooks) != 0 {
+	if len(hiveAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1941,24 +2248,25 @@ func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.ImpartWealthUserAnswers = resultSlice
+		object.R.MemberHiveHives = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAnswerR{}
+				foreign.R = &hiveR{}
 			}
-			foreign.R.ImpartWealth = object
+			foreign.R.MemberImpartWealthUsers = append(foreign.R.MemberImpartWealthUsers, object)
 		}
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
 		for _, local := range slice {
-			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthUserAnswers = append(local.R.ImpartWealthUserAnswers, foreign)
+			if local.ImpartWealthID == localJoinCol {
+				local.R.MemberHiveHives = append(local.R.MemberHiveHives, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAnswerR{}
+					foreign.R = &hiveR{}
 				}
-				foreign.R.ImpartWealth = local
+				foreign.R.MemberImpartWealthUsers = append(foreign.R.MemberImpartWealthUsers, local)
 				break
 			}
 		}
@@ -1967,9 +2275,9 @@ func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadImpartWealthUserConfigurations allows an eager lookup of values, cached into the
+// LoadImpartWealthMentions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2007,8 +2315,8 @@ func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.Cont
 	}
 
 	query := NewQuery(
-		qm.From(`user_configurations`),
-		qm.WhereIn(`user_configurations.impart_wealth_id in ?`, args...),
+		qm.From(`mentions`),
+		qm.WhereIn(`mentions.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2016,22 +2324,22 @@ func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.Cont
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_configurations")
+		return errors.Wrap(err, "failed to eager load mentions")
 	}
 
-	var resultSlice []*UserConfiguration
+	var resultSlice []*Mention
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_configurations")
+		return errors.Wrap(err, "failed to bind eager loaded slice mentions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_configurations")
+		return errors.Wrap(err, "failed to close results in eager load on mentions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_configurations")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mentions")
 	}
 
-	if len(userConfigurationAfterSelectHooks) != 0 {
+	if len(mentionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2039,10 +2347,10 @@ func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.Cont
 		}
 	}
 	if singular {
-		object.R.ImpartWealthUserConfigurations = resultSlice
+		object.R.ImpartWealthMentions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userConfigurationR{}
+				foreign.R = &mentionR{}
 			}
 			foreign.R.ImpartWealth = object
 		}
@@ -2052,9 +2360,9 @@ func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.Cont
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthUserConfigurations = append(local.R.ImpartWealthUserConfigurations, foreign)
+				local.R.ImpartWealthMentions = append(local.R.ImpartWealthMentions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userConfigurationR{}
+					foreign.R = &mentionR{}
 				}
 				foreign.R.ImpartWealth = local
 				break
@@ -2065,9 +2373,9 @@ func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.Cont
 	return nil
 }
 
-// LoadImpartWealthUserDevices allows an eager lookup of values, cached into the
+// LoadModeratorModerationDecisions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadModeratorModerationDecisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2091,7 +2399,7 @@ func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExec
 			}
 
 			for _, a := range args {
-				if a == obj.ImpartWealthID {
+				if queries.Equal(a, obj.ImpartWealthID) {
 					continue Outer
 				}
 			}
@@ -2105,9 +2413,8 @@ func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`user_devices`),
-		qm.WhereIn(`user_devices.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_devices.deleted_at`),
+		qm.From(`moderation_decisions`),
+		qm.WhereIn(`moderation_decisions.moderator_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2115,22 +2422,22 @@ func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_devices")
+		return errors.Wrap(err, "failed to eager load moderation_decisions")
 	}
 
-	var resultSlice []*UserDevice
+	var resultSlice []*ModerationDecision
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_devices")
+		return errors.Wrap(err, "failed to bind eager loaded slice moderation_decisions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_devices")
+		return errors.Wrap(err, "failed to close results in eager load on moderation_decisions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_devices")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for moderation_decisions")
 	}
 
-	if len(userDeviceAfterSelectHooks) != 0 {
+	if len(moderationDecisionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2138,24 +2445,24 @@ func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.ImpartWealthUserDevices = resultSlice
+		object.R.ModeratorModerationDecisions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userDeviceR{}
+				foreign.R = &moderationDecisionR{}
 			}
-			foreign.R.ImpartWealth = object
+			foreign.R.Moderator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthUserDevices = append(local.R.ImpartWealthUserDevices, foreign)
+			if queries.Equal(local.ImpartWealthID, foreign.ModeratorID) {
+				local.R.ModeratorModerationDecisions = append(local.R.ModeratorModerationDecisions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userDeviceR{}
+					foreign.R = &moderationDecisionR{}
 				}
-				foreign.R.ImpartWealth = local
+				foreign.R.Moderator = local
 				break
 			}
 		}
@@ -2164,9 +2471,9 @@ func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadImpartWealthUserInstitutions allows an eager lookup of values, cached into the
+// LoadImpartWealthNotificationDeviceMappings allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadImpartWealthNotificationDeviceMappings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2204,9 +2511,8 @@ func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`user_institutions`),
-		qm.WhereIn(`user_institutions.impart_wealth_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_institutions.deleted_at`),
+		qm.From(`notification_device_mapping`),
+		qm.WhereIn(`notification_device_mapping.impart_wealth_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2214,22 +2520,22 @@ func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_institutions")
+		return errors.Wrap(err, "failed to eager load notification_device_mapping")
 	}
 
-	var resultSlice []*UserInstitution
+	var resultSlice []*NotificationDeviceMapping
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_institutions")
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_device_mapping")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_institutions")
+		return errors.Wrap(err, "failed to close results in eager load on notification_device_mapping")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_institutions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_device_mapping")
 	}
 
-	if len(userInstitutionAfterSelectHooks) != 0 {
+	if len(notificationDeviceMappingAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2237,10 +2543,10 @@ func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.Contex
 		}
 	}
 	if singular {
-		object.R.ImpartWealthUserInstitutions = resultSlice
+		object.R.ImpartWealthNotificationDeviceMappings = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userInstitutionR{}
+				foreign.R = &notificationDeviceMappingR{}
 			}
 			foreign.R.ImpartWealth = object
 		}
@@ -2250,9 +2556,9 @@ func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.Contex
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ImpartWealthID == foreign.ImpartWealthID {
-				local.R.ImpartWealthUserInstitutions = append(local.R.ImpartWealthUserInstitutions, foreign)
+				local.R.ImpartWealthNotificationDeviceMappings = append(local.R.ImpartWealthNotificationDeviceMappings, foreign)
 				if foreign.R == nil {
-					foreign.R = &userInstitutionR{}
+					foreign.R = &notificationDeviceMappingR{}
 				}
 				foreign.R.ImpartWealth = local
 				break
@@ -2263,62 +2569,2435 @@ func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// SetImpartWealthProfile of the user to the related item.
-// Sets o.R.ImpartWealthProfile to related.
-// Adds o to related.R.ImpartWealth.
-func (o *User) SetImpartWealthProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Profile) error {
-	var err error
+// LoadImpartWealthPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post`),
+		qm.WhereIn(`post.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`post.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthPosts = append(local.R.ImpartWealthPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthPostEdits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthPostEdits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_edits`),
+		qm.WhereIn(`post_edits.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`post_edits.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_edits")
+	}
+
+	var resultSlice []*PostEdit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_edits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_edits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_edits")
+	}
+
+	if len(postEditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthPostEdits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postEditR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthPostEdits = append(local.R.ImpartWealthPostEdits, foreign)
+				if foreign.R == nil {
+					foreign.R = &postEditR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthPostFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthPostFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_follows`),
+		qm.WhereIn(`post_follows.impart_wealth_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_follows")
+	}
+
+	var resultSlice []*PostFollow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_follows")
+	}
+
+	if len(postFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthPostFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postFollowR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthPostFollows = append(local.R.ImpartWealthPostFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &postFollowR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthPostReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthPostReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_reactions`),
+		qm.WhereIn(`post_reactions.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`post_reactions.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_reactions")
+	}
+
+	var resultSlice []*PostReaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_reactions")
+	}
+
+	if len(postReactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthPostReactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postReactionR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthPostReactions = append(local.R.ImpartWealthPostReactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &postReactionR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserAnswers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserAnswers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_answers`),
+		qm.WhereIn(`user_answers.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_answers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_answers")
+	}
+
+	var resultSlice []*UserAnswer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_answers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_answers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_answers")
+	}
+
+	if len(userAnswerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserAnswers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAnswerR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserAnswers = append(local.R.ImpartWealthUserAnswers, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAnswerR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserConfigurations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserConfigurations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_configurations`),
+		qm.WhereIn(`user_configurations.impart_wealth_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_configurations")
+	}
+
+	var resultSlice []*UserConfiguration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_configurations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_configurations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_configurations")
+	}
+
+	if len(userConfigurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserConfigurations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userConfigurationR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserConfigurations = append(local.R.ImpartWealthUserConfigurations, foreign)
+				if foreign.R == nil {
+					foreign.R = &userConfigurationR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserDevices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_devices`),
+		qm.WhereIn(`user_devices.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_devices.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_devices")
+	}
+
+	var resultSlice []*UserDevice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_devices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_devices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_devices")
+	}
+
+	if len(userDeviceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserDevices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userDeviceR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserDevices = append(local.R.ImpartWealthUserDevices, foreign)
+				if foreign.R == nil {
+					foreign.R = &userDeviceR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserInstitutions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserInstitutions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_institutions`),
+		qm.WhereIn(`user_institutions.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_institutions.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_institutions")
+	}
+
+	var resultSlice []*UserInstitution
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_institutions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_institutions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_institutions")
+	}
+
+	if len(userInstitutionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserInstitutions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userInstitutionR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserInstitutions = append(local.R.ImpartWealthUserInstitutions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userInstitutionR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_notification_preferences`),
+		qm.WhereIn(`user_notification_preferences.impart_wealth_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_notification_preferences")
+	}
+
+	var resultSlice []*UserNotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_notification_preferences")
+	}
+
+	if len(userNotificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserNotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userNotificationPreferenceR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserNotificationPreferences = append(local.R.ImpartWealthUserNotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &userNotificationPreferenceR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_notifications`),
+		qm.WhereIn(`user_notifications.impart_wealth_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_notifications")
+	}
+
+	var resultSlice []*UserNotification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_notifications")
+	}
+
+	if len(userNotificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserNotifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userNotificationR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserNotifications = append(local.R.ImpartWealthUserNotifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &userNotificationR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpartWealthUserSanctions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpartWealthUserSanctions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_sanctions`),
+		qm.WhereIn(`user_sanctions.impart_wealth_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_sanctions")
+	}
+
+	var resultSlice []*UserSanction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_sanctions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_sanctions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_sanctions")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpartWealthUserSanctions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSanctionR{}
+			}
+			foreign.R.ImpartWealth = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealthUserSanctions = append(local.R.ImpartWealthUserSanctions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSanctionR{}
+				}
+				foreign.R.ImpartWealth = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIssuedByUserSanctions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIssuedByUserSanctions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ImpartWealthID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_sanctions`),
+		qm.WhereIn(`user_sanctions.issued_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_sanctions")
+	}
+
+	var resultSlice []*UserSanction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_sanctions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_sanctions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_sanctions")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.IssuedByUserSanctions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSanctionR{}
+			}
+			foreign.R.IssuedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ImpartWealthID, foreign.IssuedBy) {
+				local.R.IssuedByUserSanctions = append(local.R.IssuedByUserSanctions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSanctionR{}
+				}
+				foreign.R.IssuedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRevokedByUserSanctions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRevokedByUserSanctions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ImpartWealthID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ImpartWealthID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_sanctions`),
+		qm.WhereIn(`user_sanctions.revoked_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_sanctions")
+	}
+
+	var resultSlice []*UserSanction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_sanctions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_sanctions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_sanctions")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RevokedByUserSanctions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSanctionR{}
+			}
+			foreign.R.RevokedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ImpartWealthID, foreign.RevokedBy) {
+				local.R.RevokedByUserSanctions = append(local.R.RevokedByUserSanctions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSanctionR{}
+				}
+				foreign.R.RevokedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealthProfile of the user to the related item.
+// Sets o.R.ImpartWealthProfile to related.
+// Adds o to related.R.ImpartWealth.
+func (o *User) SetImpartWealthProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Profile) error {
+	var err error
+
+	if insert {
+		related.ImpartWealthID = o.ImpartWealthID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `profile` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+			strmangle.WhereClause("`", "`", 0, profilePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ImpartWealthID, related.ImpartWealthID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
 
-	if insert {
 		related.ImpartWealthID = o.ImpartWealthID
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthProfile: related,
+		}
+	} else {
+		o.R.ImpartWealthProfile = related
+	}
+
+	if related.R == nil {
+		related.R = &profileR{
+			ImpartWealth: o,
+		}
+	} else {
+		related.R.ImpartWealth = o
+	}
+	return nil
+}
+
+// AddImpartWealthComments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthComments.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.CommentID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthComments: related,
+		}
+	} else {
+		o.R.ImpartWealthComments = append(o.R.ImpartWealthComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddImpartWealthCommentEdits adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthCommentEdits.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthCommentEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CommentEdit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment_edits` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, commentEditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.CommentID, rel.EditID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthCommentEdits: related,
+		}
+	} else {
+		o.R.ImpartWealthCommentEdits = append(o.R.ImpartWealthCommentEdits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentEditR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddImpartWealthCommentReactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthCommentReactions.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthCommentReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CommentReaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comment_reactions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, commentReactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.CommentID, rel.ImpartWealthID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthCommentReactions: related,
+		}
+	} else {
+		o.R.ImpartWealthCommentReactions = append(o.R.ImpartWealthCommentReactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentReactionR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddImpartWealthContentAppeals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthContentAppeals.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `content_appeals` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.AppealID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthContentAppeals: related,
+		}
+	} else {
+		o.R.ImpartWealthContentAppeals = append(o.R.ImpartWealthContentAppeals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentAppealR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddResolvedByContentAppeals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ResolvedByContentAppeals.
+// Sets related.R.ResolvedByUser appropriately.
+func (o *User) AddResolvedByContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ResolvedBy, o.ImpartWealthID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `content_appeals` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"resolved_by"}),
+				strmangle.WhereClause("`", "`", 0, contentAppealPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.AppealID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ResolvedBy, o.ImpartWealthID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ResolvedByContentAppeals: related,
+		}
+	} else {
+		o.R.ResolvedByContentAppeals = append(o.R.ResolvedByContentAppeals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentAppealR{
+				ResolvedByUser: o,
+			}
+		} else {
+			rel.R.ResolvedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetResolvedByContentAppeals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ResolvedByUser's ResolvedByContentAppeals accordingly.
+// Replaces o.R.ResolvedByContentAppeals with related.
+// Sets related.R.ResolvedByUser's ResolvedByContentAppeals accordingly.
+func (o *User) SetResolvedByContentAppeals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentAppeal) error {
+	query := "update `content_appeals` set `resolved_by` = null where `resolved_by` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ResolvedByContentAppeals {
+			queries.SetScanner(&rel.ResolvedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ResolvedByUser = nil
+		}
+
+		o.R.ResolvedByContentAppeals = nil
+	}
+	return o.AddResolvedByContentAppeals(ctx, exec, insert, related...)
+}
+
+// RemoveResolvedByContentAppeals relationships from objects passed in.
+// Removes related items from R.ResolvedByContentAppeals (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedByUser.
+func (o *User) RemoveResolvedByContentAppeals(ctx context.Context, exec boil.ContextExecutor, related ...*ContentAppeal) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ResolvedBy, nil)
+		if rel.R != nil {
+			rel.R.ResolvedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ResolvedByContentAppeals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ResolvedByContentAppeals)
+			if ln > 1 && i < ln-1 {
+				o.R.ResolvedByContentAppeals[i] = o.R.ResolvedByContentAppeals[ln-1]
+			}
+			o.R.ResolvedByContentAppeals = o.R.ResolvedByContentAppeals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddImpartWealthDataExports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthDataExports.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthDataExports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataExport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_exports` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, dataExportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.ExportID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthDataExports: related,
+		}
+	} else {
+		o.R.ImpartWealthDataExports = append(o.R.ImpartWealthDataExports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataExportR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddImpartWealthDrafts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthDrafts.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthDrafts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Draft) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `drafts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, draftPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.DraftID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthDrafts: related,
+		}
+	} else {
+		o.R.ImpartWealthDrafts = append(o.R.ImpartWealthDrafts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &draftR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddImpartWealthFiles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthFiles.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImpartWealthID, o.ImpartWealthID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `files` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.Fid}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImpartWealthID, o.ImpartWealthID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthFiles: related,
+		}
+	} else {
+		o.R.ImpartWealthFiles = append(o.R.ImpartWealthFiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// SetImpartWealthFiles removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ImpartWealth's ImpartWealthFiles accordingly.
+// Replaces o.R.ImpartWealthFiles with related.
+// Sets related.R.ImpartWealth's ImpartWealthFiles accordingly.
+func (o *User) SetImpartWealthFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	query := "update `files` set `impart_wealth_id` = null where `impart_wealth_id` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImpartWealthFiles {
+			queries.SetScanner(&rel.ImpartWealthID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ImpartWealth = nil
+		}
+
+		o.R.ImpartWealthFiles = nil
+	}
+	return o.AddImpartWealthFiles(ctx, exec, insert, related...)
+}
+
+// RemoveImpartWealthFiles relationships from objects passed in.
+// Removes related items from R.ImpartWealthFiles (uses pointer comparison, removal does not keep order)
+// Sets related.R.ImpartWealth.
+func (o *User) RemoveImpartWealthFiles(ctx context.Context, exec boil.ContextExecutor, related ...*File) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImpartWealthID, nil)
+		if rel.R != nil {
+			rel.R.ImpartWealth = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("impart_wealth_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImpartWealthFiles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImpartWealthFiles)
+			if ln > 1 && i < ln-1 {
+				o.R.ImpartWealthFiles[i] = o.R.ImpartWealthFiles[ln-1]
+			}
+			o.R.ImpartWealthFiles = o.R.ImpartWealthFiles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAdminHiveHives adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminHiveHives.
+// Sets related.R.AdminImpartWealthUsers appropriately.
+func (o *User) AddAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `hive_admins` (`admin_impart_wealth_id`, `admin_hive_id`) values (?, ?)"
+		values := []interface{}{o.ImpartWealthID, rel.HiveID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &userR{
+			AdminHiveHives: related,
+		}
+	} else {
+		o.R.AdminHiveHives = append(o.R.AdminHiveHives, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &hiveR{
+				AdminImpartWealthUsers: UserSlice{o},
+			}
+		} else {
+			rel.R.AdminImpartWealthUsers = append(rel.R.AdminImpartWealthUsers, o)
+		}
+	}
+	return nil
+}
+
+// SetAdminHiveHives removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AdminImpartWealthUsers's AdminHiveHives accordingly.
+// Replaces o.R.AdminHiveHives with related.
+// Sets related.R.AdminImpartWealthUsers's AdminHiveHives accordingly.
+func (o *User) SetAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+	query := "delete from `hive_admins` where `admin_impart_wealth_id` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o, related)
+	if o.R != nil {
+		o.R.AdminHiveHives = nil
+	}
+	return o.AddAdminHiveHives(ctx, exec, insert, related...)
+}
+
+// RemoveAdminHiveHives relationships from objects passed in.
+// Removes related items from R.AdminHiveHives (uses pointer comparison, removal does not keep order)
+// Sets related.R.AdminImpartWealthUsers.
+func (o *User) RemoveAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, related ...*Hive) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from `hive_admins` where `admin_impart_wealth_id` = ? and `admin_hive_id` in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ImpartWealthID}
+	for _, rel := range related {
+		values = append(values, rel.HiveID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AdminHiveHives {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AdminHiveHives)
+			if ln > 1 && i < ln-1 {
+				o.R.AdminHiveHives[i] = o.R.AdminHiveHives[ln-1]
+			}
+			o.R.AdminHiveHives = o.R.AdminHiveHives[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o *User, related []*Hive) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.AdminImpartWealthUsers {
+			if o.ImpartWealthID != ri.ImpartWealthID {
+				continue
+			}
+
+			ln := len(rel.R.AdminImpartWealthUsers)
+			if ln > 1 && i < ln-1 {
+				rel.R.AdminImpartWealthUsers[i] = rel.R.AdminImpartWealthUsers[ln-1]
+			}
+			rel.R.AdminImpartWealthUsers = rel.R.AdminImpartWealthUsers[:ln-1]
+			break
+		}
+	}
+}
+
+// AddMemberHiveHives adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MemberHiveHives.
+// Sets related.R.MemberImpartWealthUsers appropriately.
+func (o *User) AddMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `hive_members` (`member_impart_wealth_id`, `member_hive_id`) values (?, ?)"
+		values := []interface{}{o.ImpartWealthID, rel.HiveID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &userR{
+			MemberHiveHives: related,
+		}
+	} else {
+		o.R.MemberHiveHives = append(o.R.MemberHiveHives, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &hiveR{
+				MemberImpartWealthUsers: UserSlice{o},
+			}
+		} else {
+			rel.R.MemberImpartWealthUsers = append(rel.R.MemberImpartWealthUsers, o)
+		}
+	}
+	return nil
+}
+
+// SetMemberHiveHives removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.MemberImpartWealthUsers's MemberHiveHives accordingly.
+// Replaces o.R.MemberHiveHives with related.
+// Sets related.R.MemberImpartWealthUsers's MemberHiveHives accordingly.
+func (o *User) SetMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+	query := "delete from `hive_members` where `member_impart_wealth_id` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o, related)
+	if o.R != nil {
+		o.R.MemberHiveHives = nil
+	}
+	return o.AddMemberHiveHives(ctx, exec, insert, related...)
+}
+
+// RemoveMemberHiveHives relationships from objects passed in.
+// Removes related items from R.MemberHiveHives (uses pointer comparison, removal does not keep order)
+// Sets related.R.MemberImpartWealthUsers.
+func (o *User) RemoveMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, related ...*Hive) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from `hive_members` where `member_impart_wealth_id` = ? and `member_hive_id` in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ImpartWealthID}
+	for _, rel := range related {
+		values = append(values, rel.HiveID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MemberHiveHives {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MemberHiveHives)
+			if ln > 1 && i < ln-1 {
+				o.R.MemberHiveHives[i] = o.R.MemberHiveHives[ln-1]
+			}
+			o.R.MemberHiveHives = o.R.MemberHiveHives[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o *User, related []*Hive) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
 		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE `profile` SET %s WHERE %s",
-			strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-			strmangle.WhereClause("`", "`", 0, profilePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ImpartWealthID, related.ImpartWealthID}
+		for i, ri := range rel.R.MemberImpartWealthUsers {
+			if o.ImpartWealthID != ri.ImpartWealthID {
+				continue
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
+			ln := len(rel.R.MemberImpartWealthUsers)
+			if ln > 1 && i < ln-1 {
+				rel.R.MemberImpartWealthUsers[i] = rel.R.MemberImpartWealthUsers[ln-1]
+			}
+			rel.R.MemberImpartWealthUsers = rel.R.MemberImpartWealthUsers[:ln-1]
+			break
 		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+	}
+}
+
+// AddImpartWealthMentions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthMentions.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Mention) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `mentions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, mentionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.MentionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
 		}
+	}
 
-		related.ImpartWealthID = o.ImpartWealthID
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthMentions: related,
+		}
+	} else {
+		o.R.ImpartWealthMentions = append(o.R.ImpartWealthMentions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mentionR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
+	}
+	return nil
+}
+
+// AddModeratorModerationDecisions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ModeratorModerationDecisions.
+// Sets related.R.Moderator appropriately.
+func (o *User) AddModeratorModerationDecisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ModerationDecision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ModeratorID, o.ImpartWealthID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `moderation_decisions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"moderator_id"}),
+				strmangle.WhereClause("`", "`", 0, moderationDecisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.DecisionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
+			queries.Assign(&rel.ModeratorID, o.ImpartWealthID)
+		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthProfile: related,
+			ModeratorModerationDecisions: related,
 		}
 	} else {
-		o.R.ImpartWealthProfile = related
+		o.R.ModeratorModerationDecisions = append(o.R.ModeratorModerationDecisions, related...)
 	}
 
-	if related.R == nil {
-		related.R = &profileR{
-			ImpartWealth: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &moderationDecisionR{
+				Moderator: o,
+			}
+		} else {
+			rel.R.Moderator = o
+		}
+	}
+	return nil
+}
+
+// SetModeratorModerationDecisions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Moderator's ModeratorModerationDecisions accordingly.
+// Replaces o.R.ModeratorModerationDecisions with related.
+// Sets related.R.Moderator's ModeratorModerationDecisions accordingly.
+func (o *User) SetModeratorModerationDecisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ModerationDecision) error {
+	query := "update `moderation_decisions` set `moderator_id` = null where `moderator_id` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ModeratorModerationDecisions {
+			queries.SetScanner(&rel.ModeratorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Moderator = nil
+		}
+
+		o.R.ModeratorModerationDecisions = nil
+	}
+	return o.AddModeratorModerationDecisions(ctx, exec, insert, related...)
+}
+
+// RemoveModeratorModerationDecisions relationships from objects passed in.
+// Removes related items from R.ModeratorModerationDecisions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Moderator.
+func (o *User) RemoveModeratorModerationDecisions(ctx context.Context, exec boil.ContextExecutor, related ...*ModerationDecision) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ModeratorID, nil)
+		if rel.R != nil {
+			rel.R.Moderator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("moderator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ModeratorModerationDecisions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ModeratorModerationDecisions)
+			if ln > 1 && i < ln-1 {
+				o.R.ModeratorModerationDecisions[i] = o.R.ModeratorModerationDecisions[ln-1]
+			}
+			o.R.ModeratorModerationDecisions = o.R.ModeratorModerationDecisions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddImpartWealthNotificationDeviceMappings adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpartWealthNotificationDeviceMappings.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthNotificationDeviceMappings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDeviceMapping) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_device_mapping` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationDeviceMappingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.MapID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImpartWealthID = o.ImpartWealthID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthNotificationDeviceMappings: related,
 		}
 	} else {
-		related.R.ImpartWealth = o
+		o.R.ImpartWealthNotificationDeviceMappings = append(o.R.ImpartWealthNotificationDeviceMappings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationDeviceMappingR{
+				ImpartWealth: o,
+			}
+		} else {
+			rel.R.ImpartWealth = o
+		}
 	}
 	return nil
 }
 
-// AddImpartWealthComments adds the given related objects to the existing relationships
+// AddImpartWealthPosts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthComments.
+// Appends related to o.R.ImpartWealthPosts.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+func (o *User) AddImpartWealthPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2328,11 +5007,11 @@ func (o *User) AddImpartWealthComments(ctx context.Context, exec boil.ContextExe
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `comment` SET %s WHERE %s",
+				"UPDATE `post` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.CommentID}
+			values := []interface{}{o.ImpartWealthID, rel.PostID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2349,15 +5028,15 @@ func (o *User) AddImpartWealthComments(ctx context.Context, exec boil.ContextExe
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthComments: related,
+			ImpartWealthPosts: related,
 		}
 	} else {
-		o.R.ImpartWealthComments = append(o.R.ImpartWealthComments, related...)
+		o.R.ImpartWealthPosts = append(o.R.ImpartWealthPosts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
+			rel.R = &postR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2367,11 +5046,11 @@ func (o *User) AddImpartWealthComments(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// AddImpartWealthCommentEdits adds the given related objects to the existing relationships
+// AddImpartWealthPostEdits adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthCommentEdits.
+// Appends related to o.R.ImpartWealthPostEdits.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthCommentEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CommentEdit) error {
+func (o *User) AddImpartWealthPostEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostEdit) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2381,11 +5060,11 @@ func (o *User) AddImpartWealthCommentEdits(ctx context.Context, exec boil.Contex
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `comment_edits` SET %s WHERE %s",
+				"UPDATE `post_edits` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, commentEditPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, postEditPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.CommentID, rel.EditID}
+			values := []interface{}{o.ImpartWealthID, rel.PostID, rel.EditID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2402,15 +5081,15 @@ func (o *User) AddImpartWealthCommentEdits(ctx context.Context, exec boil.Contex
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthCommentEdits: related,
+			ImpartWealthPostEdits: related,
 		}
 	} else {
-		o.R.ImpartWealthCommentEdits = append(o.R.ImpartWealthCommentEdits, related...)
+		o.R.ImpartWealthPostEdits = append(o.R.ImpartWealthPostEdits, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentEditR{
+			rel.R = &postEditR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2420,11 +5099,11 @@ func (o *User) AddImpartWealthCommentEdits(ctx context.Context, exec boil.Contex
 	return nil
 }
 
-// AddImpartWealthCommentReactions adds the given related objects to the existing relationships
+// AddImpartWealthPostFollows adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthCommentReactions.
+// Appends related to o.R.ImpartWealthPostFollows.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthCommentReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CommentReaction) error {
+func (o *User) AddImpartWealthPostFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostFollow) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2434,11 +5113,11 @@ func (o *User) AddImpartWealthCommentReactions(ctx context.Context, exec boil.Co
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `comment_reactions` SET %s WHERE %s",
+				"UPDATE `post_follows` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, commentReactionPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, postFollowPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.CommentID, rel.ImpartWealthID}
+			values := []interface{}{o.ImpartWealthID, rel.PostID, rel.ImpartWealthID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2455,15 +5134,15 @@ func (o *User) AddImpartWealthCommentReactions(ctx context.Context, exec boil.Co
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthCommentReactions: related,
+			ImpartWealthPostFollows: related,
 		}
 	} else {
-		o.R.ImpartWealthCommentReactions = append(o.R.ImpartWealthCommentReactions, related...)
+		o.R.ImpartWealthPostFollows = append(o.R.ImpartWealthPostFollows, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentReactionR{
+			rel.R = &postFollowR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2473,299 +5152,117 @@ func (o *User) AddImpartWealthCommentReactions(ctx context.Context, exec boil.Co
 	return nil
 }
 
-// AddAdminHiveHives adds the given related objects to the existing relationships
+// AddImpartWealthPostReactions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AdminHiveHives.
-// Sets related.R.AdminImpartWealthUsers appropriately.
-func (o *User) AddAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+// Appends related to o.R.ImpartWealthPostReactions.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthPostReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostReaction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `post_reactions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, postReactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.PostID, rel.ImpartWealthID}
 
-	for _, rel := range related {
-		query := "insert into `hive_admins` (`admin_impart_wealth_id`, `admin_hive_id`) values (?, ?)"
-		values := []interface{}{o.ImpartWealthID, rel.HiveID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			rel.ImpartWealthID = o.ImpartWealthID
 		}
 	}
+
 	if o.R == nil {
 		o.R = &userR{
-			AdminHiveHives: related,
+			ImpartWealthPostReactions: related,
 		}
 	} else {
-		o.R.AdminHiveHives = append(o.R.AdminHiveHives, related...)
+		o.R.ImpartWealthPostReactions = append(o.R.ImpartWealthPostReactions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &hiveR{
-				AdminImpartWealthUsers: UserSlice{o},
+			rel.R = &postReactionR{
+				ImpartWealth: o,
 			}
 		} else {
-			rel.R.AdminImpartWealthUsers = append(rel.R.AdminImpartWealthUsers, o)
-		}
-	}
-	return nil
-}
-
-// SetAdminHiveHives removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.AdminImpartWealthUsers's AdminHiveHives accordingly.
-// Replaces o.R.AdminHiveHives with related.
-// Sets related.R.AdminImpartWealthUsers's AdminHiveHives accordingly.
-func (o *User) SetAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
-	query := "delete from `hive_admins` where `admin_impart_wealth_id` = ?"
-	values := []interface{}{o.ImpartWealthID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o, related)
-	if o.R != nil {
-		o.R.AdminHiveHives = nil
-	}
-	return o.AddAdminHiveHives(ctx, exec, insert, related...)
-}
-
-// RemoveAdminHiveHives relationships from objects passed in.
-// Removes related items from R.AdminHiveHives (uses pointer comparison, removal does not keep order)
-// Sets related.R.AdminImpartWealthUsers.
-func (o *User) RemoveAdminHiveHives(ctx context.Context, exec boil.ContextExecutor, related ...*Hive) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	query := fmt.Sprintf(
-		"delete from `hive_admins` where `admin_impart_wealth_id` = ? and `admin_hive_id` in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ImpartWealthID}
-	for _, rel := range related {
-		values = append(values, rel.HiveID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.AdminHiveHives {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.AdminHiveHives)
-			if ln > 1 && i < ln-1 {
-				o.R.AdminHiveHives[i] = o.R.AdminHiveHives[ln-1]
-			}
-			o.R.AdminHiveHives = o.R.AdminHiveHives[:ln-1]
-			break
+			rel.R.ImpartWealth = o
 		}
 	}
-
 	return nil
 }
 
-func removeAdminHiveHivesFromAdminImpartWealthUsersSlice(o *User, related []*Hive) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.AdminImpartWealthUsers {
-			if o.ImpartWealthID != ri.ImpartWealthID {
-				continue
-			}
-
-			ln := len(rel.R.AdminImpartWealthUsers)
-			if ln > 1 && i < ln-1 {
-				rel.R.AdminImpartWealthUsers[i] = rel.R.AdminImpartWealthUsers[ln-1]
-			}
-			rel.R.AdminImpartWealthUsers = rel.R.AdminImpartWealthUsers[:ln-1]
-			break
-		}
-	}
-}
-
-// AddMemberHiveHives adds the given related objects to the existing relationships
+// AddImpartWealthUserAnswers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.MemberHiveHives.
-// Sets related.R.MemberImpartWealthUsers appropriately.
-func (o *User) AddMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
+// Appends related to o.R.ImpartWealthUserAnswers.
+// Sets related.R.ImpartWealth appropriately.
+func (o *User) AddImpartWealthUserAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserAnswer) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			rel.ImpartWealthID = o.ImpartWealthID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
-
-	for _, rel := range related {
-		query := "insert into `hive_members` (`member_impart_wealth_id`, `member_hive_id`) values (?, ?)"
-		values := []interface{}{o.ImpartWealthID, rel.HiveID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
-		}
-	}
-	if o.R == nil {
-		o.R = &userR{
-			MemberHiveHives: related,
-		}
-	} else {
-		o.R.MemberHiveHives = append(o.R.MemberHiveHives, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &hiveR{
-				MemberImpartWealthUsers: UserSlice{o},
-			}
 		} else {
-			rel.R.MemberImpartWealthUsers = append(rel.R.MemberImpartWealthUsers, o)
-		}
-	}
-	return nil
-}
-
-// SetMemberHiveHives removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.MemberImpartWealthUsers's MemberHiveHives accordingly.
-// Replaces o.R.MemberHiveHives with related.
-// Sets related.R.MemberImpartWealthUsers's MemberHiveHives accordingly.
-func (o *User) SetMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Hive) error {
-	query := "delete from `hive_members` where `member_impart_wealth_id` = ?"
-	values := []interface{}{o.ImpartWealthID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o, related)
-	if o.R != nil {
-		o.R.MemberHiveHives = nil
-	}
-	return o.AddMemberHiveHives(ctx, exec, insert, related...)
-}
-
-// RemoveMemberHiveHives relationships from objects passed in.
-// Removes related items from R.MemberHiveHives (uses pointer comparison, removal does not keep order)
-// Sets related.R.MemberImpartWealthUsers.
-func (o *User) RemoveMemberHiveHives(ctx context.Context, exec boil.ContextExecutor, related ...*Hive) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	query := fmt.Sprintf(
-		"delete from `hive_members` where `member_impart_wealth_id` = ? and `member_hive_id` in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ImpartWealthID}
-	for _, rel := range related {
-		values = append(values, rel.HiveID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
+			updateQuery := fmt.Sprintf(
+				"UPDATE `user_answers` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+				strmangle.WhereClause("`", "`", 0, userAnswerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ImpartWealthID, rel.ImpartWealthID, rel.AnswerID}
 
-	for _, rel := range related {
-		for i, ri := range o.R.MemberHiveHives {
-			if rel != ri {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-
-			ln := len(o.R.MemberHiveHives)
-			if ln > 1 && i < ln-1 {
-				o.R.MemberHiveHives[i] = o.R.MemberHiveHives[ln-1]
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
-			o.R.MemberHiveHives = o.R.MemberHiveHives[:ln-1]
-			break
+
+			rel.ImpartWealthID = o.ImpartWealthID
 		}
 	}
 
-	return nil
-}
+	if o.R == nil {
+		o.R = &userR{
+			ImpartWealthUserAnswers: related,
+		}
+	} else {
+		o.R.ImpartWealthUserAnswers = append(o.R.ImpartWealthUserAnswers, related...)
+	}
 
-func removeMemberHiveHivesFromMemberImpartWealthUsersSlice(o *User, related []*Hive) {
 	for _, rel := range related {
 		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.MemberImpartWealthUsers {
-			if o.ImpartWealthID != ri.ImpartWealthID {
-				continue
-			}
-
-			ln := len(rel.R.MemberImpartWealthUsers)
-			if ln > 1 && i < ln-1 {
-				rel.R.MemberImpartWealthUsers[i] = rel.R.MemberImpartWealthUsers[ln-1]
+			rel.R = &userAnswerR{
+				ImpartWealth: o,
 			}
-			rel.R.MemberImpartWealthUsers = rel.R.MemberImpartWealthUsers[:ln-1]
-			break
+		} else {
+			rel.R.ImpartWealth = o
 		}
 	}
+	return nil
 }
 
-// AddImpartWealthNotificationDeviceMappings adds the given related objects to the existing relationships
+// AddImpartWealthUserConfigurations adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthNotificationDeviceMappings.
+// Appends related to o.R.ImpartWealthUserConfigurations.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthNotificationDeviceMappings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationDeviceMapping) error {
+func (o *User) AddImpartWealthUserConfigurations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserConfiguration) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2775,11 +5272,11 @@ func (o *User) AddImpartWealthNotificationDeviceMappings(ctx context.Context, ex
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `notification_device_mapping` SET %s WHERE %s",
+				"UPDATE `user_configurations` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, notificationDeviceMappingPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userConfigurationPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.MapID}
+			values := []interface{}{o.ImpartWealthID, rel.ConfigID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2796,15 +5293,15 @@ func (o *User) AddImpartWealthNotificationDeviceMappings(ctx context.Context, ex
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthNotificationDeviceMappings: related,
+			ImpartWealthUserConfigurations: related,
 		}
 	} else {
-		o.R.ImpartWealthNotificationDeviceMappings = append(o.R.ImpartWealthNotificationDeviceMappings, related...)
+		o.R.ImpartWealthUserConfigurations = append(o.R.ImpartWealthUserConfigurations, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &notificationDeviceMappingR{
+			rel.R = &userConfigurationR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2814,11 +5311,11 @@ func (o *User) AddImpartWealthNotificationDeviceMappings(ctx context.Context, ex
 	return nil
 }
 
-// AddImpartWealthPosts adds the given related objects to the existing relationships
+// AddImpartWealthUserDevices adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthPosts.
+// Appends related to o.R.ImpartWealthUserDevices.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+func (o *User) AddImpartWealthUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2828,11 +5325,11 @@ func (o *User) AddImpartWealthPosts(ctx context.Context, exec boil.ContextExecut
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `post` SET %s WHERE %s",
+				"UPDATE `user_devices` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, postPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userDevicePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.PostID}
+			values := []interface{}{o.ImpartWealthID, rel.Token}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2849,15 +5346,15 @@ func (o *User) AddImpartWealthPosts(ctx context.Context, exec boil.ContextExecut
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthPosts: related,
+			ImpartWealthUserDevices: related,
 		}
 	} else {
-		o.R.ImpartWealthPosts = append(o.R.ImpartWealthPosts, related...)
+		o.R.ImpartWealthUserDevices = append(o.R.ImpartWealthUserDevices, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postR{
+			rel.R = &userDeviceR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2867,11 +5364,11 @@ func (o *User) AddImpartWealthPosts(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// AddImpartWealthPostEdits adds the given related objects to the existing relationships
+// AddImpartWealthUserInstitutions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthPostEdits.
+// Appends related to o.R.ImpartWealthUserInstitutions.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthPostEdits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostEdit) error {
+func (o *User) AddImpartWealthUserInstitutions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserInstitution) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2881,11 +5378,11 @@ func (o *User) AddImpartWealthPostEdits(ctx context.Context, exec boil.ContextEx
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `post_edits` SET %s WHERE %s",
+				"UPDATE `user_institutions` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, postEditPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userInstitutionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.PostID, rel.EditID}
+			values := []interface{}{o.ImpartWealthID, rel.UserInstitutionID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2902,15 +5399,15 @@ func (o *User) AddImpartWealthPostEdits(ctx context.Context, exec boil.ContextEx
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthPostEdits: related,
+			ImpartWealthUserInstitutions: related,
 		}
 	} else {
-		o.R.ImpartWealthPostEdits = append(o.R.ImpartWealthPostEdits, related...)
+		o.R.ImpartWealthUserInstitutions = append(o.R.ImpartWealthUserInstitutions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postEditR{
+			rel.R = &userInstitutionR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2920,11 +5417,11 @@ func (o *User) AddImpartWealthPostEdits(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
-// AddImpartWealthPostReactions adds the given related objects to the existing relationships
+// AddImpartWealthUserNotificationPreferences adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthPostReactions.
+// Appends related to o.R.ImpartWealthUserNotificationPreferences.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthPostReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostReaction) error {
+func (o *User) AddImpartWealthUserNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserNotificationPreference) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2934,11 +5431,11 @@ func (o *User) AddImpartWealthPostReactions(ctx context.Context, exec boil.Conte
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `post_reactions` SET %s WHERE %s",
+				"UPDATE `user_notification_preferences` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, postReactionPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userNotificationPreferencePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.PostID, rel.ImpartWealthID}
+			values := []interface{}{o.ImpartWealthID, rel.ImpartWealthID, rel.Category}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2955,15 +5452,15 @@ func (o *User) AddImpartWealthPostReactions(ctx context.Context, exec boil.Conte
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthPostReactions: related,
+			ImpartWealthUserNotificationPreferences: related,
 		}
 	} else {
-		o.R.ImpartWealthPostReactions = append(o.R.ImpartWealthPostReactions, related...)
+		o.R.ImpartWealthUserNotificationPreferences = append(o.R.ImpartWealthUserNotificationPreferences, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &postReactionR{
+			rel.R = &userNotificationPreferenceR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -2973,11 +5470,11 @@ func (o *User) AddImpartWealthPostReactions(ctx context.Context, exec boil.Conte
 	return nil
 }
 
-// AddImpartWealthUserAnswers adds the given related objects to the existing relationships
+// AddImpartWealthUserNotifications adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthUserAnswers.
+// Appends related to o.R.ImpartWealthUserNotifications.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthUserAnswers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserAnswer) error {
+func (o *User) AddImpartWealthUserNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserNotification) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2987,11 +5484,11 @@ func (o *User) AddImpartWealthUserAnswers(ctx context.Context, exec boil.Context
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `user_answers` SET %s WHERE %s",
+				"UPDATE `user_notifications` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, userAnswerPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userNotificationPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.ImpartWealthID, rel.AnswerID}
+			values := []interface{}{o.ImpartWealthID, rel.NotificationID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -3008,15 +5505,15 @@ func (o *User) AddImpartWealthUserAnswers(ctx context.Context, exec boil.Context
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthUserAnswers: related,
+			ImpartWealthUserNotifications: related,
 		}
 	} else {
-		o.R.ImpartWealthUserAnswers = append(o.R.ImpartWealthUserAnswers, related...)
+		o.R.ImpartWealthUserNotifications = append(o.R.ImpartWealthUserNotifications, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userAnswerR{
+			rel.R = &userNotificationR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -3026,11 +5523,11 @@ func (o *User) AddImpartWealthUserAnswers(ctx context.Context, exec boil.Context
 	return nil
 }
 
-// AddImpartWealthUserConfigurations adds the given related objects to the existing relationships
+// AddImpartWealthUserSanctions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthUserConfigurations.
+// Appends related to o.R.ImpartWealthUserSanctions.
 // Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthUserConfigurations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserConfiguration) error {
+func (o *User) AddImpartWealthUserSanctions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSanction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3040,11 +5537,11 @@ func (o *User) AddImpartWealthUserConfigurations(ctx context.Context, exec boil.
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `user_configurations` SET %s WHERE %s",
+				"UPDATE `user_sanctions` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, userConfigurationPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.ConfigID}
+			values := []interface{}{o.ImpartWealthID, rel.SanctionID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -3061,15 +5558,15 @@ func (o *User) AddImpartWealthUserConfigurations(ctx context.Context, exec boil.
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthUserConfigurations: related,
+			ImpartWealthUserSanctions: related,
 		}
 	} else {
-		o.R.ImpartWealthUserConfigurations = append(o.R.ImpartWealthUserConfigurations, related...)
+		o.R.ImpartWealthUserSanctions = append(o.R.ImpartWealthUserSanctions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userConfigurationR{
+			rel.R = &userSanctionR{
 				ImpartWealth: o,
 			}
 		} else {
@@ -3079,25 +5576,25 @@ func (o *User) AddImpartWealthUserConfigurations(ctx context.Context, exec boil.
 	return nil
 }
 
-// AddImpartWealthUserDevices adds the given related objects to the existing relationships
+// AddIssuedByUserSanctions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthUserDevices.
-// Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
+// Appends related to o.R.IssuedByUserSanctions.
+// Sets related.R.IssuedByUser appropriately.
+func (o *User) AddIssuedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSanction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.ImpartWealthID = o.ImpartWealthID
+			queries.Assign(&rel.IssuedBy, o.ImpartWealthID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `user_devices` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, userDevicePrimaryKeyColumns),
+				"UPDATE `user_sanctions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"issued_by"}),
+				strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.Token}
+			values := []interface{}{o.ImpartWealthID, rel.SanctionID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -3108,49 +5605,123 @@ func (o *User) AddImpartWealthUserDevices(ctx context.Context, exec boil.Context
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.ImpartWealthID = o.ImpartWealthID
+			queries.Assign(&rel.IssuedBy, o.ImpartWealthID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthUserDevices: related,
+			IssuedByUserSanctions: related,
 		}
 	} else {
-		o.R.ImpartWealthUserDevices = append(o.R.ImpartWealthUserDevices, related...)
+		o.R.IssuedByUserSanctions = append(o.R.IssuedByUserSanctions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userDeviceR{
-				ImpartWealth: o,
+			rel.R = &userSanctionR{
+				IssuedByUser: o,
 			}
 		} else {
-			rel.R.ImpartWealth = o
+			rel.R.IssuedByUser = o
 		}
 	}
 	return nil
 }
 
-// AddImpartWealthUserInstitutions adds the given related objects to the existing relationships
+// SetIssuedByUserSanctions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.IssuedByUser's IssuedByUserSanctions accordingly.
+// Replaces o.R.IssuedByUserSanctions with related.
+// Sets related.R.IssuedByUser's IssuedByUserSanctions accordingly.
+func (o *User) SetIssuedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSanction) error {
+	query := "update `user_sanctions` set `issued_by` = null where `issued_by` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.IssuedByUserSanctions {
+			queries.SetScanner(&rel.IssuedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.IssuedByUser = nil
+		}
+
+		o.R.IssuedByUserSanctions = nil
+	}
+	return o.AddIssuedByUserSanctions(ctx, exec, insert, related...)
+}
+
+// RemoveIssuedByUserSanctions relationships from objects passed in.
+// Removes related items from R.IssuedByUserSanctions (uses pointer comparison, removal does not keep order)
+// Sets related.R.IssuedByUser.
+func (o *User) RemoveIssuedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, related ...*UserSanction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.IssuedBy, nil)
+		if rel.R != nil {
+			rel.R.IssuedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("issued_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.IssuedByUserSanctions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.IssuedByUserSanctions)
+			if ln > 1 && i < ln-1 {
+				o.R.IssuedByUserSanctions[i] = o.R.IssuedByUserSanctions[ln-1]
+			}
+			o.R.IssuedByUserSanctions = o.R.IssuedByUserSanctions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRevokedByUserSanctions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ImpartWealthUserInstitutions.
-// Sets related.R.ImpartWealth appropriately.
-func (o *User) AddImpartWealthUserInstitutions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserInstitution) error {
+// Appends related to o.R.RevokedByUserSanctions.
+// Sets related.R.RevokedByUser appropriately.
+func (o *User) AddRevokedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSanction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.ImpartWealthID = o.ImpartWealthID
+			queries.Assign(&rel.RevokedBy, o.ImpartWealthID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `user_institutions` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
-				strmangle.WhereClause("`", "`", 0, userInstitutionPrimaryKeyColumns),
+				"UPDATE `user_sanctions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"revoked_by"}),
+				strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ImpartWealthID, rel.UserInstitutionID}
+			values := []interface{}{o.ImpartWealthID, rel.SanctionID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -3161,27 +5732,101 @@ func (o *User) AddImpartWealthUserInstitutions(ctx context.Context, exec boil.Co
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.ImpartWealthID = o.ImpartWealthID
+			queries.Assign(&rel.RevokedBy, o.ImpartWealthID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ImpartWealthUserInstitutions: related,
+			RevokedByUserSanctions: related,
 		}
 	} else {
-		o.R.ImpartWealthUserInstitutions = append(o.R.ImpartWealthUserInstitutions, related...)
+		o.R.RevokedByUserSanctions = append(o.R.RevokedByUserSanctions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userInstitutionR{
-				ImpartWealth: o,
+			rel.R = &userSanctionR{
+				RevokedByUser: o,
 			}
 		} else {
-			rel.R.ImpartWealth = o
+			rel.R.RevokedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetRevokedByUserSanctions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RevokedByUser's RevokedByUserSanctions accordingly.
+// Replaces o.R.RevokedByUserSanctions with related.
+// Sets related.R.RevokedByUser's RevokedByUserSanctions accordingly.
+func (o *User) SetRevokedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSanction) error {
+	query := "update `user_sanctions` set `revoked_by` = null where `revoked_by` = ?"
+	values := []interface{}{o.ImpartWealthID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RevokedByUserSanctions {
+			queries.SetScanner(&rel.RevokedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RevokedByUser = nil
+		}
+
+		o.R.RevokedByUserSanctions = nil
+	}
+	return o.AddRevokedByUserSanctions(ctx, exec, insert, related...)
+}
+
+// RemoveRevokedByUserSanctions relationships from objects passed in.
+// Removes related items from R.RevokedByUserSanctions (uses pointer comparison, removal does not keep order)
+// Sets related.R.RevokedByUser.
+func (o *User) RemoveRevokedByUserSanctions(ctx context.Context, exec boil.ContextExecutor, related ...*UserSanction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RevokedBy, nil)
+		if rel.R != nil {
+			rel.R.RevokedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("revoked_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RevokedByUserSanctions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RevokedByUserSanctions)
+			if ln > 1 && i < ln-1 {
+				o.R.RevokedByUserSanctions[i] = o.R.RevokedByUserSanctions[ln-1]
+			}
+			o.R.RevokedByUserSanctions = o.R.RevokedByUserSanctions[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
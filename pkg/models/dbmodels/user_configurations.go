@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,9 +24,12 @@ import (
 
 // UserConfiguration is an object representing the database table.
 type UserConfiguration struct {
-	ConfigID           uint   `boil:"config_id" json:"config_id" toml:"config_id" yaml:"config_id"`
-	ImpartWealthID     string `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
-	NotificationStatus bool   `boil:"notification_status" json:"notification_status" toml:"notification_status" yaml:"notification_status"`
+	ConfigID           uint        `boil:"config_id" json:"config_id" toml:"config_id" yaml:"config_id"`
+	ImpartWealthID     string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	NotificationStatus bool        `boil:"notification_status" json:"notification_status" toml:"notification_status" yaml:"notification_status"`
+	QuietHoursStart    null.Uint16 `boil:"quiet_hours_start" json:"quiet_hours_start,omitempty" toml:"quiet_hours_start" yaml:"quiet_hours_start,omitempty"`
+	QuietHoursEnd      null.Uint16 `boil:"quiet_hours_end" json:"quiet_hours_end,omitempty" toml:"quiet_hours_end" yaml:"quiet_hours_end,omitempty"`
+	Timezone           string      `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
 
 	R *userConfigurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userConfigurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -35,32 +39,73 @@ var UserConfigurationColumns = struct {
 	ConfigID           string
 	ImpartWealthID     string
 	NotificationStatus string
+	QuietHoursStart    string
+	QuietHoursEnd      string
+	Timezone           string
 }{
 	ConfigID:           "config_id",
 	ImpartWealthID:     "impart_wealth_id",
 	NotificationStatus: "notification_status",
+	QuietHoursStart:    "quiet_hours_start",
+	QuietHoursEnd:      "quiet_hours_end",
+	Timezone:           "timezone",
 }
 
 var UserConfigurationTableColumns = struct {
 	ConfigID           string
 	ImpartWealthID     string
 	NotificationStatus string
+	QuietHoursStart    string
+	QuietHoursEnd      string
+	Timezone           string
 }{
 	ConfigID:           "user_configurations.config_id",
 	ImpartWealthID:     "user_configurations.impart_wealth_id",
 	NotificationStatus: "user_configurations.notification_status",
+	QuietHoursStart:    "user_configurations.quiet_hours_start",
+	QuietHoursEnd:      "user_configurations.quiet_hours_end",
+	Timezone:           "user_configurations.timezone",
 }
 
 // Generated where
 
+type whereHelpernull_Uint16 struct{ field string }
+
+func (w whereHelpernull_Uint16) EQ(x null.Uint16) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Uint16) NEQ(x null.Uint16) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Uint16) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Uint16) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Uint16) LT(x null.Uint16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Uint16) LTE(x null.Uint16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Uint16) GT(x null.Uint16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Uint16) GTE(x null.Uint16) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var UserConfigurationWhere = struct {
 	ConfigID           whereHelperuint
 	ImpartWealthID     whereHelperstring
 	NotificationStatus whereHelperbool
+	QuietHoursStart    whereHelpernull_Uint16
+	QuietHoursEnd      whereHelpernull_Uint16
+	Timezone           whereHelperstring
 }{
 	ConfigID:           whereHelperuint{field: "`user_configurations`.`config_id`"},
 	ImpartWealthID:     whereHelperstring{field: "`user_configurations`.`impart_wealth_id`"},
 	NotificationStatus: whereHelperbool{field: "`user_configurations`.`notification_status`"},
+	QuietHoursStart:    whereHelpernull_Uint16{field: "`user_configurations`.`quiet_hours_start`"},
+	QuietHoursEnd:      whereHelpernull_Uint16{field: "`user_configurations`.`quiet_hours_end`"},
+	Timezone:           whereHelperstring{field: "`user_configurations`.`timezone`"},
 }
 
 // UserConfigurationRels is where relationship names are stored.
@@ -84,9 +129,9 @@ func (*userConfigurationR) NewStruct() *userConfigurationR {
 type userConfigurationL struct{}
 
 var (
-	userConfigurationAllColumns            = []string{"config_id", "impart_wealth_id", "notification_status"}
-	userConfigurationColumnsWithoutDefault = []string{"impart_wealth_id", "notification_status"}
-	userConfigurationColumnsWithDefault    = []string{"config_id"}
+	userConfigurationAllColumns            = []string{"config_id", "impart_wealth_id", "notification_status", "quiet_hours_start", "quiet_hours_end", "timezone"}
+	userConfigurationColumnsWithoutDefault = []string{"impart_wealth_id", "notification_status", "quiet_hours_start", "quiet_hours_end"}
+	userConfigurationColumnsWithDefault    = []string{"config_id", "timezone"}
 	userConfigurationPrimaryKeyColumns     = []string{"config_id"}
 )
 
@@ -35,6 +35,7 @@ type UserDevice struct {
 	DeletedAt      null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 	DeviceToken    string    `boil:"device_token" json:"device_token" toml:"device_token" yaml:"device_token"`
 	LastloginAt    null.Time `boil:"lastlogin_at" json:"lastlogin_at,omitempty" toml:"lastlogin_at" yaml:"lastlogin_at,omitempty"`
+	Platform       string    `boil:"platform" json:"platform" toml:"platform" yaml:"platform"`
 
 	R *userDeviceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userDeviceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +53,7 @@ var UserDeviceColumns = struct {
 	DeletedAt      string
 	DeviceToken    string
 	LastloginAt    string
+	Platform       string
 }{
 	Token:          "token",
 	ImpartWealthID: "impart_wealth_id",
@@ -64,6 +66,7 @@ var UserDeviceColumns = struct {
 	DeletedAt:      "deleted_at",
 	DeviceToken:    "device_token",
 	LastloginAt:    "lastlogin_at",
+	Platform:       "platform",
 }
 
 var UserDeviceTableColumns = struct {
@@ -78,6 +81,7 @@ var UserDeviceTableColumns = struct {
 	DeletedAt      string
 	DeviceToken    string
 	LastloginAt    string
+	Platform       string
 }{
 	Token:          "user_devices.token",
 	ImpartWealthID: "user_devices.impart_wealth_id",
@@ -90,6 +94,7 @@ var UserDeviceTableColumns = struct {
 	DeletedAt:      "user_devices.deleted_at",
 	DeviceToken:    "user_devices.device_token",
 	LastloginAt:    "user_devices.lastlogin_at",
+	Platform:       "user_devices.platform",
 }
 
 // Generated where
@@ -106,6 +111,7 @@ var UserDeviceWhere = struct {
 	DeletedAt      whereHelpernull_Time
 	DeviceToken    whereHelperstring
 	LastloginAt    whereHelpernull_Time
+	Platform       whereHelperstring
 }{
 	Token:          whereHelperstring{field: "`user_devices`.`token`"},
 	ImpartWealthID: whereHelperstring{field: "`user_devices`.`impart_wealth_id`"},
@@ -118,6 +124,7 @@ var UserDeviceWhere = struct {
 	DeletedAt:      whereHelpernull_Time{field: "`user_devices`.`deleted_at`"},
 	DeviceToken:    whereHelperstring{field: "`user_devices`.`device_token`"},
 	LastloginAt:    whereHelpernull_Time{field: "`user_devices`.`lastlogin_at`"},
+	Platform:       whereHelperstring{field: "`user_devices`.`platform`"},
 }
 
 // UserDeviceRels is where relationship names are stored.
@@ -144,9 +151,9 @@ func (*userDeviceR) NewStruct() *userDeviceR {
 type userDeviceL struct{}
 
 var (
-	userDeviceAllColumns            = []string{"token", "impart_wealth_id", "device_id", "app_version", "device_name", "device_version", "created_at", "updated_at", "deleted_at", "device_token", "lastlogin_at"}
+	userDeviceAllColumns            = []string{"token", "impart_wealth_id", "device_id", "app_version", "device_name", "device_version", "created_at", "updated_at", "deleted_at", "device_token", "lastlogin_at", "platform"}
 	userDeviceColumnsWithoutDefault = []string{"token", "impart_wealth_id", "device_id", "app_version", "device_name", "device_version", "created_at", "updated_at", "deleted_at", "device_token", "lastlogin_at"}
-	userDeviceColumnsWithDefault    = []string{}
+	userDeviceColumnsWithDefault    = []string{"platform"}
 	userDevicePrimaryKeyColumns     = []string{"token"}
 )
 
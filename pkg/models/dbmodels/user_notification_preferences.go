@@ -0,0 +1,1133 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserNotificationPreference is an object representing the database table.
+type UserNotificationPreference struct {
+	ImpartWealthID string    `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Category       string    `boil:"category" json:"category" toml:"category" yaml:"category"`
+	Enabled        bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *userNotificationPreferenceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userNotificationPreferenceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserNotificationPreferenceColumns = struct {
+	ImpartWealthID string
+	Category       string
+	Enabled        string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ImpartWealthID: "impart_wealth_id",
+	Category:       "category",
+	Enabled:        "enabled",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var UserNotificationPreferenceTableColumns = struct {
+	ImpartWealthID string
+	Category       string
+	Enabled        string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ImpartWealthID: "user_notification_preferences.impart_wealth_id",
+	Category:       "user_notification_preferences.category",
+	Enabled:        "user_notification_preferences.enabled",
+	CreatedAt:      "user_notification_preferences.created_at",
+	UpdatedAt:      "user_notification_preferences.updated_at",
+}
+
+// Generated where
+
+var UserNotificationPreferenceWhere = struct {
+	ImpartWealthID whereHelperstring
+	Category       whereHelperstring
+	Enabled        whereHelperbool
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ImpartWealthID: whereHelperstring{field: "`user_notification_preferences`.`impart_wealth_id`"},
+	Category:       whereHelperstring{field: "`user_notification_preferences`.`category`"},
+	Enabled:        whereHelperbool{field: "`user_notification_preferences`.`enabled`"},
+	CreatedAt:      whereHelpertime_Time{field: "`user_notification_preferences`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`user_notification_preferences`.`updated_at`"},
+}
+
+// UserNotificationPreferenceRels is where relationship names are stored.
+var UserNotificationPreferenceRels = struct {
+	ImpartWealth string
+}{
+	ImpartWealth: "ImpartWealth",
+}
+
+// userNotificationPreferenceR is where relationships are stored.
+type userNotificationPreferenceR struct {
+	ImpartWealth *User `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userNotificationPreferenceR) NewStruct() *userNotificationPreferenceR {
+	return &userNotificationPreferenceR{}
+}
+
+// userNotificationPreferenceL is where Load methods for each relationship are stored.
+type userNotificationPreferenceL struct{}
+
+var (
+	userNotificationPreferenceAllColumns            = []string{"impart_wealth_id", "category", "enabled", "created_at", "updated_at"}
+	userNotificationPreferenceColumnsWithoutDefault = []string{"impart_wealth_id", "category", "created_at", "updated_at"}
+	userNotificationPreferenceColumnsWithDefault    = []string{"enabled"}
+	userNotificationPreferencePrimaryKeyColumns     = []string{"impart_wealth_id", "category"}
+)
+
+type (
+	// UserNotificationPreferenceSlice is an alias for a slice of pointers to UserNotificationPreference.
+	// This should almost always be used instead of []UserNotificationPreference.
+	UserNotificationPreferenceSlice []*UserNotificationPreference
+	// UserNotificationPreferenceHook is the signature for custom UserNotificationPreference hook methods
+	UserNotificationPreferenceHook func(context.Context, boil.ContextExecutor, *UserNotificationPreference) error
+
+	userNotificationPreferenceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userNotificationPreferenceType                 = reflect.TypeOf(&UserNotificationPreference{})
+	userNotificationPreferenceMapping              = queries.MakeStructMapping(userNotificationPreferenceType)
+	userNotificationPreferencePrimaryKeyMapping, _ = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, userNotificationPreferencePrimaryKeyColumns)
+	userNotificationPreferenceInsertCacheMut       sync.RWMutex
+	userNotificationPreferenceInsertCache          = make(map[string]insertCache)
+	userNotificationPreferenceUpdateCacheMut       sync.RWMutex
+	userNotificationPreferenceUpdateCache          = make(map[string]updateCache)
+	userNotificationPreferenceUpsertCacheMut       sync.RWMutex
+	userNotificationPreferenceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userNotificationPreferenceBeforeInsertHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceBeforeUpdateHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceBeforeDeleteHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceBeforeUpsertHooks []UserNotificationPreferenceHook
+
+var userNotificationPreferenceAfterInsertHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceAfterSelectHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceAfterUpdateHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceAfterDeleteHooks []UserNotificationPreferenceHook
+var userNotificationPreferenceAfterUpsertHooks []UserNotificationPreferenceHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserNotificationPreference) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserNotificationPreference) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserNotificationPreference) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserNotificationPreference) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserNotificationPreference) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserNotificationPreference) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserNotificationPreference) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserNotificationPreference) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserNotificationPreference) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationPreferenceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserNotificationPreferenceHook registers your hook function for all future operations.
+func AddUserNotificationPreferenceHook(hookPoint boil.HookPoint, userNotificationPreferenceHook UserNotificationPreferenceHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		userNotificationPreferenceBeforeInsertHooks = append(userNotificationPreferenceBeforeInsertHooks, userNotificationPreferenceHook)
+	case boil.BeforeUpdateHook:
+		userNotificationPreferenceBeforeUpdateHooks = append(userNotificationPreferenceBeforeUpdateHooks, userNotificationPreferenceHook)
+	case boil.BeforeDeleteHook:
+		userNotificationPreferenceBeforeDeleteHooks = append(userNotificationPreferenceBeforeDeleteHooks, userNotificationPreferenceHook)
+	case boil.BeforeUpsertHook:
+		userNotificationPreferenceBeforeUpsertHooks = append(userNotificationPreferenceBeforeUpsertHooks, userNotificationPreferenceHook)
+	case boil.AfterInsertHook:
+		userNotificationPreferenceAfterInsertHooks = append(userNotificationPreferenceAfterInsertHooks, userNotificationPreferenceHook)
+	case boil.AfterSelectHook:
+		userNotificationPreferenceAfterSelectHooks = append(userNotificationPreferenceAfterSelectHooks, userNotificationPreferenceHook)
+	case boil.AfterUpdateHook:
+		userNotificationPreferenceAfterUpdateHooks = append(userNotificationPreferenceAfterUpdateHooks, userNotificationPreferenceHook)
+	case boil.AfterDeleteHook:
+		userNotificationPreferenceAfterDeleteHooks = append(userNotificationPreferenceAfterDeleteHooks, userNotificationPreferenceHook)
+	case boil.AfterUpsertHook:
+		userNotificationPreferenceAfterUpsertHooks = append(userNotificationPreferenceAfterUpsertHooks, userNotificationPreferenceHook)
+	}
+}
+
+// One returns a single userNotificationPreference record from the query.
+func (q userNotificationPreferenceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserNotificationPreference, error) {
+	o := &UserNotificationPreference{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for user_notification_preferences")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserNotificationPreference records from the query.
+func (q userNotificationPreferenceQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserNotificationPreferenceSlice, error) {
+	var o []*UserNotificationPreference
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to UserNotificationPreference slice")
+	}
+
+	if len(userNotificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserNotificationPreference records in the query.
+func (q userNotificationPreferenceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count user_notification_preferences rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userNotificationPreferenceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if user_notification_preferences exists")
+	}
+
+	return count > 0, nil
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *UserNotificationPreference) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userNotificationPreferenceL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserNotificationPreference interface{}, mods queries.Applicator) error {
+	var slice []*UserNotificationPreference
+	var object *UserNotificationPreference
+
+	if singular {
+		object = maybeUserNotificationPreference.(*UserNotificationPreference)
+	} else {
+		slice = *maybeUserNotificationPreference.(*[]*UserNotificationPreference)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userNotificationPreferenceR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userNotificationPreferenceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userNotificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthUserNotificationPreferences = append(foreign.R.ImpartWealthUserNotificationPreferences, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthUserNotificationPreferences = append(foreign.R.ImpartWealthUserNotificationPreferences, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the userNotificationPreference to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthUserNotificationPreferences.
+func (o *UserNotificationPreference) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_notification_preferences` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, userNotificationPreferencePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.ImpartWealthID, o.Category}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &userNotificationPreferenceR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthUserNotificationPreferences: UserNotificationPreferenceSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthUserNotificationPreferences = append(related.R.ImpartWealthUserNotificationPreferences, o)
+	}
+
+	return nil
+}
+
+// UserNotificationPreferences retrieves all the records using an executor.
+func UserNotificationPreferences(mods ...qm.QueryMod) userNotificationPreferenceQuery {
+	mods = append(mods, qm.From("`user_notification_preferences`"))
+	return userNotificationPreferenceQuery{NewQuery(mods...)}
+}
+
+// FindUserNotificationPreference retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserNotificationPreference(ctx context.Context, exec boil.ContextExecutor, impartWealthID string, category string, selectCols ...string) (*UserNotificationPreference, error) {
+	userNotificationPreferenceObj := &UserNotificationPreference{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `user_notification_preferences` where `impart_wealth_id`=? AND `category`=?", sel,
+	)
+
+	q := queries.Raw(query, impartWealthID, category)
+
+	err := q.Bind(ctx, exec, userNotificationPreferenceObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from user_notification_preferences")
+	}
+
+	if err = userNotificationPreferenceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userNotificationPreferenceObj, err
+	}
+
+	return userNotificationPreferenceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserNotificationPreference) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_notification_preferences provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userNotificationPreferenceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userNotificationPreferenceInsertCacheMut.RLock()
+	cache, cached := userNotificationPreferenceInsertCache[key]
+	userNotificationPreferenceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userNotificationPreferenceAllColumns,
+			userNotificationPreferenceColumnsWithDefault,
+			userNotificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `user_notification_preferences` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `user_notification_preferences` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `user_notification_preferences` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userNotificationPreferencePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into user_notification_preferences")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ImpartWealthID,
+		o.Category,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_notification_preferences")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userNotificationPreferenceInsertCacheMut.Lock()
+		userNotificationPreferenceInsertCache[key] = cache
+		userNotificationPreferenceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserNotificationPreference.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserNotificationPreference) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userNotificationPreferenceUpdateCacheMut.RLock()
+	cache, cached := userNotificationPreferenceUpdateCache[key]
+	userNotificationPreferenceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userNotificationPreferenceAllColumns,
+			userNotificationPreferencePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update user_notification_preferences, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `user_notification_preferences` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, userNotificationPreferencePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, append(wl, userNotificationPreferencePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update user_notification_preferences row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for user_notification_preferences")
+	}
+
+	if !cached {
+		userNotificationPreferenceUpdateCacheMut.Lock()
+		userNotificationPreferenceUpdateCache[key] = cache
+		userNotificationPreferenceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userNotificationPreferenceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for user_notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for user_notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserNotificationPreferenceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `user_notification_preferences` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPreferencePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in userNotificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all userNotificationPreference")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUserNotificationPreferenceUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserNotificationPreference) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_notification_preferences provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userNotificationPreferenceColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUserNotificationPreferenceUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userNotificationPreferenceUpsertCacheMut.RLock()
+	cache, cached := userNotificationPreferenceUpsertCache[key]
+	userNotificationPreferenceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userNotificationPreferenceAllColumns,
+			userNotificationPreferenceColumnsWithDefault,
+			userNotificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			userNotificationPreferenceAllColumns,
+			userNotificationPreferencePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert user_notification_preferences, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`user_notification_preferences`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `user_notification_preferences` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for user_notification_preferences")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(userNotificationPreferenceType, userNotificationPreferenceMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for user_notification_preferences")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_notification_preferences")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userNotificationPreferenceUpsertCacheMut.Lock()
+		userNotificationPreferenceUpsertCache[key] = cache
+		userNotificationPreferenceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserNotificationPreference record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserNotificationPreference) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no UserNotificationPreference provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userNotificationPreferencePrimaryKeyMapping)
+	sql := "DELETE FROM `user_notification_preferences` WHERE `impart_wealth_id`=? AND `category`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from user_notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for user_notification_preferences")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userNotificationPreferenceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no userNotificationPreferenceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from user_notification_preferences")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_notification_preferences")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserNotificationPreferenceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userNotificationPreferenceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `user_notification_preferences` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPreferencePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from userNotificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_notification_preferences")
+	}
+
+	if len(userNotificationPreferenceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserNotificationPreference) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserNotificationPreference(ctx, exec, o.ImpartWealthID, o.Category)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserNotificationPreferenceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserNotificationPreferenceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `user_notification_preferences`.* FROM `user_notification_preferences` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPreferencePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in UserNotificationPreferenceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserNotificationPreferenceExists checks if the UserNotificationPreference row exists.
+func UserNotificationPreferenceExists(ctx context.Context, exec boil.ContextExecutor, impartWealthID string, category string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `user_notification_preferences` where `impart_wealth_id`=? AND `category`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, impartWealthID, category)
+	}
+	row := exec.QueryRowContext(ctx, sql, impartWealthID, category)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if user_notification_preferences exists")
+	}
+
+	return exists, nil
+}
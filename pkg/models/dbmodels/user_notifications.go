@@ -0,0 +1,1169 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserNotification is an object representing the database table.
+type UserNotification struct {
+	NotificationID uint64     `boil:"notification_id" json:"notification_id" toml:"notification_id" yaml:"notification_id"`
+	ImpartWealthID string     `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Category       string     `boil:"category" json:"category" toml:"category" yaml:"category"`
+	Title          string     `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body           string     `boil:"body" json:"body" toml:"body" yaml:"body"`
+	Data           types.JSON `boil:"data" json:"data" toml:"data" yaml:"data"`
+	ReadAt         null.Time  `boil:"read_at" json:"read_at,omitempty" toml:"read_at" yaml:"read_at,omitempty"`
+	CreatedAt      time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *userNotificationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userNotificationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserNotificationColumns = struct {
+	NotificationID string
+	ImpartWealthID string
+	Category       string
+	Title          string
+	Body           string
+	Data           string
+	ReadAt         string
+	CreatedAt      string
+}{
+	NotificationID: "notification_id",
+	ImpartWealthID: "impart_wealth_id",
+	Category:       "category",
+	Title:          "title",
+	Body:           "body",
+	Data:           "data",
+	ReadAt:         "read_at",
+	CreatedAt:      "created_at",
+}
+
+var UserNotificationTableColumns = struct {
+	NotificationID string
+	ImpartWealthID string
+	Category       string
+	Title          string
+	Body           string
+	Data           string
+	ReadAt         string
+	CreatedAt      string
+}{
+	NotificationID: "user_notifications.notification_id",
+	ImpartWealthID: "user_notifications.impart_wealth_id",
+	Category:       "user_notifications.category",
+	Title:          "user_notifications.title",
+	Body:           "user_notifications.body",
+	Data:           "user_notifications.data",
+	ReadAt:         "user_notifications.read_at",
+	CreatedAt:      "user_notifications.created_at",
+}
+
+// Generated where
+
+var UserNotificationWhere = struct {
+	NotificationID whereHelperuint64
+	ImpartWealthID whereHelperstring
+	Category       whereHelperstring
+	Title          whereHelperstring
+	Body           whereHelperstring
+	Data           whereHelpertypes_JSON
+	ReadAt         whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+}{
+	NotificationID: whereHelperuint64{field: "`user_notifications`.`notification_id`"},
+	ImpartWealthID: whereHelperstring{field: "`user_notifications`.`impart_wealth_id`"},
+	Category:       whereHelperstring{field: "`user_notifications`.`category`"},
+	Title:          whereHelperstring{field: "`user_notifications`.`title`"},
+	Body:           whereHelperstring{field: "`user_notifications`.`body`"},
+	Data:           whereHelpertypes_JSON{field: "`user_notifications`.`data`"},
+	ReadAt:         whereHelpernull_Time{field: "`user_notifications`.`read_at`"},
+	CreatedAt:      whereHelpertime_Time{field: "`user_notifications`.`created_at`"},
+}
+
+// UserNotificationRels is where relationship names are stored.
+var UserNotificationRels = struct {
+	ImpartWealth string
+}{
+	ImpartWealth: "ImpartWealth",
+}
+
+// userNotificationR is where relationships are stored.
+type userNotificationR struct {
+	ImpartWealth *User `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userNotificationR) NewStruct() *userNotificationR {
+	return &userNotificationR{}
+}
+
+// userNotificationL is where Load methods for each relationship are stored.
+type userNotificationL struct{}
+
+var (
+	userNotificationAllColumns            = []string{"notification_id", "impart_wealth_id", "category", "title", "body", "data", "read_at", "created_at"}
+	userNotificationColumnsWithoutDefault = []string{"impart_wealth_id", "category", "title", "body", "data", "read_at", "created_at"}
+	userNotificationColumnsWithDefault    = []string{"notification_id"}
+	userNotificationPrimaryKeyColumns     = []string{"notification_id"}
+)
+
+type (
+	// UserNotificationSlice is an alias for a slice of pointers to UserNotification.
+	// This should almost always be used instead of []UserNotification.
+	UserNotificationSlice []*UserNotification
+	// UserNotificationHook is the signature for custom UserNotification hook methods
+	UserNotificationHook func(context.Context, boil.ContextExecutor, *UserNotification) error
+
+	userNotificationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userNotificationType                 = reflect.TypeOf(&UserNotification{})
+	userNotificationMapping              = queries.MakeStructMapping(userNotificationType)
+	userNotificationPrimaryKeyMapping, _ = queries.BindMapping(userNotificationType, userNotificationMapping, userNotificationPrimaryKeyColumns)
+	userNotificationInsertCacheMut       sync.RWMutex
+	userNotificationInsertCache          = make(map[string]insertCache)
+	userNotificationUpdateCacheMut       sync.RWMutex
+	userNotificationUpdateCache          = make(map[string]updateCache)
+	userNotificationUpsertCacheMut       sync.RWMutex
+	userNotificationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userNotificationBeforeInsertHooks []UserNotificationHook
+var userNotificationBeforeUpdateHooks []UserNotificationHook
+var userNotificationBeforeDeleteHooks []UserNotificationHook
+var userNotificationBeforeUpsertHooks []UserNotificationHook
+
+var userNotificationAfterInsertHooks []UserNotificationHook
+var userNotificationAfterSelectHooks []UserNotificationHook
+var userNotificationAfterUpdateHooks []UserNotificationHook
+var userNotificationAfterDeleteHooks []UserNotificationHook
+var userNotificationAfterUpsertHooks []UserNotificationHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserNotification) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserNotification) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserNotification) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserNotification) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserNotification) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserNotification) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserNotification) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserNotification) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserNotification) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userNotificationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserNotificationHook registers your hook function for all future operations.
+func AddUserNotificationHook(hookPoint boil.HookPoint, userNotificationHook UserNotificationHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		userNotificationBeforeInsertHooks = append(userNotificationBeforeInsertHooks, userNotificationHook)
+	case boil.BeforeUpdateHook:
+		userNotificationBeforeUpdateHooks = append(userNotificationBeforeUpdateHooks, userNotificationHook)
+	case boil.BeforeDeleteHook:
+		userNotificationBeforeDeleteHooks = append(userNotificationBeforeDeleteHooks, userNotificationHook)
+	case boil.BeforeUpsertHook:
+		userNotificationBeforeUpsertHooks = append(userNotificationBeforeUpsertHooks, userNotificationHook)
+	case boil.AfterInsertHook:
+		userNotificationAfterInsertHooks = append(userNotificationAfterInsertHooks, userNotificationHook)
+	case boil.AfterSelectHook:
+		userNotificationAfterSelectHooks = append(userNotificationAfterSelectHooks, userNotificationHook)
+	case boil.AfterUpdateHook:
+		userNotificationAfterUpdateHooks = append(userNotificationAfterUpdateHooks, userNotificationHook)
+	case boil.AfterDeleteHook:
+		userNotificationAfterDeleteHooks = append(userNotificationAfterDeleteHooks, userNotificationHook)
+	case boil.AfterUpsertHook:
+		userNotificationAfterUpsertHooks = append(userNotificationAfterUpsertHooks, userNotificationHook)
+	}
+}
+
+// One returns a single userNotification record from the query.
+func (q userNotificationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserNotification, error) {
+	o := &UserNotification{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for user_notifications")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserNotification records from the query.
+func (q userNotificationQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserNotificationSlice, error) {
+	var o []*UserNotification
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to UserNotification slice")
+	}
+
+	if len(userNotificationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserNotification records in the query.
+func (q userNotificationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count user_notifications rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userNotificationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if user_notifications exists")
+	}
+
+	return count > 0, nil
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *UserNotification) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userNotificationL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserNotification interface{}, mods queries.Applicator) error {
+	var slice []*UserNotification
+	var object *UserNotification
+
+	if singular {
+		object = maybeUserNotification.(*UserNotification)
+	} else {
+		slice = *maybeUserNotification.(*[]*UserNotification)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userNotificationR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userNotificationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userNotificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthUserNotifications = append(foreign.R.ImpartWealthUserNotifications, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthUserNotifications = append(foreign.R.ImpartWealthUserNotifications, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the userNotification to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthUserNotifications.
+func (o *UserNotification) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, userNotificationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.NotificationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &userNotificationR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthUserNotifications: UserNotificationSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthUserNotifications = append(related.R.ImpartWealthUserNotifications, o)
+	}
+
+	return nil
+}
+
+// UserNotifications retrieves all the records using an executor.
+func UserNotifications(mods ...qm.QueryMod) userNotificationQuery {
+	mods = append(mods, qm.From("`user_notifications`"))
+	return userNotificationQuery{NewQuery(mods...)}
+}
+
+// FindUserNotification retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserNotification(ctx context.Context, exec boil.ContextExecutor, notificationID uint64, selectCols ...string) (*UserNotification, error) {
+	userNotificationObj := &UserNotification{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `user_notifications` where `notification_id`=?", sel,
+	)
+
+	q := queries.Raw(query, notificationID)
+
+	err := q.Bind(ctx, exec, userNotificationObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from user_notifications")
+	}
+
+	if err = userNotificationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userNotificationObj, err
+	}
+
+	return userNotificationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserNotification) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_notifications provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userNotificationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userNotificationInsertCacheMut.RLock()
+	cache, cached := userNotificationInsertCache[key]
+	userNotificationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userNotificationAllColumns,
+			userNotificationColumnsWithDefault,
+			userNotificationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userNotificationType, userNotificationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userNotificationType, userNotificationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `user_notifications` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `user_notifications` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `user_notifications` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userNotificationPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into user_notifications")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.NotificationID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userNotificationMapping["notification_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.NotificationID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_notifications")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userNotificationInsertCacheMut.Lock()
+		userNotificationInsertCache[key] = cache
+		userNotificationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserNotification.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserNotification) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userNotificationUpdateCacheMut.RLock()
+	cache, cached := userNotificationUpdateCache[key]
+	userNotificationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userNotificationAllColumns,
+			userNotificationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update user_notifications, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `user_notifications` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, userNotificationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userNotificationType, userNotificationMapping, append(wl, userNotificationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update user_notifications row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for user_notifications")
+	}
+
+	if !cached {
+		userNotificationUpdateCacheMut.Lock()
+		userNotificationUpdateCache[key] = cache
+		userNotificationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userNotificationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for user_notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for user_notifications")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserNotificationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `user_notifications` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in userNotification slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all userNotification")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUserNotificationUniqueColumns = []string{
+	"notification_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserNotification) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_notifications provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userNotificationColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUserNotificationUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userNotificationUpsertCacheMut.RLock()
+	cache, cached := userNotificationUpsertCache[key]
+	userNotificationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userNotificationAllColumns,
+			userNotificationColumnsWithDefault,
+			userNotificationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			userNotificationAllColumns,
+			userNotificationPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert user_notifications, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`user_notifications`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `user_notifications` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userNotificationType, userNotificationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userNotificationType, userNotificationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for user_notifications")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.NotificationID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userNotificationMapping["notification_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(userNotificationType, userNotificationMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for user_notifications")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_notifications")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userNotificationUpsertCacheMut.Lock()
+		userNotificationUpsertCache[key] = cache
+		userNotificationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserNotification record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserNotification) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no UserNotification provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userNotificationPrimaryKeyMapping)
+	sql := "DELETE FROM `user_notifications` WHERE `notification_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from user_notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for user_notifications")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userNotificationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no userNotificationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from user_notifications")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_notifications")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserNotificationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userNotificationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `user_notifications` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from userNotification slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_notifications")
+	}
+
+	if len(userNotificationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserNotification) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserNotification(ctx, exec, o.NotificationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserNotificationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserNotificationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userNotificationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `user_notifications`.* FROM `user_notifications` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userNotificationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in UserNotificationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserNotificationExists checks if the UserNotification row exists.
+func UserNotificationExists(ctx context.Context, exec boil.ContextExecutor, notificationID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `user_notifications` where `notification_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, notificationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, notificationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if user_notifications exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1589 @@
+// Code generated by SQLBoiler 4.6.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserSanction is an object representing the database table.
+type UserSanction struct {
+	SanctionID     uint64      `boil:"sanction_id" json:"sanction_id" toml:"sanction_id" yaml:"sanction_id"`
+	ImpartWealthID string      `boil:"impart_wealth_id" json:"impart_wealth_id" toml:"impart_wealth_id" yaml:"impart_wealth_id"`
+	Kind           string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Reason         string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	IssuedBy       null.String `boil:"issued_by" json:"issued_by,omitempty" toml:"issued_by" yaml:"issued_by,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt      null.Time   `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	RevokedAt      null.Time   `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+	RevokedBy      null.String `boil:"revoked_by" json:"revoked_by,omitempty" toml:"revoked_by" yaml:"revoked_by,omitempty"`
+
+	R *userSanctionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userSanctionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserSanctionColumns = struct {
+	SanctionID     string
+	ImpartWealthID string
+	Kind           string
+	Reason         string
+	IssuedBy       string
+	CreatedAt      string
+	ExpiresAt      string
+	RevokedAt      string
+	RevokedBy      string
+}{
+	SanctionID:     "sanction_id",
+	ImpartWealthID: "impart_wealth_id",
+	Kind:           "kind",
+	Reason:         "reason",
+	IssuedBy:       "issued_by",
+	CreatedAt:      "created_at",
+	ExpiresAt:      "expires_at",
+	RevokedAt:      "revoked_at",
+	RevokedBy:      "revoked_by",
+}
+
+var UserSanctionTableColumns = struct {
+	SanctionID     string
+	ImpartWealthID string
+	Kind           string
+	Reason         string
+	IssuedBy       string
+	CreatedAt      string
+	ExpiresAt      string
+	RevokedAt      string
+	RevokedBy      string
+}{
+	SanctionID:     "user_sanctions.sanction_id",
+	ImpartWealthID: "user_sanctions.impart_wealth_id",
+	Kind:           "user_sanctions.kind",
+	Reason:         "user_sanctions.reason",
+	IssuedBy:       "user_sanctions.issued_by",
+	CreatedAt:      "user_sanctions.created_at",
+	ExpiresAt:      "user_sanctions.expires_at",
+	RevokedAt:      "user_sanctions.revoked_at",
+	RevokedBy:      "user_sanctions.revoked_by",
+}
+
+// Generated where
+
+var UserSanctionWhere = struct {
+	SanctionID     whereHelperuint64
+	ImpartWealthID whereHelperstring
+	Kind           whereHelperstring
+	Reason         whereHelperstring
+	IssuedBy       whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+	ExpiresAt      whereHelpernull_Time
+	RevokedAt      whereHelpernull_Time
+	RevokedBy      whereHelpernull_String
+}{
+	SanctionID:     whereHelperuint64{field: "`user_sanctions`.`sanction_id`"},
+	ImpartWealthID: whereHelperstring{field: "`user_sanctions`.`impart_wealth_id`"},
+	Kind:           whereHelperstring{field: "`user_sanctions`.`kind`"},
+	Reason:         whereHelperstring{field: "`user_sanctions`.`reason`"},
+	IssuedBy:       whereHelpernull_String{field: "`user_sanctions`.`issued_by`"},
+	CreatedAt:      whereHelpertime_Time{field: "`user_sanctions`.`created_at`"},
+	ExpiresAt:      whereHelpernull_Time{field: "`user_sanctions`.`expires_at`"},
+	RevokedAt:      whereHelpernull_Time{field: "`user_sanctions`.`revoked_at`"},
+	RevokedBy:      whereHelpernull_String{field: "`user_sanctions`.`revoked_by`"},
+}
+
+// UserSanctionRels is where relationship names are stored.
+var UserSanctionRels = struct {
+	ImpartWealth  string
+	IssuedByUser  string
+	RevokedByUser string
+}{
+	ImpartWealth:  "ImpartWealth",
+	IssuedByUser:  "IssuedByUser",
+	RevokedByUser: "RevokedByUser",
+}
+
+// userSanctionR is where relationships are stored.
+type userSanctionR struct {
+	ImpartWealth  *User `boil:"ImpartWealth" json:"ImpartWealth" toml:"ImpartWealth" yaml:"ImpartWealth"`
+	IssuedByUser  *User `boil:"IssuedByUser" json:"IssuedByUser" toml:"IssuedByUser" yaml:"IssuedByUser"`
+	RevokedByUser *User `boil:"RevokedByUser" json:"RevokedByUser" toml:"RevokedByUser" yaml:"RevokedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userSanctionR) NewStruct() *userSanctionR {
+	return &userSanctionR{}
+}
+
+// userSanctionL is where Load methods for each relationship are stored.
+type userSanctionL struct{}
+
+var (
+	userSanctionAllColumns            = []string{"sanction_id", "impart_wealth_id", "kind", "reason", "issued_by", "created_at", "expires_at", "revoked_at", "revoked_by"}
+	userSanctionColumnsWithoutDefault = []string{"impart_wealth_id", "kind", "reason", "issued_by", "created_at", "expires_at", "revoked_at", "revoked_by"}
+	userSanctionColumnsWithDefault    = []string{"sanction_id"}
+	userSanctionPrimaryKeyColumns     = []string{"sanction_id"}
+)
+
+type (
+	// UserSanctionSlice is an alias for a slice of pointers to UserSanction.
+	// This should almost always be used instead of []UserSanction.
+	UserSanctionSlice []*UserSanction
+	// UserSanctionHook is the signature for custom UserSanction hook methods
+	UserSanctionHook func(context.Context, boil.ContextExecutor, *UserSanction) error
+
+	userSanctionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userSanctionType                 = reflect.TypeOf(&UserSanction{})
+	userSanctionMapping              = queries.MakeStructMapping(userSanctionType)
+	userSanctionPrimaryKeyMapping, _ = queries.BindMapping(userSanctionType, userSanctionMapping, userSanctionPrimaryKeyColumns)
+	userSanctionInsertCacheMut       sync.RWMutex
+	userSanctionInsertCache          = make(map[string]insertCache)
+	userSanctionUpdateCacheMut       sync.RWMutex
+	userSanctionUpdateCache          = make(map[string]updateCache)
+	userSanctionUpsertCacheMut       sync.RWMutex
+	userSanctionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userSanctionBeforeInsertHooks []UserSanctionHook
+var userSanctionBeforeUpdateHooks []UserSanctionHook
+var userSanctionBeforeDeleteHooks []UserSanctionHook
+var userSanctionBeforeUpsertHooks []UserSanctionHook
+
+var userSanctionAfterInsertHooks []UserSanctionHook
+var userSanctionAfterSelectHooks []UserSanctionHook
+var userSanctionAfterUpdateHooks []UserSanctionHook
+var userSanctionAfterDeleteHooks []UserSanctionHook
+var userSanctionAfterUpsertHooks []UserSanctionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserSanction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserSanction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserSanction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserSanction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserSanction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserSanction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserSanction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserSanction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserSanction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userSanctionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserSanctionHook registers your hook function for all future operations.
+func AddUserSanctionHook(hookPoint boil.HookPoint, userSanctionHook UserSanctionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		userSanctionBeforeInsertHooks = append(userSanctionBeforeInsertHooks, userSanctionHook)
+	case boil.BeforeUpdateHook:
+		userSanctionBeforeUpdateHooks = append(userSanctionBeforeUpdateHooks, userSanctionHook)
+	case boil.BeforeDeleteHook:
+		userSanctionBeforeDeleteHooks = append(userSanctionBeforeDeleteHooks, userSanctionHook)
+	case boil.BeforeUpsertHook:
+		userSanctionBeforeUpsertHooks = append(userSanctionBeforeUpsertHooks, userSanctionHook)
+	case boil.AfterInsertHook:
+		userSanctionAfterInsertHooks = append(userSanctionAfterInsertHooks, userSanctionHook)
+	case boil.AfterSelectHook:
+		userSanctionAfterSelectHooks = append(userSanctionAfterSelectHooks, userSanctionHook)
+	case boil.AfterUpdateHook:
+		userSanctionAfterUpdateHooks = append(userSanctionAfterUpdateHooks, userSanctionHook)
+	case boil.AfterDeleteHook:
+		userSanctionAfterDeleteHooks = append(userSanctionAfterDeleteHooks, userSanctionHook)
+	case boil.AfterUpsertHook:
+		userSanctionAfterUpsertHooks = append(userSanctionAfterUpsertHooks, userSanctionHook)
+	}
+}
+
+// One returns a single userSanction record from the query.
+func (q userSanctionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserSanction, error) {
+	o := &UserSanction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for user_sanctions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserSanction records from the query.
+func (q userSanctionQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserSanctionSlice, error) {
+	var o []*UserSanction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to UserSanction slice")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserSanction records in the query.
+func (q userSanctionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count user_sanctions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userSanctionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if user_sanctions exists")
+	}
+
+	return count > 0, nil
+}
+
+// ImpartWealth pointed to by the foreign key.
+func (o *UserSanction) ImpartWealth(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.ImpartWealthID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// IssuedByUser pointed to by the foreign key.
+func (o *UserSanction) IssuedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.IssuedBy),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// RevokedByUser pointed to by the foreign key.
+func (o *UserSanction) RevokedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`impart_wealth_id` = ?", o.RevokedBy),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`user`")
+
+	return query
+}
+
+// LoadImpartWealth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userSanctionL) LoadImpartWealth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserSanction interface{}, mods queries.Applicator) error {
+	var slice []*UserSanction
+	var object *UserSanction
+
+	if singular {
+		object = maybeUserSanction.(*UserSanction)
+	} else {
+		slice = *maybeUserSanction.(*[]*UserSanction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userSanctionR{}
+		}
+		args = append(args, object.ImpartWealthID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userSanctionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ImpartWealthID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ImpartWealthID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ImpartWealth = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ImpartWealthUserSanctions = append(foreign.R.ImpartWealthUserSanctions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ImpartWealthID == foreign.ImpartWealthID {
+				local.R.ImpartWealth = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ImpartWealthUserSanctions = append(foreign.R.ImpartWealthUserSanctions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIssuedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userSanctionL) LoadIssuedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserSanction interface{}, mods queries.Applicator) error {
+	var slice []*UserSanction
+	var object *UserSanction
+
+	if singular {
+		object = maybeUserSanction.(*UserSanction)
+	} else {
+		slice = *maybeUserSanction.(*[]*UserSanction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userSanctionR{}
+		}
+		if !queries.IsNil(object.IssuedBy) {
+			args = append(args, object.IssuedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userSanctionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.IssuedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.IssuedBy) {
+				args = append(args, obj.IssuedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.IssuedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.IssuedByUserSanctions = append(foreign.R.IssuedByUserSanctions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.IssuedBy, foreign.ImpartWealthID) {
+				local.R.IssuedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.IssuedByUserSanctions = append(foreign.R.IssuedByUserSanctions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRevokedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userSanctionL) LoadRevokedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserSanction interface{}, mods queries.Applicator) error {
+	var slice []*UserSanction
+	var object *UserSanction
+
+	if singular {
+		object = maybeUserSanction.(*UserSanction)
+	} else {
+		slice = *maybeUserSanction.(*[]*UserSanction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userSanctionR{}
+		}
+		if !queries.IsNil(object.RevokedBy) {
+			args = append(args, object.RevokedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userSanctionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RevokedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RevokedBy) {
+				args = append(args, obj.RevokedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.impart_wealth_id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(userSanctionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RevokedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RevokedByUserSanctions = append(foreign.R.RevokedByUserSanctions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RevokedBy, foreign.ImpartWealthID) {
+				local.R.RevokedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RevokedByUserSanctions = append(foreign.R.RevokedByUserSanctions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetImpartWealth of the userSanction to the related item.
+// Sets o.R.ImpartWealth to related.
+// Adds o to related.R.ImpartWealthUserSanctions.
+func (o *UserSanction) SetImpartWealth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_sanctions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"impart_wealth_id"}),
+		strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.SanctionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ImpartWealthID = related.ImpartWealthID
+	if o.R == nil {
+		o.R = &userSanctionR{
+			ImpartWealth: related,
+		}
+	} else {
+		o.R.ImpartWealth = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ImpartWealthUserSanctions: UserSanctionSlice{o},
+		}
+	} else {
+		related.R.ImpartWealthUserSanctions = append(related.R.ImpartWealthUserSanctions, o)
+	}
+
+	return nil
+}
+
+// SetIssuedByUser of the userSanction to the related item.
+// Sets o.R.IssuedByUser to related.
+// Adds o to related.R.IssuedByUserSanctions.
+func (o *UserSanction) SetIssuedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_sanctions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"issued_by"}),
+		strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.SanctionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.IssuedBy, related.ImpartWealthID)
+	if o.R == nil {
+		o.R = &userSanctionR{
+			IssuedByUser: related,
+		}
+	} else {
+		o.R.IssuedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			IssuedByUserSanctions: UserSanctionSlice{o},
+		}
+	} else {
+		related.R.IssuedByUserSanctions = append(related.R.IssuedByUserSanctions, o)
+	}
+
+	return nil
+}
+
+// RemoveIssuedByUser relationship.
+// Sets o.R.IssuedByUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *UserSanction) RemoveIssuedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.IssuedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("issued_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.IssuedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.IssuedByUserSanctions {
+		if queries.Equal(o.IssuedBy, ri.IssuedBy) {
+			continue
+		}
+
+		ln := len(related.R.IssuedByUserSanctions)
+		if ln > 1 && i < ln-1 {
+			related.R.IssuedByUserSanctions[i] = related.R.IssuedByUserSanctions[ln-1]
+		}
+		related.R.IssuedByUserSanctions = related.R.IssuedByUserSanctions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRevokedByUser of the userSanction to the related item.
+// Sets o.R.RevokedByUser to related.
+// Adds o to related.R.RevokedByUserSanctions.
+func (o *UserSanction) SetRevokedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user_sanctions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"revoked_by"}),
+		strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ImpartWealthID, o.SanctionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RevokedBy, related.ImpartWealthID)
+	if o.R == nil {
+		o.R = &userSanctionR{
+			RevokedByUser: related,
+		}
+	} else {
+		o.R.RevokedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RevokedByUserSanctions: UserSanctionSlice{o},
+		}
+	} else {
+		related.R.RevokedByUserSanctions = append(related.R.RevokedByUserSanctions, o)
+	}
+
+	return nil
+}
+
+// RemoveRevokedByUser relationship.
+// Sets o.R.RevokedByUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *UserSanction) RemoveRevokedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.RevokedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("revoked_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RevokedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RevokedByUserSanctions {
+		if queries.Equal(o.RevokedBy, ri.RevokedBy) {
+			continue
+		}
+
+		ln := len(related.R.RevokedByUserSanctions)
+		if ln > 1 && i < ln-1 {
+			related.R.RevokedByUserSanctions[i] = related.R.RevokedByUserSanctions[ln-1]
+		}
+		related.R.RevokedByUserSanctions = related.R.RevokedByUserSanctions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// UserSanctions retrieves all the records using an executor.
+func UserSanctions(mods ...qm.QueryMod) userSanctionQuery {
+	mods = append(mods, qm.From("`user_sanctions`"))
+	return userSanctionQuery{NewQuery(mods...)}
+}
+
+// FindUserSanction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserSanction(ctx context.Context, exec boil.ContextExecutor, sanctionID uint64, selectCols ...string) (*UserSanction, error) {
+	userSanctionObj := &UserSanction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `user_sanctions` where `sanction_id`=?", sel,
+	)
+
+	q := queries.Raw(query, sanctionID)
+
+	err := q.Bind(ctx, exec, userSanctionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from user_sanctions")
+	}
+
+	if err = userSanctionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userSanctionObj, err
+	}
+
+	return userSanctionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserSanction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_sanctions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userSanctionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userSanctionInsertCacheMut.RLock()
+	cache, cached := userSanctionInsertCache[key]
+	userSanctionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userSanctionAllColumns,
+			userSanctionColumnsWithDefault,
+			userSanctionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userSanctionType, userSanctionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userSanctionType, userSanctionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `user_sanctions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `user_sanctions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `user_sanctions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into user_sanctions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.SanctionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userSanctionMapping["sanction_id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.SanctionID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_sanctions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userSanctionInsertCacheMut.Lock()
+		userSanctionInsertCache[key] = cache
+		userSanctionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserSanction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserSanction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userSanctionUpdateCacheMut.RLock()
+	cache, cached := userSanctionUpdateCache[key]
+	userSanctionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userSanctionAllColumns,
+			userSanctionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update user_sanctions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `user_sanctions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, userSanctionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userSanctionType, userSanctionMapping, append(wl, userSanctionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update user_sanctions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for user_sanctions")
+	}
+
+	if !cached {
+		userSanctionUpdateCacheMut.Lock()
+		userSanctionUpdateCache[key] = cache
+		userSanctionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userSanctionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for user_sanctions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for user_sanctions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserSanctionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userSanctionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `user_sanctions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userSanctionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in userSanction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all userSanction")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUserSanctionUniqueColumns = []string{
+	"sanction_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserSanction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_sanctions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userSanctionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUserSanctionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userSanctionUpsertCacheMut.RLock()
+	cache, cached := userSanctionUpsertCache[key]
+	userSanctionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userSanctionAllColumns,
+			userSanctionColumnsWithDefault,
+			userSanctionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			userSanctionAllColumns,
+			userSanctionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert user_sanctions, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`user_sanctions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `user_sanctions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userSanctionType, userSanctionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userSanctionType, userSanctionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert for user_sanctions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.SanctionID = uint64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userSanctionMapping["sanction_id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(userSanctionType, userSanctionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to retrieve unique values for user_sanctions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to populate default values for user_sanctions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userSanctionUpsertCacheMut.Lock()
+		userSanctionUpsertCache[key] = cache
+		userSanctionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserSanction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserSanction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no UserSanction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userSanctionPrimaryKeyMapping)
+	sql := "DELETE FROM `user_sanctions` WHERE `sanction_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from user_sanctions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for user_sanctions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userSanctionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no userSanctionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from user_sanctions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_sanctions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserSanctionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userSanctionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userSanctionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `user_sanctions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userSanctionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from userSanction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_sanctions")
+	}
+
+	if len(userSanctionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserSanction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserSanction(ctx, exec, o.SanctionID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserSanctionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserSanctionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userSanctionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `user_sanctions`.* FROM `user_sanctions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userSanctionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in UserSanctionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserSanctionExists checks if the UserSanction row exists.
+func UserSanctionExists(ctx context.Context, exec boil.ContextExecutor, sanctionID uint64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `user_sanctions` where `sanction_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, sanctionID)
+	}
+	row := exec.QueryRowContext(ctx, sql, sanctionID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if user_sanctions exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type Drafts []Draft
+
+// Draft is an unpublished post or comment, Post is set for post drafts and Comment for comment drafts
+type Draft struct {
+	DraftID   uint64    `json:"draftId"`
+	Kind      string    `json:"kind"`
+	HiveID    uint64    `json:"hiveId,omitempty"`
+	PostID    uint64    `json:"postId,omitempty"`
+	Post      *Post     `json:"post,omitempty"`
+	Comment   *Comment  `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type PagedDraftsResponse struct {
+	Drafts   Drafts    `json:"drafts"`
+	NextPage *NextPage `json:"nextPage"`
+}
+
+// Payload is the post or comment of the draft as it is stored
+func (d Draft) Payload() ([]byte, error) {
+	if d.Kind == dbmodels.DraftsKindComment {
+		return json.Marshal(d.Comment)
+	}
+	return json.Marshal(d.Post)
+}
+
+func DraftFromDBModel(d *dbmodels.Draft) (Draft, error) {
+	out := Draft{
+		DraftID:   d.DraftID,
+		Kind:      d.Kind,
+		HiveID:    d.HiveID.Uint64,
+		PostID:    d.PostID.Uint64,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+	var err error
+	if d.Kind == dbmodels.DraftsKindComment {
+		out.Comment = &Comment{}
+		err = d.Payload.Unmarshal(out.Comment)
+	} else {
+		out.Post = &Post{}
+		err = d.Payload.Unmarshal(out.Post)
+	}
+	return out, err
+}
+
+func DraftsFromDBModel(drafts dbmodels.DraftSlice) (Drafts, error) {
+	out := make(Drafts, len(drafts))
+	for i, d := range drafts {
+		var err error
+		if out[i], err = DraftFromDBModel(d); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDraftPayloadRoundTrip(t *testing.T) {
+	draft := Draft{
+		Kind: dbmodels.DraftsKindPost,
+		Post: &Post{HiveID: 3, Subject: "a subject", Content: Content{Markdown: "some content"}, TagIDs: []int{1}, Url: "https://example.com"},
+	}
+	payload, err := draft.Payload()
+	assert.NoError(t, err)
+
+	out, err := DraftFromDBModel(&dbmodels.Draft{DraftID: 9, Kind: dbmodels.DraftsKindPost, HiveID: null.Uint64From(3), Payload: payload})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), out.DraftID)
+	assert.Nil(t, out.Comment)
+	assert.Equal(t, "a subject", out.Post.Subject)
+	assert.Equal(t, "https://example.com", out.Post.Url)
+	assert.Equal(t, uint64(3), out.HiveID)
+
+	draft = Draft{Kind: dbmodels.DraftsKindComment, Comment: &Comment{PostID: 4, ParentCommentID: 7, Content: Content{Markdown: "a reply"}}}
+	payload, err = draft.Payload()
+	assert.NoError(t, err)
+	out, err = DraftFromDBModel(&dbmodels.Draft{Kind: dbmodels.DraftsKindComment, PostID: null.Uint64From(4), Payload: payload})
+	assert.NoError(t, err)
+	assert.Nil(t, out.Post)
+	assert.Equal(t, uint64(7), out.Comment.ParentCommentID)
+	assert.Equal(t, "a reply", out.Comment.Content.Markdown)
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
 // file
 type File struct {
 	FID      int
@@ -8,4 +14,40 @@ type File struct {
 	FileType string `json:"fileType"`
 	URL      string `json:"url"`
 	Content  string `json:"content"`
+	// renditions of an image, set once it is processed
+	ThumbnailURL     string `json:"thumbnailUrl,omitempty"`
+	MediumURL        string `json:"mediumUrl,omitempty"`
+	ProcessingStatus string `json:"processingStatus,omitempty"`
+	// StorageKey is where the file is stored, the urls are signed from it
+	StorageKey string `json:"-"`
+}
+
+func FileFromDBModel(f *dbmodels.File) File {
+	return File{
+		FID:              int(f.Fid),
+		FileName:         f.FileName,
+		FileType:         f.FileType,
+		URL:              f.URL,
+		ThumbnailURL:     f.ThumbnailURL.String,
+		MediumURL:        f.MediumURL.String,
+		ProcessingStatus: f.ProcessingStatus,
+		StorageKey:       f.StorageKey.String,
+	}
+}
+
+// Upload is a file the client is about to upload directly to the storage
+type Upload struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+// UploadSlot is where the client uploads a file, the file is referenced by FileID once uploaded.
+// Headers have to be sent with the upload as they are part of the signed url.
+type UploadSlot struct {
+	FileID    uint64            `json:"fileId"`
+	UploadURL string            `json:"uploadUrl"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expiresAt"`
 }
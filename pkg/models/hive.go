@@ -52,6 +52,9 @@ type Hive struct {
 	PinnedPostID   uint64              `json:"pinnedPostId,omitempty"`
 	TagComparisons tags.TagComparisons `json:"tagComparisons,omitempty"`
 	//PinnedPostNotificationTopicARN string              `json:"pinnedPostNotificationTopicARN"`
+	// ReportHideThreshold is the number of members whose reports hide a post or comment,
+	// weighted by their reporting history
+	ReportHideThreshold uint16 `json:"reportHideThreshold,omitempty"`
 }
 
 type HiveMetrics struct {
@@ -104,6 +107,7 @@ type PostCommentTrack struct {
 	VotedDatetime  time.Time `json:"votedDatetime,omitempty"`
 	Reported       bool      `json:"reported"`
 	ReportedReason string    `json:"reportedReason" conform:"trim"`
+	Following      bool      `json:"following"`
 }
 
 func PostCommentTrackFromDB(p *dbmodels.PostReaction, c *dbmodels.CommentReaction) PostCommentTrack {
@@ -233,10 +237,11 @@ func HivesFromDB(dbHives dbmodels.HiveSlice) (Hives, error) {
 
 func HiveFromDB(dbHive *dbmodels.Hive) (Hive, error) {
 	out := Hive{
-		HiveID:          dbHive.HiveID,
-		HiveName:        dbHive.Name,
-		HiveDescription: dbHive.Description,
-		PinnedPostID:    dbHive.PinnedPostID.Uint64,
+		HiveID:              dbHive.HiveID,
+		HiveName:            dbHive.Name,
+		HiveDescription:     dbHive.Description,
+		PinnedPostID:        dbHive.PinnedPostID.Uint64,
+		ReportHideThreshold: dbHive.ReportHideThreshold,
 	}
 
 	if err := dbHive.HiveDistributions.Unmarshal(&out.HiveDistributions); err != nil {
@@ -258,7 +263,8 @@ func (h Hive) ToDBModel() (*dbmodels.Hive, error) {
 		PinnedPostID: null.Uint64From(h.PinnedPostID),
 		//TagComparisons:       null.JSON{},
 		//HiveDistributions:    null.JSON{},
-		CreatedAt: null.TimeFrom(impart.CurrentUTC()),
+		CreatedAt:           null.TimeFrom(impart.CurrentUTC()),
+		ReportHideThreshold: h.ReportHideThreshold,
 	}
 	err := dbh.HiveDistributions.Marshal(&h.HiveDistributions)
 	if err != nil {
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type ScheduledJobs []ScheduledJob
+type ScheduledJob struct {
+	JobName         string     `json:"jobName"`
+	Schedule        string     `json:"schedule"`
+	LastScheduledAt *time.Time `json:"lastScheduledAt,omitempty"`
+	LastStartedAt   *time.Time `json:"lastStartedAt,omitempty"`
+	LastFinishedAt  *time.Time `json:"lastFinishedAt,omitempty"`
+	LastStatus      string     `json:"lastStatus"`
+	LastError       string     `json:"lastError,omitempty"`
+	LastErrorAt     *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+type ScheduledJobRuns []ScheduledJobRun
+type ScheduledJobRun struct {
+	RunID       uint64     `json:"runId"`
+	JobName     string     `json:"jobName"`
+	InstanceID  string     `json:"instanceId"`
+	ScheduledAt time.Time  `json:"scheduledAt"`
+	StartedAt   time.Time  `json:"startedAt"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+}
+
+type PagedScheduledJobRunsResponse struct {
+	Runs     ScheduledJobRuns `json:"runs"`
+	NextPage *NextPage        `json:"nextPage"`
+}
+
+func ScheduledJobFromDB(j *dbmodels.ScheduledJob) ScheduledJob {
+	out := ScheduledJob{
+		JobName:    j.JobName,
+		Schedule:   j.Schedule,
+		LastStatus: j.LastStatus,
+		LastError:  j.LastError.String,
+	}
+	if j.LastScheduledAt.Valid {
+		out.LastScheduledAt = &j.LastScheduledAt.Time
+	}
+	if j.LastStartedAt.Valid {
+		out.LastStartedAt = &j.LastStartedAt.Time
+	}
+	if j.LastFinishedAt.Valid {
+		out.LastFinishedAt = &j.LastFinishedAt.Time
+	}
+	if j.LastErrorAt.Valid {
+		out.LastErrorAt = &j.LastErrorAt.Time
+	}
+	return out
+}
+
+func ScheduledJobsFromDB(jobs dbmodels.ScheduledJobSlice) ScheduledJobs {
+	out := make(ScheduledJobs, len(jobs))
+	for i, j := range jobs {
+		out[i] = ScheduledJobFromDB(j)
+	}
+	return out
+}
+
+func ScheduledJobRunsFromDB(runs dbmodels.ScheduledJobRunSlice) ScheduledJobRuns {
+	out := make(ScheduledJobRuns, len(runs))
+	for i, r := range runs {
+		out[i] = ScheduledJobRun{
+			RunID:       r.RunID,
+			JobName:     r.JobName,
+			InstanceID:  r.InstanceID,
+			ScheduledAt: r.ScheduledAt,
+			StartedAt:   r.StartedAt,
+			Status:      r.Status,
+			Error:       r.Error.String,
+		}
+		if r.FinishedAt.Valid {
+			finishedAt := r.FinishedAt.Time
+			out[i].FinishedAt = &finishedAt
+		}
+	}
+	return out
+}
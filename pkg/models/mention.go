@@ -0,0 +1,25 @@
+package models
+
+import "github.com/impartwealthapp/backend/pkg/models/dbmodels"
+
+// Mention is a member mentioned by @screenName in a post or comment
+type Mentions []Mention
+type Mention struct {
+	ImpartWealthID string `json:"impartWealthId"`
+	ScreenName     string `json:"screenName"`
+}
+
+func MentionFromDBModel(u *dbmodels.User) Mention {
+	return Mention{
+		ImpartWealthID: u.ImpartWealthID,
+		ScreenName:     u.ScreenName,
+	}
+}
+
+func MentionsFromDBModel(users dbmodels.UserSlice) Mentions {
+	out := make(Mentions, len(users))
+	for i, u := range users {
+		out[i] = MentionFromDBModel(u)
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type Notifications []Notification
+type Notification struct {
+	NotificationID uint64                  `json:"notificationId"`
+	Category       string                  `json:"category,omitempty"`
+	Title          string                  `json:"title"`
+	Body           string                  `json:"body"`
+	Data           impart.NotificationData `json:"data"`
+	Read           bool                    `json:"read"`
+	ReadAt         *time.Time              `json:"readAt,omitempty"`
+	CreatedAt      time.Time               `json:"createdAt"`
+}
+
+type PagedNotificationsResponse struct {
+	Notifications Notifications `json:"notifications"`
+	NextPage      *NextPage     `json:"nextPage"`
+}
+
+type NotificationUnreadCount struct {
+	Unread int64 `json:"unread"`
+}
+
+type MarkNotificationsReadInput struct {
+	NotificationIDs []uint64 `json:"notificationIds" binding:"required"`
+}
+
+func NotificationFromDBModel(n *dbmodels.UserNotification) Notification {
+	out := Notification{
+		NotificationID: n.NotificationID,
+		Category:       n.Category,
+		Title:          n.Title,
+		Body:           n.Body,
+		Read:           n.ReadAt.Valid,
+		CreatedAt:      n.CreatedAt,
+	}
+	if n.ReadAt.Valid {
+		out.ReadAt = &n.ReadAt.Time
+	}
+	// the deep link is best effort, an unreadable payload still lists the notification
+	_ = json.Unmarshal(n.Data, &out.Data)
+	return out
+}
+
+func NotificationsFromDBModel(notifications dbmodels.UserNotificationSlice) Notifications {
+	out := make(Notifications, len(notifications))
+	for i, n := range notifications {
+		out[i] = NotificationFromDBModel(n)
+	}
+	return out
+}
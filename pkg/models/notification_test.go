@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestNotificationFromDBModel(t *testing.T) {
+	now := impart.CurrentUTC()
+	out := NotificationFromDBModel(&dbmodels.UserNotification{
+		NotificationID: 3,
+		Category:       impart.PostCommentNotification.String(),
+		Title:          "New Activity on Your Post",
+		Body:           "someone commented on your post",
+		Data:           []byte(`{"eventDatetime":"2022-01-17T09:41:05Z","postId":12,"category":"post_comment"}`),
+		CreatedAt:      now,
+	})
+	assert.False(t, out.Read)
+	assert.Nil(t, out.ReadAt)
+	assert.Equal(t, uint64(12), out.Data.PostID)
+	assert.Equal(t, impart.PostCommentNotification, out.Data.Category)
+
+	out = NotificationFromDBModel(&dbmodels.UserNotification{
+		Data:   []byte(`not json`),
+		ReadAt: null.TimeFrom(now),
+	})
+	assert.True(t, out.Read)
+	assert.Equal(t, now, *out.ReadAt)
+	assert.Equal(t, uint64(0), out.Data.PostID)
+}
@@ -6,14 +6,12 @@ import (
 	"fmt"
 	"math"
 	"reflect"
-	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/impartwealthapp/backend/pkg/data/types"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
-	"github.com/otiai10/opengraph/v2"
 	"github.com/volatiletech/null/v8"
 
 	r "github.com/Pallinder/go-randomdata"
@@ -40,6 +38,7 @@ type ReportedUser struct {
 type Posts []Post
 type Post struct {
 	HiveID              uint64           `json:"hiveId" jsonschema:"minLength=27,maxLength=27"`
+	HiveName            string           `json:"hiveName,omitempty"`
 	IsPinnedPost        bool             `json:"isPinnedPost"`
 	PostID              uint64           `json:"postId"`
 	PostDatetime        time.Time        `json:"postDatetime"`
@@ -58,6 +57,7 @@ type Post struct {
 	NextCommentPage     *NextPage        `json:"nextCommentPage"`
 	ReportedCount       int              `json:"reportedCount"`
 	Obfuscated          bool             `json:"obfuscated"`
+	Held                bool             `json:"held"`
 	Reviewed            bool             `json:"reviewed"`
 	ReviewComment       string           `json:"reviewComment"`
 	ReviewedDatetime    time.Time        `json:"reviewedDatetime,omitempty"`
@@ -66,6 +66,7 @@ type Post struct {
 	Video               PostVideo        `json:"video,omitempty"`
 	IsAdminPost         bool             `json:"isAdminPost"`
 	Files               []File           `json:"file,omitempty"`
+	FileIDs             []uint64         `json:"fileIds,omitempty"`
 	Url                 string           `json:"url,omitempty"`
 	UrlData             PostUrl          `json:"urlData,omitempty"`
 	Hives               []uint64         `json:"hives,omitempty"`
@@ -75,6 +76,7 @@ type Post struct {
 	AvatarBackground    string           `json:"avatarBackground,omitempty"`
 	AvatarLetter        string           `json:"avatarLetter,omitempty"`
 	LoggedInUserDetails LoggedInUser     `json:"loggedInUserDetails"`
+	Mentions            Mentions         `json:"mentions,omitempty"`
 }
 
 type PostVideo struct {
@@ -198,6 +200,7 @@ func PostFromDB(p *dbmodels.Post, loggedInUser *dbmodels.User) Post {
 		//NextCommentPage:     nil,
 		ReportedCount: p.ReportedCount,
 		Obfuscated:    p.Obfuscated,
+		Held:          p.Held,
 		Reviewed:      p.Reviewed,
 		ReviewComment: p.ReviewComment.String,
 	}
@@ -212,6 +215,9 @@ func PostFromDB(p *dbmodels.Post, loggedInUser *dbmodels.User) Post {
 	if p.ReviewedAt.Valid {
 		out.ReviewedDatetime = p.ReviewedAt.Time
 	}
+	if p.R.Hive != nil {
+		out.HiveName = p.R.Hive.Name
+	}
 	if len(p.R.Tags) > 0 {
 		out.TagIDs = make([]int, len(p.R.Tags), len(p.R.Tags))
 		for i, tId := range p.R.Tags {
@@ -333,12 +339,7 @@ func PostsWithlimit(dbPosts dbmodels.PostSlice, limit int, loggedInUser *dbmodel
 }
 
 func PostFileToFile(f *dbmodels.PostFile) File {
-	return File{
-		FID:      int(f.Fid),
-		FileName: f.R.FidFile.FileName,
-		FileType: f.R.FidFile.FileType,
-		URL:      f.R.FidFile.URL,
-	}
+	return FileFromDBModel(f.R.FidFile)
 }
 
 func (p PostVideo) PostVideoToDBModel(postId uint64) *dbmodels.PostVideo {
@@ -350,27 +351,13 @@ func (p PostVideo) PostVideoToDBModel(postId uint64) *dbmodels.PostVideo {
 	}
 	return out
 }
-func (p PostUrl) PostUrlToDBModel(postId uint64, postUrl string) *dbmodels.PostURL {
-	match, _ := regexp.MatchString(`^(?:f|ht)tps?://`, postUrl)
-	var imageUrl string
-	if !match && postUrl != "" {
-		postUrl = "http://" + postUrl
-	}
-	ogp, err := opengraph.Fetch(postUrl)
-
-	if err != nil {
-	}
-	if ogp != nil && ogp.Image != nil && len(ogp.Image) > 0 {
-		imageUrl = ogp.Image[0].URL
-	} else {
-		imageUrl = ""
-	}
+func (p PostUrl) PostUrlToDBModel(postId uint64) *dbmodels.PostURL {
 	out := &dbmodels.PostURL{
-		Title:       ogp.Title,
-		ImageUrl:    imageUrl,
-		URL:         null.StringFrom(postUrl),
+		Title:       p.Title,
+		ImageUrl:    p.ImageUrl,
+		URL:         null.StringFrom(p.Url),
 		PostID:      postId,
-		Description: ogp.Description,
+		Description: p.Description,
 	}
 	return out
 }
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type ProfanityWords []ProfanityWord
+type ProfanityWord struct {
+	WordID    uint64    `json:"wordId"`
+	Word      string    `json:"word"`
+	Severity  string    `json:"severity"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type PagedProfanityWordsResponse struct {
+	Words    ProfanityWords `json:"words"`
+	NextPage *NextPage      `json:"nextPage"`
+}
+
+type NewProfanityWordInput struct {
+	Word     string `json:"word" binding:"required"`
+	Severity string `json:"severity"`
+}
+
+// UpdateProfanityWordInput changes only the fields that are set
+type UpdateProfanityWordInput struct {
+	Severity *string `json:"severity"`
+	Enabled  *bool   `json:"enabled"`
+}
+
+func ProfanityWordFromDBModel(w *dbmodels.ProfanityWordsList) ProfanityWord {
+	return ProfanityWord{
+		WordID:    w.WordID,
+		Word:      w.Word,
+		Severity:  w.Severity,
+		Enabled:   w.Enabled,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func ProfanityWordsFromDBModel(words dbmodels.ProfanityWordsListSlice) ProfanityWords {
+	out := make(ProfanityWords, len(words))
+	for i, w := range words {
+		out[i] = ProfanityWordFromDBModel(w)
+	}
+	return out
+}
@@ -55,6 +55,7 @@ type Profile struct {
 	FullName              string          `json:"fullName,omitempty" conform:"trim,ucfirst"`
 	AvatarBackground      string          `json:"avatarBackground,omitempty" conform:"trim"`
 	AvatarLetter          string          `json:"avatarLetter,omitempty" conform:"trim"`
+	Standing              *MemberStanding `json:"standing,omitempty"`
 }
 
 // Attributes for Impart Wealth
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+type Sanctions []Sanction
+type Sanction struct {
+	SanctionID     uint64     `json:"sanctionId"`
+	ImpartWealthID string     `json:"impartWealthId"`
+	Kind           string     `json:"kind"`
+	Reason         string     `json:"reason"`
+	IssuedBy       string     `json:"issuedBy,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt      *time.Time `json:"revokedAt,omitempty"`
+	Active         bool       `json:"active"`
+}
+
+type PagedSanctionsResponse struct {
+	Sanctions Sanctions `json:"sanctions"`
+	NextPage  *NextPage `json:"nextPage"`
+}
+
+// NewSanctionInput issues a sanction, DurationHours is required for a suspension and
+// a read only sanction without it lasts until it is revoked.
+type NewSanctionInput struct {
+	ImpartWealthID string `json:"impartWealthId" binding:"required"`
+	Kind           string `json:"kind" binding:"required"`
+	Reason         string `json:"reason" binding:"required"`
+	DurationHours  int    `json:"durationHours"`
+}
+
+// MemberStanding is the enforcement in effect for a member, Strikes counts the active warnings
+type MemberStanding struct {
+	Strikes        int        `json:"strikes"`
+	Suspended      bool       `json:"suspended"`
+	SuspendedUntil *time.Time `json:"suspendedUntil,omitempty"`
+	ReadOnly       bool       `json:"readOnly"`
+	ReadOnlyUntil  *time.Time `json:"readOnlyUntil,omitempty"`
+	Sanctions      Sanctions  `json:"sanctions"`
+}
+
+// CanPost reports whether the member can create posts and comments
+func (s MemberStanding) CanPost() bool {
+	return !s.Suspended && !s.ReadOnly
+}
+
+// CanInteract reports whether the member can edit their content and vote
+func (s MemberStanding) CanInteract() bool {
+	return !s.ReadOnly
+}
+
+// RedactModerators hides who issued the sanctions from the member
+func (s *MemberStanding) RedactModerators() {
+	for i := range s.Sanctions {
+		s.Sanctions[i].IssuedBy = ""
+	}
+}
+
+// SanctionActive reports whether the sanction is neither revoked nor expired at now
+func SanctionActive(s *dbmodels.UserSanction, now time.Time) bool {
+	if s.RevokedAt.Valid {
+		return false
+	}
+	return !s.ExpiresAt.Valid || s.ExpiresAt.Time.After(now)
+}
+
+func SanctionFromDBModel(s *dbmodels.UserSanction, now time.Time) Sanction {
+	return Sanction{
+		SanctionID:     s.SanctionID,
+		ImpartWealthID: s.ImpartWealthID,
+		Kind:           s.Kind,
+		Reason:         s.Reason,
+		IssuedBy:       s.IssuedBy.String,
+		CreatedAt:      s.CreatedAt,
+		ExpiresAt:      s.ExpiresAt.Ptr(),
+		RevokedAt:      s.RevokedAt.Ptr(),
+		Active:         SanctionActive(s, now),
+	}
+}
+
+func SanctionsFromDBModel(sanctions dbmodels.UserSanctionSlice, now time.Time) Sanctions {
+	out := make(Sanctions, len(sanctions))
+	for i, s := range sanctions {
+		out[i] = SanctionFromDBModel(s, now)
+	}
+	return out
+}
+
+// StandingFromDBModel sums up the active sanctions, a read only sanction without an expiry
+// leaves ReadOnlyUntil empty.
+func StandingFromDBModel(sanctions dbmodels.UserSanctionSlice, now time.Time) MemberStanding {
+	standing := MemberStanding{Sanctions: Sanctions{}}
+	readOnlyIndefinite := false
+	for _, s := range sanctions {
+		if !SanctionActive(s, now) {
+			continue
+		}
+		standing.Sanctions = append(standing.Sanctions, SanctionFromDBModel(s, now))
+		switch s.Kind {
+		case dbmodels.UserSanctionsKindWarning:
+			standing.Strikes++
+		case dbmodels.UserSanctionsKindSuspension:
+			standing.Suspended = true
+			standing.SuspendedUntil = laterOf(standing.SuspendedUntil, s.ExpiresAt.Ptr())
+		case dbmodels.UserSanctionsKindReadOnly:
+			standing.ReadOnly = true
+			if !s.ExpiresAt.Valid {
+				readOnlyIndefinite = true
+			}
+			standing.ReadOnlyUntil = laterOf(standing.ReadOnlyUntil, s.ExpiresAt.Ptr())
+		}
+	}
+	if readOnlyIndefinite {
+		standing.ReadOnlyUntil = nil
+	}
+	return standing
+}
+
+func laterOf(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestStandingFromDBModel(t *testing.T) {
+	now := impart.CurrentUTC()
+	soon := now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	standing := StandingFromDBModel(dbmodels.UserSanctionSlice{
+		{SanctionID: 1, Kind: dbmodels.UserSanctionsKindWarning, ExpiresAt: null.TimeFrom(later)},
+		{SanctionID: 2, Kind: dbmodels.UserSanctionsKindWarning, ExpiresAt: null.TimeFrom(now.Add(-time.Hour))},
+		{SanctionID: 3, Kind: dbmodels.UserSanctionsKindWarning, RevokedAt: null.TimeFrom(now)},
+		{SanctionID: 4, Kind: dbmodels.UserSanctionsKindSuspension, ExpiresAt: null.TimeFrom(soon)},
+		{SanctionID: 5, Kind: dbmodels.UserSanctionsKindSuspension, ExpiresAt: null.TimeFrom(later)},
+	}, now)
+	assert.Equal(t, 1, standing.Strikes)
+	assert.True(t, standing.Suspended)
+	assert.Equal(t, later, *standing.SuspendedUntil)
+	assert.False(t, standing.ReadOnly)
+	assert.False(t, standing.CanPost())
+	assert.True(t, standing.CanInteract())
+	assert.Len(t, standing.Sanctions, 3)
+
+	standing = StandingFromDBModel(dbmodels.UserSanctionSlice{
+		{SanctionID: 6, Kind: dbmodels.UserSanctionsKindReadOnly, ExpiresAt: null.TimeFrom(soon)},
+		{SanctionID: 7, Kind: dbmodels.UserSanctionsKindReadOnly},
+	}, now)
+	assert.True(t, standing.ReadOnly)
+	assert.Nil(t, standing.ReadOnlyUntil)
+	assert.False(t, standing.CanInteract())
+
+	standing = StandingFromDBModel(nil, now)
+	assert.True(t, standing.CanPost())
+	assert.NotNil(t, standing.Sanctions)
+}
+
+func TestMemberStandingRedactModerators(t *testing.T) {
+	standing := MemberStanding{Sanctions: Sanctions{{SanctionID: 1, IssuedBy: "moderator"}}}
+	standing.RedactModerators()
+	assert.Empty(t, standing.Sanctions[0].IssuedBy)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/impartwealthapp/backend/pkg/impart"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -18,6 +19,7 @@ type UserDevice struct {
 	AppVersion     string    `json:"appVersion"`
 	DeviceName     string    `json:"deviceName"`
 	DeviceVersion  string    `json:"deviceVersion"`
+	Platform       string    `json:"platform,omitempty"`
 	CreatedAt      time.Time `json:"createdAt,omitempty"`
 	UpdatedAt      time.Time `json:"updatedAt,omitempty"`
 }
@@ -32,6 +34,59 @@ type UserSettings struct {
 	NotificationStatus bool `json:"notificationStatus"`
 }
 
+// NotificationPreferences holds the per category push settings of a user,
+// categories not present are enabled.
+type NotificationPreferences struct {
+	Categories map[string]bool `json:"categories"`
+	QuietHours *QuietHours     `json:"quietHours"`
+	Timezone   string          `json:"timezone"`
+}
+
+// QuietHours is a daily window in the users timezone, formatted as HH:MM, where no pushes are sent
+type QuietHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ParseClockMinutes converts a HH:MM time of day to minutes from midnight
+func ParseClockMinutes(clock string) (uint16, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", clock)
+	}
+	return uint16(t.Hour()*60 + t.Minute()), nil
+}
+
+// FormatClockMinutes converts minutes from midnight to a HH:MM time of day
+func FormatClockMinutes(minutes uint16) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func NotificationPreferencesFromDBModel(conf *dbmodels.UserConfiguration, prefs dbmodels.UserNotificationPreferenceSlice) NotificationPreferences {
+	out := NotificationPreferences{
+		Categories: make(map[string]bool),
+		Timezone:   "UTC",
+	}
+	for _, c := range impart.NotificationCategories {
+		out.Categories[c.String()] = true
+	}
+	for _, p := range prefs {
+		out.Categories[p.Category] = p.Enabled
+	}
+	if conf != nil {
+		if conf.Timezone != "" {
+			out.Timezone = conf.Timezone
+		}
+		if conf.QuietHoursStart.Valid && conf.QuietHoursEnd.Valid {
+			out.QuietHours = &QuietHours{
+				Start: FormatClockMinutes(conf.QuietHoursStart.Uint16),
+				End:   FormatClockMinutes(conf.QuietHoursEnd.Uint16),
+			}
+		}
+	}
+	return out
+}
+
 func (d UserDevice) UserDeviceToDBModel() *dbmodels.UserDevice {
 	out := &dbmodels.UserDevice{
 		Token:          d.Token,
@@ -41,6 +96,7 @@ func (d UserDevice) UserDeviceToDBModel() *dbmodels.UserDevice {
 		AppVersion:     d.AppVersion,
 		DeviceName:     d.DeviceName,
 		DeviceVersion:  d.DeviceVersion,
+		Platform:       impart.ParseDevicePlatform(d.Platform).String(),
 		CreatedAt:      d.CreatedAt,
 		UpdatedAt:      d.UpdatedAt,
 	}
@@ -57,6 +113,7 @@ func UserDeviceFromDBModel(d *dbmodels.UserDevice) UserDevice {
 		AppVersion:     d.AppVersion,
 		DeviceName:     d.DeviceName,
 		DeviceVersion:  d.DeviceVersion,
+		Platform:       d.Platform,
 		CreatedAt:      d.CreatedAt,
 		UpdatedAt:      d.UpdatedAt,
 	}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestParseClockMinutes(t *testing.T) {
+	m, err := ParseClockMinutes("22:30")
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(22*60+30), m)
+	assert.Equal(t, "22:30", FormatClockMinutes(m))
+
+	m, err = ParseClockMinutes("07:05")
+	assert.NoError(t, err)
+	assert.Equal(t, "07:05", FormatClockMinutes(m))
+
+	for _, in := range []string{"", "24:00", "7pm", "12:60"} {
+		_, err = ParseClockMinutes(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestNotificationPreferencesFromDBModel(t *testing.T) {
+	out := NotificationPreferencesFromDBModel(nil, nil)
+	assert.Len(t, out.Categories, len(impart.NotificationCategories))
+	assert.Nil(t, out.QuietHours)
+	assert.Equal(t, "UTC", out.Timezone)
+
+	out = NotificationPreferencesFromDBModel(&dbmodels.UserConfiguration{
+		QuietHoursStart: null.Uint16From(22 * 60),
+		QuietHoursEnd:   null.Uint16From(7 * 60),
+		Timezone:        "Europe/London",
+	}, dbmodels.UserNotificationPreferenceSlice{
+		{Category: impart.VoteNotification.String(), Enabled: false},
+	})
+	assert.False(t, out.Categories[impart.VoteNotification.String()])
+	assert.True(t, out.Categories[impart.PostCommentNotification.String()])
+	assert.Equal(t, &QuietHours{Start: "22:00", End: "07:00"}, out.QuietHours)
+	assert.Equal(t, "Europe/London", out.Timezone)
+}
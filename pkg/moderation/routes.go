@@ -0,0 +1,197 @@
+package moderation
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"go.uber.org/zap"
+)
+
+type moderationHandler struct {
+	moderationService Service
+	logger            *zap.Logger
+}
+
+func SetupRoutes(version *gin.RouterGroup, moderationService Service, logger *zap.Logger) {
+	handler := &moderationHandler{
+		moderationService: moderationService,
+		logger:            logger,
+	}
+
+	wordRoutes := version.Group("/admin/profanity/words")
+	wordRoutes.Use(impart.RequireRole(impart.SuperAdminRole))
+	wordRoutes.GET("", handler.GetWordsFunc())
+	wordRoutes.POST("", handler.AddWordFunc())
+	wordRoutes.PATCH("/:wordId", handler.UpdateWordFunc())
+
+	sanctionRoutes := version.Group("/admin/sanctions")
+	sanctionRoutes.Use(impart.RequireRole(impart.AdminRole))
+	sanctionRoutes.GET("", handler.GetSanctionsFunc())
+	sanctionRoutes.POST("", handler.IssueSanctionFunc())
+	sanctionRoutes.POST("/:sanctionId/revoke", handler.RevokeSanctionFunc())
+	sanctionRoutes.GET("/standing/:impartWealthId", handler.GetStandingFunc())
+
+	version.Group("/user").GET("/standing", handler.GetOwnStandingFunc())
+}
+
+// GetWordsFunc lists the word list alphabetically, optionally filtered by enabled and severity
+func (mh *moderationHandler) GetWordsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		limit, offset, err := impart.ParseLimitOffset(ctx)
+		if err != nil {
+			return
+		}
+		params := ctx.Request.URL.Query()
+		var enabled *bool
+		if enabledParam := strings.TrimSpace(params.Get("enabled")); enabledParam != "" {
+			e, err := strconv.ParseBool(enabledParam)
+			if err != nil {
+				impartErr := impart.NewError(impart.ErrBadRequest, "invalid enabled passed in")
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			enabled = &e
+		}
+
+		words, nextPage, impartErr := mh.moderationService.GetWords(ctx, enabled, strings.TrimSpace(params.Get("severity")), limit, offset)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.PagedProfanityWordsResponse{
+			Words:    words,
+			NextPage: nextPage,
+		})
+	}
+}
+
+func (mh *moderationHandler) AddWordFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		input := models.NewProfanityWordInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, mh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a profanity word")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		word, impartErr := mh.moderationService.AddWord(ctx, input)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusCreated, word)
+	}
+}
+
+func (mh *moderationHandler) UpdateWordFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		wordID, err := strconv.ParseUint(ctx.Param("wordId"), 10, 64)
+		if err != nil {
+			impartErr := impart.NewError(impart.ErrBadRequest, "invalid word id passed in")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		input := models.UpdateProfanityWordInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, mh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a profanity word")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		word, impartErr := mh.moderationService.UpdateWord(ctx, wordID, input)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, word)
+	}
+}
+
+// GetSanctionsFunc lists the sanctions of the member in the impartWealthId query parameter
+func (mh *moderationHandler) GetSanctionsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params := ctx.Request.URL.Query()
+		impartWealthID := strings.TrimSpace(params.Get("impartWealthId"))
+		if impartWealthID == "" {
+			impartErr := impart.NewError(impart.ErrBadRequest, "impartWealthId is required", impart.ImpartWealthID)
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		limit, offset, err := impart.ParseLimitOffset(ctx)
+		if err != nil {
+			return
+		}
+
+		sanctions, nextPage, impartErr := mh.moderationService.GetSanctions(ctx, impartWealthID, limit, offset)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.PagedSanctionsResponse{
+			Sanctions: sanctions,
+			NextPage:  nextPage,
+		})
+	}
+}
+
+func (mh *moderationHandler) IssueSanctionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		input := models.NewSanctionInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, mh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a sanction")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		sanction, impartErr := mh.moderationService.IssueSanction(ctx, input)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusCreated, sanction)
+	}
+}
+
+func (mh *moderationHandler) RevokeSanctionFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		sanctionID, err := strconv.ParseUint(ctx.Param("sanctionId"), 10, 64)
+		if err != nil {
+			impartErr := impart.NewError(impart.ErrBadRequest, "invalid sanction id passed in")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		sanction, impartErr := mh.moderationService.RevokeSanction(ctx, sanctionID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, sanction)
+	}
+}
+
+func (mh *moderationHandler) GetStandingFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		standing, impartErr := mh.moderationService.GetStanding(ctx, ctx.Param("impartWealthId"))
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, standing)
+	}
+}
+
+// GetOwnStandingFunc lets a member see the sanctions in effect on their account
+func (mh *moderationHandler) GetOwnStandingFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		standing, impartErr := mh.moderationService.GetStanding(ctx, impart.GetCtxUser(ctx).ImpartWealthID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, standing)
+	}
+}
@@ -0,0 +1,205 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	// strikeDuration is how long a warning counts against a member without an explicit duration
+	strikeDuration      = 90 * 24 * time.Hour
+	maxSanctionDuration = 365 * 24 * time.Hour
+	// maxReasonLength matches the user_sanctions.reason column
+	maxReasonLength = 500
+)
+
+// Standing reads the active sanctions of the member, expired sanctions are left out so
+// enforcement ends without a job.
+func Standing(ctx context.Context, exec boil.ContextExecutor, impartWealthID string) (models.MemberStanding, error) {
+	now := impart.CurrentUTC()
+	sanctions, err := dbmodels.UserSanctions(
+		dbmodels.UserSanctionWhere.ImpartWealthID.EQ(impartWealthID),
+		dbmodels.UserSanctionWhere.RevokedAt.IsNull(),
+		qm.Expr(
+			dbmodels.UserSanctionWhere.ExpiresAt.IsNull(),
+			qm.Or2(dbmodels.UserSanctionWhere.ExpiresAt.GT(null.TimeFrom(now))),
+		),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.UserSanctionColumns.SanctionID)),
+	).All(ctx, exec)
+	if err != nil {
+		return models.MemberStanding{}, err
+	}
+	return models.StandingFromDBModel(sanctions, now), nil
+}
+
+// GetStanding is the standing of the member, the moderators issuing the sanctions are
+// only shown to admins.
+func (s *service) GetStanding(ctx context.Context, impartWealthID string) (models.MemberStanding, impart.Error) {
+	standing, err := Standing(ctx, s.db, impartWealthID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch member standing", zap.String("impartWealthId", impartWealthID), zap.Error(err))
+		return models.MemberStanding{}, impart.NewError(impart.ErrUnknown, "unable to fetch member standing")
+	}
+	if impart.UserRole(impart.GetCtxUser(ctx)) < impart.AdminRole {
+		standing.RedactModerators()
+	}
+	return standing, nil
+}
+
+// GetSanctions lists the sanctions of the member newest first, including revoked and expired ones
+func (s *service) GetSanctions(ctx context.Context, impartWealthID string, limit, offset int) (models.Sanctions, *models.NextPage, impart.Error) {
+	if limit <= 0 {
+		limit = impart.DefaultLimit
+	}
+	if limit > impart.MaxLimit {
+		limit = impart.MaxLimit
+	}
+	sanctions, err := dbmodels.UserSanctions(
+		dbmodels.UserSanctionWhere.ImpartWealthID.EQ(impartWealthID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.UserSanctionColumns.SanctionID)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch sanctions", zap.String("impartWealthId", impartWealthID), zap.Error(err))
+		return nil, nil, impart.NewError(impart.ErrUnknown, "unable to fetch sanctions")
+	}
+	var nextPage *models.NextPage
+	if len(sanctions) == limit {
+		nextPage = &models.NextPage{Offset: offset + len(sanctions)}
+	}
+	return models.SanctionsFromDBModel(sanctions, impart.CurrentUTC()), nextPage, nil
+}
+
+// IssueSanction records the sanction and lets the member know, a warning without a duration
+// counts as a strike for 90 days.
+func (s *service) IssueSanction(ctx context.Context, in models.NewSanctionInput) (models.Sanction, impart.Error) {
+	ctxUser := impart.GetCtxUser(ctx)
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" || len([]rune(reason)) > maxReasonLength {
+		return models.Sanction{}, impart.NewError(impart.ErrBadRequest, "reason must be between 1 and 500 characters", impart.Sanction)
+	}
+	duration := time.Duration(in.DurationHours) * time.Hour
+	if in.DurationHours < 0 || duration > maxSanctionDuration {
+		return models.Sanction{}, impart.NewError(impart.ErrBadRequest, "duration must be at most 365 days", impart.Sanction)
+	}
+	switch in.Kind {
+	case dbmodels.UserSanctionsKindWarning:
+		if duration == 0 {
+			duration = strikeDuration
+		}
+	case dbmodels.UserSanctionsKindSuspension:
+		if duration == 0 {
+			return models.Sanction{}, impart.NewError(impart.ErrBadRequest, "a suspension requires a duration", impart.Sanction)
+		}
+	case dbmodels.UserSanctionsKindReadOnly:
+	default:
+		return models.Sanction{}, impart.NewError(impart.ErrBadRequest, "kind must be one of warning, suspension or read_only", impart.Sanction)
+	}
+	if in.ImpartWealthID == ctxUser.ImpartWealthID {
+		return models.Sanction{}, impart.NewError(impart.ErrBadRequest, "unable to sanction yourself", impart.ImpartWealthID)
+	}
+	member, err := dbmodels.FindUser(ctx, s.db, in.ImpartWealthID)
+	if err == sql.ErrNoRows {
+		return models.Sanction{}, impart.NewError(impart.ErrNotFound, "unable to find the user", impart.ImpartWealthID)
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch user", zap.String("impartWealthId", in.ImpartWealthID), zap.Error(err))
+		return models.Sanction{}, impart.NewError(impart.ErrUnknown, "unable to issue sanction")
+	}
+	if impart.UserRole(member) >= impart.UserRole(ctxUser) {
+		return models.Sanction{}, impart.NewError(impart.ErrUnauthorized, "unable to sanction a member at or above your role", impart.ImpartWealthID)
+	}
+
+	now := impart.CurrentUTC()
+	row := &dbmodels.UserSanction{
+		ImpartWealthID: member.ImpartWealthID,
+		Kind:           in.Kind,
+		Reason:         reason,
+		IssuedBy:       null.StringFrom(ctxUser.ImpartWealthID),
+		CreatedAt:      now,
+	}
+	if duration > 0 {
+		row.ExpiresAt = null.TimeFrom(now.Add(duration))
+	}
+	if err := row.Insert(ctx, s.db, boil.Infer()); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to issue sanction", zap.String("impartWealthId", member.ImpartWealthID), zap.Error(err))
+		return models.Sanction{}, impart.NewError(impart.ErrUnknown, "unable to issue sanction")
+	}
+	s.notifySanction(ctx, row)
+	return models.SanctionFromDBModel(row, now), nil
+}
+
+// RevokeSanction ends the sanction right away, revoking an ended sanction changes nothing
+func (s *service) RevokeSanction(ctx context.Context, sanctionID uint64) (models.Sanction, impart.Error) {
+	row, err := dbmodels.FindUserSanction(ctx, s.db, sanctionID)
+	if err == sql.ErrNoRows {
+		return models.Sanction{}, impart.NewError(impart.ErrNotFound, "unable to find the sanction")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch sanction", zap.Uint64("sanctionId", sanctionID), zap.Error(err))
+		return models.Sanction{}, impart.NewError(impart.ErrUnknown, "unable to revoke sanction")
+	}
+	ctxUser := impart.GetCtxUser(ctx)
+	member, err := dbmodels.FindUser(ctx, s.db, row.ImpartWealthID)
+	if err != nil && err != sql.ErrNoRows {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch user", zap.String("impartWealthId", row.ImpartWealthID), zap.Error(err))
+		return models.Sanction{}, impart.NewError(impart.ErrUnknown, "unable to revoke sanction")
+	}
+	if member != nil && impart.UserRole(member) >= impart.UserRole(ctxUser) {
+		return models.Sanction{}, impart.NewError(impart.ErrUnauthorized, "unable to revoke a sanction of a member at or above your role")
+	}
+	now := impart.CurrentUTC()
+	if !models.SanctionActive(row, now) {
+		return models.SanctionFromDBModel(row, now), nil
+	}
+	row.RevokedAt = null.TimeFrom(now)
+	row.RevokedBy = null.StringFrom(ctxUser.ImpartWealthID)
+	if _, err := row.Update(ctx, s.db, boil.Whitelist(
+		dbmodels.UserSanctionColumns.RevokedAt,
+		dbmodels.UserSanctionColumns.RevokedBy,
+	)); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to revoke sanction", zap.Uint64("sanctionId", sanctionID), zap.Error(err))
+		return models.Sanction{}, impart.NewError(impart.ErrUnknown, "unable to revoke sanction")
+	}
+	return models.SanctionFromDBModel(row, now), nil
+}
+
+func (s *service) notifySanction(ctx context.Context, row *dbmodels.UserSanction) {
+	title := "You received a warning"
+	body := row.Reason
+	switch row.Kind {
+	case dbmodels.UserSanctionsKindSuspension:
+		title = "Your posting is suspended"
+		body = fmt.Sprintf("You can't post or comment until %s. %s", row.ExpiresAt.Time.Format(time.RFC1123), row.Reason)
+	case dbmodels.UserSanctionsKindReadOnly:
+		title = "Your account is read only"
+		if row.ExpiresAt.Valid {
+			body = fmt.Sprintf("You can only read your Hives until %s. %s", row.ExpiresAt.Time.Format(time.RFC1123), row.Reason)
+		}
+	}
+	data := impart.NotificationData{
+		EventDatetime: impart.CurrentUTC(),
+		Category:      impart.ModerationNotification,
+	}
+	alert := impart.Alert{
+		Title: aws.String(title),
+		Body:  aws.String(body),
+	}
+	if err := s.notificationService.Notify(ctx, data, alert, row.ImpartWealthID); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("push-notification : error attempting to send sanction notification",
+			zap.Uint64("sanctionId", row.SanctionID), zap.Error(err))
+	}
+}
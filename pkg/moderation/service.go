@@ -0,0 +1,163 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// maxWordLength matches the profanity_words_list.word column
+const maxWordLength = 255
+
+// Service manages the profanity word list and the sanctions against members. Every word list
+// change is applied to the filter of this instance right away and reaches the other instances
+// on their next reload.
+type Service interface {
+	GetWords(ctx context.Context, enabled *bool, severity string, limit, offset int) (models.ProfanityWords, *models.NextPage, impart.Error)
+	AddWord(ctx context.Context, in models.NewProfanityWordInput) (models.ProfanityWord, impart.Error)
+	UpdateWord(ctx context.Context, wordID uint64, in models.UpdateProfanityWordInput) (models.ProfanityWord, impart.Error)
+
+	GetStanding(ctx context.Context, impartWealthID string) (models.MemberStanding, impart.Error)
+	GetSanctions(ctx context.Context, impartWealthID string, limit, offset int) (models.Sanctions, *models.NextPage, impart.Error)
+	IssueSanction(ctx context.Context, in models.NewSanctionInput) (models.Sanction, impart.Error)
+	RevokeSanction(ctx context.Context, sanctionID uint64) (models.Sanction, impart.Error)
+}
+
+type service struct {
+	db                  *sql.DB
+	filter              *impart.ProfanityFilter
+	notificationService impart.NotificationService
+	logger              *zap.Logger
+}
+
+func New(db *sql.DB, filter *impart.ProfanityFilter, notificationService impart.NotificationService, logger *zap.Logger) Service {
+	return &service{
+		db:                  db,
+		filter:              filter,
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+func (s *service) GetWords(ctx context.Context, enabled *bool, severity string, limit, offset int) (models.ProfanityWords, *models.NextPage, impart.Error) {
+	if limit <= 0 {
+		limit = impart.DefaultLimit
+	}
+	if limit > impart.MaxLimit {
+		limit = impart.MaxLimit
+	}
+	where := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s asc", dbmodels.ProfanityWordsListColumns.Word)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	}
+	if enabled != nil {
+		where = append(where, dbmodels.ProfanityWordsListWhere.Enabled.EQ(*enabled))
+	}
+	if severity != "" {
+		if !impart.ProfanitySeverity(severity).Valid() {
+			return nil, nil, impart.NewError(impart.ErrBadRequest, "invalid severity passed in", impart.Severity)
+		}
+		where = append(where, dbmodels.ProfanityWordsListWhere.Severity.EQ(severity))
+	}
+	words, err := dbmodels.ProfanityWordsLists(where...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch profanity words", zap.Error(err))
+		return nil, nil, impart.NewError(impart.ErrUnknown, "unable to fetch profanity words")
+	}
+	var nextPage *models.NextPage
+	if len(words) == limit {
+		nextPage = &models.NextPage{Offset: offset + len(words)}
+	}
+	return models.ProfanityWordsFromDBModel(words), nextPage, nil
+}
+
+// AddWord adds an enabled word, the severity defaults to mask
+func (s *service) AddWord(ctx context.Context, in models.NewProfanityWordInput) (models.ProfanityWord, impart.Error) {
+	word := strings.TrimSpace(in.Word)
+	if word == "" || len(word) > maxWordLength {
+		return models.ProfanityWord{}, impart.NewError(impart.ErrBadRequest, "word must be between 1 and 255 characters", impart.Word)
+	}
+	severity := impart.MaskProfanity
+	if in.Severity != "" {
+		severity = impart.ProfanitySeverity(in.Severity)
+		if !severity.Valid() {
+			return models.ProfanityWord{}, impart.NewError(impart.ErrBadRequest, "severity must be one of mask, hold or reject", impart.Severity)
+		}
+	}
+	// the column collation is case insensitive
+	exists, err := dbmodels.ProfanityWordsLists(dbmodels.ProfanityWordsListWhere.Word.EQ(word)).Exists(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to check profanity word", zap.String("word", word), zap.Error(err))
+		return models.ProfanityWord{}, impart.NewError(impart.ErrUnknown, "unable to add profanity word")
+	}
+	if exists {
+		return models.ProfanityWord{}, impart.NewError(impart.ErrExists, "word is already in the list", impart.Word)
+	}
+	row := &dbmodels.ProfanityWordsList{
+		Word:     word,
+		Enabled:  true,
+		Severity: severity.String(),
+	}
+	if err := row.Insert(ctx, s.db, boil.Whitelist(
+		dbmodels.ProfanityWordsListColumns.Word,
+		dbmodels.ProfanityWordsListColumns.Enabled,
+		dbmodels.ProfanityWordsListColumns.Severity,
+	)); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to add profanity word", zap.String("word", word), zap.Error(err))
+		return models.ProfanityWord{}, impart.NewError(impart.ErrUnknown, "unable to add profanity word")
+	}
+	return s.changed(ctx, row.WordID)
+}
+
+func (s *service) UpdateWord(ctx context.Context, wordID uint64, in models.UpdateProfanityWordInput) (models.ProfanityWord, impart.Error) {
+	row, err := dbmodels.FindProfanityWordsList(ctx, s.db, wordID)
+	if err == sql.ErrNoRows {
+		return models.ProfanityWord{}, impart.NewError(impart.ErrNotFound, "unable to find the word")
+	}
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch profanity word", zap.Uint64("wordId", wordID), zap.Error(err))
+		return models.ProfanityWord{}, impart.NewError(impart.ErrUnknown, "unable to update profanity word")
+	}
+	var columns []string
+	if in.Severity != nil {
+		if !impart.ProfanitySeverity(*in.Severity).Valid() {
+			return models.ProfanityWord{}, impart.NewError(impart.ErrBadRequest, "severity must be one of mask, hold or reject", impart.Severity)
+		}
+		row.Severity = *in.Severity
+		columns = append(columns, dbmodels.ProfanityWordsListColumns.Severity)
+	}
+	if in.Enabled != nil {
+		row.Enabled = *in.Enabled
+		columns = append(columns, dbmodels.ProfanityWordsListColumns.Enabled)
+	}
+	if len(columns) == 0 {
+		return models.ProfanityWord{}, impart.NewError(impart.ErrBadRequest, "nothing to update")
+	}
+	if _, err := row.Update(ctx, s.db, boil.Whitelist(columns...)); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to update profanity word", zap.Uint64("wordId", wordID), zap.Error(err))
+		return models.ProfanityWord{}, impart.NewError(impart.ErrUnknown, "unable to update profanity word")
+	}
+	return s.changed(ctx, wordID)
+}
+
+// changed reloads the local filter and returns the stored word, updated_at is set by the database
+func (s *service) changed(ctx context.Context, wordID uint64) (models.ProfanityWord, impart.Error) {
+	if err := s.filter.Reload(ctx, s.db); err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to reload profanity list", zap.Error(err))
+	}
+	row, err := dbmodels.FindProfanityWordsList(ctx, s.db, wordID)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch profanity word", zap.Uint64("wordId", wordID), zap.Error(err))
+		return models.ProfanityWord{}, impart.UnknownError
+	}
+	return models.ProfanityWordFromDBModel(row), nil
+}
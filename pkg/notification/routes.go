@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"go.uber.org/zap"
+)
+
+type notificationHandler struct {
+	notificationService Service
+	logger              *zap.Logger
+}
+
+func SetupRoutes(version *gin.RouterGroup, notificationService Service, logger *zap.Logger) {
+	handler := &notificationHandler{
+		notificationService: notificationService,
+		logger:              logger,
+	}
+
+	notificationRoutes := version.Group("/notifications")
+	notificationRoutes.GET("", handler.GetNotificationsFunc())
+	notificationRoutes.GET("/unread-count", handler.GetUnreadCountFunc())
+	notificationRoutes.POST("/read", handler.MarkReadFunc())
+	notificationRoutes.POST("/read-all", handler.MarkAllReadFunc())
+}
+
+// GetNotificationsFunc lists the inbox newest first, pass unread=true for only unread notifications
+func (nh *notificationHandler) GetNotificationsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctxUser := impart.GetCtxUser(ctx)
+		limit, offset := impart.DefaultLimit, 0
+		params := ctx.Request.URL.Query()
+		if limitParam := strings.TrimSpace(params.Get("limit")); limitParam != "" {
+			l, err := strconv.Atoi(limitParam)
+			if err != nil || l <= 0 {
+				impartErr := impart.NewError(impart.ErrBadRequest, "invalid limit passed in", impart.Limit)
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			limit = l
+		}
+		if offsetParam := strings.TrimSpace(params.Get("offset")); offsetParam != "" {
+			o, err := strconv.Atoi(offsetParam)
+			if err != nil || o < 0 {
+				impartErr := impart.NewError(impart.ErrBadRequest, "invalid offset passed in")
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			offset = o
+		}
+		unreadOnly, _ := strconv.ParseBool(params.Get("unread"))
+
+		notifications, nextPage, impartErr := nh.notificationService.GetNotifications(ctx, ctxUser.ImpartWealthID, unreadOnly, limit, offset)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.PagedNotificationsResponse{
+			Notifications: notifications,
+			NextPage:      nextPage,
+		})
+	}
+}
+
+func (nh *notificationHandler) GetUnreadCountFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctxUser := impart.GetCtxUser(ctx)
+		count, impartErr := nh.notificationService.GetUnreadCount(ctx, ctxUser.ImpartWealthID)
+		if impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.NotificationUnreadCount{Unread: count})
+	}
+}
+
+func (nh *notificationHandler) MarkReadFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctxUser := impart.GetCtxUser(ctx)
+		input := models.MarkNotificationsReadInput{}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			impart.CtxLogger(ctx, nh.logger).Error("invalid json payload", zap.Error(err))
+			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to notification ids")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		if impartErr := nh.notificationService.MarkRead(ctx, ctxUser.ImpartWealthID, input.NotificationIDs); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "notifications marked read"})
+	}
+}
+
+func (nh *notificationHandler) MarkAllReadFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctxUser := impart.GetCtxUser(ctx)
+		if impartErr := nh.notificationService.MarkAllRead(ctx, ctxUser.ImpartWealthID); impartErr != nil {
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "notifications marked read"})
+	}
+}
@@ -0,0 +1,107 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+// Service reads and updates the in-app notification inbox of a user,
+// notifications are written to the inbox by the impart.NotificationService.
+type Service interface {
+	GetNotifications(ctx context.Context, impartWealthID string, unreadOnly bool, limit, offset int) (models.Notifications, *models.NextPage, impart.Error)
+	GetUnreadCount(ctx context.Context, impartWealthID string) (int64, impart.Error)
+	MarkRead(ctx context.Context, impartWealthID string, notificationIDs []uint64) impart.Error
+	MarkAllRead(ctx context.Context, impartWealthID string) impart.Error
+}
+
+type service struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func New(db *sql.DB, logger *zap.Logger) Service {
+	return &service{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (s *service) GetNotifications(ctx context.Context, impartWealthID string, unreadOnly bool, limit, offset int) (models.Notifications, *models.NextPage, impart.Error) {
+	if limit <= 0 {
+		limit = impart.DefaultLimit
+	}
+	if limit > impart.MaxLimit {
+		limit = impart.MaxLimit
+	}
+	where := []qm.QueryMod{
+		dbmodels.UserNotificationWhere.ImpartWealthID.EQ(impartWealthID),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.UserNotificationColumns.NotificationID)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	}
+	if unreadOnly {
+		where = append(where, dbmodels.UserNotificationWhere.ReadAt.IsNull())
+	}
+	notifications, err := dbmodels.UserNotifications(where...).All(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to fetch notifications", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return nil, nil, impart.NewError(impart.ErrUnknown, "unable to fetch notifications")
+	}
+	var nextPage *models.NextPage
+	if len(notifications) == limit {
+		nextPage = &models.NextPage{Offset: offset + len(notifications)}
+	}
+	return models.NotificationsFromDBModel(notifications), nextPage, nil
+}
+
+func (s *service) GetUnreadCount(ctx context.Context, impartWealthID string) (int64, impart.Error) {
+	count, err := dbmodels.UserNotifications(
+		dbmodels.UserNotificationWhere.ImpartWealthID.EQ(impartWealthID),
+		dbmodels.UserNotificationWhere.ReadAt.IsNull(),
+	).Count(ctx, s.db)
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to count unread notifications", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return 0, impart.NewError(impart.ErrUnknown, "unable to count unread notifications")
+	}
+	return count, nil
+}
+
+// MarkRead marks the notifications read, ids belonging to other users are ignored
+func (s *service) MarkRead(ctx context.Context, impartWealthID string, notificationIDs []uint64) impart.Error {
+	if len(notificationIDs) == 0 {
+		return impart.NewError(impart.ErrBadRequest, "no notifications to mark read")
+	}
+	ids := make([]interface{}, len(notificationIDs))
+	for i, id := range notificationIDs {
+		ids[i] = id
+	}
+	return s.markRead(ctx, impartWealthID,
+		qm.WhereIn(fmt.Sprintf("%s in ?", dbmodels.UserNotificationColumns.NotificationID), ids...))
+}
+
+func (s *service) MarkAllRead(ctx context.Context, impartWealthID string) impart.Error {
+	return s.markRead(ctx, impartWealthID)
+}
+
+func (s *service) markRead(ctx context.Context, impartWealthID string, mods ...qm.QueryMod) impart.Error {
+	where := append([]qm.QueryMod{
+		dbmodels.UserNotificationWhere.ImpartWealthID.EQ(impartWealthID),
+		dbmodels.UserNotificationWhere.ReadAt.IsNull(),
+	}, mods...)
+	_, err := dbmodels.UserNotifications(where...).UpdateAll(ctx, s.db, dbmodels.M{
+		dbmodels.UserNotificationColumns.ReadAt: null.TimeFrom(impart.CurrentUTC()),
+	})
+	if err != nil {
+		impart.CtxLogger(ctx, s.logger).Error("unable to mark notifications read", zap.String("impartWealthID", impartWealthID), zap.Error(err))
+		return impart.NewError(impart.ErrUnknown, "unable to mark notifications read")
+	}
+	return nil
+}
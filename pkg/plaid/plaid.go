@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	plaid "github.com/plaid/plaid-go/plaid"
@@ -21,6 +23,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// httpClient records the calls to plaid in the metrics
+var httpClient = metrics.InstrumentClient(metrics.Plaid, http.DefaultClient)
+
 func (ser *service) SavePlaidInstitutions(ctx context.Context) error {
 	configuration := plaid.NewConfiguration()
 	cfg, _ := config.GetImpart()
@@ -39,6 +44,7 @@ func (ser *service) SavePlaidInstitutions(ctx context.Context) error {
 		configuration.UseEnvironment(plaid.Sandbox)
 	}
 
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 	var countrCode = []plaid.CountryCode{plaid.COUNTRYCODE_US}
 	request := plaid.NewInstitutionsGetRequest(Count, OffSet, countrCode)
@@ -61,7 +67,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 	_, err := dbmodels.Users(dbmodels.UserWhere.ImpartWealthID.EQ(userInstitution.ImpartWealthID)).One(ctx, ser.db)
 	if err != nil {
 		impartErr := impart.NewError(impart.ErrBadRequest, "Could not find the user.")
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", userInstitution.ImpartWealthID),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", userInstitution.ImpartWealthID),
 			zap.String("user", userInstitution.ImpartWealthID))
 		return impartErr
 	}
@@ -82,6 +88,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 		configuration.UseEnvironment(plaid.Sandbox)
 	}
 
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 	var countrCode = []plaid.CountryCode{plaid.COUNTRYCODE_US}
 	var includeOptionalMetadata bool = true
@@ -92,7 +99,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 	response, _, err := client.PlaidApi.InstitutionsGetById(ctx).InstitutionsGetByIdRequest(*request).Execute()
 	if err != nil {
 		impartErr := impart.NewError(impart.ErrBadRequest, "Could not find Plaid institution for the user.")
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", userInstitution.ImpartWealthID),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", userInstitution.ImpartWealthID),
 			zap.String("PlaidInstitutionId", userInstitution.PlaidInstitutionId))
 
 		return impartErr
@@ -127,7 +134,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 			err = out.Insert(ctx, ser.db, boil.Infer())
 			if err != nil {
 				impartErr := impart.NewError(impart.ErrBadRequest, "Institution save failed.")
-				ser.logger.Error("Institution save failed.", zap.String("User", userInstitution.ImpartWealthID),
+				impart.CtxLogger(ctx, ser.logger).Error("Institution save failed.", zap.String("User", userInstitution.ImpartWealthID),
 					zap.String("PlaidInstitutionId", userInstitution.PlaidInstitutionId))
 
 				return impartErr
@@ -135,7 +142,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 			inst, err = dbmodels.Institutions(dbmodels.InstitutionWhere.PlaidInstitutionID.EQ(userInstitution.PlaidInstitutionId)).One(ctx, ser.db)
 			if err != nil {
 				impartErr := impart.NewError(impart.ErrBadRequest, "Institution fetching failed.")
-				ser.logger.Error("IInstitution fetching failed in db.", zap.String("User", userInstitution.ImpartWealthID),
+				impart.CtxLogger(ctx, ser.logger).Error("IInstitution fetching failed in db.", zap.String("User", userInstitution.ImpartWealthID),
 					zap.String("PlaidInstitutionId", userInstitution.PlaidInstitutionId))
 
 				return impartErr
@@ -149,13 +156,13 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 		userInst, err := dbmodels.UserInstitutions(dbmodels.UserInstitutionWhere.ImpartWealthID.EQ(userInstitution.ImpartWealthID),
 			dbmodels.UserInstitutionWhere.InstitutionID.EQ(inst.ID)).One(ctx, ser.db)
 		if err != nil {
-			ser.logger.Error("UserInstitutions fetching failed", zap.String("User", userInstitution.ImpartWealthID),
+			impart.CtxLogger(ctx, ser.logger).Error("UserInstitutions fetching failed", zap.String("User", userInstitution.ImpartWealthID),
 				zap.String("PlaidInstitutionId", userInstitution.PlaidInstitutionId),
 				zap.Any("InstitutionID", inst.ID))
 		}
 		if userInst != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "You already have an account with this bank, please register with some other bank account, thanks.")
-			ser.logger.Error("Acces token saving failed", zap.String("User", userInstitution.ImpartWealthID),
+			impart.CtxLogger(ctx, ser.logger).Error("Acces token saving failed", zap.String("User", userInstitution.ImpartWealthID),
 				zap.Any("impartErr", impartErr))
 
 			return impartErr
@@ -164,7 +171,7 @@ func (ser *service) SavePlaidInstitutionToken(ctx context.Context, userInstituti
 	err = dbUserInstitution.Insert(ctx, ser.db, boil.Infer())
 	if err != nil {
 		impartErr := impart.NewError(impart.ErrBadRequest, "Acces token saving failed.")
-		ser.logger.Error("Acces token saving failed", zap.String("User", userInstitution.ImpartWealthID),
+		impart.CtxLogger(ctx, ser.logger).Error("Acces token saving failed", zap.String("User", userInstitution.ImpartWealthID),
 			zap.String("PlaidInstitutionId", userInstitution.PlaidInstitutionId))
 
 		return impartErr
@@ -204,7 +211,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 	_, err := dbmodels.Users(dbmodels.UserWhere.ImpartWealthID.EQ(impartWealthId)).One(ctx, ser.db)
 	if err != nil {
 		impartErr := impart.NewError(impart.ErrBadRequest, "Could not find the user.")
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", impartWealthId),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", impartWealthId),
 			zap.String("user", impartWealthId))
 		return UserAccount{}, nil, impartErr
 	}
@@ -224,7 +231,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 	}
 	if err != nil {
 		impartErr := impart.NewError(impart.ErrBadRequest, "Could not find the user institution details.")
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", impartWealthId),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", impartWealthId),
 			zap.String("user", impartWealthId))
 		return UserAccount{}, nil, impartErr
 	}
@@ -246,6 +253,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 		}
 
 	}
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 
 	userData := UserAccount{}
@@ -274,7 +282,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 			}
 		}
 		if err != nil {
-			ser.logger.Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
+			impart.CtxLogger(ctx, ser.logger).Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
 				zap.String("token", user.AccessToken))
 			// continue
 		}
@@ -290,7 +298,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 		}
 		if err != nil {
 			fmt.Println(err)
-			ser.logger.Error("error checking UserPlaidAccountsLogExists ", zap.Error(err))
+			impart.CtxLogger(ctx, ser.logger).Error("error checking UserPlaidAccountsLogExists ", zap.Error(err))
 		}
 
 		for i, act := range accounts {
@@ -320,7 +328,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 		go func() {
 			tx, err := ser.db.BeginTx(ctx, nil)
 			if err != nil {
-				ser.logger.Error("Query", zap.Any("Query", err))
+				impart.CtxLogger(ctx, ser.logger).Error("Query", zap.Any("Query", err))
 			} else {
 				defer impart.CommitRollbackLogger(tx, err, ser.logger)
 				lastQury := "LOCK TABLE user_plaid_accounts_log WRITE ;INSERT INTO `user_plaid_accounts_log` (`user_institution_id`,`account_id`,`mask`,`name`,`official_name`,`subtype`,`type`,`iso_currency_code`,`unofficial_currency_code`,`available`,`current`,`credit_limit`,`created_at`) VALUES "
@@ -329,7 +337,7 @@ func (ser *service) GetPlaidUserInstitutionAccounts(ctx context.Context, impartW
 				lastQury = fmt.Sprintf("%s ; UNLOCK TABLES;", lastQury)
 				_, err = queries.Raw(lastQury).ExecContext(ctx, ser.db)
 				if err != nil {
-					ser.logger.Error("error attempting to  log in user_plaid_accounts_log ", zap.Error(err))
+					impart.CtxLogger(ctx, ser.logger).Error("error attempting to  log in user_plaid_accounts_log ", zap.Error(err))
 				}
 				tx.Commit()
 			}
@@ -424,7 +432,7 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 		// plaidErr.AccessToken = userInstitutions.AccessToken
 		newPlaidErr = append(newPlaidErr, plaidErr)
 
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", impartWealthId),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", impartWealthId),
 			zap.String("user", impartWealthId))
 		return UserTransaction{}, nil, newPlaidErr
 	}
@@ -443,7 +451,7 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 		// plaidErr.AccessToken = userInstitutions.AccessToken
 		newPlaidErr = append(newPlaidErr, plaidErr)
 
-		ser.logger.Error("Could not find the user institution details.", zap.String("User", impartWealthId),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("User", impartWealthId),
 			zap.String("user", impartWealthId))
 		return UserTransaction{}, nil, newPlaidErr
 	}
@@ -465,6 +473,7 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 		}
 
 	}
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 	userData := UserTransaction{}
 	var investTransactions []plaid.InvestmentTransaction
@@ -480,7 +489,7 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 				*transGetRequest,
 			).Execute()
 			if err != nil || resp.StatusCode == 400 {
-				ser.logger.Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
+				impart.CtxLogger(ctx, ser.logger).Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
 					zap.String("token", userInstitutions.AccessToken))
 				plaidErr.Msg = "Could not find the  transaction details."
 				if resp.StatusCode == 400 {
@@ -514,19 +523,19 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 				for _, act := range transactions {
 					currentDate := act.Date
 
-					ser.logger.Info(currentDate)
-					ser.logger.Info("allDates", zap.Any("allDates", allDates))
+					impart.CtxLogger(ctx, ser.logger).Info(currentDate)
+					impart.CtxLogger(ctx, ser.logger).Info("allDates", zap.Any("allDates", allDates))
 					if !checkDateExist(currentDate, allDates) {
-						ser.logger.Info("alredy date added", zap.Any("allDates", allDates),
+						impart.CtxLogger(ctx, ser.logger).Info("alredy date added", zap.Any("allDates", allDates),
 							zap.Any("currentDate", currentDate))
 
 						for _, acnts := range transactions {
 							if currentDate == acnts.Date {
-								ser.logger.Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
+								impart.CtxLogger(ctx, ser.logger).Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
 								if !checkDateExist(currentDate, allDates) {
 									allDates = append(allDates, currentDate)
 								}
-								ser.logger.Info("aallDates", zap.Any("allDates", allDates))
+								impart.CtxLogger(ctx, ser.logger).Info("aallDates", zap.Any("allDates", allDates))
 								transDatawithdate := TransactionToModel(acnts, userInstitutions.UserInstitutionID)
 								transDatawithdateFinalData = append(transDatawithdateFinalData, transDatawithdate)
 							}
@@ -564,7 +573,7 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 				*transInvestGetRequest,
 			).Execute()
 			if err != nil || resp.StatusCode == 400 {
-				ser.logger.Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
+				impart.CtxLogger(ctx, ser.logger).Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
 					zap.String("token", userInstitutions.AccessToken))
 				plaidErr.Msg = "Could not find the  transaction details."
 				if resp.StatusCode == 400 {
@@ -597,19 +606,19 @@ func (ser *service) GetPlaidUserInstitutionTransactions(ctx context.Context, imp
 				var allDates []string
 				for _, act := range investTransactions {
 					currentDate := act.Date
-					ser.logger.Info(currentDate)
-					ser.logger.Info("allDates", zap.Any("allDates", allDates))
+					impart.CtxLogger(ctx, ser.logger).Info(currentDate)
+					impart.CtxLogger(ctx, ser.logger).Info("allDates", zap.Any("allDates", allDates))
 					if !checkDateExist(currentDate, allDates) {
-						ser.logger.Info("alredy date added", zap.Any("allDates", allDates),
+						impart.CtxLogger(ctx, ser.logger).Info("alredy date added", zap.Any("allDates", allDates),
 							zap.Any("currentDate", currentDate))
 
 						for _, acnts := range investTransactions {
 							if currentDate == acnts.Date {
-								ser.logger.Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
+								impart.CtxLogger(ctx, ser.logger).Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
 								if !checkDateExist(currentDate, allDates) {
 									allDates = append(allDates, currentDate)
 								}
-								ser.logger.Info("aallDates", zap.Any("allDates", allDates))
+								impart.CtxLogger(ctx, ser.logger).Info("aallDates", zap.Any("allDates", allDates))
 								transDatawithdate := InvestmentTransactionToModel(acnts, userInstitutions.UserInstitutionID)
 								transDatawithdateFinalData = append(transDatawithdateFinalData, transDatawithdate)
 							}
@@ -701,7 +710,7 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 		// plaidErr.AccessToken = userInstitutions.AccessToken
 		newPlaidErr = append(newPlaidErr, plaidErr)
 
-		ser.logger.Error("Could not find the user institution details.", zap.String("access_token", accessToken),
+		impart.CtxLogger(ctx, ser.logger).Error("Could not find the user institution details.", zap.String("access_token", accessToken),
 			zap.String("access_token", accessToken))
 		return UserTransaction{}, nil, newPlaidErr
 	}
@@ -723,6 +732,7 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 		}
 
 	}
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 	userData := UserTransaction{}
 	var investTransactions []plaid.InvestmentTransaction
@@ -740,7 +750,7 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 			*transGetRequest,
 		).Execute()
 		if err != nil || resp.StatusCode == 400 {
-			ser.logger.Error("Could not find the user plaid account details.",
+			impart.CtxLogger(ctx, ser.logger).Error("Could not find the user plaid account details.",
 				zap.String("token", accessToken))
 			plaidErr.Msg = "Could not find the  transaction details."
 			if resp.StatusCode == 400 {
@@ -775,10 +785,10 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 			for _, act := range transactions {
 				if act.AccountId == accountId {
 					currentDate := act.Date
-					ser.logger.Info(currentDate)
-					ser.logger.Info("allDates", zap.Any("allDates", allDates))
+					impart.CtxLogger(ctx, ser.logger).Info(currentDate)
+					impart.CtxLogger(ctx, ser.logger).Info("allDates", zap.Any("allDates", allDates))
 					if !checkDateExist(currentDate, allDates) {
-						ser.logger.Info("alredy date added", zap.Any("allDates", allDates),
+						impart.CtxLogger(ctx, ser.logger).Info("alredy date added", zap.Any("allDates", allDates),
 							zap.Any("currentDate", currentDate))
 
 						for _, acnts := range transactions {
@@ -836,7 +846,7 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 			*transInvestGetRequest,
 		).Execute()
 		if err != nil || resp.StatusCode == 400 {
-			ser.logger.Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
+			impart.CtxLogger(ctx, ser.logger).Error("Could not find the user plaid account details.", zap.String("User", impartWealthId),
 				zap.String("token", accessToken))
 			plaidErr.Msg = "Could not find the  transaction details."
 			if resp.StatusCode == 400 {
@@ -870,19 +880,19 @@ func (ser *service) GetPlaidUserAccountsTransactions(ctx context.Context, accoun
 			for _, act := range investTransactions {
 				if act.AccountId == accountId {
 					currentDate := act.Date
-					ser.logger.Info(currentDate)
-					ser.logger.Info("allDates", zap.Any("allDates", allDates))
+					impart.CtxLogger(ctx, ser.logger).Info(currentDate)
+					impart.CtxLogger(ctx, ser.logger).Info("allDates", zap.Any("allDates", allDates))
 					if !checkDateExist(currentDate, allDates) {
-						ser.logger.Info("alredy date added", zap.Any("allDates", allDates),
+						impart.CtxLogger(ctx, ser.logger).Info("alredy date added", zap.Any("allDates", allDates),
 							zap.Any("currentDate", currentDate))
 
 						for _, acnts := range investTransactions {
 							if currentDate == acnts.Date && acnts.AccountId == accountId {
-								ser.logger.Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
+								impart.CtxLogger(ctx, ser.logger).Info("acnts.Date", zap.Any("acnts.Date", acnts.Date))
 								if !checkDateExist(currentDate, allDates) {
 									allDates = append(allDates, currentDate)
 								}
-								ser.logger.Info("aallDates", zap.Any("allDates", allDates))
+								impart.CtxLogger(ctx, ser.logger).Info("aallDates", zap.Any("allDates", allDates))
 								transDatawithdate := InvestmentTransactionToModel(acnts, userInstitutionList[0].UserInstitutionID)
 								transDatawithdateFinalData = append(transDatawithdateFinalData, transDatawithdate)
 							}
@@ -972,6 +982,7 @@ func GetAccessTokenStatus(accessToken string, ctx context.Context) bool {
 			configuration.UseEnvironment(plaid.Sandbox)
 		}
 	}
+	configuration.HTTPClient = httpClient
 	client := plaid.NewAPIClient(configuration)
 	accountsGetRequest := plaid.NewAccountsGetRequest(accessToken)
 	_, response, _ := client.PlaidApi.AccountsGet(ctx).AccountsGetRequest(
@@ -1006,3 +1017,57 @@ func (ser *service) DeletePlaidUserInstitutionAccounts(ctx context.Context, user
 	}
 	return nil
 }
+
+// RemoveUserItems removes every item the member linked from plaid so the access tokens stop
+// working, including the items of institutions they unlinked. Items plaid no longer knows are
+// skipped so it can be retried.
+func (ser *service) RemoveUserItems(ctx context.Context, impartWealthId string) error {
+	var rows []struct {
+		AccessToken string `boil:"access_token"`
+	}
+	err := queries.Raw(`select distinct access_token from user_institutions where impart_wealth_id = ?
+		union select plaid_access_token from user where impart_wealth_id = ? and plaid_access_token is not null`,
+		impartWealthId, impartWealthId,
+	).Bind(ctx, ser.db, &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	client := newAPIClient()
+	for _, row := range rows {
+		if strings.TrimSpace(row.AccessToken) == "" {
+			continue
+		}
+		request := plaid.NewItemRemoveRequest(row.AccessToken)
+		_, _, err := client.PlaidApi.ItemRemove(ctx).ItemRemoveRequest(*request).Execute()
+		if err == nil {
+			continue
+		}
+		if plaidErr, perr := plaid.ToPlaidError(err); perr == nil &&
+			(plaidErr.ErrorCode == "ITEM_NOT_FOUND" || plaidErr.ErrorCode == "INVALID_ACCESS_TOKEN") {
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func newAPIClient() *plaid.APIClient {
+	configuration := plaid.NewConfiguration()
+	cfg, _ := config.GetImpart()
+	if cfg != nil {
+		configuration.AddDefaultHeader("PLAID-CLIENT-ID", cfg.PlaidClientId)
+		configuration.AddDefaultHeader("PLAID-SECRET", cfg.PlaidSecret)
+		if cfg.Env == config.Production {
+			configuration.UseEnvironment(plaid.Production)
+		} else if cfg.Env == config.Preproduction {
+			configuration.UseEnvironment(plaid.Development)
+		} else {
+			configuration.UseEnvironment(plaid.Sandbox)
+		}
+	}
+	configuration.HTTPClient = httpClient
+	return plaid.NewAPIClient(configuration)
+}
@@ -21,6 +21,7 @@ type Service interface {
 	GetPlaidUserInstitutionTransactions(ctx context.Context, impartWealthId string, gpi models.GetPlaidInput) (UserTransaction, *NextPage, []PlaidError)
 	GetPlaidUserAccountsTransactions(ctx context.Context, accountId string, userInstitutionId uint64, impartWealthId string, gpi models.GetPlaidAccountTransactionInput) (UserTransaction, *NextPage, []PlaidError)
 	DeletePlaidUserInstitutionAccounts(ctx context.Context, userInstitutionId uint64) impart.Error
+	RemoveUserItems(ctx context.Context, impartWealthId string) error
 }
 
 type service struct {
@@ -9,17 +9,18 @@ import (
 	"strings"
 	"time"
 
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
 
-	"github.com/beeker1121/mailchimp-go/lists/members"
 	hive_data "github.com/impartwealthapp/backend/pkg/data/hive"
 	profile_data "github.com/impartwealthapp/backend/pkg/data/profile"
 	"github.com/impartwealthapp/backend/pkg/data/types"
+	"github.com/impartwealthapp/backend/pkg/deletion"
 	hive_main "github.com/impartwealthapp/backend/pkg/hive"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/models"
+	"github.com/impartwealthapp/backend/pkg/moderation"
 	"github.com/xeipuuv/gojsonschema"
 	"go.uber.org/zap"
 )
@@ -36,10 +37,12 @@ type Service interface {
 	ValidateScreenNameInput(document gojsonschema.JSONLoader, scrnName []byte) []impart.Error
 	ValidateScreenNameString(ctx context.Context, screenName string) impart.Error
 	ValidateInput(document gojsonschema.JSONLoader, validationModel types.Type) []impart.Error
-	Logger() *zap.Logger
+	Logger(ctx context.Context) *zap.Logger
 
 	ModifyUserConfigurations(ctx context.Context, conf models.UserConfigurations) (models.UserConfigurations, impart.Error)
 	GetUserConfigurations(ctx context.Context, impartWealthID string) (models.UserConfigurations, impart.Error)
+	GetNotificationPreferences(ctx context.Context, impartWealthID string) (models.NotificationPreferences, impart.Error)
+	UpdateNotificationPreferences(ctx context.Context, impartWealthID string, prefs models.NotificationPreferences) (models.NotificationPreferences, impart.Error)
 
 	GetUserDevice(ctx context.Context, token string, impartWealthID string, deviceToken string) (models.UserDevice, error)
 	CreateUserDevice(ctx context.Context, user *dbmodels.User, ud *dbmodels.UserDevice) (models.UserDevice, impart.Error)
@@ -71,7 +74,7 @@ type Service interface {
 	UserEmailDetailsUpdate(ctx context.Context, gpi models.WebAppUserInput) impart.Error
 }
 
-func New(logger *zap.SugaredLogger, db *sql.DB, dal profile_data.Store, ns impart.NotificationService, schema gojsonschema.JSONLoader, stage string, hivedata hive_main.Service, hiveSotre hive_data.Hives) Service {
+func New(logger *zap.SugaredLogger, db *sql.DB, dal profile_data.Store, ns impart.NotificationService, schema gojsonschema.JSONLoader, stage string, hivedata hive_main.Service, hiveSotre hive_data.Hives, deletions deletion.Service) Service {
 	return &profileService{
 		stage:               stage,
 		SugaredLogger:       logger,
@@ -81,6 +84,7 @@ func New(logger *zap.SugaredLogger, db *sql.DB, dal profile_data.Store, ns impar
 		db:                  db,
 		hiveData:            hivedata,
 		hiveStore:           hiveSotre,
+		deletions:           deletions,
 	}
 }
 
@@ -93,6 +97,7 @@ type profileService struct {
 	db                  *sql.DB
 	hiveData            hive_main.Service
 	hiveStore           hive_data.Hives
+	deletions           deletion.Service
 }
 
 func (ps *profileService) ScreenNameExists(ctx context.Context, screenName string) bool {
@@ -103,8 +108,9 @@ func (ps *profileService) ScreenNameExists(ctx context.Context, screenName strin
 	return false
 }
 
-func (ps *profileService) Logger() *zap.Logger {
-	return ps.Desugar()
+// Logger is the service logger with the request fields of ctx, see impart.CtxLogger
+func (ps *profileService) Logger(ctx context.Context) *zap.Logger {
+	return impart.CtxLogger(ctx, ps.Desugar())
 }
 
 func (ps *profileService) DeleteProfile(ctx context.Context, impartWealthID string, hardDelete bool, deleteUser models.DeleteUserInput) impart.Error {
@@ -117,7 +123,7 @@ func (ps *profileService) DeleteProfile(ctx context.Context, impartWealthID stri
 	}
 	if contextUser.Admin {
 		errorString := "Admin user doesn't have the permission"
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrUnauthorized, errorString)
 	}
 
@@ -128,7 +134,7 @@ func (ps *profileService) DeleteProfile(ctx context.Context, impartWealthID stri
 
 	// admin removed- APP-144
 	if contextUser.ImpartWealthID != userToDelete.ImpartWealthID {
-		ps.Logger().Info("request to delete a user failed validation", zap.String("deleteUser", userToDelete.ImpartWealthID),
+		ps.Logger(ctx).Info("request to delete a user failed validation", zap.String("deleteUser", userToDelete.ImpartWealthID),
 			zap.String("contextUser", contextUser.ImpartWealthID))
 
 		return impart.NewError(impart.ErrUnauthorized, "user is not authorized")
@@ -136,11 +142,9 @@ func (ps *profileService) DeleteProfile(ctx context.Context, impartWealthID stri
 	if userToDelete.Blocked {
 		return impart.NewError(impart.ErrBadRequest, "Cannot delete blocked user.")
 	}
-	err = ps.profileStore.DeleteUserProfile(ctx, deleteUser, hardDelete)
-	if err != nil {
-		return impart.NewError(impart.ErrBadRequest, "User delete failed.")
-	}
-	return nil
+	// the account is purged once the grace period ends, until then the member can cancel
+	_, impartErr := ps.deletions.RequestDeletion(ctx, deleteUser.Feedback)
+	return impartErr
 }
 
 func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiVersion string) (models.Profile, impart.Error) {
@@ -166,7 +170,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 	// If device token is not found from input
 	//
 	if deviceToken == "" {
-		ps.Logger().Debug("Unable to locate device token",
+		ps.Logger(ctx).Debug("Unable to locate device token",
 			zap.Any("profile", p),
 		)
 	}
@@ -176,15 +180,15 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 		if err == impart.ErrNotFound {
 			//new authenticated user is created this profile/user
 			p.AuthenticationID = contextAuthId
-			ps.Logger().Debug("requested to create new profile",
+			ps.Logger(ctx).Debug("requested to create new profile",
 				zap.Any("AuthenticationID", p.AuthenticationID))
 		} else {
-			ps.Logger().Error("error checking existing profile", zap.Error(err))
+			ps.Logger(ctx).Error("error checking existing profile", zap.Error(err))
 			return empty, impart.NewError(impart.ErrUnknown, "unable to check existing profile")
 		}
 	} else if ctxUser != nil && ctxUser.Admin {
 		//allow the creation of the profile by an admin
-		ps.Logger().Info("admin user is creating a new user",
+		ps.Logger(ctx).Info("admin user is creating a new user",
 			zap.String("admin", ctxUser.Email),
 			zap.String("userEmail", p.ImpartWealthID),
 			zap.String("userEmail", p.Email))
@@ -192,7 +196,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 		return empty, impart.NewError(impart.ErrExists, "an existing impart wealth user for this id exists")
 	} else {
 		//what?
-		ps.Logger().Error("create of profile failed - unexpected situation", zap.Any("contextUser", ctxUser), zap.Any("inputProfile", p))
+		ps.Logger(ctx).Error("create of profile failed - unexpected situation", zap.Any("contextUser", ctxUser), zap.Any("inputProfile", p))
 		return empty, impart.NewError(impart.ErrUnknown, "unable to create profile; unknown state")
 	}
 
@@ -200,7 +204,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 		return empty, impartErr
 	}
 
-	ps.Logger().Debug("creating a new user profile", zap.Any("updated", p))
+	ps.Logger(ctx).Debug("creating a new user profile", zap.Any("updated", p))
 	p.CreatedDate = impart.CurrentUTC()
 	p.UpdatedDate = impart.CurrentUTC()
 	p.Attributes.UpdatedDate = impart.CurrentUTC()
@@ -220,7 +224,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 	dbUser.HiveUpdatedAt = impart.CurrentUTC()
 
 	// if err != nil {
-	// 	ps.Logger().Error("Token Sync Endpoint error", zap.Any("Error", err), zap.Any("contextUser", ctxUser), zap.Any("inputProfile", p))
+	// 	ps.Logger(ctx).Error("Token Sync Endpoint error", zap.Any("Error", err), zap.Any("contextUser", ctxUser), zap.Any("inputProfile", p))
 	// }
 	// dbUser.AwsSNSAppArn = endpointARN
 	// hide this : end
@@ -263,7 +267,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 		NotificationStatus: notificationStatus,
 	})
 	if err != nil {
-		ps.Logger().Error("unable to process your request", zap.Error(err))
+		ps.Logger(ctx).Error("unable to process your request", zap.Error(err))
 	}
 	out.Settings.NotificationStatus = notificationStatus
 
@@ -280,7 +284,7 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 		userDevice, err := ps.CreateUserDevice(ctx, dbUser, p.UserDevices[0].UserDeviceToDBModel())
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("unable to add/update the device information %v", err))
-			ps.Logger().Error(impartErr.Error())
+			ps.Logger(ctx).Error(impartErr.Error())
 		}
 		out.UserDevices = append(out.UserDevices, userDevice)
 
@@ -296,22 +300,13 @@ func (ps *profileService) NewProfile(ctx context.Context, p models.Profile, apiV
 			err = ps.MapDeviceForNotification(ctx, userDevice, isAdmin)
 			if err != nil {
 				impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("an error occured in update mapping for notification %v", err))
-				ps.Logger().Error(impartErr.Error())
+				ps.Logger(ctx).Error(impartErr.Error())
 			}
 		}
 	}
-	// // Adding User to MailChimp
-	mailChimpParams := &members.NewParams{
-		EmailAddress: dbUser.Email,
-		Status:       members.StatusSubscribed,
-	}
-	cfg, _ := config.GetImpart()
-	ps.Logger().Info("Mailcimp -", zap.Any("MailchimpApikey", cfg.MailchimpApikey),
-		zap.Any("MailchimpAudienceId", cfg.MailchimpAudienceId))
-	_, err = members.New(cfg.MailchimpAudienceId, mailChimpParams)
-	if err != nil {
-		impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("User is not  added to the mailchimp %v", err))
-		ps.Logger().Error(impartErr.Error())
+	// add the user to the marketing audience
+	if err := marketing.QueueSync(ctx, ps.db, dbUser.ImpartWealthID); err != nil {
+		ps.Logger(ctx).Error("unable to queue the marketing sync", zap.String("impartWealthID", dbUser.ImpartWealthID), zap.Error(err))
 	}
 	return *out, nil
 }
@@ -367,6 +362,20 @@ func (ps *profileService) GetProfile(ctx context.Context, gpi GetProfileInput) (
 		return models.Profile{}, impart.NewError(err, "")
 	}
 
+	// the standing is only shown to the member and to admins
+	isAdmin := ctxUser.Admin || ctxUser.SuperAdmin
+	if isAdmin || u.ImpartWealthID == ctxUser.ImpartWealthID {
+		standing, err := moderation.Standing(ctx, ps.db, u.ImpartWealthID)
+		if err != nil {
+			ps.Logger(ctx).Error("unable to fetch member standing", zap.String("impartWealthId", u.ImpartWealthID), zap.Error(err))
+		} else {
+			if !isAdmin {
+				standing.RedactModerators()
+			}
+			out.Standing = &standing
+		}
+	}
+
 	return *out, nil
 }
 
@@ -386,7 +395,7 @@ func (ps *profileService) UpdateProfile(ctx context.Context, p models.Profile) (
 		return models.Profile{}, impart.NewError(impart.ErrUnknown, "unable to fetch existing user from dB")
 	}
 	existingDBProfile := existingDBUser.R.ImpartWealthProfile
-	ps.Logger().Debug("Checking Updated Profile",
+	ps.Logger(ctx).Debug("Checking Updated Profile",
 		zap.Any("existingDBUser", *existingDBUser),
 		zap.Any("existingDBProfile", *existingDBProfile),
 		zap.Any("updated", p))
@@ -394,7 +403,7 @@ func (ps *profileService) UpdateProfile(ctx context.Context, p models.Profile) (
 	if p.CreatedDate.IsZero() ||
 		p.UpdatedDate.IsZero() || !p.CreatedDate.Equal(existingDBUser.CreatedAt) || p.UpdatedDate.Sub(existingDBUser.UpdatedAt) < 0 {
 		msg := "profile being updated appears to be incorrect - critical properties do not match the profile being updated."
-		ps.Logger().Error(msg, zap.Time("inCreatedAt", p.CreatedDate),
+		ps.Logger(ctx).Error(msg, zap.Time("inCreatedAt", p.CreatedDate),
 			zap.Time("existingCreatedAt", existingDBUser.CreatedAt),
 			zap.Time("inUpdatedAt", p.UpdatedDate),
 			zap.Time("existingUpdatedAt", existingDBUser.UpdatedAt))
@@ -418,7 +427,7 @@ func (ps *profileService) UpdateProfile(ctx context.Context, p models.Profile) (
 
 	err = ps.profileStore.UpdateProfile(ctx, existingDBUser, existingDBProfile)
 	if err != nil {
-		ps.Logger().Error("couldn't save profile in DB", zap.Error(err))
+		ps.Logger(ctx).Error("couldn't save profile in DB", zap.Error(err))
 		return empty, impart.NewError(impart.ErrUnknown, "unable save profile in DB")
 	}
 
@@ -445,7 +454,7 @@ func (ps *profileService) UpdateReadCommunity(ctx context.Context, p models.Upda
 		return impart.NewError(impart.ErrUnknown, "unable to fetch existing user from dB")
 	}
 	existingDBProfile := existingDBUser.R.ImpartWealthProfile
-	ps.Logger().Debug("Checking Updated Profile",
+	ps.Logger(ctx).Debug("Checking Updated Profile",
 		zap.Any("existingDBUser", *existingDBUser),
 		zap.Any("existingDBProfile", *existingDBProfile),
 		zap.Any("updated", p))
@@ -454,7 +463,7 @@ func (ps *profileService) UpdateReadCommunity(ctx context.Context, p models.Upda
 	}
 	err = ps.profileStore.UpdateProfile(ctx, existingDBUser, existingDBProfile)
 	if err != nil {
-		ps.Logger().Error("Upadte Read Community  user requset failed", zap.String("UpadteReadCommunity", impartID))
+		ps.Logger(ctx).Error("Upadte Read Community  user requset failed", zap.String("UpadteReadCommunity", impartID))
 		return impart.NewError(err, "Upadte Read Community failed")
 	}
 	return nil
@@ -470,7 +479,7 @@ func (ps *profileService) DeleteUserByAdmin(ctx context.Context, hardDelete bool
 	}
 	if !contextUser.SuperAdmin {
 		errorString := "Current user does not have the permission."
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrUnauthorized, errorString)
 	}
 	userToDelete, err := ps.profileStore.GetUser(ctx, deleteUser.ImpartWealthID)
@@ -480,18 +489,22 @@ func (ps *profileService) DeleteUserByAdmin(ctx context.Context, hardDelete bool
 
 	if userToDelete.Blocked {
 		errorString := "Cannot delete  blocked user."
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrUnauthorized, errorString)
 	}
 	if userToDelete.ImpartWealthID == contextUser.ImpartWealthID {
 		errorString := "You cannot delete logged in user."
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrUnauthorized, errorString)
 	}
 	imperr := ps.profileStore.DeleteUserProfile(ctx, deleteUser, hardDelete)
 	if imperr != nil {
 		return impart.NewError(impart.ErrBadRequest, "User delete failed.")
 	}
+	if err := ps.deletions.ScheduleDeletions(ctx, contextUser.ImpartWealthID, userToDelete.ImpartWealthID); err != nil {
+		ps.Logger(ctx).Error("unable to schedule the purge of a deleted user", zap.String("deleteUser", userToDelete.ImpartWealthID), zap.Error(err))
+		return impart.NewError(impart.ErrUnknown, "User deleted, but the purge of its data could not be scheduled.")
+	}
 	return nil
 }
 
@@ -516,11 +529,11 @@ func (ps *profileService) CreatePlaidProfile(ctx context.Context, plaid models.P
 }
 
 func (ps *profileService) GetWeeklyNotification(ctx context.Context) {
-	impart.NotifyWeeklyActivity(ps.db, ps.Logger())
+	impart.NotifyWeeklyActivity(ps.db, ps.Logger(ctx))
 }
 
 func (ps *profileService) GetWeeklyMostPopularNotification(ctx context.Context) {
-	impart.NotifyWeeklyMostPopularPost(ps.db, ps.Logger())
+	impart.NotifyWeeklyMostPopularPost(ps.db, ps.Logger(ctx))
 }
 
 func (ps *profileService) GetHiveNotification(ctx context.Context) error {
@@ -10,9 +10,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/beeker1121/mailchimp-go/lists/members"
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
+	"github.com/impartwealthapp/backend/pkg/metrics"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
@@ -36,13 +36,13 @@ func (ps *profileService) GetQuestionnaires(ctx context.Context, name string) ([
 	if name == "" {
 		dbqs, err = ps.profileStore.GetAllCurrentQuestionnaires(ctx)
 		if err != nil {
-			ps.Logger().Error("unable to fetch questionnaires", zap.Error(err))
+			ps.Logger(ctx).Error("unable to fetch questionnaires", zap.Error(err))
 			return out, impart.NewError(impart.ErrUnknown, "unable to fetch questionnaires")
 		}
 	} else {
 		dbq, err := ps.profileStore.GetQuestionnaire(ctx, name, nil)
 		if err != nil {
-			ps.Logger().Error("unable to fetch questionnaires", zap.Error(err))
+			ps.Logger(ctx).Error("unable to fetch questionnaires", zap.Error(err))
 			return out, impart.NewError(impart.ErrUnknown, "unable to fetch questionnaires")
 		}
 		dbqs = append(dbqs, dbq)
@@ -62,7 +62,7 @@ func (ps *profileService) GetUserQuestionnaires(ctx context.Context, impartWealt
 	}
 	dbQs, err := ps.profileStore.GetUserQuestionnaires(ctx, impartWealthId, &name)
 	if err != nil {
-		ps.Logger().Error("unable to fetch user questionnaires", zap.Error(err))
+		ps.Logger(ctx).Error("unable to fetch user questionnaires", zap.Error(err))
 		return []models.Questionnaire{}, impart.UnknownError
 	}
 	out := make([]models.Questionnaire, len(dbQs), len(dbQs))
@@ -79,11 +79,11 @@ func (ps *profileService) SaveQuestionnaire(ctx context.Context, questionnaire m
 		return false, impart.NewError(impart.ErrBadRequest, "invalid input - questionnaire name and version are required")
 	}
 
-	ps.Logger().Debug("attempting to save a questionnaire", zap.Any("questionnaire", questionnaire))
+	ps.Logger(ctx).Debug("attempting to save a questionnaire", zap.Any("questionnaire", questionnaire))
 
 	currentQuestionnaire, err := ps.profileStore.GetQuestionnaire(ctx, questionnaire.Name, nil)
 	if err != nil {
-		ps.Logger().Error("unable to fetch current questionnaire", zap.Error(err), zap.String("questionnaire", questionnaire.Name))
+		ps.Logger(ctx).Error("unable to fetch current questionnaire", zap.Error(err), zap.String("questionnaire", questionnaire.Name))
 		return false, impart.UnknownError
 	}
 	if currentQuestionnaire == nil {
@@ -118,14 +118,14 @@ func (ps *profileService) SaveQuestionnaire(ctx context.Context, questionnaire m
 		}
 	}
 	if len(answeredQuestions) != len(currentQuestionnaire.R.Questions) {
-		ps.Logger().Error("invalid request - number of questions answered did not match the number of questions in the questionnaire",
+		ps.Logger(ctx).Error("invalid request - number of questions answered did not match the number of questions in the questionnaire",
 			zap.Int("expectedCount", len(currentQuestionnaire.R.Questions)), zap.Int("actualCount", len(answeredQuestions)), zap.String("questionnaireName", currentQuestionnaire.Name))
 
 		return false, impart.NewError(impart.ErrBadRequest, "not all questions were answered")
 	}
 
 	if err := ps.profileStore.SaveUserQuestionnaire(ctx, answers); err != nil {
-		ps.Logger().Error("unable to save user questionnaire", zap.Error(err))
+		ps.Logger(ctx).Error("unable to save user questionnaire", zap.Error(err))
 		return false, impart.UnknownError
 	}
 
@@ -236,7 +236,7 @@ func (ps *profileService) isAssignedMillenialWithChildren(questionnaire models.Q
 		case "Income":
 		case "EmploymentStatus":
 		default:
-			ps.Logger().Error("unknown onboarding question name", zap.String("questionName", q.Name))
+			ps.Desugar().Error("unknown onboarding question name", zap.String("questionName", q.Name))
 			return nil
 
 		}
@@ -260,7 +260,6 @@ func (ps *profileService) AssignHives(ctx context.Context, questionnaire models.
 		&dbmodels.Hive{HiveID: DefaultHiveId},
 	}
 	var isnewhive bool
-	status := impart.WaitList
 	ctxUser := impart.GetCtxUser(ctx)
 	//call all the hive assignment funcs
 	if id := ps.isAssignedMillenialWithChildren(questionnaire); id != nil {
@@ -302,9 +301,9 @@ func (ps *profileService) AssignHives(ctx context.Context, questionnaire models.
 		if len(deviceDetails) > 0 {
 			for _, device := range deviceDetails {
 				if (device.LastloginAt == null.Time{}) {
-					endpointARN, err := ps.notificationService.GetEndPointArn(ctx, device.DeviceToken, "")
+					endpointARN, err := ps.notificationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(device.Platform), device.DeviceToken, "")
 					if err != nil {
-						ps.Logger().Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
+						ps.Logger(ctx).Error("End point ARN finding failed", zap.String("DeviceToken", device.DeviceToken),
 							zap.Error(err))
 					}
 					if endpointARN != "" && hiveData.NotificationTopicArn.String != "" {
@@ -314,27 +313,33 @@ func (ps *profileService) AssignHives(ctx context.Context, questionnaire models.
 			}
 		}
 	}
-	err := ctxUser.SetMemberHiveHives(ctx, ps.db, false, hives...)
+	// resubmitting the questionnaire moves a member between hives, only the first hive is a sign up
+	signedUp, err := ctxUser.MemberHiveHives().Exists(ctx, ps.db)
 	if err != nil {
-		ps.Logger().Error("error setting member hives", zap.Error(err))
+		ps.Logger(ctx).Error("error checking member hives", zap.Error(err))
+		signedUp = true
+	}
+	err = ctxUser.SetMemberHiveHives(ctx, ps.db, false, hives...)
+	if err != nil {
+		ps.Logger(ctx).Error("error setting member hives", zap.Error(err))
 		return isnewhive, impart.NewError(impart.ErrUnknown, "unable to set the member hive")
 	}
 	memberhive := impart.DefaultHiveID
 	if hiveId != nil {
 		memberhive = *hiveId
 	}
+	if !signedUp {
+		metrics.SignedUp(memberhive)
+	}
 	err = ps.AssignHiveDemograpics(ctx, answer, memberhive)
 	if err != nil {
-		ps.Logger().Error("error in update user demogrpahics", zap.Error(err))
-	}
-	if memberhive != impart.DefaultHiveID {
-		status = impart.Hive
+		ps.Logger(ctx).Error("error in update user demogrpahics", zap.Error(err))
 	}
 
 	profile, err := dbmodels.Profiles(dbmodels.ProfileWhere.ImpartWealthID.EQ(ctxUser.ImpartWealthID)).One(ctx, ps.db)
 
 	if err != nil {
-		ps.Logger().Error("error finding profile", zap.Error(err))
+		ps.Logger(ctx).Error("error finding profile", zap.Error(err))
 	}
 	newProfile := dbmodels.Profile{}
 	attr := &models.Attributes{}
@@ -358,24 +363,9 @@ func (ps *profileService) AssignHives(ctx context.Context, questionnaire models.
 	err = profile.Attributes.Marshal(newAttr)
 	err = ps.profileStore.UpdateProfile(ctx, nil, profile)
 
-	userAnswer := impart.GetUserAnswerList()
-	userAns, err := ps.profileStore.GetUserAnswer(ctx, ctxUser.ImpartWealthID)
-	if len(userAns) > 0 {
-		for _, anser := range userAns {
-			userAnswer[anser.R.Answer.R.Question.QuestionID] = fmt.Sprintf("%s,%s", userAnswer[anser.R.Answer.R.Question.QuestionID], anser.R.Answer.Text)
-			userAnswer[anser.R.Answer.R.Question.QuestionID] = strings.Trim(userAnswer[anser.R.Answer.R.Question.QuestionID], ",")
-		}
-	}
-	mergeFlds := impart.SetMailChimpAnswer(userAnswer, status, questionnaire.ZipCode, ctxUser.FirstName, ctxUser.LastName)
-	mailChimpParams := &members.UpdateParams{
-		MergeFields: mergeFlds,
-	}
-
-	cfg, _ := config.GetImpart()
-	_, err = members.Update(cfg.MailchimpAudienceId, ctxUser.Email, mailChimpParams)
-	if err != nil {
-		impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("User is not  added to the mailchimp %v", err))
-		ps.Logger().Error(impartErr.Error())
+	// the answers, hive status and zip code are pushed as merge fields of the marketing audience
+	if err := marketing.QueueSync(ctx, ps.db, ctxUser.ImpartWealthID); err != nil {
+		ps.Logger(ctx).Error("unable to queue the marketing sync", zap.String("impartWealthID", ctxUser.ImpartWealthID), zap.Error(err))
 	}
 	return isnewhive, nil
 }
@@ -383,7 +373,7 @@ func (ps *profileService) AssignHives(ctx context.Context, questionnaire models.
 func (ps *profileService) GetMakeUp(ctx context.Context) (interface{}, impart.Error) {
 	result, err := ps.profileStore.GetMakeUp(ctx)
 	if err != nil {
-		ps.Logger().Error("Error in data fetching", zap.Error(err))
+		ps.Logger(ctx).Error("Error in data fetching", zap.Error(err))
 		return nil, impart.NewError(impart.ErrUnknown, "unable to fetch the details")
 	}
 	return result, nil
@@ -395,7 +385,7 @@ func (ps *profileService) isAssignHiveRule(ctx context.Context, questionnaire mo
 		answer_ids_str = append(answer_ids_str, strconv.Itoa(int(userAns.AnswerID)))
 	}
 	var ruleId uint64
-	existingRules := FindTheMatchingRules(ctx, answer_ids_str, ps.db, ps.Logger())
+	existingRules := FindTheMatchingRules(ctx, answer_ids_str, ps.db, ps.Logger(ctx))
 	if existingRules != nil {
 		min := existingRules[0]
 		for _, v := range existingRules {
@@ -448,7 +438,7 @@ func (ps *profileService) isAssignHiveRule(ctx context.Context, questionnaire mo
 					hives := models.Hive{HiveName: hiveName}
 					hives, err := ps.hiveData.CreateHive(ctx, hives)
 					if err != nil {
-						ps.Logger().Error("New hive creation failed", zap.String("hive", hiveName),
+						ps.Logger(ctx).Error("New hive creation failed", zap.String("hive", hiveName),
 							zap.Error(err))
 					}
 					hive_id = hives.HiveID
@@ -459,7 +449,7 @@ func (ps *profileService) isAssignHiveRule(ctx context.Context, questionnaire mo
 					newHive := &dbmodels.Hive{HiveID: hive_id}
 					errHive := existHiveRule.AddHives(ctx, ps.db, false, newHive)
 					if errHive != nil {
-						ps.Logger().Error("New hive rule map failed", zap.String("hive", hiveName),
+						ps.Logger(ctx).Error("New hive rule map failed", zap.String("hive", hiveName),
 							zap.Error(errHive))
 					}
 					existHiveRule.NoOfUsers = existHiveRule.NoOfUsers + 1
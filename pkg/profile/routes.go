@@ -65,6 +65,8 @@ func SetupRoutes(version *gin.RouterGroup, profileData profiledata.Store,
 	userRoutes.POST("/register-device", handler.CreateUserDevice())
 	userRoutes.GET("/notification", handler.GetConfiguration())
 	userRoutes.POST("/notification", handler.CreateNotificationConfiguration())
+	userRoutes.GET("/notification/preferences", handler.GetNotificationPreferences())
+	userRoutes.PUT("/notification/preferences", handler.UpdateNotificationPreferences())
 	userRoutes.POST("/block", handler.BlockUser())
 
 	mainRoutes := version.Group("/profile")
@@ -82,7 +84,6 @@ func SetupRoutes(version *gin.RouterGroup, profileData profiledata.Store,
 	filterRoutes.GET("", handler.GetFilterDetails())
 
 	mailChimpRoutes := version.Group("/mailchimp")
-	mailChimpRoutes.POST("", handler.CreateMailChimpForExistingUsers())
 	mailChimpRoutes.PATCH("/:impartWealthId", handler.UserEmailDetailsUpdate())
 
 	plaidRoutes := version.Group("/plaid")
@@ -180,7 +181,7 @@ func (ph *profileHandler) GetProfileFunc() gin.HandlerFunc {
 			gpi = GetProfileInput{ImpartWealthID: ctxUser.ImpartWealthID}
 		}
 
-		ph.logger.Debug("getting profile", zap.Any("gpi", gpi))
+		impart.CtxLogger(ctx, ph.logger).Debug("getting profile", zap.Any("gpi", gpi))
 
 		p, impartErr = ph.profileService.GetProfile(ctx, gpi)
 		if impartErr != nil {
@@ -195,7 +196,7 @@ func (ph *profileHandler) CreateProfileFunc() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -211,13 +212,13 @@ func (ph *profileHandler) CreateProfileFunc() gin.HandlerFunc {
 		stdErr := json.Unmarshal(b, &p)
 		if stdErr != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Profile")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 		p, impartErr := ph.profileService.NewProfile(ctx, p, apiVersion)
 		if impartErr != nil {
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -234,7 +235,7 @@ func (ph *profileHandler) EditProfileFunc() gin.HandlerFunc {
 			return
 		}
 
-		ph.logger.Debug("received raw update payload", zap.String("json", string(b)))
+		impart.CtxLogger(ctx, ph.logger).Debug("received raw update payload", zap.String("json", string(b)))
 
 		impartErrL := ph.profileService.ValidateSchema(gojsonschema.NewStringLoader(string(b)))
 		if impartErrL != nil {
@@ -249,7 +250,7 @@ func (ph *profileHandler) EditProfileFunc() gin.HandlerFunc {
 			return
 		}
 
-		ph.profileService.Logger().Debug("received ")
+		ph.profileService.Logger(ctx).Debug("received ")
 		p, impartErr := ph.profileService.UpdateProfile(ctx, p)
 		if impartErr != nil {
 
@@ -272,7 +273,7 @@ func (ph *profileHandler) DeleteProfileFunc() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "profile deleted"})
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "profile scheduled for deletion"})
 	}
 }
 
@@ -306,15 +307,15 @@ func (ph *profileHandler) SaveUserQuestionnaire() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		q := models.Questionnaire{}
 		if err := ctx.ShouldBindJSON(&q); err != nil {
-			ph.logger.Error("invalid json payload", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("invalid json payload", zap.Error(err))
 			err := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Questionnaire")
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
 		}
 
-		ph.logger.Info("SaveQuestionnaire started")
+		impart.CtxLogger(ctx, ph.logger).Info("SaveQuestionnaire started")
 		if hivedtype, err := ph.profileService.SaveQuestionnaire(ctx, q); err != nil {
-			ph.logger.Error("getting SaveQuestionnaire", zap.Any("err", err))
+			impart.CtxLogger(ctx, ph.logger).Error("getting SaveQuestionnaire", zap.Any("err", err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		} else {
@@ -331,7 +332,7 @@ func (ph *profileHandler) ValidateScreenName() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -349,14 +350,14 @@ func (ph *profileHandler) ValidateScreenName() gin.HandlerFunc {
 
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to validate screen name")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 
 		if screenNameRegexp.FindString(p.ScreenName) != p.ScreenName {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Invalid characters, please use letters and numbers only.", impart.ScreenName)
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -364,7 +365,7 @@ func (ph *profileHandler) ValidateScreenName() gin.HandlerFunc {
 		valid := ph.profileService.ScreenNameExists(ctx, p.ScreenName)
 		if valid {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Screen name already in use.")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -373,7 +374,7 @@ func (ph *profileHandler) ValidateScreenName() gin.HandlerFunc {
 		err = ph.profileService.ValidateScreenNameString(ctx, p.ScreenName)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Screen name includes invalid terms.")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -389,7 +390,7 @@ func (ph *profileHandler) UpdateReadCommunity() gin.HandlerFunc {
 		ctxUser := impart.GetCtxUser(ctx)
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -398,13 +399,13 @@ func (ph *profileHandler) UpdateReadCommunity() gin.HandlerFunc {
 		stdErr := json.Unmarshal(b, &p)
 		if stdErr != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Profile")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
 		impartErr := ph.profileService.UpdateReadCommunity(ctx, p, ctxUser.ImpartWealthID)
 		if impartErr != nil {
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -419,7 +420,7 @@ func (ph *profileHandler) ResentEmail() gin.HandlerFunc {
 
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "Couldn't parse JSON request body."),
 			))
@@ -429,7 +430,7 @@ func (ph *profileHandler) ResentEmail() gin.HandlerFunc {
 
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to Authenticationid.")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -452,7 +453,7 @@ func (ph *profileHandler) ResentEmail() gin.HandlerFunc {
 		mgmnt, err := auth.NewImpartManagementClient()
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Resent email sending failed.")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -462,7 +463,7 @@ func (ph *profileHandler) ResentEmail() gin.HandlerFunc {
 		err = mgmnt.User.Job.VerifyEmail(&jobs)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Resent email sending failed.")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -480,7 +481,7 @@ func (ph *profileHandler) CreateUserDevice() gin.HandlerFunc {
 		ctxUser := impart.GetCtxUser(ctx)
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -498,7 +499,7 @@ func (ph *profileHandler) CreateUserDevice() gin.HandlerFunc {
 		dbModel := device.UserDeviceToDBModel()
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a UserDevice")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -506,7 +507,7 @@ func (ph *profileHandler) CreateUserDevice() gin.HandlerFunc {
 		userDevice, err := ph.profileService.CreateUserDevice(ctx, nil, dbModel)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("unable to add/update the device information %v", err))
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -521,7 +522,7 @@ func (ph *profileHandler) CreateUserDevice() gin.HandlerFunc {
 			err = ph.profileService.MapDeviceForNotification(ctx, userDevice, isAdmin)
 			if err != nil {
 				impartErr := impart.NewError(impart.ErrBadRequest, fmt.Sprintf("an error occured in update mapping for notification %v", err))
-				ph.logger.Error(impartErr.Error())
+				impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 				return
 			}
@@ -548,7 +549,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		conf := models.UserGlobalConfigInput{}
 		if err := ctx.ShouldBindJSON(&conf); err != nil {
-			ph.logger.Error("invalid json payload", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("invalid json payload", zap.Error(err))
 			err := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Questionnaire")
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
@@ -559,7 +560,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 			NotificationStatus: conf.Status,
 		})
 		if err != nil {
-			ph.logger.Error("unable to process your request", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("unable to process your request", zap.Error(err))
 			err := impart.NewError(impart.ErrBadRequest, "unable to process your request")
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
@@ -580,7 +581,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 		}
 
 		if refToken == "" {
-			ph.logger.Error("unable to find device token to update notification", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("unable to find device token to update notification", zap.Error(err))
 			err := impart.NewError(impart.ErrBadRequest, "unable to find device identity token")
 			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 			return
@@ -601,7 +602,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 		if conf.Status {
 			// empty device token is not allowed here
 			if deviceToken == "" {
-				ph.logger.Error("have to provide device token", zap.Any("request", conf))
+				impart.CtxLogger(ctx, ph.logger).Error("have to provide device token", zap.Any("request", conf))
 				err := impart.NewError(impart.ErrBadRequest, "have to provide device token")
 				ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 				return
@@ -609,7 +610,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 
 			deviceDetails, devErr := ph.profileService.GetUserDevice(ctx, refToken, "", "")
 			if devErr != nil {
-				ph.logger.Error("unable to find device", zap.Error(err))
+				impart.CtxLogger(ctx, ph.logger).Error("unable to find device", zap.Error(err))
 				err := impart.NewError(impart.ErrBadRequest, "unable to find device")
 				ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
 				return
@@ -620,7 +621,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 			if deviceDetails.DeviceToken != deviceToken {
 				err := ph.profileService.UpdateDeviceToken(ctx, refToken, deviceToken)
 				if err != nil {
-					ph.logger.Error("unable to update device token", zap.Error(err))
+					impart.CtxLogger(ctx, ph.logger).Error("unable to update device token", zap.Error(err))
 				} else {
 					deviceDetails.DeviceToken = deviceToken
 					var isAdmin bool
@@ -631,7 +632,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 					}
 					err = ph.profileService.MapDeviceForNotification(ctx, deviceDetails, isAdmin)
 					if err != nil {
-						ph.logger.Error("unable to map device token", zap.Error(err))
+						impart.CtxLogger(ctx, ph.logger).Error("unable to map device token", zap.Error(err))
 					}
 				}
 
@@ -643,7 +644,7 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 					refToken,
 				)
 				if dErr != nil {
-					ph.logger.Error("unable to remove existing devices", zap.Error(dErr))
+					impart.CtxLogger(ctx, ph.logger).Error("unable to remove existing devices", zap.Error(dErr))
 				}
 			}
 			// check the same device id exists for another user, then set to false
@@ -660,9 +661,9 @@ func (ph *profileHandler) CreateNotificationConfiguration() gin.HandlerFunc {
 
 			///subsribe for the topic
 			if context != nil && !context.Admin {
-				endpointARN, err := ph.noticationService.GetEndPointArn(ctx, deviceDetails.DeviceToken, "")
+				endpointARN, err := ph.noticationService.GetEndPointArn(ctx, impart.ParseDevicePlatform(deviceDetails.Platform), deviceDetails.DeviceToken, "")
 				if err != nil {
-					ph.logger.Error("Error while get enpoint arn", zap.Error(err))
+					impart.CtxLogger(ctx, ph.logger).Error("Error while get enpoint arn", zap.Error(err))
 					return
 				}
 				if hiveData.NotificationTopicArn.String != "" {
@@ -718,6 +719,41 @@ func (ph *profileHandler) GetConfiguration() gin.HandlerFunc {
 	}
 }
 
+// Get the notification category preferences and quiet hours
+func (ph *profileHandler) GetNotificationPreferences() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		context := impart.GetCtxUser(ctx)
+
+		data, err := ph.profileService.GetNotificationPreferences(ctx, context.ImpartWealthID)
+		if err != nil {
+			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusOK, data)
+	}
+}
+
+// Update the notification category preferences and quiet hours
+func (ph *profileHandler) UpdateNotificationPreferences() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		prefs := models.NotificationPreferences{}
+		if err := ctx.ShouldBindJSON(&prefs); err != nil {
+			impart.CtxLogger(ctx, ph.logger).Error("invalid json payload", zap.Error(err))
+			err := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to Notification Preferences")
+			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
+			return
+		}
+		context := impart.GetCtxUser(ctx)
+
+		data, err := ph.profileService.UpdateNotificationPreferences(ctx, context.ImpartWealthID, prefs)
+		if err != nil {
+			ctx.JSON(err.HttpStatus(), impart.ErrorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusOK, data)
+	}
+}
+
 //  User Logout
 //
 //  Once the user is logout,
@@ -736,7 +772,7 @@ func (ph *profileHandler) HandlerUserLogout() gin.HandlerFunc {
 		// un subsribe from topic on logout
 		deviceDetails, devErr := ph.profileData.GetUserDevice(ctx, deviceToken, context.ImpartWealthID, "")
 		if devErr != nil {
-			ph.logger.Error("Error while get deviceDetails", zap.Error(devErr))
+			impart.CtxLogger(ctx, ph.logger).Error("Error while get deviceDetails", zap.Error(devErr))
 			//return
 		}
 		if deviceDetails != nil && deviceDetails.R != nil && len(deviceDetails.R.NotificationDeviceMappings) > 0 {
@@ -749,7 +785,7 @@ func (ph *profileHandler) HandlerUserLogout() gin.HandlerFunc {
 			}
 			hiveData, err := ph.profileService.GetHive(ctx, hiveId)
 			if err != nil {
-				ph.logger.Error("Error while get hiveData", zap.Error(err))
+				impart.CtxLogger(ctx, ph.logger).Error("Error while get hiveData", zap.Error(err))
 				//return
 			} else {
 				if hiveData.NotificationTopicArn.String != "" {
@@ -758,7 +794,7 @@ func (ph *profileHandler) HandlerUserLogout() gin.HandlerFunc {
 			}
 			_, logoutErr := ph.profileService.UpdateUserDevicesDetails(ctx, deviceDetails, false)
 			if logoutErr != nil {
-				ph.logger.Error("logout update failed", zap.Error(logoutErr))
+				impart.CtxLogger(ctx, ph.logger).Error("logout update failed", zap.Error(logoutErr))
 			}
 		}
 
@@ -785,7 +821,7 @@ func (ph *profileHandler) BlockUser() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -794,7 +830,7 @@ func (ph *profileHandler) BlockUser() gin.HandlerFunc {
 		// validate the inputs
 		impartErrl := ph.profileService.ValidateInput(gojsonschema.NewStringLoader(string(rawData)), types.UserBlockValidationModel)
 		if impartErrl != nil {
-			ph.logger.Error("input validation error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input validation error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impartErrl))
 			return
 		}
@@ -802,7 +838,7 @@ func (ph *profileHandler) BlockUser() gin.HandlerFunc {
 		input := models.BlockUserInput{}
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -857,7 +893,7 @@ func (ph *profileHandler) DeleteUserProfileFunc() gin.HandlerFunc {
 		}
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -866,7 +902,7 @@ func (ph *profileHandler) DeleteUserProfileFunc() gin.HandlerFunc {
 		input := models.DeleteUserInput{}
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -889,7 +925,7 @@ func (ph *profileHandler) DeleteUserProfileFunc() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "profile deleted"})
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "profile scheduled for deletion"})
 	}
 }
 
@@ -924,7 +960,7 @@ func (ph *profileHandler) GetUsersDetails() gin.HandlerFunc {
 			}
 		}
 		var err error
-		gpi.Limit, gpi.Offset, err = parseLimitOffset(ctx)
+		gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -974,7 +1010,7 @@ func (ph *profileHandler) GetPostDetails() gin.HandlerFunc {
 		}
 
 		var err error
-		gpi.Limit, gpi.Offset, err = parseLimitOffset(ctx)
+		gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -993,31 +1029,11 @@ func (ph *profileHandler) GetPostDetails() gin.HandlerFunc {
 	}
 }
 
-func parseLimitOffset(ctx *gin.Context) (limit int, offset int, err error) {
-	params := ctx.Request.URL.Query()
-
-	if limitParam := strings.TrimSpace(params.Get("limit")); limitParam != "" {
-		if limit, err = strconv.Atoi(limitParam); err != nil {
-			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impart.NewError(err, "invalid limit passed in")))
-			return
-		}
-	}
-
-	if offsetParam := strings.TrimSpace(params.Get("offset")); offsetParam != "" {
-		if offset, err = strconv.Atoi(offsetParam); err != nil {
-			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impart.NewError(err, "invalid limit passed in")))
-			return
-		}
-	}
-
-	return
-}
-
 func (ph *profileHandler) EditUserDetails() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1034,7 +1050,7 @@ func (ph *profileHandler) EditUserDetails() gin.HandlerFunc {
 		input.ImpartWealthID = ctx.Param("impartWealthId")
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -1087,7 +1103,7 @@ func (ph *profileHandler) GetHiveDetails() gin.HandlerFunc {
 		gpi := models.GetAdminInputs{}
 
 		var err error
-		gpi.Limit, gpi.Offset, err = parseLimitOffset(ctx)
+		gpi.Limit, gpi.Offset, err = impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrUnknown, "couldn't parse limit and offset")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -1139,7 +1155,7 @@ func (ph *profileHandler) EditBulkUserDetails() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1160,40 +1176,30 @@ func (ph *profileHandler) EditBulkUserDetails() gin.HandlerFunc {
 		input := models.UserUpdate{}
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
 		output, impartErr := ph.profileService.EditBulkUserDetails(ctx, input)
-		ph.logger.Info("bulk action complted and returned to route, giving api resp", zap.Any("output", output))
+		impart.CtxLogger(ctx, ph.logger).Info("bulk action complted and returned to route, giving api resp", zap.Any("output", output))
 		if impartErr != nil {
-			ph.logger.Info("no error all success. Now we can  give response")
-			ph.logger.Error("EditBulkUserDetails error", zap.Any("impartErr", impartErr))
+			impart.CtxLogger(ctx, ph.logger).Info("no error all success. Now we can  give response")
+			impart.CtxLogger(ctx, ph.logger).Error("EditBulkUserDetails error", zap.Any("impartErr", impartErr))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(impartErr))
 			return
 		}
-		ph.logger.Info("no error all success. next is response")
+		impart.CtxLogger(ctx, ph.logger).Info("no error all success. next is response")
 		ctx.JSON(http.StatusOK, models.PagedUserUpdateResponse{
 			Users: output,
 		})
 	}
 }
-func (ph *profileHandler) CreateMailChimpForExistingUsers() gin.HandlerFunc {
-	return func(ctx *gin.Context) {
-		err := ph.profileData.CreateMailChimpForExistingUsers(ctx)
-		if err != nil {
-			ctx.JSON(http.StatusBadRequest, "Failed")
-		}
-		ctx.JSON(http.StatusOK, "Success")
-	}
-}
-
 func (ph *profileHandler) CreateCookies() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// ctx.Header("Set-Cookie", "foo=bar; HttpOnly")
 		b, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1202,7 +1208,7 @@ func (ph *profileHandler) CreateCookies() gin.HandlerFunc {
 		stdErr := json.Unmarshal(b, &p)
 		if stdErr != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Unable to Deserialize JSON Body to a Profile")
-			ph.logger.Error(impartErr.Error())
+			impart.CtxLogger(ctx, ph.logger).Error(impartErr.Error())
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
@@ -1237,7 +1243,7 @@ func (ph *profileHandler) CreatePlaidToken() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1258,7 +1264,7 @@ func (ph *profileHandler) CreatePlaidToken() gin.HandlerFunc {
 			return
 		}
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
@@ -1356,7 +1362,7 @@ func (ph *profileHandler) GetPlaidUserInstitutionAccounts() gin.HandlerFunc {
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
 			return
 		}
-		limit, offset, err := parseLimitOffset(ctx)
+		limit, offset, err := impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Invalid parameter.")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -1426,7 +1432,7 @@ func (ph *profileHandler) GetPlaidUserInstitutionTransactions() gin.HandlerFunc
 			return
 		}
 		gpi := models.GetPlaidInput{}
-		limit, offset, err := parseLimitOffset(ctx)
+		limit, offset, err := impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Invalid parameter.")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -1478,7 +1484,7 @@ func (ph *profileHandler) GetPlaidUserAccountsTransactions() gin.HandlerFunc {
 		fmt.Println("the id is", insId, accountId)
 		gpi := models.GetPlaidAccountTransactionInput{}
 		gpi.UserInstitutionId = insId
-		limit, offset, err := parseLimitOffset(ctx)
+		limit, offset, err := impart.ParseLimitOffset(ctx)
 		if err != nil {
 			impartErr := impart.NewError(impart.ErrBadRequest, "Invalid parameter.")
 			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
@@ -1545,7 +1551,7 @@ func (ph *profileHandler) UserEmailDetailsUpdate() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		rawData, err := ctx.GetRawData()
 		if err != nil && err != io.EOF {
-			ph.logger.Error("error deserializing", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("error deserializing", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(
 				impart.NewError(impart.ErrBadRequest, "couldn't parse JSON request body"),
 			))
@@ -1562,7 +1568,7 @@ func (ph *profileHandler) UserEmailDetailsUpdate() gin.HandlerFunc {
 		input.ImpartWealthID = ctx.Param("impartWealthId")
 		err = json.Unmarshal(rawData, &input)
 		if err != nil {
-			ph.logger.Error("input json parse error", zap.Error(err))
+			impart.CtxLogger(ctx, ph.logger).Error("input json parse error", zap.Error(err))
 			ctx.JSON(http.StatusBadRequest, impart.ErrorResponse(err))
 			return
 		}
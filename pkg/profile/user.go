@@ -4,11 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/beeker1121/mailchimp-go/lists/members"
-	"github.com/impartwealthapp/backend/internal/pkg/impart/config"
 	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/marketing"
 	"github.com/impartwealthapp/backend/pkg/models"
 	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
 	"github.com/volatiletech/null/v8"
@@ -20,7 +20,7 @@ func (ps *profileService) GetUserDevice(ctx context.Context, token string, impar
 	device, err := ps.profileStore.GetUserDevice(ctx, token, impartID, deviceToken)
 	if err != nil {
 		errorString := fmt.Sprintf("error occured during update existing %s device token", deviceToken)
-		ps.Logger().Error(errorString, zap.Any("error", err))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", err))
 		return models.UserDevice{}, impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
@@ -65,6 +65,7 @@ func (ps *profileService) CreateUserDevice(ctx context.Context, user *dbmodels.U
 		exists.DeviceID = ud.DeviceID
 		exists.DeviceName = ud.DeviceName
 		exists.DeviceVersion = ud.DeviceVersion
+		exists.Platform = impart.ParseDevicePlatform(ud.Platform).String()
 		exists.LastloginAt = null.Time{}
 		err = ps.profileStore.UpdateDevice(ctx, exists)
 		if err != nil && err != impart.ErrNotFound {
@@ -80,7 +81,7 @@ func (ps *profileService) CreateUserDevice(ctx context.Context, user *dbmodels.U
 		userToUpdate.LastloginAt = null.TimeFrom(currTime)
 		err = ps.profileStore.UpdateProfile(ctx, userToUpdate, existingDBProfile)
 		if err != nil {
-			ps.Logger().Error("Update user last login requset failed", zap.String("Update", userToUpdate.ImpartWealthID),
+			ps.Logger(ctx).Error("Update user last login requset failed", zap.String("Update", userToUpdate.ImpartWealthID),
 				zap.String("contextUser", contextUser.ImpartWealthID))
 		}
 	}
@@ -136,7 +137,7 @@ func (ps *profileService) MapDeviceForNotification(ctx context.Context, ud model
 	}, false)
 	if mapErr != nil && err != sql.ErrNoRows {
 		errorString := fmt.Sprintf("error occured during update existing %s device id", ud.DeviceToken)
-		ps.Logger().Error(errorString, zap.Any("error", mapErr))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", mapErr))
 		return impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
@@ -150,14 +151,14 @@ func (ps *profileService) MapDeviceForNotification(ctx context.Context, ud model
 	})
 	if existsErr != nil {
 		errorString := fmt.Sprintf("unable to fetch the existing mapped data %s device id", ud.DeviceToken)
-		ps.Logger().Error(errorString, zap.Any("error", mapErr))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", mapErr))
 		return impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
 	// from here, this device id should be sync with sns
-	arn, nErr := ps.notificationService.SyncTokenEndpoint(ctx, ud.DeviceToken, "")
+	arn, nErr := ps.notificationService.SyncTokenEndpoint(ctx, impart.ParseDevicePlatform(ud.Platform), ud.DeviceToken, "")
 	if nErr != nil {
-		ps.Logger().Error("Token Sync Endpoint error",
+		ps.Logger(ctx).Error("Token Sync Endpoint error",
 			zap.Any("Error", nErr),
 			zap.Any("Device", ud),
 		)
@@ -216,14 +217,14 @@ func (ps *profileService) MapDeviceForNotification(ctx context.Context, ud model
 
 		if mapErr != nil {
 			errorString := fmt.Sprintf("error occure during delete existing %s device token", ud.DeviceName)
-			ps.Logger().Error(errorString, zap.Any("error", mapErr))
+			ps.Logger(ctx).Error(errorString, zap.Any("error", mapErr))
 			return impart.NewError(impart.ErrBadRequest, errorString)
 		}
 
 	}
 	if mapErr != nil {
 		errorString := fmt.Sprintf("unable to add %s device token", ud.DeviceToken)
-		ps.Logger().Error(errorString, zap.Any("error", mapErr))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", mapErr))
 		return impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
@@ -239,7 +240,7 @@ func (ps *profileService) ModifyUserConfigurations(ctx context.Context, conf mod
 	configuration, err = ps.profileStore.GetUserConfigurations(ctx, conf.ImpartWealthID)
 	if err != nil && err != impart.ErrNotFound {
 		errorString := "unable to get the user configuration"
-		ps.Logger().Error(errorString, zap.Any("error", err))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", err))
 		return models.UserConfigurations{}, impart.NewError(impart.ErrBadRequest, errorString)
 	}
 	// if the entry exists, then update with latest
@@ -251,20 +252,103 @@ func (ps *profileService) ModifyUserConfigurations(ctx context.Context, conf mod
 	}
 	if err != nil {
 		errorString := "unable to add/update user configuration"
-		ps.Logger().Error(errorString, zap.Any("error", err))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", err))
 		return models.UserConfigurations{}, impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
 	return models.UserConfigurationFromDBModel(configuration), nil
 }
 
+// Get the notification category preferences and quiet hours of the user
+func (ps *profileService) GetNotificationPreferences(ctx context.Context, impartWealthID string) (models.NotificationPreferences, impart.Error) {
+	configuration, err := ps.profileStore.GetUserConfigurations(ctx, impartWealthID)
+	if err != nil && err != impart.ErrNotFound {
+		ps.Logger(ctx).Error("unable to get the user configuration", zap.Error(err))
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, "error to get user configurations")
+	}
+	prefs, err := ps.profileStore.GetNotificationPreferences(ctx, impartWealthID)
+	if err != nil {
+		ps.Logger(ctx).Error("unable to get the notification preferences", zap.Error(err))
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrUnknown, "unable to get notification preferences")
+	}
+	return models.NotificationPreferencesFromDBModel(configuration, prefs), nil
+}
+
+// Update the notification preferences
+// only the categories included in the request are changed, quiet hours are cleared when not sent
+func (ps *profileService) UpdateNotificationPreferences(ctx context.Context, impartWealthID string, in models.NotificationPreferences) (models.NotificationPreferences, impart.Error) {
+	now := impart.CurrentUTC()
+	prefs := make(dbmodels.UserNotificationPreferenceSlice, 0, len(in.Categories))
+	for category, enabled := range in.Categories {
+		if !impart.NotificationCategory(category).Valid() {
+			return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid notification category %s", category), impart.Category)
+		}
+		prefs = append(prefs, &dbmodels.UserNotificationPreference{
+			ImpartWealthID: impartWealthID,
+			Category:       category,
+			Enabled:        enabled,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+
+	timezone := strings.TrimSpace(in.Timezone)
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, fmt.Sprintf("invalid timezone %s", timezone), impart.Timezone)
+	}
+	start, end := null.Uint16{}, null.Uint16{}
+	if in.QuietHours != nil {
+		s, err := models.ParseClockMinutes(in.QuietHours.Start)
+		if err != nil {
+			return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, err.Error(), impart.QuietHours)
+		}
+		e, err := models.ParseClockMinutes(in.QuietHours.End)
+		if err != nil {
+			return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, err.Error(), impart.QuietHours)
+		}
+		start, end = null.Uint16From(s), null.Uint16From(e)
+	}
+
+	configuration, err := ps.profileStore.GetUserConfigurations(ctx, impartWealthID)
+	if err != nil && err != impart.ErrNotFound {
+		ps.Logger(ctx).Error("unable to get the user configuration", zap.Error(err))
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrBadRequest, "unable to get the user configuration")
+	}
+	if configuration != nil {
+		configuration.QuietHoursStart, configuration.QuietHoursEnd, configuration.Timezone = start, end, timezone
+		_, err = ps.profileStore.EditUserConfigurations(ctx, configuration)
+	} else {
+		// no configuration yet means notifications are enabled
+		_, err = ps.profileStore.CreateUserConfigurations(ctx, &dbmodels.UserConfiguration{
+			ImpartWealthID:     impartWealthID,
+			NotificationStatus: true,
+			QuietHoursStart:    start,
+			QuietHoursEnd:      end,
+			Timezone:           timezone,
+		})
+	}
+	if err != nil {
+		ps.Logger(ctx).Error("unable to save quiet hours", zap.Error(err))
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrUnknown, "unable to save notification preferences")
+	}
+	if err := ps.profileStore.SaveNotificationPreferences(ctx, prefs); err != nil {
+		ps.Logger(ctx).Error("unable to save notification preferences", zap.Error(err))
+		return models.NotificationPreferences{}, impart.NewError(impart.ErrUnknown, "unable to save notification preferences")
+	}
+
+	return ps.GetNotificationPreferences(ctx, impartWealthID)
+}
+
 // Update Existing Notification Mapp Data
 // Which will upodate the notification mapp status into true/false
 func (ps *profileService) UpdateExistingNotificationMappData(input models.MapArgumentInput, status bool) impart.Error {
 	err := ps.profileStore.UpdateExistingNotificationMappData(input, status)
 	if err != nil {
 		errorString := "unable to update notification map status"
-		ps.Logger().Error(errorString, zap.Any("error", err))
+		ps.Logger(input.Ctx).Error(errorString, zap.Any("error", err))
 		return impart.NewError(impart.ErrBadRequest, errorString)
 	}
 	return nil
@@ -275,13 +359,13 @@ func (ps *profileService) BlockUser(ctx context.Context, impartID string, screen
 	ctxUser := impart.GetCtxUser(ctx)
 	if !ctxUser.Admin {
 		errorString := "current user doesn't have the permission"
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrUnauthorized, errorString)
 	}
 
 	if impartID == "" && screenName == "" {
 		errorString := "please provided user data to block"
-		ps.Logger().Error(errorString, zap.Any("error", errorString))
+		ps.Logger(ctx).Error(errorString, zap.Any("error", errorString))
 		return impart.NewError(impart.ErrBadRequest, errorString)
 	}
 
@@ -325,7 +409,7 @@ func (ps *profileService) BlockUser(ctx context.Context, impartID string, screen
 	go func() {
 		err = ps.profileStore.UpdateHiveUserDemographic(ctx, answerIds, hiveid, 0, true, true, false)
 		if err != nil {
-			ps.Logger().Error("UpdateHiveUserDemographic falied.", zap.Any("err", err),
+			ps.Logger(ctx).Error("UpdateHiveUserDemographic falied.", zap.Any("err", err),
 				zap.String("contextUser", dbUser.ImpartWealthID))
 		}
 	}()
@@ -334,18 +418,15 @@ func (ps *profileService) BlockUser(ctx context.Context, impartID string, screen
 		if dbUser.R.MemberHiveHives[0].NotificationTopicArn.String != "" {
 			err := ps.notificationService.UnsubscribeTopicForAllDevice(ctx, dbUser.ImpartWealthID, dbUser.R.MemberHiveHives[0].NotificationTopicArn.String)
 			if err != nil {
-				ps.Logger().Error("SubscribeTopic", zap.String("DeviceToken", dbUser.R.MemberHiveHives[0].NotificationTopicArn.String),
+				ps.Logger(ctx).Error("SubscribeTopic", zap.String("DeviceToken", dbUser.R.MemberHiveHives[0].NotificationTopicArn.String),
 					zap.Error(err))
 			}
 		}
 	}
 
-	// // delete user from mailchimp
-	cfg, _ := config.GetImpart()
-	err = members.Delete(cfg.MailchimpAudienceId, dbUser.Email)
-	if err != nil {
-		ps.Logger().Error("Delete user requset failed in MailChimp", zap.String("blockUser", ctxUser.ImpartWealthID),
-			zap.String("User", ctxUser.ImpartWealthID))
+	// the sync of a blocked user removes it from the marketing audience
+	if err := marketing.QueueSync(ctx, ps.db, dbUser.ImpartWealthID); err != nil {
+		ps.Logger(ctx).Error("unable to queue the marketing sync", zap.String("blockUser", dbUser.ImpartWealthID), zap.Error(err))
 	}
 	return nil
 }
@@ -369,7 +450,7 @@ func (ps *profileService) DeleteExceptUserDevice(ctx context.Context, impartID s
 func (ps *profileService) GetUsersDetails(ctx context.Context, gpi models.GetAdminInputs) ([]models.UserDetail, *models.NextPage, impart.Error) {
 	result, nextPage, err := ps.profileStore.GetUsersDetails(ctx, gpi)
 	if err != nil {
-		ps.Logger().Error("Error in data fetching", zap.Error(err))
+		ps.Logger(ctx).Error("Error in data fetching", zap.Error(err))
 		return nil, nextPage, impart.NewError(impart.ErrUnknown, "unable to fetch the details")
 	}
 	return result, nextPage, nil
@@ -378,7 +459,7 @@ func (ps *profileService) GetUsersDetails(ctx context.Context, gpi models.GetAdm
 func (ps *profileService) GetPostDetails(ctx context.Context, gpi models.GetAdminInputs) ([]models.PostDetail, *models.NextPage, impart.Error) {
 	result, nextPage, err := ps.profileStore.GetPostDetails(ctx, gpi)
 	if err != nil {
-		ps.Logger().Error("Error in data fetching", zap.Error(err))
+		ps.Logger(ctx).Error("Error in data fetching", zap.Error(err))
 		return nil, nextPage, impart.NewError(impart.ErrUnknown, "unable to fetch the details")
 	}
 	return result, nextPage, nil
@@ -391,16 +472,16 @@ func (ps *profileService) EditUserDetails(ctx context.Context, gpi models.WaitLi
 	}
 	userToUpdate, err := ps.profileStore.GetUser(ctx, gpi.ImpartWealthID)
 	if err != nil {
-		ps.Logger().Error("Cannot Find the user", zap.Error(err))
+		ps.Logger(ctx).Error("Cannot Find the user", zap.Error(err))
 		return "", impart.NewError(impart.ErrNotFound, "Cannot find the user")
 	}
 	if userToUpdate.Blocked {
-		ps.Logger().Error("Blocked user", zap.Error(err))
+		ps.Logger(ctx).Error("Blocked user", zap.Error(err))
 		return "", impart.NewError(impart.ErrNotFound, "Blocked user")
 	}
 	msg, err0 := ps.profileStore.EditUserDetails(ctx, gpi)
 	if err0 != nil {
-		ps.Logger().Error("Error in adding waitlist", zap.Error(err))
+		ps.Logger(ctx).Error("Error in adding waitlist", zap.Error(err))
 		return msg, err0
 	}
 	return msg, nil
@@ -409,7 +490,7 @@ func (ps *profileService) EditUserDetails(ctx context.Context, gpi models.WaitLi
 func (ps *profileService) GetHiveDetails(ctx context.Context, gpi models.GetAdminInputs) (models.HiveDetails, *models.NextPage, impart.Error) {
 	result, nextPage, err := ps.profileStore.GetHiveDetails(ctx, gpi)
 	if err != nil {
-		ps.Logger().Error("Error in data fetching", zap.Error(err))
+		ps.Logger(ctx).Error("Error in data fetching", zap.Error(err))
 		return nil, nextPage, impart.NewError(impart.ErrUnknown, "unable to fetch the details")
 	}
 	return result, nextPage, nil
@@ -439,13 +520,22 @@ func (ps *profileService) EditBulkUserDetails(ctx context.Context, userUpdates m
 			return nil, impart.NewError(impart.ErrBadRequest, "Missing hive details.")
 		}
 		userOutput := ps.profileStore.EditBulkUserDetails(ctx, userUpdates)
-		ps.Logger().Info("bulk action proccess completed and return to route")
+		ps.Logger(ctx).Info("bulk action proccess completed and return to route")
 		return userOutput, nil
 	} else if userUpdates.Action == "delete" {
 		if len(userUpdates.Users) == 0 {
 			return nil, impart.NewError(impart.ErrBadRequest, "User details not found")
 		}
 		userOutput = ps.profileStore.DeleteBulkUserDetails(ctx, userUpdates)
+		var deleted []string
+		for _, u := range userOutput.Users {
+			if u.Status {
+				deleted = append(deleted, u.ImpartWealthID)
+			}
+		}
+		if err := ps.deletions.ScheduleDeletions(ctx, impart.GetCtxUser(ctx).ImpartWealthID, deleted...); err != nil {
+			ps.Logger(ctx).Error("unable to schedule the purge of deleted users", zap.Strings("deleteUsers", deleted), zap.Error(err))
+		}
 		return userOutput, nil
 	}
 	return userOutput, nil
@@ -456,7 +546,7 @@ func (ps *profileService) UpdateUserDevicesDetails(ctx context.Context, userDevi
 		currTime := time.Now().In(boil.GetLocation())
 		userDevice.LastloginAt = null.TimeFrom(currTime)
 		if _, err := userDevice.Update(ctx, ps.db, boil.Infer()); err != nil {
-			ps.Logger().Error("Logout update failed", zap.Any("logout", err))
+			ps.Logger(ctx).Error("Logout update failed", zap.Any("logout", err))
 			return false, err
 		}
 	}
@@ -471,11 +561,11 @@ func (ps *profileService) UserEmailDetailsUpdate(ctx context.Context, gpi models
 	}
 	userToUpdate, err := ps.profileStore.GetUser(ctx, gpi.ImpartWealthID)
 	if err != nil {
-		ps.Logger().Error("Cannot Find the user", zap.Error(err))
+		ps.Logger(ctx).Error("Cannot Find the user", zap.Error(err))
 		return impart.NewError(impart.ErrNotFound, "Cannot find the user")
 	}
 	if userToUpdate.Blocked {
-		ps.Logger().Error("Blocked user", zap.Error(err))
+		ps.Logger(ctx).Error("Blocked user", zap.Error(err))
 		return impart.NewError(impart.ErrNotFound, "Blocked user")
 	}
 	if gpi.Subscribe {
@@ -489,7 +579,7 @@ func (ps *profileService) UserEmailDetailsUpdate(ctx context.Context, gpi models
 	}
 	err0 := ps.profileStore.UserEmailDetailsUpdate(ctx, gpi)
 	if err0 != nil {
-		ps.Logger().Error("Error in adding waitlist", zap.Error(err))
+		ps.Logger(ctx).Error("Error in adding waitlist", zap.Error(err))
 		return err0
 	}
 	return nil
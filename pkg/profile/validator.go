@@ -86,7 +86,7 @@ func (ps *profileService) validateNewProfile(ctx context.Context, p models.Profi
 
 	if screenNameRegexp.FindString(p.ScreenName) != p.ScreenName {
 		{
-			ps.Logger().Error("invalid screen name", zap.String("screenName", p.ScreenName))
+			ps.Logger(ctx).Error("invalid screen name", zap.String("screenName", p.ScreenName))
 			return impart.NewError(impart.ErrBadRequest, "Invalid characters, please use letters and numbers only.", impart.ScreenName)
 		}
 	}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store drops the windows that ended
+const sweepInterval = time.Minute
+
+type window struct {
+	start   time.Time
+	hits    int
+	expires time.Time
+}
+
+// memoryStore counts the requests of a single instance, every instance has its own limits
+type memoryStore struct {
+	mu        sync.Mutex
+	windows   map[string]*window
+	lastSweep time.Time
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		windows: make(map[string]*window),
+	}
+}
+
+func (s *memoryStore) Take(ctx context.Context, key string, windowStart time.Time, length time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if windowStart.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(windowStart)
+	}
+	w, ok := s.windows[key]
+	if !ok || !w.start.Equal(windowStart) {
+		w = &window{start: windowStart, expires: windowStart.Add(length)}
+		s.windows[key] = w
+	}
+	w.hits++
+	return w.hits, nil
+}
+
+func (s *memoryStore) Cleanup(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+	return nil
+}
+
+func (s *memoryStore) sweep(now time.Time) {
+	for key, w := range s.windows {
+		if !w.expires.After(now) {
+			delete(s.windows, key)
+		}
+	}
+	s.lastSweep = now
+}
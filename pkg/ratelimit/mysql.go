@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+)
+
+// mysqlStore counts the requests in rate_limit_counters so the limits hold across instances
+type mysqlStore struct {
+	db *sql.DB
+}
+
+func NewMySQLStore(db *sql.DB) Store {
+	return &mysqlStore{db: db}
+}
+
+func (s *mysqlStore) Take(ctx context.Context, key string, windowStart time.Time, length time.Duration) (int, error) {
+	windowStart = windowStart.UTC()
+	_, err := s.db.ExecContext(ctx, `
+		insert into rate_limit_counters (bucket_key, window_start, hits, expires_at)
+		values (?, ?, 1, ?)
+		on duplicate key update hits = hits + 1`,
+		key, windowStart, windowStart.Add(length))
+	if err != nil {
+		return 0, err
+	}
+	w, err := dbmodels.FindRateLimitCounter(ctx, s.db, key, windowStart, dbmodels.RateLimitCounterColumns.Hits)
+	if err != nil {
+		return 0, err
+	}
+	return int(w.Hits), nil
+}
+
+func (s *mysqlStore) Cleanup(ctx context.Context, now time.Time) error {
+	_, err := dbmodels.RateLimitCounters(dbmodels.RateLimitCounterWhere.ExpiresAt.LT(now.UTC())).DeleteAll(ctx, s.db)
+	return err
+}
@@ -0,0 +1,200 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"go.uber.org/zap"
+)
+
+// Policy allows Limit requests per Window for every key, the requests are counted in fixed
+// windows. The name prefixes the keys so every policy has its own counters.
+type Policy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+// Route applies a policy to a route, Path is the route template relative to the group the
+// limiter is used on, e.g. /hives/:hiveId/posts.
+type Route struct {
+	Method string
+	Path   string
+	// Query only matches the requests with the query parameter, for actions sharing a route
+	Query  string
+	Policy Policy
+}
+
+// Store counts the requests of the keys, shared by the instances unless it is in memory.
+type Store interface {
+	// Take counts a request of the key in the window starting at windowStart and returns the
+	// requests counted so far, including this one.
+	Take(ctx context.Context, key string, windowStart time.Time, window time.Duration) (int, error)
+	// Cleanup deletes the windows that ended before now
+	Cleanup(ctx context.Context, now time.Time) error
+}
+
+// Result is the outcome of a request against its policy
+type Result struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	Allowed   bool
+}
+
+type Limiter struct {
+	store    Store
+	fallback Policy
+	routes   []Route
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// New returns a limiter applying the policy of the matching route, or the fallback policy to the
+// other routes.
+func New(store Store, fallback Policy, routes []Route, logger *zap.Logger) *Limiter {
+	return &Limiter{
+		store:    store,
+		fallback: fallback,
+		routes:   routes,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Override replaces the limits of the named policies, each value is "limit/window", e.g. 10/1m
+func (l *Limiter) Override(overrides map[string]string) error {
+	for name, value := range overrides {
+		limit, window, err := ParseLimit(value)
+		if err != nil {
+			return fmt.Errorf("rate limit policy %s: %v", name, err)
+		}
+		found := false
+		if l.fallback.Name == name {
+			l.fallback.Limit, l.fallback.Window = limit, window
+			found = true
+		}
+		for i := range l.routes {
+			if l.routes[i].Policy.Name == name {
+				l.routes[i].Policy.Limit, l.routes[i].Policy.Window = limit, window
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown rate limit policy %s", name)
+		}
+	}
+	return nil
+}
+
+// ParseLimit parses a "limit/window" value such as 10/1m
+func ParseLimit(value string) (int, time.Duration, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid limit %q, expected limit/window", value)
+	}
+	limit, err := strconv.Atoi(parts[0])
+	if err != nil || limit <= 0 {
+		return 0, 0, fmt.Errorf("invalid limit %q", parts[0])
+	}
+	window, err := time.ParseDuration(parts[1])
+	if err != nil || window <= 0 {
+		return 0, 0, fmt.Errorf("invalid window %q", parts[1])
+	}
+	return limit, window, nil
+}
+
+// policy returns the policy of the route the request matched
+func (l *Limiter) policy(ctx *gin.Context, basePath string) Policy {
+	path := strings.TrimPrefix(ctx.FullPath(), strings.TrimSuffix(basePath, "/"))
+	for _, r := range l.routes {
+		if r.Method != ctx.Request.Method || r.Path != path {
+			continue
+		}
+		if r.Query != "" {
+			if _, ok := ctx.GetQuery(r.Query); !ok {
+				continue
+			}
+		}
+		return r.Policy
+	}
+	return l.fallback
+}
+
+// Key identifies who the request is counted against; the validated token of the caller, which is
+// also set while the user is being created, or else the address of the caller. The client
+// header is not authenticated so it can't be a key on its own.
+func Key(ctx *gin.Context) string {
+	if authID := ctx.GetString(impart.AuthIDRequestContextKey); authID != "" {
+		return "auth:" + authID
+	}
+	return "ip:" + ctx.ClientIP()
+}
+
+// Allow counts a request of the key against the policy
+func (l *Limiter) Allow(ctx context.Context, policy Policy, key string) (Result, error) {
+	now := l.now()
+	windowStart := now.Truncate(policy.Window)
+	res := Result{
+		Limit:     policy.Limit,
+		Remaining: policy.Limit,
+		Reset:     windowStart.Add(policy.Window),
+		Allowed:   true,
+	}
+	hits, err := l.store.Take(ctx, policy.Name+":"+key, windowStart, policy.Window)
+	if err != nil {
+		return res, err
+	}
+	res.Remaining = policy.Limit - hits
+	if res.Remaining < 0 {
+		res.Remaining = 0
+		res.Allowed = false
+	}
+	return res, nil
+}
+
+// Cleanup deletes the counters of the windows that ended, it is run by the scheduler
+func (l *Limiter) Cleanup(ctx context.Context) error {
+	return l.store.Cleanup(ctx, l.now())
+}
+
+// Handler limits the requests of the group at basePath. The RateLimit-* headers tell the client
+// where it stands, over the limit it gets a 429 with Retry-After. A failing store lets the
+// requests through.
+func (l *Limiter) Handler(basePath string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Request.Method == http.MethodOptions {
+			ctx.Next()
+			return
+		}
+		policy := l.policy(ctx, basePath)
+		res, err := l.Allow(ctx, policy, Key(ctx))
+		if err != nil {
+			impart.CtxLogger(ctx, l.logger).Error("unable to check the rate limit", zap.String("policy", policy.Name), zap.Error(err))
+			ctx.Next()
+			return
+		}
+		reset := int(math.Ceil(res.Reset.Sub(l.now()).Seconds()))
+		if reset < 1 {
+			reset = 1
+		}
+		ctx.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+		ctx.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		ctx.Header("RateLimit-Reset", strconv.Itoa(reset))
+		if !res.Allowed {
+			ctx.Header("Retry-After", strconv.Itoa(reset))
+			impartErr := impart.NewError(impart.ErrTooManyRequests,
+				fmt.Sprintf("too many requests, try again in %d seconds", reset))
+			ctx.AbortWithStatusJSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.Next()
+	}
+}
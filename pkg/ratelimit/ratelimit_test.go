@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	testDefault = Policy{Name: "default", Limit: 5, Window: time.Minute}
+	testPost    = Policy{Name: "create-post", Limit: 2, Window: time.Minute}
+	testReport  = Policy{Name: "report", Limit: 1, Window: time.Hour}
+)
+
+func testRouter(now *time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	limiter := New(NewMemoryStore(), testDefault, []Route{
+		{Method: http.MethodPost, Path: "/hives/:hiveId/posts", Policy: testPost},
+		{Method: http.MethodPost, Path: "/hives/:hiveId/posts/:postId", Query: "report", Policy: testReport},
+	}, zap.NewNop())
+	limiter.now = func() time.Time { return *now }
+
+	r := gin.New()
+	v1 := r.Group("/v1")
+	v1.Use(func(ctx *gin.Context) {
+		if id := ctx.GetHeader("X-Test-User"); id != "" {
+			ctx.Set(impart.AuthIDRequestContextKey, "auth0|"+id)
+			ctx.Set(impart.UserRequestContextKey, &dbmodels.User{ImpartWealthID: id})
+		}
+		ctx.Next()
+	})
+	v1.Use(limiter.Handler(v1.BasePath()))
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+	v1.POST("/hives/:hiveId/posts", ok)
+	v1.POST("/hives/:hiveId/posts/:postId", ok)
+	v1.GET("/hives/:hiveId/posts", ok)
+	return r
+}
+
+func request(r *gin.Engine, method, target, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Date(2022, 2, 21, 14, 3, 10, 0, time.UTC)
+	r := testRouter(&now)
+
+	w := request(r, http.MethodPost, "/v1/hives/1/posts", "user1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "50", w.Header().Get("RateLimit-Reset"))
+
+	assert.Equal(t, http.StatusOK, request(r, http.MethodPost, "/v1/hives/1/posts", "user1").Code)
+	w = request(r, http.MethodPost, "/v1/hives/2/posts", "user1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code, "the policy covers every hive")
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "50", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), impart.ErrTooManyRequests.Error())
+
+	// other users, routes and policies have their own counters
+	assert.Equal(t, http.StatusOK, request(r, http.MethodPost, "/v1/hives/1/posts", "user2").Code)
+	w = request(r, http.MethodGet, "/v1/hives/1/posts", "user1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+
+	// reports share the reaction route
+	assert.Equal(t, http.StatusOK, request(r, http.MethodPost, "/v1/hives/1/posts/1?report=true", "user1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(r, http.MethodPost, "/v1/hives/1/posts/2?report=true", "user1").Code)
+	w = request(r, http.MethodPost, "/v1/hives/1/posts/1?upvote=true", "user1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, http.StatusOK, request(r, http.MethodPost, "/v1/hives/1/posts", "user1").Code, "a new window starts")
+}
+
+func TestKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/v1/tags", nil)
+	ctx.Request.RemoteAddr = "203.0.113.7:51234"
+	assert.Equal(t, "ip:203.0.113.7", Key(ctx))
+
+	ctx.Set(impart.ClientIdentificationHeaderKey, "ios-client")
+	assert.Equal(t, "ip:203.0.113.7", Key(ctx), "the client header is not authenticated")
+
+	// a user being created has a token but no user yet
+	ctx.Set(impart.AuthIDRequestContextKey, "auth0|1234")
+	assert.Equal(t, "auth:auth0|1234", Key(ctx))
+	ctx.Set(impart.UserRequestContextKey, &dbmodels.User{ImpartWealthID: "1xRvvB2ztPPVYpRLLgArq7KHQ8Q"})
+	assert.Equal(t, "auth:auth0|1234", Key(ctx))
+}
+
+func TestOverride(t *testing.T) {
+	limiter := New(NewMemoryStore(), testDefault, []Route{{Method: http.MethodPost, Path: "/hives/:hiveId/posts", Policy: testPost}}, zap.NewNop())
+	require.NoError(t, limiter.Override(map[string]string{"create-post": "5/1h", "default": "100/1s"}))
+	assert.Equal(t, Policy{Name: "create-post", Limit: 5, Window: time.Hour}, limiter.routes[0].Policy)
+	assert.Equal(t, Policy{Name: "default", Limit: 100, Window: time.Second}, limiter.fallback)
+
+	assert.Error(t, limiter.Override(map[string]string{"unknown": "5/1h"}))
+	for _, invalid := range []string{"5", "0/1m", "five/1m", "5/soon", "5/-1m"} {
+		_, _, err := ParseLimit(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestMemoryStoreCleanup(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore().(*memoryStore)
+	start := time.Date(2022, 2, 21, 14, 0, 0, 0, time.UTC)
+	hits, err := s.Take(ctx, "default:user:1", start, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, hits)
+	hits, _ = s.Take(ctx, "default:user:1", start, time.Minute)
+	assert.Equal(t, 2, hits)
+
+	require.NoError(t, s.Cleanup(ctx, start.Add(time.Minute)))
+	assert.Empty(t, s.windows)
+}
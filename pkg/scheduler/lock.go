@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// releaseTimeout bounds the release of a lock, it does not depend on the job context which may
+// be cancelled by then
+const releaseTimeout = 10 * time.Second
+
+// MySQL named locks are bound to the connection that acquired them,
+// so the lock holds on to a dedicated connection until it is released.
+type jobLock struct {
+	name string
+	conn *sql.Conn
+}
+
+func lockName(jobName string) string {
+	return fmt.Sprintf("impart_job_%s", jobName)
+}
+
+// acquireLock attempts to take the job lock without waiting.
+func acquireLock(ctx context.Context, db *sql.DB, jobName string) (*jobLock, bool, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	name := lockName(jobName)
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", name).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		conn.Close()
+		return nil, false, nil
+	}
+	return &jobLock{name: name, conn: conn}, true, nil
+}
+
+// release frees the lock. When that fails the connection is discarded rather than returned to
+// the pool, closing it ends the session and the lock with it.
+func (l *jobLock) release(logger *zap.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if _, err := l.conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", l.name); err != nil {
+		logger.Error("unable to release job lock, discarding its connection", zap.String("lock", l.name), zap.Error(err))
+		// a bad connection is closed instead of being put back in the pool
+		l.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		return
+	}
+	if err := l.conn.Close(); err != nil {
+		logger.Error("unable to close job lock connection", zap.String("lock", l.name), zap.Error(err))
+	}
+}
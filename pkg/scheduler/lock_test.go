@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// lockServer fakes the named locks of mysql, a lock belongs to a connection and goes away with it
+type lockServer struct {
+	mu          sync.Mutex
+	held        map[string]*lockConn
+	failRelease bool
+	closed      int
+}
+
+func (s *lockServer) Open(string) (driver.Conn, error) {
+	return &lockConn{server: s}, nil
+}
+
+type lockConn struct {
+	server *lockServer
+}
+
+func (c *lockConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *lockConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *lockConn) Close() error {
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed++
+	for name, owner := range s.held {
+		if owner == c {
+			delete(s.held, name)
+		}
+	}
+	return nil
+}
+
+func (c *lockConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := args[0].Value.(string)
+	if owner, ok := s.held[name]; ok && owner != c {
+		return &lockRows{value: 0}, nil
+	}
+	s.held[name] = c
+	return &lockRows{value: 1}, nil
+}
+
+func (c *lockConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failRelease {
+		return nil, errors.New("connection lost")
+	}
+	name := args[0].Value.(string)
+	if s.held[name] == c {
+		delete(s.held, name)
+	}
+	return driver.RowsAffected(0), nil
+}
+
+type lockRows struct {
+	value int64
+	done  bool
+}
+
+func (r *lockRows) Columns() []string { return []string{"lock"} }
+func (r *lockRows) Close() error      { return nil }
+
+func (r *lockRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+func testLockDB(t *testing.T) (*sql.DB, *lockServer) {
+	server := &lockServer{held: map[string]*lockConn{}}
+	sql.Register("locks-"+t.Name(), server)
+	db, err := sql.Open("locks-"+t.Name(), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, server
+}
+
+func TestJobLock(t *testing.T) {
+	db, server := testLockDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lock, acquired, err := acquireLock(ctx, db, "digest")
+	require.NoError(t, err)
+	require.True(t, acquired)
+	_, acquired, err = acquireLock(context.Background(), db, "digest")
+	require.NoError(t, err)
+	assert.False(t, acquired, "the lock is held")
+	other, acquired, err := acquireLock(context.Background(), db, "export")
+	require.NoError(t, err)
+	assert.True(t, acquired, "other jobs have their own lock")
+	other.release(zap.NewNop())
+
+	// the job context is done by the time the lock is released
+	cancel()
+	lock.release(zap.NewNop())
+	lock, acquired, err = acquireLock(context.Background(), db, "digest")
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// a lock that can't be released goes away with its connection
+	server.failRelease = true
+	closed := server.closed
+	lock.release(zap.NewNop())
+	assert.Equal(t, closed+1, server.closed)
+	server.failRelease = false
+	_, acquired, err = acquireLock(context.Background(), db, "digest")
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models"
+	"go.uber.org/zap"
+)
+
+type schedulerHandler struct {
+	scheduler Scheduler
+	logger    *zap.Logger
+}
+
+func SetupRoutes(version *gin.RouterGroup, scheduler Scheduler, logger *zap.Logger) {
+	handler := &schedulerHandler{
+		scheduler: scheduler,
+		logger:    logger,
+	}
+
+	jobRoutes := version.Group("/admin/jobs")
+	jobRoutes.Use(impart.RequireRole(impart.SuperAdminRole))
+	jobRoutes.GET("", handler.GetJobsFunc())
+	jobRoutes.GET("/:jobName/runs", handler.GetJobRunsFunc())
+	jobRoutes.POST("/:jobName/run", handler.RunJobFunc())
+}
+
+func (sh *schedulerHandler) GetJobsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		jobs, err := sh.scheduler.GetJobs(ctx)
+		if err != nil {
+			impart.CtxLogger(ctx, sh.logger).Error("unable to fetch scheduled jobs", zap.Error(err))
+			ctx.JSON(impart.UnknownError.HttpStatus(), impart.ErrorResponse(impart.UnknownError))
+			return
+		}
+		ctx.JSON(http.StatusOK, models.ScheduledJobsFromDB(jobs))
+	}
+}
+
+func (sh *schedulerHandler) GetJobRunsFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		limit, offset := impart.DefaultLimit, 0
+		params := ctx.Request.URL.Query()
+		if limitParam := strings.TrimSpace(params.Get("limit")); limitParam != "" {
+			l, err := strconv.Atoi(limitParam)
+			if err != nil || l <= 0 {
+				impartErr := impart.NewError(impart.ErrBadRequest, "invalid limit passed in", impart.Limit)
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			limit = l
+		}
+		if limit > impart.MaxLimit {
+			limit = impart.MaxLimit
+		}
+		if offsetParam := strings.TrimSpace(params.Get("offset")); offsetParam != "" {
+			o, err := strconv.Atoi(offsetParam)
+			if err != nil || o < 0 {
+				impartErr := impart.NewError(impart.ErrBadRequest, "invalid offset passed in")
+				ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+				return
+			}
+			offset = o
+		}
+
+		runs, err := sh.scheduler.GetJobRuns(ctx, ctx.Param("jobName"), limit, offset)
+		if err != nil {
+			impart.CtxLogger(ctx, sh.logger).Error("unable to fetch scheduled job runs", zap.Error(err))
+			ctx.JSON(impart.UnknownError.HttpStatus(), impart.ErrorResponse(impart.UnknownError))
+			return
+		}
+		var nextPage *models.NextPage
+		if len(runs) == limit {
+			nextPage = &models.NextPage{Offset: offset + len(runs)}
+		}
+		ctx.JSON(http.StatusOK, models.PagedScheduledJobRunsResponse{
+			Runs:     models.ScheduledJobRunsFromDB(runs),
+			NextPage: nextPage,
+		})
+	}
+}
+
+func (sh *schedulerHandler) RunJobFunc() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		jobName := ctx.Param("jobName")
+		err := sh.scheduler.RunNow(ctx, jobName)
+		if err == impart.ErrNotFound {
+			impartErr := impart.NewError(impart.ErrNotFound, "unable to find a job with the given name")
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		if err != nil {
+			impart.CtxLogger(ctx, sh.logger).Error("manual job run failed", zap.String("job", jobName), zap.Error(err))
+			impartErr := impart.NewError(impart.ErrUnknown, err.Error())
+			ctx.JSON(impartErr.HttpStatus(), impart.ErrorResponse(impartErr))
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"status": true, "message": "job completed"})
+	}
+}
@@ -0,0 +1,240 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/impartwealthapp/backend/pkg/impart"
+	"github.com/impartwealthapp/backend/pkg/models/dbmodels"
+	"github.com/robfig/cron/v3"
+	"github.com/segmentio/ksuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"
+)
+
+// JobFunc is the work executed on every tick of a job
+type JobFunc func(ctx context.Context) error
+
+// Job is a recurring unit of work, scheduled with a standard 5 field cron expression
+type Job struct {
+	Name     string
+	Schedule string
+	Run      JobFunc
+}
+
+var _ Scheduler = &scheduler{}
+
+type Scheduler interface {
+	// Register adds the job to the scheduler, jobs with an empty schedule are ignored.
+	Register(job Job) error
+	Start()
+	Stop()
+
+	// RunNow runs the job immediately, still honouring the cluster wide lock.
+	RunNow(ctx context.Context, jobName string) error
+	GetJobs(ctx context.Context) (dbmodels.ScheduledJobSlice, error)
+	GetJobRuns(ctx context.Context, jobName string, limit, offset int) (dbmodels.ScheduledJobRunSlice, error)
+}
+
+type scheduler struct {
+	logger     *zap.Logger
+	db         *sql.DB
+	cron       *cron.Cron
+	instanceID string
+	timeout    time.Duration
+
+	mu   sync.RWMutex
+	jobs map[string]Job
+}
+
+// New creates a new scheduler; only one instance across the cluster will run a job for a given tick,
+// the leader is elected through a named MySQL lock.
+func New(db *sql.DB, logger *zap.Logger) Scheduler {
+	instanceID, err := os.Hostname()
+	if err != nil || instanceID == "" {
+		instanceID = ksuid.New().String()
+	}
+	return &scheduler{
+		logger:     logger,
+		db:         db,
+		cron:       cron.New(cron.WithLocation(time.UTC)),
+		instanceID: instanceID,
+		timeout:    time.Hour,
+		jobs:       make(map[string]Job),
+	}
+}
+
+func (s *scheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return fmt.Errorf("invalid job definition %q", job.Name)
+	}
+	if job.Schedule == "" {
+		s.logger.Info("job has no schedule configured, skipping", zap.String("job", job.Name))
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.Name]; ok {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+	_, err := s.cron.AddFunc(job.Schedule, func() {
+		scheduledAt := impart.CurrentUTC().Truncate(time.Minute)
+		if err := s.execute(context.Background(), job, scheduledAt); err != nil {
+			s.logger.Error("scheduled job failed", zap.String("job", job.Name), zap.Error(err))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for job %s: %v", job.Schedule, job.Name, err)
+	}
+	s.jobs[job.Name] = job
+
+	ctx := context.Background()
+	state, err := dbmodels.FindScheduledJob(ctx, s.db, job.Name)
+	if err == sql.ErrNoRows {
+		state = &dbmodels.ScheduledJob{
+			JobName:   job.Name,
+			Schedule:  job.Schedule,
+			CreatedAt: impart.CurrentUTC(),
+			UpdatedAt: impart.CurrentUTC(),
+		}
+		return state.Insert(ctx, s.db, boil.Infer())
+	}
+	if err != nil {
+		return err
+	}
+	if state.Schedule != job.Schedule {
+		state.Schedule = job.Schedule
+		state.UpdatedAt = impart.CurrentUTC()
+		_, err = state.Update(ctx, s.db, boil.Whitelist(dbmodels.ScheduledJobColumns.Schedule, dbmodels.ScheduledJobColumns.UpdatedAt))
+	}
+	return err
+}
+
+func (s *scheduler) Start() {
+	s.logger.Info("starting job scheduler", zap.String("instance", s.instanceID), zap.Int("jobs", len(s.jobs)))
+	s.cron.Start()
+}
+
+// Stop prevents new runs and waits for the running jobs to complete
+func (s *scheduler) Stop() {
+	<-s.cron.Stop().Done()
+	s.logger.Info("job scheduler stopped")
+}
+
+func (s *scheduler) RunNow(ctx context.Context, jobName string) error {
+	s.mu.RLock()
+	job, ok := s.jobs[jobName]
+	s.mu.RUnlock()
+	if !ok {
+		return impart.ErrNotFound
+	}
+	return s.execute(ctx, job, impart.CurrentUTC())
+}
+
+func (s *scheduler) GetJobs(ctx context.Context) (dbmodels.ScheduledJobSlice, error) {
+	return dbmodels.ScheduledJobs(qm.OrderBy(dbmodels.ScheduledJobColumns.JobName)).All(ctx, s.db)
+}
+
+func (s *scheduler) GetJobRuns(ctx context.Context, jobName string, limit, offset int) (dbmodels.ScheduledJobRunSlice, error) {
+	return dbmodels.ScheduledJobRuns(
+		dbmodels.ScheduledJobRunWhere.JobName.EQ(jobName),
+		qm.OrderBy(fmt.Sprintf("%s desc", dbmodels.ScheduledJobRunColumns.RunID)),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, s.db)
+}
+
+// execute runs the job when this instance is able to take the job lock, and the tick
+// has not already been handled by another instance.
+func (s *scheduler) execute(ctx context.Context, job Job, scheduledAt time.Time) error {
+	lock, acquired, err := acquireLock(ctx, s.db, job.Name)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		s.logger.Debug("job is running on another instance", zap.String("job", job.Name))
+		return nil
+	}
+	defer lock.release(s.logger)
+
+	state, err := dbmodels.FindScheduledJob(ctx, s.db, job.Name)
+	if err != nil {
+		return err
+	}
+	if state.LastScheduledAt.Valid && !state.LastScheduledAt.Time.Before(scheduledAt) {
+		s.logger.Debug("job already ran for this schedule", zap.String("job", job.Name), zap.Time("scheduledAt", scheduledAt))
+		return nil
+	}
+
+	run := &dbmodels.ScheduledJobRun{
+		JobName:     job.Name,
+		InstanceID:  s.instanceID,
+		ScheduledAt: scheduledAt,
+		StartedAt:   impart.CurrentUTC(),
+		Status:      StatusRunning,
+	}
+	if err := run.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return err
+	}
+	state.LastScheduledAt = null.TimeFrom(scheduledAt)
+	state.LastStartedAt = null.TimeFrom(run.StartedAt)
+	state.LastStatus = StatusRunning
+	state.UpdatedAt = impart.CurrentUTC()
+	if _, err := state.Update(ctx, s.db, boil.Infer()); err != nil {
+		return err
+	}
+
+	s.logger.Info("running scheduled job", zap.String("job", job.Name), zap.Time("scheduledAt", scheduledAt))
+	jobErr := s.runJob(ctx, job)
+
+	run.FinishedAt = null.TimeFrom(impart.CurrentUTC())
+	run.Status = StatusSucceeded
+	state.LastStatus = StatusSucceeded
+	if jobErr != nil {
+		run.Status = StatusFailed
+		run.Error = null.StringFrom(jobErr.Error())
+		state.LastStatus = StatusFailed
+		state.LastError = run.Error
+		state.LastErrorAt = run.FinishedAt
+	}
+	state.LastFinishedAt = run.FinishedAt
+	state.UpdatedAt = impart.CurrentUTC()
+
+	if _, err := run.Update(ctx, s.db, boil.Infer()); err != nil {
+		s.logger.Error("unable to update job run", zap.String("job", job.Name), zap.Error(err))
+	}
+	if _, err := state.Update(ctx, s.db, boil.Infer()); err != nil {
+		s.logger.Error("unable to update job state", zap.String("job", job.Name), zap.Error(err))
+	}
+	s.logger.Info("scheduled job completed", zap.String("job", job.Name), zap.String("status", run.Status),
+		zap.Duration("elapsed", run.FinishedAt.Time.Sub(run.StartedAt)))
+	return jobErr
+}
+
+func (s *scheduler) runJob(ctx context.Context, job Job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("scheduled job panicked", zap.String("job", job.Name), zap.Any("panic", r),
+				zap.ByteString("stack", debug.Stack()))
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return job.Run(ctx)
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func noopJob(ctx context.Context) error {
+	return nil
+}
+
+func TestRegisterInvalidJobs(t *testing.T) {
+	s := New(nil, zap.NewNop())
+
+	assert.Error(t, s.Register(Job{Schedule: "0 16 * * 5", Run: noopJob}))
+	assert.Error(t, s.Register(Job{Name: "no-func", Schedule: "0 16 * * 5"}))
+	assert.Error(t, s.Register(Job{Name: "bad-schedule", Schedule: "every friday", Run: noopJob}))
+}
+
+func TestRegisterEmptyScheduleIsDisabled(t *testing.T) {
+	s := New(nil, zap.NewNop()).(*scheduler)
+
+	assert.NoError(t, s.Register(Job{Name: "disabled", Schedule: "", Run: noopJob}))
+	assert.Len(t, s.jobs, 0)
+	assert.Len(t, s.cron.Entries(), 0)
+}
+
+func TestRunNowUnknownJob(t *testing.T) {
+	s := New(nil, zap.NewNop())
+	assert.Error(t, s.RunNow(context.Background(), "unknown"))
+}
@@ -11,6 +11,7 @@ import (
 // Configuration is a minimal set of parameters for Sentry integration.
 type Configuration struct {
 	Tags              map[string]string
+	TagFields         []string // log fields reported as tags so the events can be searched by them
 	DisableStacktrace bool
 	Level             zapcore.Level
 	FlushTimeout      time.Duration
@@ -89,7 +90,7 @@ func (c *core) Write(ent zapcore.Entry, fs []zapcore.Field) error {
 	event.ServerName = c.Sentry.ServerName
 	event.Environment = c.Sentry.Environment
 	event.Extra = clone.fields
-	event.Tags = c.cfg.Tags
+	event.Tags = clone.tags()
 
 	if !c.cfg.DisableStacktrace {
 		trace := sentry.NewStacktrace()
@@ -148,9 +149,24 @@ func (c *core) with(fs []zapcore.Field) *core {
 		flushTimeout: c.flushTimeout,
 		fields:       m,
 		LevelEnabler: c.LevelEnabler,
+		Sentry:       c.Sentry,
 	}
 }
 
+// tags returns the configured tags along with the tag fields of the entry
+func (c *core) tags() map[string]string {
+	tags := make(map[string]string, len(c.cfg.Tags)+len(c.cfg.TagFields))
+	for k, v := range c.cfg.Tags {
+		tags[k] = v
+	}
+	for _, name := range c.cfg.TagFields {
+		if v, ok := c.fields[name]; ok {
+			tags[name] = fmt.Sprintf("%v", v)
+		}
+	}
+	return tags
+}
+
 type SentryEventConfig struct {
 	ServerName  string
 	Platform    string
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scheduled_job_runs;
+DROP TABLE IF EXISTS scheduled_jobs;
\ No newline at end of file
@@ -0,0 +1,43 @@
+-- 
+-- scheduled_jobs
+-- 
+-- Which will hold the state of each recurring job run by the in-process scheduler
+
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    job_name            NVARCHAR(100)   NOT NULL,
+    schedule            NVARCHAR(100)   NOT NULL,
+    last_scheduled_at   DATETIME(3)     NULL,
+    last_started_at     DATETIME(3)     NULL,
+    last_finished_at    DATETIME(3)     NULL,
+    last_status         NVARCHAR(20)    NOT NULL DEFAULT '',
+    last_error          TEXT            NULL,
+    last_error_at       DATETIME(3)     NULL,
+    created_at          DATETIME(3)     NOT NULL,
+    updated_at          DATETIME(3)     NOT NULL,
+    PRIMARY KEY (job_name)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
+
+-- 
+-- scheduled_job_runs
+-- 
+-- Which will keep the history of each job execution
+
+CREATE TABLE IF NOT EXISTS scheduled_job_runs (
+    run_id              BIGINT UNSIGNED AUTO_INCREMENT  NOT NULL,
+    job_name            NVARCHAR(100)   NOT NULL,
+    instance_id         NVARCHAR(100)   NOT NULL,
+    scheduled_at        DATETIME(3)     NOT NULL,
+    started_at          DATETIME(3)     NOT NULL,
+    finished_at         DATETIME(3)     NULL,
+    status              NVARCHAR(20)    NOT NULL,
+    error               TEXT            NULL,
+    PRIMARY KEY (run_id),
+    INDEX (job_name, started_at),
+    FOREIGN KEY (job_name) REFERENCES scheduled_jobs (job_name) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,6 @@
+ALTER TABLE user_configurations
+    DROP COLUMN quiet_hours_start,
+    DROP COLUMN quiet_hours_end,
+    DROP COLUMN timezone;
+
+DROP TABLE IF EXISTS user_notification_preferences;
@@ -0,0 +1,25 @@
+-- 
+-- user_notification_preferences
+-- 
+-- Per category notification opt in/out, a missing row means the category is enabled
+
+CREATE TABLE IF NOT EXISTS user_notification_preferences (
+    impart_wealth_id CHAR(27)     NOT NULL,
+    category         NVARCHAR(50) NOT NULL,
+    enabled          BOOL         NOT NULL DEFAULT TRUE,
+    created_at       DATETIME(3)  NOT NULL,
+    updated_at       DATETIME(3)  NOT NULL,
+    PRIMARY KEY (impart_wealth_id, category),
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
+
+-- 
+-- quiet hours, stored as minutes from midnight in the user's timezone
+-- 
+ALTER TABLE user_configurations
+    ADD COLUMN quiet_hours_start SMALLINT UNSIGNED NULL,
+    ADD COLUMN quiet_hours_end   SMALLINT UNSIGNED NULL,
+    ADD COLUMN timezone          NVARCHAR(64) NOT NULL DEFAULT 'UTC';
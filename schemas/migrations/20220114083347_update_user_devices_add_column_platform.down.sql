@@ -0,0 +1,2 @@
+alter table user_devices
+    drop column platform;
@@ -0,0 +1,2 @@
+alter table user_devices
+    add column platform NVARCHAR(20) NOT NULL DEFAULT 'ios';
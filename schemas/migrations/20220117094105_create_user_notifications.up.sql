@@ -0,0 +1,22 @@
+-- 
+-- user_notifications
+-- 
+-- The in-app inbox, a row for every notification sent to a user, including hive broadcasts
+
+CREATE TABLE IF NOT EXISTS user_notifications (
+    notification_id  BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+    impart_wealth_id CHAR(27)                       NOT NULL,
+    category         NVARCHAR(50)                   NOT NULL DEFAULT '',
+    title            NVARCHAR(255)                  NOT NULL DEFAULT '',
+    body             TEXT                           NOT NULL,
+    data             JSON                           NOT NULL,
+    read_at          DATETIME(3)                    NULL,
+    created_at       DATETIME(3)                    NOT NULL,
+    PRIMARY KEY (notification_id),
+    INDEX (impart_wealth_id, notification_id),
+    INDEX (impart_wealth_id, read_at),
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
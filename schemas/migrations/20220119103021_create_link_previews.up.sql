@@ -0,0 +1,21 @@
+-- 
+-- link_previews
+-- 
+-- Cached open graph details of links posted by members, keyed by the sha256 of the normalized url.
+-- Failed fetches are cached as well so a bad link is not fetched on every post.
+
+CREATE TABLE IF NOT EXISTS link_previews (
+    url_hash    CHAR(64)       NOT NULL,
+    url         NVARCHAR(2048) NOT NULL,
+    title       NVARCHAR(250)  NOT NULL DEFAULT '',
+    description NVARCHAR(250)  NOT NULL DEFAULT '',
+    image_url   NVARCHAR(250)  NOT NULL DEFAULT '',
+    failed      BOOL           NOT NULL DEFAULT FALSE,
+    fetched_at  DATETIME(3)    NOT NULL,
+    expires_at  DATETIME(3)    NOT NULL,
+    PRIMARY KEY (url_hash),
+    INDEX (expires_at)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,10 @@
+alter table comment
+    drop column held;
+
+alter table post
+    drop column held;
+
+alter table profanity_words_list
+    drop column severity,
+    drop column created_at,
+    drop column updated_at;
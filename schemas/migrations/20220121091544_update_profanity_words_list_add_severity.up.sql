@@ -0,0 +1,21 @@
+-- 
+-- profanity_words_list
+-- 
+-- severity decides what happens to content using the word: mask, hold for review or reject.
+-- updated_at lets every instance notice list changes and reload.
+
+alter table profanity_words_list
+    add column severity   NVARCHAR(10) NOT NULL DEFAULT 'mask',
+    add column created_at DATETIME(3)  NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    add column updated_at DATETIME(3)  NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3);
+
+-- 
+-- post, comment
+-- 
+-- held content is waiting in the reported content queue and only visible to its author and admins
+
+alter table post
+    add column held BOOL NOT NULL DEFAULT FALSE;
+
+alter table comment
+    add column held BOOL NOT NULL DEFAULT FALSE;
@@ -0,0 +1,8 @@
+alter table comment_reactions
+    drop column report_weight;
+
+alter table post_reactions
+    drop column report_weight;
+
+alter table hive
+    drop column report_hide_threshold;
@@ -0,0 +1,18 @@
+-- 
+-- hive
+-- 
+-- content is hidden once the weighted reports reach the threshold, counted in members
+
+alter table hive
+    add column report_hide_threshold SMALLINT UNSIGNED NOT NULL DEFAULT 3;
+
+-- 
+-- post_reactions, comment_reactions
+-- 
+-- weight of a report in hundredths of a member, it depends on the reporting history of the member
+
+alter table post_reactions
+    add column report_weight SMALLINT UNSIGNED NOT NULL DEFAULT 100;
+
+alter table comment_reactions
+    add column report_weight SMALLINT UNSIGNED NOT NULL DEFAULT 100;
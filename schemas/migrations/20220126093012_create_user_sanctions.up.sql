@@ -0,0 +1,26 @@
+-- 
+-- user_sanctions
+-- 
+-- Enforcement against a member short of blocking the account. A warning is a strike on the
+-- member's standing, a suspension stops new posts and comments, read only stops all activity.
+-- A sanction is active until it expires or is revoked, a read only sanction may have no expiry.
+
+CREATE TABLE IF NOT EXISTS user_sanctions (
+    sanction_id      BIGINT UNSIGNED AUTO_INCREMENT                 NOT NULL,
+    impart_wealth_id CHAR(27)                                       NOT NULL,
+    kind             ENUM ('warning', 'suspension', 'read_only')    NOT NULL,
+    reason           NVARCHAR(500)                                  NOT NULL DEFAULT '',
+    issued_by        CHAR(27)                                       NOT NULL,
+    created_at       DATETIME(3)                                    NOT NULL,
+    expires_at       DATETIME(3)                                    NULL,
+    revoked_at       DATETIME(3)                                    NULL,
+    revoked_by       CHAR(27)                                       NULL,
+    PRIMARY KEY (sanction_id),
+    INDEX (impart_wealth_id, revoked_at, expires_at),
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE,
+    FOREIGN KEY (issued_by) REFERENCES user (impart_wealth_id),
+    FOREIGN KEY (revoked_by) REFERENCES user (impart_wealth_id)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
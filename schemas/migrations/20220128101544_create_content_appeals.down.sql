@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS content_appeals;
+DROP TABLE IF EXISTS moderation_decisions;
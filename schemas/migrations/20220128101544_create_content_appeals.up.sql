@@ -0,0 +1,57 @@
+-- 
+-- moderation_decisions
+-- 
+-- The moderation history of a post or comment, a row for every review of reported or held
+-- content and for every resolved appeal. comment_id is null for decisions on the post itself.
+
+CREATE TABLE IF NOT EXISTS moderation_decisions (
+    decision_id    BIGINT UNSIGNED AUTO_INCREMENT                                  NOT NULL,
+    post_id        BIGINT UNSIGNED                                                 NOT NULL,
+    comment_id     BIGINT UNSIGNED                                                 NULL,
+    action         ENUM ('approved', 'removed', 'appeal_granted', 'appeal_denied') NOT NULL,
+    review_comment NVARCHAR(500)                                                   NOT NULL DEFAULT '',
+    moderator_id   CHAR(27)                                                        NOT NULL,
+    created_at     DATETIME(3)                                                     NOT NULL,
+    PRIMARY KEY (decision_id),
+    INDEX (post_id, comment_id, decision_id),
+    FOREIGN KEY (post_id) REFERENCES post (post_id) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (comment_id) ON DELETE CASCADE,
+    FOREIGN KEY (moderator_id) REFERENCES user (impart_wealth_id)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
+
+-- 
+-- content_appeals
+-- 
+-- An author's appeal of a removal, one per removal. It is resolved by a moderator other than
+-- the one who removed the content.
+
+CREATE TABLE IF NOT EXISTS content_appeals (
+    appeal_id          BIGINT UNSIGNED AUTO_INCREMENT                NOT NULL,
+    decision_id        BIGINT UNSIGNED                               NOT NULL,
+    post_id            BIGINT UNSIGNED                               NOT NULL,
+    comment_id         BIGINT UNSIGNED                               NULL,
+    hive_id            BIGINT UNSIGNED                               NOT NULL,
+    impart_wealth_id   CHAR(27)                                      NOT NULL,
+    message            NVARCHAR(1000)                                NOT NULL,
+    status             ENUM ('pending', 'granted', 'denied')         NOT NULL DEFAULT 'pending',
+    created_at         DATETIME(3)                                   NOT NULL,
+    resolved_at        DATETIME(3)                                   NULL,
+    resolved_by        CHAR(27)                                      NULL,
+    resolution_comment NVARCHAR(500)                                 NOT NULL DEFAULT '',
+    PRIMARY KEY (appeal_id),
+    UNIQUE (decision_id),
+    INDEX (hive_id, status, appeal_id),
+    INDEX (post_id, comment_id),
+    FOREIGN KEY (decision_id) REFERENCES moderation_decisions (decision_id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES post (post_id) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (comment_id) ON DELETE CASCADE,
+    FOREIGN KEY (hive_id) REFERENCES hive (hive_id) ON DELETE CASCADE,
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES user (impart_wealth_id)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,23 @@
+-- 
+-- mentions
+-- 
+-- Members mentioned by @screenName in a post or comment, comment_id is null for mentions in the
+-- post itself. Only members of the hive who are not blocked are stored.
+
+CREATE TABLE IF NOT EXISTS mentions (
+    mention_id       BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+    post_id          BIGINT UNSIGNED                NOT NULL,
+    comment_id       BIGINT UNSIGNED                NULL,
+    impart_wealth_id CHAR(27)                       NOT NULL,
+    created_at       DATETIME(3)                    NOT NULL,
+    PRIMARY KEY (mention_id),
+    INDEX (post_id, comment_id),
+    INDEX (comment_id),
+    INDEX (impart_wealth_id),
+    FOREIGN KEY (post_id) REFERENCES post (post_id) ON DELETE CASCADE,
+    FOREIGN KEY (comment_id) REFERENCES comment (comment_id) ON DELETE CASCADE,
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
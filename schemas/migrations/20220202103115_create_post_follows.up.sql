@@ -0,0 +1,18 @@
+-- 
+-- post_follows
+-- 
+-- Members following a post to be notified of new comments, members follow a post explicitly
+-- or by commenting on it.
+
+CREATE TABLE IF NOT EXISTS post_follows (
+    post_id          BIGINT UNSIGNED NOT NULL,
+    impart_wealth_id CHAR(27)        NOT NULL,
+    created_at       DATETIME(3)     NOT NULL,
+    PRIMARY KEY (post_id, impart_wealth_id),
+    INDEX (impart_wealth_id),
+    FOREIGN KEY (post_id) REFERENCES post (post_id) ON DELETE CASCADE,
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,5 @@
+ALTER TABLE post
+    DROP INDEX post_hive_hot_score,
+    DROP INDEX post_hive_popularity,
+    DROP COLUMN popularity,
+    DROP COLUMN hot_score;
@@ -0,0 +1,15 @@
+-- 
+-- post ranking scores, kept up to date whenever the votes or the comments of a post change
+-- 
+-- popularity weighs up votes, down votes and comments, hot_score decays it by the age of the post
+-- so a post needs ten times the popularity to outrank one posted 12.5 hours later.
+ALTER TABLE post
+    ADD COLUMN popularity INT    NOT NULL DEFAULT 0,
+    ADD COLUMN hot_score  DOUBLE NOT NULL DEFAULT 0,
+    ADD INDEX post_hive_hot_score (hive_id, hot_score),
+    ADD INDEX post_hive_popularity (hive_id, popularity);
+
+UPDATE post
+SET popularity = up_vote_count - down_vote_count + 2 * comment_count,
+    hot_score  = SIGN(popularity) * LOG10(GREATEST(ABS(popularity), 1)) +
+                 TIMESTAMPDIFF(SECOND, '2021-01-01', created_at) / 45000;
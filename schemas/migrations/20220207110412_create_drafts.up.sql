@@ -0,0 +1,24 @@
+-- 
+-- drafts
+-- 
+-- Unpublished posts and comments of a member, payload is the post or comment as the client
+-- sent it including staged attachments. hive_id is set for post drafts, post_id for comment drafts.
+
+CREATE TABLE IF NOT EXISTS drafts (
+    draft_id         BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+    impart_wealth_id CHAR(27)                       NOT NULL,
+    kind             ENUM ('post','comment')        NOT NULL,
+    hive_id          BIGINT UNSIGNED                NULL,
+    post_id          BIGINT UNSIGNED                NULL,
+    payload          JSON                           NOT NULL,
+    created_at       DATETIME(3)                    NOT NULL,
+    updated_at       DATETIME(3)                    NOT NULL,
+    PRIMARY KEY (draft_id),
+    INDEX (impart_wealth_id, updated_at),
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE,
+    FOREIGN KEY (hive_id) REFERENCES hive (hive_id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES post (post_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,9 @@
+ALTER TABLE files
+    DROP FOREIGN KEY files_owner_fk,
+    DROP INDEX files_owner_status,
+    DROP COLUMN impart_wealth_id,
+    DROP COLUMN storage_key,
+    DROP COLUMN size,
+    DROP COLUMN status,
+    DROP COLUMN created_at,
+    DROP COLUMN expires_at;
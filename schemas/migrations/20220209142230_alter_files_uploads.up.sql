@@ -0,0 +1,15 @@
+-- 
+-- direct uploads
+-- 
+-- Files uploaded straight to the storage with a signed url. The slot is created pending for its
+-- owner, and attached once a post references it and the stored object matches the declared
+-- type and size. Files saved before direct uploads have no owner and are attached.
+ALTER TABLE files
+    ADD COLUMN impart_wealth_id CHAR(27)                                 NULL,
+    ADD COLUMN storage_key      NVARCHAR(512)                            NULL,
+    ADD COLUMN size             BIGINT UNSIGNED                          NOT NULL DEFAULT 0,
+    ADD COLUMN status           ENUM ('pending','uploaded','attached')   NOT NULL DEFAULT 'attached',
+    ADD COLUMN created_at       DATETIME(3)                              NULL,
+    ADD COLUMN expires_at       DATETIME(3)                              NULL,
+    ADD INDEX files_owner_status (impart_wealth_id, status),
+    ADD FOREIGN KEY files_owner_fk (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE;
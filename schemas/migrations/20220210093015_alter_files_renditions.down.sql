@@ -0,0 +1,7 @@
+ALTER TABLE files
+    DROP INDEX files_processing_status,
+    DROP COLUMN thumbnail_url,
+    DROP COLUMN medium_url,
+    DROP COLUMN processing_status,
+    DROP COLUMN processed_at,
+    MODIFY COLUMN url NVARCHAR(255) NOT NULL;
@@ -0,0 +1,13 @@
+-- 
+-- file renditions
+-- 
+-- Uploaded images are processed in the background, the metadata is stripped and a thumbnail
+-- and a medium rendition are stored next to the original. Files stored before processing
+-- existed are left as they are and marked ready.
+ALTER TABLE files
+    MODIFY COLUMN url           NVARCHAR(1024)                                   NOT NULL,
+    ADD COLUMN thumbnail_url     NVARCHAR(1024)                                   NULL,
+    ADD COLUMN medium_url        NVARCHAR(1024)                                   NULL,
+    ADD COLUMN processing_status ENUM ('pending','processing','ready','failed')   NOT NULL DEFAULT 'ready',
+    ADD COLUMN processed_at      DATETIME(3)                                      NULL,
+    ADD INDEX files_processing_status (processing_status, processed_at);
@@ -0,0 +1,24 @@
+-- 
+-- data_exports
+-- 
+-- Copies of the data of a member, built in the background into a zip kept in the media storage.
+-- The file is deleted once the export expires, the row is kept so the member sees it expired.
+
+CREATE TABLE IF NOT EXISTS data_exports (
+    export_id        BIGINT UNSIGNED AUTO_INCREMENT                           NOT NULL,
+    impart_wealth_id CHAR(27)                                                 NOT NULL,
+    status           ENUM ('pending','processing','ready','failed','expired') NOT NULL DEFAULT 'pending',
+    storage_key      NVARCHAR(512)                                            NULL,
+    size             BIGINT UNSIGNED                                          NOT NULL DEFAULT 0,
+    created_at       DATETIME(3)                                              NOT NULL,
+    started_at       DATETIME(3)                                              NULL,
+    completed_at     DATETIME(3)                                              NULL,
+    expires_at       DATETIME(3)                                              NULL,
+    PRIMARY KEY (export_id),
+    INDEX (impart_wealth_id, created_at),
+    INDEX (status, expires_at),
+    FOREIGN KEY (impart_wealth_id) REFERENCES user (impart_wealth_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
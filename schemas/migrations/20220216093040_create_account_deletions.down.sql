@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS account_deletion_steps;
+DROP TABLE IF EXISTS account_deletions;
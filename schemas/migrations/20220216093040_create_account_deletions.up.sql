@@ -0,0 +1,46 @@
+-- 
+-- account_deletions
+-- 
+-- Requests to delete an account. The account is purged once the grace period ends unless the
+-- request is cancelled first. The row outlives the user so it has no foreign key, the email and
+-- authentication id are kept until the purge completes to reach the external services.
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    deletion_id       BIGINT UNSIGNED AUTO_INCREMENT                                NOT NULL,
+    impart_wealth_id  CHAR(27)                                                      NOT NULL,
+    authentication_id NVARCHAR(50)                                                  NOT NULL,
+    email             NVARCHAR(320)                                                 NOT NULL,
+    status            ENUM ('pending','cancelled','purging','purged','failed')      NOT NULL DEFAULT 'pending',
+    requested_by      CHAR(27)                                                      NOT NULL,
+    feedback          NVARCHAR(1000)                                                NULL,
+    created_at        DATETIME(3)                                                   NOT NULL,
+    purge_after       DATETIME(3)                                                   NOT NULL,
+    cancelled_at      DATETIME(3)                                                   NULL,
+    completed_at      DATETIME(3)                                                   NULL,
+    PRIMARY KEY (deletion_id),
+    INDEX (impart_wealth_id, status),
+    INDEX (status, purge_after)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
+
+-- 
+-- account_deletion_steps
+-- 
+-- The steps of a purge, each one is retried until it is done or runs out of attempts.
+
+CREATE TABLE IF NOT EXISTS account_deletion_steps (
+    deletion_id  BIGINT UNSIGNED                                           NOT NULL,
+    step         ENUM ('sns','plaid','mailchimp','media','auth0','mysql')  NOT NULL,
+    status       ENUM ('pending','done','failed')                          NOT NULL DEFAULT 'pending',
+    attempts     INT UNSIGNED                                              NOT NULL DEFAULT 0,
+    last_error   NVARCHAR(1000)                                            NULL,
+    updated_at   DATETIME(3)                                               NOT NULL,
+    completed_at DATETIME(3)                                               NULL,
+    PRIMARY KEY (deletion_id, step),
+    FOREIGN KEY (deletion_id) REFERENCES account_deletions (deletion_id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS marketing_sync_queue;
@@ -0,0 +1,25 @@
+-- 
+-- marketing_sync_queue
+-- 
+-- Changes waiting to be pushed to the marketing audience. A sync pushes the current state of the
+-- user, a remove takes the email out of the audience and outlives the user so it has no foreign
+-- key. Failed changes are retried with a backoff until they run out of attempts.
+
+CREATE TABLE IF NOT EXISTS marketing_sync_queue (
+    sync_id          BIGINT UNSIGNED AUTO_INCREMENT         NOT NULL,
+    action           ENUM ('sync','remove')                 NOT NULL,
+    impart_wealth_id CHAR(27)                               NULL,
+    email            NVARCHAR(320)                          NULL,
+    status           ENUM ('pending','done','failed')       NOT NULL DEFAULT 'pending',
+    attempts         INT UNSIGNED                           NOT NULL DEFAULT 0,
+    last_error       NVARCHAR(1000)                         NULL,
+    next_attempt_at  DATETIME(3)                            NOT NULL,
+    created_at       DATETIME(3)                            NOT NULL,
+    updated_at       DATETIME(3)                            NOT NULL,
+    PRIMARY KEY (sync_id),
+    INDEX (status, next_attempt_at),
+    INDEX (impart_wealth_id, status)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,17 @@
+-- 
+-- rate_limit_counters
+-- 
+-- Request counts of the rate limits shared by every instance, one row per key and fixed window.
+-- Expired windows are deleted by the scheduler.
+
+CREATE TABLE IF NOT EXISTS rate_limit_counters (
+    bucket_key   VARCHAR(191)  NOT NULL,
+    window_start DATETIME(3)   NOT NULL,
+    hits         INT UNSIGNED  NOT NULL DEFAULT 0,
+    expires_at   DATETIME(3)   NOT NULL,
+    PRIMARY KEY (bucket_key, window_start),
+    INDEX (expires_at)
+) DEFAULT CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci
+  ENGINE = InnoDB
+  ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,5 @@
+alter table moderation_decisions
+    modify column moderator_id CHAR(27) NOT NULL;
+
+alter table user_sanctions
+    modify column issued_by CHAR(27) NOT NULL;
@@ -0,0 +1,11 @@
+-- 
+-- user_sanctions, moderation_decisions
+-- 
+-- the moderator is cleared when their account is purged, the sanctions and decisions they made
+-- stay on the member's record and the content's history
+
+alter table user_sanctions
+    modify column issued_by CHAR(27) NULL;
+
+alter table moderation_decisions
+    modify column moderator_id CHAR(27) NULL;
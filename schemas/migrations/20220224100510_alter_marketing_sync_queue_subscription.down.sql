@@ -0,0 +1,6 @@
+update marketing_sync_queue
+set action = 'sync'
+where action = 'subscription';
+
+alter table marketing_sync_queue
+    modify column action ENUM ('sync','remove') NOT NULL;
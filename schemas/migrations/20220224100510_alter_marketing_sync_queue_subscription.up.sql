@@ -0,0 +1,8 @@
+-- 
+-- marketing_sync_queue
+-- 
+-- a subscription pushes the state of the user like a sync and also overwrites the status of the
+-- member, routine syncs leave the status to the audience so an unsubscribe made there is kept
+
+alter table marketing_sync_queue
+    modify column action ENUM ('sync','subscription','remove') NOT NULL;
@@ -0,0 +1,2 @@
+alter table mentions
+    drop column notified_at;
@@ -0,0 +1,11 @@
+-- 
+-- mentions
+-- 
+-- notified_at is set once the member is notified, mentions in held content are notified when
+-- the content is approved
+
+alter table mentions
+    add column notified_at DATETIME(3) NULL;
+
+update mentions
+set notified_at = created_at;
@@ -0,0 +1,2 @@
+ALTER TABLE post
+    DROP INDEX post_created_at;
@@ -0,0 +1,7 @@
+-- 
+-- post
+-- 
+-- the home feed of admins pages through the posts of every hive by creation date
+
+ALTER TABLE post
+    ADD INDEX post_created_at (created_at DESC, post_id DESC);